@@ -0,0 +1,199 @@
+package eulumies
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// distributionOperation describes a linear combination of two photometries: the absolute intensities are combined
+// with the intensity factors, the lamp flux and the power with the metadata factors.
+type distributionOperation struct {
+	intensityA, intensityB float64
+	metadataA, metadataB   float64
+}
+
+// Add returns the sum of both photometries, e.g. a twin-head fixture modeled from two modules. The absolute
+// intensities are added on the union of both angle grids after symmetry expansion, the lamp flux (the luminaire flux of
+// absolute photometry) and the power are added as well. The result keeps the metadata of e and has no symmetry. The
+// light output ratio, the downward flux fraction and the direct ratios are recalculated.
+func (e Eulumdat) Add(other PhotometricData) (*Eulumdat, error) {
+	return e.combine(other, distributionOperation{intensityA: 1, intensityB: 1, metadataA: 1, metadataB: 1})
+}
+
+// Subtract returns the photometry with the absolute intensities of the baseline subtracted, e.g. a dark or stray
+// light measurement. Negative results are clamped to zero, lamp flux and power of e are kept. See Add.
+func (e Eulumdat) Subtract(baseline PhotometricData) (*Eulumdat, error) {
+	return e.combine(baseline, distributionOperation{intensityA: 1, intensityB: -1, metadataA: 1})
+}
+
+// Blend returns the weighted mean of both photometries, a weight of 0 yields e and 1 yields the other photometry.
+// Intensities, lamp flux and power are interpolated. See Add.
+func (e Eulumdat) Blend(other PhotometricData, weight float64) (*Eulumdat, error) {
+	if weight < 0 || weight > 1 {
+		return nil, fmt.Errorf("blend weight %g out of range (0 - 1)", weight)
+	}
+
+	return e.combine(other, distributionOperation{intensityA: 1 - weight, intensityB: weight,
+		metadataA: 1 - weight, metadataB: weight})
+}
+
+// combine returns a copy of e with the distribution and lamp data combined with the other photometry.
+func (e Eulumdat) combine(other PhotometricData, operation distributionOperation) (*Eulumdat, error) {
+	cAngles, gAngles, planes, err := combineDistributions(e, other, operation)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := CopyEulumdat(e)
+	if err != nil {
+		return nil, err
+	}
+	otherPhotometry := other.normalizedPhotometry()
+	lampFlux := operation.metadataA*e.lampFlux() + operation.metadataB*otherPhotometry.lampFlux
+	if e.IsAbsolutePhotometry() {
+		lampFlux = zonalFlux(cAngles, gAngles, planes)
+	}
+	if lampFlux <= 0 {
+		return nil, errors.New("combined photometry emits no luminous flux")
+	}
+	if len(result.TotalLuminousFluxLamps) == 0 {
+		result.NumberStandardSetLamps = 1
+		result.NumberLamps = []int{1}
+		result.TypeLamps = []string{""}
+		result.TotalLuminousFluxLamps = []float64{1000}
+		result.ColorTemperature = []string{""}
+		result.ColorRenderingIndexCRI = []string{""}
+		result.BallastWatts = []float64{0}
+	}
+	if err := result.Scale(lampFlux / result.lampFlux()); err != nil {
+		return nil, err
+	}
+	if len(result.BallastWatts) > 0 {
+		result.BallastWatts[0] = operation.metadataA*result.BallastWatts[0] +
+			operation.metadataB*otherPhotometry.inputWatts
+	}
+
+	result.SymmetryIndicator = 0
+	if len(cAngles) == 1 {
+		result.SymmetryIndicator = 1
+		result.TypeIndicator = 1
+	} else if result.TypeIndicator == 1 {
+		result.TypeIndicator = 3
+	}
+	result.NumberMcCPlanes = len(cAngles)
+	result.AnglesC = cAngles
+	result.DistanceDcCPlanes = angleDistance(cAngles)
+	result.NumberNgIntensitiesCPlane = len(gAngles)
+	result.AnglesG = gAngles
+	result.DistanceDgCPlane = angleDistance(gAngles)
+
+	scale := result.intensityScale(0)
+	result.LuminousIntensityDistributionRaw = nil
+	for _, plane := range scalePlanes(planes, 1/scale) {
+		result.LuminousIntensityDistributionRaw = append(result.LuminousIntensityDistributionRaw, plane...)
+	}
+	result.calcMc1andMc2()
+	if err := result.CalcLuminousIntensityDistributionFromRaw(); err != nil {
+		return nil, err
+	}
+
+	if !result.IsAbsolutePhotometry() {
+		result.LightOutputRatioLuminaire = result.ComputeLightOutputRatio()
+	}
+	result.DownwardFluxFractionPhiu = 100 * (1 - result.ComputeUpwardLightRatio())
+	result.DirectRatios = result.ComputeDirectRatios()
+
+	return &result, nil
+}
+
+// Add returns the sum of both photometries, e.g. a twin-head fixture modeled from two modules. The absolute candela
+// values are added on the union of both angle grids after symmetry expansion, the lamp lumens and the input watts are
+// added as well. The result keeps the keywords of i and covers the full circle of horizontal angles. Type A and B
+// photometry is resampled with ToTypeC first.
+func (i *IES) Add(other PhotometricData) (*IES, error) {
+	return i.combine(other, distributionOperation{intensityA: 1, intensityB: 1, metadataA: 1, metadataB: 1})
+}
+
+// Subtract returns the photometry with the absolute candela values of the baseline subtracted, e.g. a dark or stray
+// light measurement. Negative results are clamped to zero, lamp lumens and input watts of i are kept. See Add.
+func (i *IES) Subtract(baseline PhotometricData) (*IES, error) {
+	return i.combine(baseline, distributionOperation{intensityA: 1, intensityB: -1, metadataA: 1})
+}
+
+// Blend returns the weighted mean of both photometries, a weight of 0 yields i and 1 yields the other photometry.
+// Candela values, lamp lumens and input watts are interpolated. See Add.
+func (i *IES) Blend(other PhotometricData, weight float64) (*IES, error) {
+	if weight < 0 || weight > 1 {
+		return nil, fmt.Errorf("blend weight %g out of range (0 - 1)", weight)
+	}
+
+	return i.combine(other, distributionOperation{intensityA: 1 - weight, intensityB: weight,
+		metadataA: 1 - weight, metadataB: weight})
+}
+
+// combine returns a copy of i with the distribution and lamp data combined with the other photometry.
+func (i *IES) combine(other PhotometricData, operation distributionOperation) (*IES, error) {
+	cAngles, gAngles, planes, err := combineDistributions(i, other, operation)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := i.ToTypeC(TypeCOptions{})
+	if err != nil {
+		return nil, err
+	}
+	otherPhotometry := other.normalizedPhotometry()
+	if !result.IsAbsolutePhotometry() && result.NumberLamps > 0 {
+		lumens := operation.metadataA*result.lampLumens() + operation.metadataB*otherPhotometry.lampFlux
+		result.LumensPerLamp = lumens / float64(result.NumberLamps)
+	}
+	result.InputWatts = operation.metadataA*result.InputWatts + operation.metadataB*otherPhotometry.inputWatts
+
+	scale := result.candelaScale()
+	if scale <= 0 {
+		return nil, errors.New("candela multiplier must be positive")
+	}
+	if len(cAngles) > 1 {
+		// IES closes full circle measurements with the 360 degree plane
+		cAngles = append(cAngles, 360)
+		planes = append(planes, planes[0])
+	}
+	result.HorizontalAngles = cAngles
+	result.VerticalAngles = gAngles
+	result.CandelaValues = scalePlanes(planes, 1/scale)
+	result.NumberHorizontalAngles = len(cAngles)
+	result.NumberVerticalAngles = len(gAngles)
+
+	return result, nil
+}
+
+// combineDistributions returns the linear combination of the absolute intensities of both photometries on the union
+// of their angle grids, the C-planes start at 0 degrees. Negative intensities are clamped to zero.
+func combineDistributions(a, b PhotometricData, operation distributionOperation) ([]float64, []float64, [][]float64,
+	error) {
+	photometryA := a.normalizedPhotometry()
+	photometryB := b.normalizedPhotometry()
+	if len(photometryA.planes) == 0 || len(photometryB.planes) == 0 {
+		return nil, nil, nil, errors.New("photometry contains no luminous intensity distribution")
+	}
+
+	cAngles := unionAngles(photometryA.cAngles, photometryB.cAngles)
+	if len(cAngles) > 1 {
+		cAngles = unionAngles(cAngles, []float64{0})
+	}
+	gAngles := unionAngles(photometryA.gAngles, photometryB.gAngles)
+	planes := make([][]float64, len(cAngles))
+	for n, c := range cAngles {
+		planes[n] = make([]float64, len(gAngles))
+		for m, g := range gAngles {
+			intensity := operation.intensityA*
+				interpolateIntensity(photometryA.cAngles, photometryA.gAngles, photometryA.planes, c, g) +
+				operation.intensityB*
+					interpolateIntensity(photometryB.cAngles, photometryB.gAngles, photometryB.planes, c, g)
+			planes[n][m] = math.Max(0, intensity)
+		}
+	}
+
+	return cAngles, gAngles, planes, nil
+}
@@ -0,0 +1,87 @@
+package eulumies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEulumdat_Add(t *testing.T) {
+	eulumdat := loadSample2(t)
+	flux := eulumdat.ComputeTotalFlux()
+	peak := eulumdat.IntensityFunc()(0, 0)
+
+	sum, err := eulumdat.Add(eulumdat)
+	require.NoError(t, err)
+	assert.InDelta(t, 2*flux, sum.ComputeTotalFlux(), 0.01*flux)
+	assert.InDelta(t, 2*peak, sum.IntensityFunc()(0, 0), 1e-6*peak)
+	assert.InDelta(t, 2*eulumdat.TotalLuminousFluxLamps[0], sum.TotalLuminousFluxLamps[0], 1e-9)
+	assert.InDelta(t, 2*eulumdat.BallastWatts[0], sum.BallastWatts[0], 1e-9)
+	assert.InDelta(t, eulumdat.ComputeLightOutputRatio(), sum.LightOutputRatioLuminaire, 0.5)
+	assert.Equal(t, 0, sum.SymmetryIndicator)
+	assert.Empty(t, sum.ValidateChecks(CheckAngleMonotonicity))
+	assert.Equal(t, loadSample2(t).LuminousIntensityDistribution, eulumdat.LuminousIntensityDistribution)
+
+	ies, err := NewIES("test/sample.ies", false)
+	require.NoError(t, err)
+	mixed, err := eulumdat.Add(ies)
+	require.NoError(t, err)
+	assert.InDelta(t, flux+ies.ComputeTotalFlux(), mixed.ComputeTotalFlux(), 0.02*(flux+ies.ComputeTotalFlux()))
+}
+
+func TestEulumdat_SubtractAndBlend(t *testing.T) {
+	eulumdat := loadSample2(t)
+	peak := eulumdat.IntensityFunc()(0, 0)
+	baseline, err := CopyEulumdat(eulumdat)
+	require.NoError(t, err)
+	require.NoError(t, baseline.Scale(0.25))
+
+	difference, err := eulumdat.Subtract(baseline)
+	require.NoError(t, err)
+	assert.InDelta(t, 0.75*peak, difference.IntensityFunc()(0, 0), 1e-6*peak)
+	assert.Equal(t, eulumdat.TotalLuminousFluxLamps[0], difference.TotalLuminousFluxLamps[0])
+
+	difference, err = baseline.Subtract(eulumdat)
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, difference.IntensityFunc()(0, 0))
+
+	blend, err := eulumdat.Blend(baseline, 0.5)
+	require.NoError(t, err)
+	assert.InDelta(t, 0.625*peak, blend.IntensityFunc()(0, 0), 1e-6*peak)
+	assert.InDelta(t, 0.625*eulumdat.TotalLuminousFluxLamps[0], blend.TotalLuminousFluxLamps[0], 1e-9)
+
+	_, err = eulumdat.Blend(baseline, 1.5)
+	assert.Error(t, err)
+	_, err = eulumdat.Add(Eulumdat{})
+	assert.Error(t, err)
+}
+
+func TestIES_Add(t *testing.T) {
+	ies, err := NewIES("test/sample.ies", false)
+	require.NoError(t, err)
+	flux := ies.ComputeTotalFlux()
+
+	sum, err := ies.Add(ies)
+	require.NoError(t, err)
+	assert.InDelta(t, 2*flux, sum.ComputeTotalFlux(), 0.01*flux)
+	assert.InDelta(t, 2*ies.lampLumens(), sum.lampLumens(), 1e-9)
+	assert.Equal(t, []float64{0}, sum.HorizontalAngles)
+	assert.Equal(t, ies.Keywords, sum.Keywords)
+	assert.Empty(t, sum.ValidateChecks(CheckAngleMonotonicity))
+
+	difference, err := sum.Subtract(ies)
+	require.NoError(t, err)
+	assert.InDelta(t, flux, difference.ComputeTotalFlux(), 0.01*flux)
+
+	mixed, err := ies.Add(loadSample2(t))
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, mixed.HorizontalAngles[0])
+	assert.Equal(t, 360.0, mixed.HorizontalAngles[len(mixed.HorizontalAngles)-1])
+	assert.Equal(t, mixed.CandelaValues[0], mixed.CandelaValues[len(mixed.CandelaValues)-1])
+
+	blend, err := ies.Blend(lambertianTypeAB(2, -90), 1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, blend.PhotometricType)
+	assert.InDelta(t, 1000, blend.IntensityFunc()(0, 0), 1)
+}
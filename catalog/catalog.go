@@ -0,0 +1,191 @@
+// Package catalog builds indexes of photometric file libraries, the starting point of product catalog integrations.
+// Every EULUMDAT and IES file below a directory is parsed and summarized in an Entry, the index is written as JSON or
+// CSV.
+//
+// The module supports Go versions without io/fs, so the index is built from the operating system file system with
+// Build. Files of other sources (archives, object storage, embedded files) are indexed one by one with Read.
+package catalog
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/h44z/eulumies"
+)
+
+// Entry is the index entry of one photometric file.
+type Entry struct {
+	Path          string  `json:"path"`
+	Format        string  `json:"format"` // EULUMDAT or the IES format version
+	Name          string  `json:"name"`
+	Manufacturer  string  `json:"manufacturer"`
+	CatalogNumber string  `json:"catalogNumber"`
+	Flux          float64 `json:"flux"`      // luminaire flux (lm)
+	Watts         float64 `json:"watts"`     // power including ballast (W)
+	BeamAngle     float64 `json:"beamAngle"` // mean beam angle of both principal planes (degrees)
+	Fingerprint   string  `json:"fingerprint"`
+	Quality       int     `json:"quality"`         // quality score 0 - 100, see eulumies.QualityScore
+	Error         string  `json:"error,omitempty"` // the file could not be parsed, all other fields except Path are empty
+}
+
+// Options controls the building of an index.
+type Options struct {
+	// SkipErrors leaves files that cannot be parsed out of the index instead of adding entries with Error set.
+	SkipErrors bool
+	// Progress is called after each indexed file, the total is the number of photometric files found.
+	Progress eulumies.ProgressFunc
+}
+
+// Build walks the directory tree below root and indexes all .ldt and .ies files, optionally gzip compressed (.gz).
+// The paths of the entries are relative to root and use forward slashes, the entries are sorted by path. Files that
+// cannot be parsed are reported in their entry, the returned error is set if the tree cannot be walked.
+func Build(root string, opts Options) ([]Entry, error) {
+	var paths []string
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.Mode().IsRegular() && Format(path) != "" {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	entries := make([]Entry, 0, len(paths))
+	for n, path := range paths {
+		name, err := filepath.Rel(root, path)
+		if err != nil {
+			name = path
+		}
+		entry := readFile(path)
+		entry.Path = filepath.ToSlash(name)
+		if entry.Error == "" || !opts.SkipErrors {
+			entries = append(entries, entry)
+		}
+		if opts.Progress != nil {
+			opts.Progress(eulumies.Progress{Done: n + 1, Total: len(paths), File: path})
+		}
+	}
+
+	return entries, nil
+}
+
+// Format returns the format (ldt or ies) of the file selected by its extension, an empty string for other files.
+func Format(path string) string {
+	switch strings.ToLower(filepath.Ext(strings.TrimSuffix(strings.ToLower(path), ".gz"))) {
+	case ".ldt":
+		return "ldt"
+	case ".ies":
+		return "ies"
+	default:
+		return ""
+	}
+}
+
+// readFile returns the entry of the file, errors are recorded in the entry.
+func readFile(path string) Entry {
+	file, err := os.Open(path)
+	if err != nil {
+		return Entry{Path: path, Error: err.Error()}
+	}
+	defer file.Close()
+
+	entry, err := Read(path, file)
+	if err != nil {
+		return Entry{Path: path, Error: err.Error()}
+	}
+
+	return entry
+}
+
+// Read parses the photometric data of the reader and returns its entry. The format is selected by the extension of
+// the path (see Format), the data may be gzip compressed.
+func Read(path string, in io.Reader) (Entry, error) {
+	switch Format(path) {
+	case "ldt":
+		eulumdat, err := eulumies.NewEulumdat(in, false)
+		if err != nil {
+			return Entry{}, err
+		}
+		entry := newEntry(path, eulumdat.Info(), eulumdat)
+		entry.Flux = eulumdat.ComputeTotalFlux()
+		entry.Fingerprint = eulumdat.Fingerprint()
+		entry.Quality = eulumdat.QualityScore().Score
+		return entry, nil
+	case "ies":
+		ies, err := eulumies.NewIESFromReader(in, false)
+		if err != nil {
+			return Entry{}, err
+		}
+		entry := newEntry(path, ies.Info(), ies)
+		entry.Flux = ies.ComputeTotalFlux()
+		entry.Fingerprint = ies.Fingerprint()
+		entry.Quality = ies.QualityScore().Score
+		return entry, nil
+	default:
+		return Entry{}, errors.New("unknown format of " + path + ", expected .ldt or .ies")
+	}
+}
+
+// newEntry returns the entry with the catalog data and the beam angle of the photometry.
+func newEntry(path string, info eulumies.PhotometryInfo, data eulumies.PhotometricData) Entry {
+	return Entry{
+		Path:          path,
+		Format:        info.Format,
+		Name:          info.Name,
+		Manufacturer:  info.Manufacturer,
+		CatalogNumber: info.CatalogNumber,
+		Watts:         info.Power,
+		BeamAngle:     (eulumies.BeamAngle(data, 0) + eulumies.BeamAngle(data, 90)) / 2,
+	}
+}
+
+// WriteJSON writes the entries as indented JSON array.
+func WriteJSON(out io.Writer, entries []Entry) error {
+	if entries == nil {
+		entries = []Entry{}
+	}
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(entries)
+}
+
+// csvHeader are the column names of the CSV index.
+var csvHeader = []string{"path", "format", "name", "manufacturer", "catalog_number", "flux", "watts", "beam_angle",
+	"fingerprint", "quality", "error"}
+
+// WriteCSV writes the entries as CSV with a header line. Numbers are written with two decimal places.
+func WriteCSV(out io.Writer, entries []Entry) error {
+	writer := csv.NewWriter(out)
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		record := []string{entry.Path, entry.Format, entry.Name, entry.Manufacturer, entry.CatalogNumber,
+			formatNumber(entry.Flux), formatNumber(entry.Watts), formatNumber(entry.BeamAngle), entry.Fingerprint,
+			strconv.Itoa(entry.Quality), entry.Error}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+
+	return writer.Error()
+}
+
+// formatNumber formats the value with two decimal places.
+func formatNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', 2, 64)
+}
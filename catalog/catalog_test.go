@@ -0,0 +1,104 @@
+package catalog
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/h44z/eulumies"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testLibrary returns a directory with photometric files and a text file, it is removed by the returned function.
+func testLibrary(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "catalog")
+	require.NoError(t, err)
+	for source, target := range map[string]string{
+		"sample2.ldt":                  "ldt/sample2.ldt",
+		"sample.ies":                   "ies/nested/sample.IES",
+		"DT106.XTM10.N.84.61 - S1.ies": "ies/broken.ies",
+	} {
+		data, err := ioutil.ReadFile(filepath.Join("../test", source))
+		require.NoError(t, err)
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, target)), 0755))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, target), data, 0644))
+	}
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "readme.txt"), []byte("no photometry"), 0644))
+
+	return dir, func() { os.RemoveAll(dir) }
+}
+
+func TestBuild(t *testing.T) {
+	dir, cleanup := testLibrary(t)
+	defer cleanup()
+
+	var progress []eulumies.Progress
+	entries, err := Build(dir, Options{Progress: func(p eulumies.Progress) { progress = append(progress, p) }})
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	assert.Len(t, progress, 3)
+	assert.Equal(t, eulumies.Progress{Done: 3, Total: 3, File: filepath.Join(dir, "ldt/sample2.ldt")}, progress[2])
+
+	assert.Equal(t, "ies/broken.ies", entries[0].Path)
+	assert.NotEmpty(t, entries[0].Error)
+	assert.Empty(t, entries[0].Fingerprint)
+
+	ies := entries[1]
+	assert.Equal(t, "ies/nested/sample.IES", ies.Path)
+	assert.Equal(t, "LM-63-1995", ies.Format)
+	assert.Equal(t, "A SUPER LAMP", ies.Name)
+	assert.Equal(t, "Sample Company", ies.Manufacturer)
+	assert.Greater(t, ies.Flux, 0.0)
+	assert.Greater(t, ies.BeamAngle, 0.0)
+	assert.Len(t, ies.Fingerprint, 64)
+	assert.Equal(t, 98, ies.Quality)
+
+	ldt := entries[2]
+	assert.Equal(t, "ldt/sample2.ldt", ldt.Path)
+	assert.Equal(t, eulumies.PhotometryInfoFormatEulumdat, ldt.Format)
+	assert.Equal(t, 3.19, ldt.Watts)
+	assert.Empty(t, ldt.Error)
+
+	entries, err = Build(dir, Options{SkipErrors: true})
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+
+	_, err = Build(filepath.Join(dir, "missing"), Options{})
+	assert.Error(t, err)
+}
+
+func TestRead(t *testing.T) {
+	_, err := Read("photometry.txt", strings.NewReader(""))
+	assert.Error(t, err)
+	assert.Equal(t, "ldt", Format("LAMP.LDT.gz"))
+}
+
+func TestWrite(t *testing.T) {
+	entries := []Entry{
+		{Path: "a.ldt", Format: "EULUMDAT", Name: "Lamp, round", Flux: 1234.567, Watts: 10, BeamAngle: 60.25,
+			Quality: 87},
+		{Path: "b.ies", Error: "invalid"},
+	}
+
+	var buffer bytes.Buffer
+	require.NoError(t, WriteCSV(&buffer, entries))
+	assert.Equal(t, "path,format,name,manufacturer,catalog_number,flux,watts,beam_angle,fingerprint,quality,error\n"+
+		"a.ldt,EULUMDAT,\"Lamp, round\",,,1234.57,10.00,60.25,,87,\n"+
+		"b.ies,,,,,0.00,0.00,0.00,,0,invalid\n", buffer.String())
+
+	buffer.Reset()
+	require.NoError(t, WriteJSON(&buffer, entries))
+	var decoded []Entry
+	require.NoError(t, json.Unmarshal(buffer.Bytes(), &decoded))
+	assert.Equal(t, entries, decoded)
+	assert.Contains(t, buffer.String(), `"beamAngle": 60.25`)
+
+	buffer.Reset()
+	require.NoError(t, WriteJSON(&buffer, nil))
+	assert.Equal(t, "[]\n", buffer.String())
+}
@@ -0,0 +1,336 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/h44z/eulumies"
+)
+
+// conversionJob converts a single input file to the given output file.
+type conversionJob struct {
+	input  string
+	output string
+	skip   string // reason why the file is not converted
+	err    error
+}
+
+func runConvert(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("convert", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	from := flags.String("from", "", "format ldt or ies of the data read from standard input")
+	target := flags.String("to", "", "target format ldt or ies, defaults to the other format of each input file")
+	outDir := flags.String("out", "", "output directory, defaults to the directory of the input files")
+	parallel := flags.Int("parallel", runtime.NumCPU(), "number of files converted in parallel")
+	preserveSymmetry := flags.Bool("preserve-symmetry", false, "encode the symmetry of the distribution in the target file")
+	showProgress := flags.Bool("progress", false, "report each converted file on standard error")
+	provenance := flags.Bool("provenance", false, "record the source file, library version, options and time in the converted file")
+	keywordTemplates := keywordFlags{}
+	flags.Var(keywordTemplates, "keyword", "IES keyword as KEY=TEMPLATE, a Go template over the metadata of the "+
+		"source file like {{.Name}} or {{.File}} (repeatable)")
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "usage: eulumies convert [flags] <file|directory|glob>...")
+		fmt.Fprintln(stderr, "       eulumies convert -from ldt|ies [-to ldt|ies] < input > output")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if !validFormat(*target) || !validFormat(*from) || (flags.NArg() == 0 && *from == "") {
+		flags.Usage()
+		return 2
+	}
+	opts := eulumies.ConversionOptions{PreserveSymmetry: *preserveSymmetry, Provenance: *provenance}
+	var keywords *eulumies.KeywordTemplate
+	if len(keywordTemplates) > 0 {
+		var err error
+		if keywords, err = eulumies.NewKeywordTemplate(keywordTemplates); err != nil {
+			fmt.Fprintln(stderr, err)
+			return 2
+		}
+	}
+
+	if flags.NArg() == 0 || (flags.NArg() == 1 && flags.Arg(0) == "-") {
+		if *from == "" || *target == *from {
+			fmt.Fprintln(stderr, "converting standard input requires -from and a different -to format")
+			return 2
+		}
+		if err := convertStream(stdin, stdout, *from, opts, keywords); err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+
+		return 0
+	}
+
+	jobs, err := conversionJobs(flags.Args(), *outDir, *target)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	var progress eulumies.ProgressFunc
+	if *showProgress {
+		progress = func(p eulumies.Progress) {
+			fmt.Fprintf(stderr, "[%d/%d] %s\n", p.Done, p.Total, p.File)
+		}
+	}
+	convertParallel(jobs, *parallel, opts, keywords, progress)
+
+	var converted, skipped, failed int
+	for _, job := range jobs {
+		switch {
+		case job.err != nil:
+			failed++
+			fmt.Fprintf(stdout, "failed:    %s: %v\n", job.input, job.err)
+		case job.skip != "":
+			skipped++
+			fmt.Fprintf(stdout, "skipped:   %s: %s\n", job.input, job.skip)
+		default:
+			converted++
+			fmt.Fprintf(stdout, "converted: %s -> %s\n", job.input, job.output)
+		}
+	}
+	fmt.Fprintf(stdout, "%d converted, %d skipped, %d failed\n", converted, skipped, failed)
+
+	if failed > 0 {
+		return 1
+	}
+
+	return 0
+}
+
+// conversionJobs expands the given files, directories and glob patterns to conversion jobs sorted by input path.
+// The directory structure below a directory argument (or the fixed part of a glob pattern) is preserved in the
+// output directory.
+func conversionJobs(args []string, outDir, target string) ([]*conversionJob, error) {
+	var jobs []*conversionJob
+	seen := make(map[string]bool)
+	add := func(base, path string) error {
+		if seen[path] {
+			return nil
+		}
+		seen[path] = true
+
+		job := &conversionJob{input: path}
+		jobs = append(jobs, job)
+		format := fileFormat(path)
+		switch {
+		case format == "":
+			job.skip = "not a photometric file"
+			return nil
+		case format == target:
+			job.skip = "already in target format"
+			return nil
+		}
+
+		output, err := outputPath(base, path, outDir)
+		if err != nil {
+			return err
+		}
+		job.output = output
+
+		return nil
+	}
+
+	for _, arg := range args {
+		if strings.ContainsAny(arg, "*?[") {
+			matches, err := filepath.Glob(arg)
+			if err != nil {
+				return nil, err
+			}
+			if len(matches) == 0 {
+				return nil, errors.New("no files match " + arg)
+			}
+			base := globBase(arg)
+			for _, match := range matches {
+				if info, err := os.Stat(match); err == nil && info.IsDir() {
+					continue
+				}
+				if err := add(base, match); err != nil {
+					return nil, err
+				}
+			}
+			continue
+		}
+
+		info, err := os.Stat(arg)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			if err := add(filepath.Dir(arg), arg); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		err = filepath.Walk(arg, func(path string, info os.FileInfo, err error) error {
+			if err != nil || info.IsDir() {
+				return err
+			}
+			if fileFormat(path) == "" {
+				// only report unsupported files that were requested explicitly
+				return nil
+			}
+			return add(arg, path)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].input < jobs[j].input
+	})
+
+	return jobs, nil
+}
+
+// outputPath returns the path of the converted file. The path relative to base is kept below outDir, or below base
+// if no output directory is given.
+func outputPath(base, path, outDir string) (string, error) {
+	relative, err := filepath.Rel(base, path)
+	if err != nil {
+		return "", err
+	}
+	directory := base
+	if outDir != "" {
+		directory = outDir
+	}
+	name := strings.TrimSuffix(relative, filepath.Ext(relative))
+	if strings.HasSuffix(strings.ToLower(path), ".gz") {
+		name = strings.TrimSuffix(name, filepath.Ext(name))
+	}
+
+	return filepath.Join(directory, name+"."+otherFormat(fileFormat(path))), nil
+}
+
+// globBase returns the directory part of a glob pattern in front of the first wildcard.
+func globBase(pattern string) string {
+	prefix := pattern[:strings.IndexAny(pattern, "*?[")]
+
+	return filepath.Dir(prefix + "x")
+}
+
+// validFormat reports whether the format is empty, ldt or ies.
+func validFormat(format string) bool {
+	return format == "" || format == "ldt" || format == "ies"
+}
+
+// otherFormat returns ies for ldt and ldt for ies.
+func otherFormat(format string) string {
+	if format == "ldt" {
+		return "ies"
+	}
+
+	return "ldt"
+}
+
+// convertParallel converts all jobs that are not skipped using the given number of workers. The optional keyword
+// templates are applied to IES results, the optional progress function is called after each conversion.
+func convertParallel(jobs []*conversionJob, workers int, opts eulumies.ConversionOptions,
+	keywords *eulumies.KeywordTemplate, progress eulumies.ProgressFunc) {
+	if workers < 1 {
+		workers = 1
+	}
+
+	var pending []*conversionJob
+	for _, job := range jobs {
+		if job.skip == "" {
+			pending = append(pending, job)
+		}
+	}
+
+	queue := make(chan int)
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	done := 0
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range queue {
+				job := pending[index]
+				data := eulumies.KeywordTemplateData{File: filepath.Base(job.input), Index: index}
+				job.err = convertFile(job.input, job.output, opts, keywords, data)
+				if progress != nil {
+					mutex.Lock()
+					done++
+					progress(eulumies.Progress{Done: done, Total: len(pending), File: job.input})
+					mutex.Unlock()
+				}
+			}
+		}()
+	}
+	for index := range pending {
+		queue <- index
+	}
+	close(queue)
+	wg.Wait()
+}
+
+// convertFile converts the input file to the other format and writes it to the output path. The keyword templates
+// are executed with the given data and the metadata of the input file.
+func convertFile(input, output string, opts eulumies.ConversionOptions, keywords *eulumies.KeywordTemplate,
+	data eulumies.KeywordTemplateData) error {
+	file, err := loadFile(input)
+	if err != nil {
+		return err
+	}
+	opts.SourceFile = filepath.Base(input)
+	converted, err := file.convert(opts)
+	if err != nil {
+		return err
+	}
+	if err := converted.applyKeywords(keywords, file, data); err != nil {
+		return err
+	}
+	if converted.eulumdat != nil {
+		converted.eulumdat.SetFileName(output) // keep the file name field consistent with the written file
+	}
+	if err := os.MkdirAll(filepath.Dir(output), 0755); err != nil {
+		return err
+	}
+
+	out, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	if err := converted.write(out, eulumies.ExportOptions{}); err != nil {
+		return err
+	}
+
+	return out.Close()
+}
+
+// convertStream converts the data of the input in the given format and writes the result to the output.
+func convertStream(in io.Reader, out io.Writer, format string, opts eulumies.ConversionOptions,
+	keywords *eulumies.KeywordTemplate) error {
+	file, err := loadReader(in, format)
+	if err != nil {
+		return err
+	}
+	converted, err := file.convert(opts)
+	if err != nil {
+		return err
+	}
+	if err := converted.applyKeywords(keywords, file, eulumies.KeywordTemplateData{File: "-"}); err != nil {
+		return err
+	}
+
+	buffered := bufio.NewWriter(out)
+	if err := converted.write(buffered, eulumies.ExportOptions{}); err != nil {
+		return err
+	}
+
+	return buffered.Flush()
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// copyTestFile copies a file of the test directory to the given path below dir.
+func copyTestFile(t *testing.T, name, dir, path string) {
+	data, err := ioutil.ReadFile(filepath.Join("../../test", name))
+	assert.NoError(t, err)
+	assert.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, path)), 0755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, path), data, 0644))
+}
+
+func TestRunConvert(t *testing.T) {
+	dir, err := ioutil.TempDir("", "eulumies")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	input := filepath.Join(dir, "in")
+	copyTestFile(t, "sample2.ldt", input, "ldt/sample2.ldt")
+	copyTestFile(t, "sample.ies", input, "ies/nested/sample.ies")
+	copyTestFile(t, "DT106.XTM10.N.84.61 - S1.ies", input, "ies/broken.ies")
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(input, "readme.txt"), []byte("no photometry"), 0644))
+
+	var stdout, stderr bytes.Buffer
+	output := filepath.Join(dir, "out")
+	code := run([]string{"convert", "-out", output, "-parallel", "2", input}, &stdout, &stderr)
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stdout.String(), "2 converted, 0 skipped, 1 failed")
+	assert.Contains(t, stdout.String(), "failed:    "+filepath.Join(input, "ies/broken.ies"))
+	assert.FileExists(t, filepath.Join(output, "ldt/sample2.ies"))
+	assert.FileExists(t, filepath.Join(output, "ies/nested/sample.ldt"))
+	converted, err := loadFile(filepath.Join(output, "ies/nested/sample.ldt"))
+	assert.NoError(t, err)
+	assert.Equal(t, "sample", converted.eulumdat.FileName)
+
+	stdout.Reset()
+	stderr.Reset()
+	code = run([]string{"convert", "-progress", "-to", "ies", filepath.Join(input, "ldt/*"), filepath.Join(input, "*.txt")}, &stdout, &stderr)
+	assert.Equal(t, 0, code)
+	assert.Contains(t, stdout.String(), "1 converted, 1 skipped, 0 failed")
+	assert.FileExists(t, filepath.Join(input, "ldt/sample2.ies"))
+	assert.Equal(t, "[1/1] "+filepath.Join(input, "ldt/sample2.ldt")+"\n", stderr.String())
+
+	assert.Equal(t, 2, run([]string{"convert", "-to", "pdf", input}, &stdout, &stderr))
+	assert.Equal(t, 1, run([]string{"convert", filepath.Join(dir, "missing")}, &stdout, &stderr))
+}
+
+func Test_globBase(t *testing.T) {
+	assert.Equal(t, ".", globBase("*.ldt"))
+	assert.Equal(t, "a", globBase("a/*.ldt"))
+	assert.Equal(t, "a", globBase("a/b*/c.ldt"))
+}
+
+func TestRunConvert_Stdin(t *testing.T) {
+	data, err := ioutil.ReadFile("../../test/sample2.ldt")
+	assert.NoError(t, err)
+	defer func() { stdin = os.Stdin }()
+
+	var stdout, stderr bytes.Buffer
+	stdin = bytes.NewReader(data)
+	assert.Equal(t, 0, run([]string{"convert", "--from", "ldt", "--to", "ies"}, &stdout, &stderr))
+	assert.Empty(t, stderr.String())
+	assert.True(t, strings.HasPrefix(stdout.String(), "IESNA:LM-63-2002\r\n"))
+
+	converted := stdout.Bytes()
+	stdout = bytes.Buffer{}
+	stdin = bytes.NewReader(converted)
+	assert.Equal(t, 0, run([]string{"convert", "-from", "ies", "-"}, &stdout, &stderr))
+	assert.Contains(t, stdout.String(), "A SUPER LAMP 2")
+
+	stdin = bytes.NewReader(data)
+	assert.Equal(t, 1, run([]string{"convert", "-from", "ies"}, &stdout, &stderr))
+	assert.Equal(t, 2, run([]string{"convert", "-from", "ldt", "-to", "ldt"}, &stdout, &stderr))
+}
+
+func TestRunConvert_Keyword(t *testing.T) {
+	dir, err := ioutil.TempDir("", "eulumies")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	copyTestFile(t, "sample2.ldt", dir, "sample2.ldt")
+
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"convert", "-to", "ies", "-keyword", "MANUFAC=ACME", "-keyword",
+		"OTHER=source {{.File}}", filepath.Join(dir, "sample2.ldt")}, &stdout, &stderr)
+	assert.Equal(t, 0, code, stderr.String())
+	data, err := ioutil.ReadFile(filepath.Join(dir, "sample2.ies"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), "[MANUFAC] ACME")
+	assert.Contains(t, string(data), "[OTHER] source sample2.ldt")
+
+	assert.Equal(t, 2, run([]string{"convert", "-keyword", "OTHER={{.File", dir}, &stdout, &stderr))
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/h44z/eulumies"
+)
+
+// runDiff compares two photometric files. Like diff(1) it exits with 0 if the files match, 1 if they differ and
+// 2 on errors.
+func runDiff(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("diff", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	tolerance := flags.Float64("tolerance", 0.01, "allowed intensity deviation relative to the peak intensity")
+	normalize := flags.Bool("normalize", false, "compare the distributions normalized to 1000 lm of luminaire flux")
+	ignoreMetadata := flags.Bool("ignore-metadata", false, "only fail on distribution deviations")
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "usage: eulumies diff [flags] <file a> <file b>")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 2 || *tolerance <= 0 {
+		flags.Usage()
+		return 2
+	}
+
+	var files [2]photometricFile
+	for i, path := range flags.Args() {
+		file, err := loadFile(path)
+		if err != nil {
+			fmt.Fprintf(stderr, "%s: %v\n", path, err)
+			return 2
+		}
+		files[i] = file
+	}
+
+	comparison := eulumies.ComparePhotometries(files[0].data(), files[1].data(), eulumies.CompareOptions{
+		Tolerance: *tolerance,
+		Normalize: *normalize,
+	})
+	unit := "cd"
+	if *normalize {
+		unit = "cd/klm"
+	}
+
+	if len(comparison.Metadata) > 0 {
+		fmt.Fprintln(stdout, "metadata differences:")
+		for _, difference := range comparison.Metadata {
+			fmt.Fprintf(stdout, "  %s: %q != %q\n", difference.Field, difference.A, difference.B)
+		}
+	}
+	fmt.Fprintln(stdout, "distribution:")
+	fmt.Fprintf(stdout, "  compared directions: %d\n", comparison.ComparedDirections)
+	fmt.Fprintf(stdout, "  peak intensity:      %.1f %s / %.1f %s\n",
+		comparison.PeakIntensityA, unit, comparison.PeakIntensityB, unit)
+	fmt.Fprintf(stdout, "  max deviation:       %.2f %s (%.2f %%) at C%g G%g\n", comparison.MaxDeviation, unit,
+		comparison.RelativeMaxDeviation*100, comparison.MaxDeviationC, comparison.MaxDeviationGamma)
+	fmt.Fprintf(stdout, "  rms deviation:       %.2f %s\n", comparison.RMSDeviation, unit)
+	fmt.Fprintf(stdout, "  exceeding tolerance: %.1f %% of the directions (tolerance %g %%)\n",
+		comparison.ExceedingPercentage, *tolerance*100)
+
+	switch {
+	case !comparison.DistributionsMatching:
+		fmt.Fprintln(stdout, "distributions differ")
+		return 1
+	case len(comparison.Metadata) > 0 && !*ignoreMetadata:
+		fmt.Fprintln(stdout, "metadata differs")
+		return 1
+	default:
+		fmt.Fprintln(stdout, "files match")
+		return 0
+	}
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/h44z/eulumies"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunDiff(t *testing.T) {
+	dir, err := ioutil.TempDir("", "eulumies")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	converted := filepath.Join(dir, "sample2.ies")
+	assert.NoError(t, convertFile("../../test/sample2.ldt", converted, eulumies.ConversionOptions{}, nil,
+		eulumies.KeywordTemplateData{}))
+
+	var stdout, stderr bytes.Buffer
+	assert.Equal(t, 0, run([]string{"diff", "../../test/sample2.ldt", converted}, &stdout, &stderr))
+	assert.Contains(t, stdout.String(), "files match")
+
+	stdout.Reset()
+	assert.Equal(t, 1, run([]string{"diff", "-normalize", "../../test/sample2.ldt", "../../test/sample.ldt"}, &stdout, &stderr))
+	assert.Contains(t, stdout.String(), `Luminaire: "A SUPER LAMP 2" != "A SUPER LAMP"`)
+	assert.Contains(t, stdout.String(), "distributions differ")
+
+	assert.Equal(t, 2, run([]string{"diff", "../../test/sample2.ldt"}, &stdout, &stderr))
+	assert.Equal(t, 2, run([]string{"diff", "../../test/sample2.ldt", "../../test/missing.ies"}, &stdout, &stderr))
+}
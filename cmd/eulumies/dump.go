@@ -0,0 +1,128 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/h44z/eulumies"
+)
+
+// structureDump is the JSON representation of a parsed file, exactly one of both formats is set.
+type structureDump struct {
+	Format   string             `json:"format"`
+	Eulumdat *eulumies.Eulumdat `json:"eulumdat,omitempty"`
+	IES      *eulumies.IES      `json:"ies,omitempty"`
+}
+
+// intensityMatrix holds the absolute luminous intensities (cd) on the angle grid of the file, one row per C-plane.
+type intensityMatrix struct {
+	Unit        string      `json:"unit"`
+	C           []float64   `json:"c"`
+	Gamma       []float64   `json:"gamma"`
+	Intensities [][]float64 `json:"intensities"`
+}
+
+func runDump(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("dump", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	format := flags.String("format", "json", "output format json or csv (csv always writes the intensity matrix)")
+	matrix := flags.Bool("matrix", false, "write the intensity matrix (cd) instead of the parsed structure")
+	from := flags.String("from", "", "format ldt or ies of the data read from standard input")
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "usage: eulumies dump [flags] <file>")
+		fmt.Fprintln(stderr, "       eulumies dump -from ldt|ies [flags] < input")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if (*format != "json" && *format != "csv") || !validFormat(*from) || flags.NArg() > 1 ||
+		(flags.NArg() == 0 && *from == "") {
+		flags.Usage()
+		return 2
+	}
+
+	var file photometricFile
+	var err error
+	if flags.NArg() == 0 || flags.Arg(0) == "-" {
+		file, err = loadReader(stdin, *from)
+	} else {
+		file, err = loadFile(flags.Arg(0))
+	}
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	switch {
+	case *format == "csv":
+		err = writeMatrixCSV(stdout, file.intensityMatrix())
+	case *matrix:
+		err = writeJSON(stdout, file.intensityMatrix())
+	default:
+		err = writeJSON(stdout, structureDump{Format: file.formatName(), Eulumdat: file.eulumdat, IES: file.ies})
+	}
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	return 0
+}
+
+// intensityMatrix samples the absolute intensities at the C-plane and gamma angles of the file.
+func (f photometricFile) intensityMatrix() intensityMatrix {
+	matrix := intensityMatrix{Unit: "cd"}
+	if f.eulumdat != nil {
+		matrix.C, matrix.Gamma = f.eulumdat.AnglesC, f.eulumdat.AnglesG
+	} else {
+		matrix.C, matrix.Gamma = f.ies.HorizontalAngles, f.ies.VerticalAngles
+	}
+
+	intensity := f.photometry().IntensityFunc()
+	matrix.Intensities = make([][]float64, len(matrix.C))
+	for i, c := range matrix.C {
+		matrix.Intensities[i] = make([]float64, len(matrix.Gamma))
+		for j, gamma := range matrix.Gamma {
+			matrix.Intensities[i][j] = intensity(c, gamma)
+		}
+	}
+
+	return matrix
+}
+
+func writeJSON(out io.Writer, value interface{}) error {
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(value)
+}
+
+// writeMatrixCSV writes one row per gamma angle and one column per C-plane, the header row holds the C angles.
+func writeMatrixCSV(out io.Writer, matrix intensityMatrix) error {
+	writer := csv.NewWriter(out)
+	header := []string{"gamma"}
+	for _, c := range matrix.C {
+		header = append(header, "C"+strconv.FormatFloat(c, 'f', -1, 64))
+	}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for j, gamma := range matrix.Gamma {
+		row := []string{strconv.FormatFloat(gamma, 'f', -1, 64)}
+		for i := range matrix.C {
+			row = append(row, strconv.FormatFloat(matrix.Intensities[i][j], 'f', -1, 64))
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+
+	return writer.Error()
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunDump(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	assert.Equal(t, 0, run([]string{"dump", "../../test/sample2.ldt"}, &stdout, &stderr))
+	var structure structureDump
+	assert.NoError(t, json.Unmarshal(stdout.Bytes(), &structure))
+	assert.Equal(t, "EULUMDAT", structure.Format)
+	assert.Equal(t, "A SUPER LAMP 2", structure.Eulumdat.LuminaireName)
+	assert.Nil(t, structure.IES)
+
+	stdout.Reset()
+	assert.Equal(t, 0, run([]string{"dump", "-matrix", "../../test/sample.ies"}, &stdout, &stderr))
+	var matrix intensityMatrix
+	assert.NoError(t, json.Unmarshal(stdout.Bytes(), &matrix))
+	assert.Equal(t, "cd", matrix.Unit)
+	assert.Len(t, matrix.Intensities, len(matrix.C))
+	assert.Len(t, matrix.Intensities[0], len(matrix.Gamma))
+
+	stdout.Reset()
+	assert.Equal(t, 0, run([]string{"dump", "-format", "csv", "../../test/sample2.ldt"}, &stdout, &stderr))
+	records, err := csv.NewReader(&stdout).ReadAll()
+	assert.NoError(t, err)
+	assert.Len(t, records, 1+181)
+	assert.Len(t, records[0], 1+72)
+	assert.Equal(t, []string{"gamma", "C0", "C5"}, records[0][:3])
+	assert.Equal(t, "0", records[1][0])
+
+	data, err := ioutil.ReadFile("../../test/sample.ies")
+	assert.NoError(t, err)
+	defer func() { stdin = os.Stdin }()
+	stdin = bytes.NewReader(data)
+	stdout.Reset()
+	assert.Equal(t, 0, run([]string{"dump", "-from", "ies"}, &stdout, &stderr))
+	assert.Contains(t, stdout.String(), `"format": "IES LM-63"`)
+
+	assert.Equal(t, 2, run([]string{"dump", "-format", "xml", "../../test/sample2.ldt"}, &stdout, &stderr))
+	assert.Equal(t, 2, run([]string{"dump"}, &stdout, &stderr))
+}
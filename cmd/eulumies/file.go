@@ -0,0 +1,165 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/h44z/eulumies"
+	"github.com/h44z/eulumies/plot"
+)
+
+// photometricFile holds a parsed EULUMDAT or IES file, exactly one of both is set.
+type photometricFile struct {
+	path     string
+	eulumdat *eulumies.Eulumdat
+	ies      *eulumies.IES
+}
+
+// loadFile parses the given file, the format is selected by the file extension (.ldt or .ies, optionally .gz).
+func loadFile(path string) (photometricFile, error) {
+	format := fileFormat(path)
+	if format == "" {
+		return photometricFile{}, errors.New("unsupported file extension: " + filepath.Ext(path))
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return photometricFile{}, err
+	}
+	defer file.Close()
+
+	photometry, err := loadReader(file, format)
+	photometry.path = path
+
+	return photometry, err
+}
+
+// loadReader parses the data of the given reader in the given format (ldt or ies).
+func loadReader(in io.Reader, format string) (photometricFile, error) {
+	switch format {
+	case "ldt":
+		eulumdat, err := eulumies.NewEulumdat(in, false)
+		if err != nil {
+			return photometricFile{}, err
+		}
+
+		return photometricFile{eulumdat: &eulumdat}, nil
+	case "ies":
+		ies, err := eulumies.NewIESFromReader(in, false)
+		if err != nil {
+			return photometricFile{}, err
+		}
+
+		return photometricFile{ies: ies}, nil
+	default:
+		return photometricFile{}, errors.New("unsupported format: " + format)
+	}
+}
+
+// fileFormat returns ldt or ies depending on the file extension, an empty string for other files.
+func fileFormat(path string) string {
+	switch strings.ToLower(filepath.Ext(strings.TrimSuffix(path, ".gz"))) {
+	case ".ldt":
+		return "ldt"
+	case ".ies":
+		return "ies"
+	default:
+		return ""
+	}
+}
+
+func (f photometricFile) photometry() plot.Photometry {
+	if f.eulumdat != nil {
+		return *f.eulumdat
+	}
+
+	return f.ies
+}
+
+// luminaireName returns the luminaire name of the EULUMDAT file or the LUMINAIRE keyword of the IES file.
+func (f photometricFile) luminaireName() string {
+	if f.eulumdat != nil {
+		return f.eulumdat.LuminaireName
+	}
+
+	return f.ies.Keywords["LUMINAIRE"]
+}
+
+// formatName returns a human readable name of the file format.
+func (f photometricFile) formatName() string {
+	if f.eulumdat != nil {
+		return "EULUMDAT"
+	}
+
+	return "IES LM-63"
+}
+
+// validate returns the issues of a strict validation.
+func (f photometricFile) validate() eulumies.ValidationIssues {
+	if f.eulumdat != nil {
+		return f.eulumdat.Validate(true)
+	}
+
+	return f.ies.Validate(true)
+}
+
+// convert converts the file to the other format.
+func (f photometricFile) convert(opts eulumies.ConversionOptions) (photometricFile, error) {
+	if f.eulumdat != nil {
+		ies, err := eulumies.ConvertEulumdatToIES(f.eulumdat, opts)
+		if err != nil {
+			return photometricFile{}, err
+		}
+
+		return photometricFile{ies: ies}, nil
+	}
+
+	eulumdat, err := eulumies.ConvertIESToEulumdat(f.ies, opts)
+	if err != nil {
+		return photometricFile{}, err
+	}
+
+	return photometricFile{eulumdat: eulumdat}, nil
+}
+
+// applyKeywords sets the templated keywords of converted IES data, the templates are executed with the metadata of
+// the source file. EULUMDAT data is left unchanged.
+func (f photometricFile) applyKeywords(keywords *eulumies.KeywordTemplate, source photometricFile,
+	data eulumies.KeywordTemplateData) error {
+	if keywords == nil || f.ies == nil {
+		return nil
+	}
+
+	if source.eulumdat != nil {
+		data.PhotometryInfo = source.eulumdat.Info()
+	} else {
+		data.PhotometryInfo = source.ies.Info()
+	}
+	issues, err := keywords.Apply(f.ies, data, eulumies.KeywordOverflowSplit)
+	if err != nil {
+		return err
+	}
+
+	return issues.Err()
+}
+
+// write exports the file to the given output.
+func (f photometricFile) write(out io.StringWriter, opts eulumies.ExportOptions) error {
+	if f.eulumdat != nil {
+		return f.eulumdat.ExportWithOptions(out, opts)
+	}
+
+	return f.ies.ExportTo(out, opts)
+}
+
+// data returns the photometric data for format independent processing.
+func (f photometricFile) data() eulumies.PhotometricData {
+	if f.eulumdat != nil {
+		return *f.eulumdat
+	}
+
+	return f.ies
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/h44z/eulumies"
+	"github.com/h44z/eulumies/catalog"
+)
+
+func runIndex(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("index", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	format := flags.String("format", "json", "output format json or csv")
+	output := flags.String("out", "", "output file, defaults to standard output")
+	skipErrors := flags.Bool("skip-errors", false, "leave files that cannot be parsed out of the index")
+	showProgress := flags.Bool("progress", false, "report each indexed file on standard error")
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "usage: eulumies index [flags] <directory>")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if (*format != "json" && *format != "csv") || flags.NArg() != 1 {
+		flags.Usage()
+		return 2
+	}
+
+	opts := catalog.Options{SkipErrors: *skipErrors}
+	if *showProgress {
+		opts.Progress = func(progress eulumies.Progress) {
+			fmt.Fprintf(stderr, "[%d/%d] %s\n", progress.Done, progress.Total, progress.File)
+		}
+	}
+	entries, err := catalog.Build(flags.Arg(0), opts)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	if *output == "" {
+		err = writeIndex(stdout, *format, entries)
+	} else {
+		err = writeIndexFile(*output, *format, entries)
+	}
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	return 0
+}
+
+// writeIndex writes the entries in the given format (json or csv).
+func writeIndex(out io.Writer, format string, entries []catalog.Entry) error {
+	if format == "csv" {
+		return catalog.WriteCSV(out, entries)
+	}
+
+	return catalog.WriteJSON(out, entries)
+}
+
+// writeIndexFile writes the entries in the given format to the file.
+func writeIndexFile(path, format string, entries []catalog.Entry) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	if err := writeIndex(file, format, entries); err != nil {
+		return err
+	}
+
+	return file.Close()
+}
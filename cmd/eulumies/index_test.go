@@ -0,0 +1,35 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunIndex(t *testing.T) {
+	dir, err := ioutil.TempDir("", "eulumies")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	copyTestFile(t, "sample2.ldt", dir, "lib/sample2.ldt")
+	copyTestFile(t, "sample.ies", dir, "lib/ies/sample.ies")
+
+	var stdout, stderr bytes.Buffer
+	assert.Equal(t, 0, run([]string{"index", "-format", "csv", filepath.Join(dir, "lib")}, &stdout, &stderr))
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	assert.Len(t, lines, 3)
+	assert.True(t, strings.HasPrefix(lines[1], "ies/sample.ies,LM-63-1995,A SUPER LAMP,"))
+
+	output := filepath.Join(dir, "index.json")
+	assert.Equal(t, 0, run([]string{"index", "-out", output, filepath.Join(dir, "lib")}, &stdout, &stderr))
+	data, err := ioutil.ReadFile(output)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"path": "sample2.ldt"`)
+
+	assert.Equal(t, 2, run([]string{"index", "-format", "xml", dir}, &stdout, &stderr))
+	assert.Equal(t, 1, run([]string{"index", filepath.Join(dir, "missing")}, &stdout, &stderr))
+}
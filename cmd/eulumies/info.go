@@ -0,0 +1,51 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/h44z/eulumies/plot"
+)
+
+func runInfo(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("info", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	width := flags.Int("width", 61, "width of the polar diagram in characters")
+	relative := flags.Bool("relative", false, "plot intensities in cd/klm")
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "usage: eulumies info [flags] <file>...")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return 2
+	}
+
+	exitCode := 0
+	for i, path := range flags.Args() {
+		if i > 0 {
+			fmt.Fprintln(stdout)
+		}
+		file, err := loadFile(path)
+		if err != nil {
+			fmt.Fprintf(stderr, "%s: %v\n", path, err)
+			exitCode = 1
+			continue
+		}
+
+		fmt.Fprintf(stdout, "File:      %s\n", path)
+		fmt.Fprintf(stdout, "Format:    %s\n", file.formatName())
+		fmt.Fprintf(stdout, "Luminaire: %s\n\n", strings.SplitN(file.luminaireName(), "\n", 2)[0])
+		if err := plot.PolarText(stdout, file.photometry(), plot.TextOptions{Width: *width, Relative: *relative}); err != nil {
+			fmt.Fprintf(stderr, "%s: %v\n", path, err)
+			exitCode = 1
+		}
+	}
+
+	return exitCode
+}
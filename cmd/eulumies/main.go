@@ -0,0 +1,60 @@
+// Command eulumies inspects EULUMDAT and IES photometric files.
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+)
+
+// command is a subcommand of the CLI, run returns the exit code.
+type command struct {
+	description string
+	run         func(args []string, stdout, stderr io.Writer) int
+}
+
+var commands = map[string]command{
+	"diff":    {"compare the metadata and distributions of two photometric files", runDiff},
+	"convert": {"convert files, directories or glob patterns between EULUMDAT and IES", runConvert},
+	"dump":    {"write the parsed structure or the intensity matrix as JSON or CSV", runDump},
+	"index":   {"write a JSON or CSV catalog index of all photometric files below a directory", runIndex},
+	"info":    {"print metadata, key metrics and a text polar diagram of photometric files", runInfo},
+	"set":     {"edit header fields and keywords of a photometric file", runSet},
+	"watch":   {"validate and convert photometric files dropped into a directory", runWatch},
+}
+
+// stdin is read by commands that process standard input, replaced in tests.
+var stdin io.Reader = os.Stdin
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		usage(stderr)
+		return 2
+	}
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "unknown command %q\n", args[0])
+		usage(stderr)
+		return 2
+	}
+
+	return cmd.run(args[1:], stdout, stderr)
+}
+
+func usage(out io.Writer) {
+	fmt.Fprintln(out, "usage: eulumies <command> [arguments]")
+	fmt.Fprintln(out, "\ncommands:")
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(out, "  %-8s %s\n", name, commands[name].description)
+	}
+}
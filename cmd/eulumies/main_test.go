@@ -0,0 +1,31 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	assert.Equal(t, 2, run(nil, &stdout, &stderr))
+	assert.Contains(t, stderr.String(), "usage: eulumies <command>")
+
+	stderr.Reset()
+	assert.Equal(t, 2, run([]string{"unknown"}, &stdout, &stderr))
+	assert.Contains(t, stderr.String(), `unknown command "unknown"`)
+}
+
+func TestRunInfo(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	assert.Equal(t, 0, run([]string{"info", "-width", "31", "../../test/sample2.ldt", "../../test/sample.ies"}, &stdout, &stderr))
+	assert.Empty(t, stderr.String())
+	assert.Contains(t, stdout.String(), "Luminaire: A SUPER LAMP 2")
+	assert.Contains(t, stdout.String(), "Format:    IES LM-63")
+	assert.Contains(t, stdout.String(), "Beam angle:")
+
+	stdout.Reset()
+	assert.Equal(t, 1, run([]string{"info", "../../test/missing.ldt"}, &stdout, &stderr))
+	assert.Contains(t, stderr.String(), "missing.ldt")
+}
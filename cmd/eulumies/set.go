@@ -0,0 +1,159 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/h44z/eulumies"
+)
+
+// keywordFlags collects repeated -keyword KEY=VALUE flags.
+type keywordFlags map[string]string
+
+func (k keywordFlags) String() string {
+	pairs := make([]string, 0, len(k))
+	for keyword, value := range k {
+		pairs = append(pairs, keyword+"="+value)
+	}
+
+	return strings.Join(pairs, ", ")
+}
+
+func (k keywordFlags) Set(pair string) error {
+	parts := strings.SplitN(pair, "=", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return errors.New("keyword must be given as KEY=VALUE")
+	}
+	k[strings.ToUpper(strings.Trim(parts[0], "[]"))] = parts[1]
+
+	return nil
+}
+
+// headerField is a header field that exists in both formats, as EULUMDAT field and IES keyword.
+type headerField struct {
+	flag        string
+	description string
+	keyword     string
+	eulumdat    func(e *eulumies.Eulumdat) *string
+}
+
+var headerFields = []headerField{
+	{"company", "company identification", "MANUFAC",
+		func(e *eulumies.Eulumdat) *string { return &e.CompanyIdentification }},
+	{"luminaire-name", "luminaire name", "LUMINAIRE",
+		func(e *eulumies.Eulumdat) *string { return &e.LuminaireName }},
+	{"luminaire-number", "luminaire (catalog) number", "LUMCAT",
+		func(e *eulumies.Eulumdat) *string { return &e.LuminaireNumber }},
+	{"report-number", "measurement report number", "TEST",
+		func(e *eulumies.Eulumdat) *string { return &e.MeasurementReportNumber }},
+	{"date", "date of the measurement or issue", "ISSUEDATE",
+		func(e *eulumies.Eulumdat) *string { return &e.DateUser }},
+}
+
+// runSet edits header fields and keywords of a photometric file. The edited file must pass the strict validation,
+// otherwise it is not written.
+func runSet(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("set", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	keywords := keywordFlags{}
+	flags.Var(keywords, "keyword", "IES keyword as KEY=VALUE, an empty value removes the keyword (repeatable)")
+	values := make([]*string, len(headerFields))
+	for i, field := range headerFields {
+		values[i] = flags.String(field.flag, "", field.description+" (IES keyword "+field.keyword+")")
+	}
+	output := flags.String("out", "", "write the edited file to this path instead of editing in place")
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "usage: eulumies set [flags] <file>")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return 2
+	}
+	path := flags.Arg(0)
+	if *output == "" {
+		*output = path
+	}
+
+	file, err := loadFile(path)
+	if err != nil {
+		fmt.Fprintf(stderr, "%s: %v\n", path, err)
+		return 1
+	}
+	if file.eulumdat != nil && len(keywords) > 0 {
+		fmt.Fprintf(stderr, "%s: EULUMDAT files have no keywords\n", path)
+		return 2
+	}
+
+	visited := make(map[string]bool)
+	flags.Visit(func(f *flag.Flag) {
+		visited[f.Name] = true
+	})
+	for i, field := range headerFields {
+		if !visited[field.flag] {
+			continue
+		}
+		if file.eulumdat != nil {
+			*field.eulumdat(file.eulumdat) = *values[i]
+		} else {
+			keywords[field.keyword] = *values[i]
+		}
+	}
+	for keyword, value := range keywords {
+		if value == "" {
+			delete(file.ies.Keywords, keyword)
+		} else {
+			file.ies.Keywords[keyword] = value
+		}
+	}
+
+	if issues := file.validate().Errors(); len(issues) > 0 {
+		for _, issue := range issues {
+			fmt.Fprintf(stderr, "%s: %s\n", path, issue)
+		}
+		return 1
+	}
+	if err := writeFile(file, *output); err != nil {
+		fmt.Fprintf(stderr, "%s: %v\n", *output, err)
+		return 1
+	}
+
+	return 0
+}
+
+// writeFile replaces the file at the given path atomically, files ending with .gz are compressed.
+func writeFile(file photometricFile, path string) error {
+	temp, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(temp.Name())
+	defer temp.Close()
+
+	mode := os.FileMode(0644)
+	if info, err := os.Stat(path); err == nil {
+		mode = info.Mode()
+	}
+	if err := temp.Chmod(mode); err != nil {
+		return err
+	}
+
+	opts := eulumies.ExportOptions{Gzip: strings.HasSuffix(strings.ToLower(path), ".gz")}
+	if err := file.write(temp, opts); err != nil {
+		return err
+	}
+	if err := temp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(temp.Name(), path)
+}
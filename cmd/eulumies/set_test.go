@@ -0,0 +1,51 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunSet(t *testing.T) {
+	dir, err := ioutil.TempDir("", "eulumies")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	copyTestFile(t, "sample.ies", dir, "sample.ies")
+	copyTestFile(t, "sample2.ldt", dir, "sample2.ldt")
+	ies := filepath.Join(dir, "sample.ies")
+	ldt := filepath.Join(dir, "sample2.ldt")
+
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"set", "--keyword", "MANUFAC=Acme", "-keyword", "[_SKU]=42", "--luminaire-name", "X200", ies}, &stdout, &stderr)
+	assert.Equal(t, 0, code, stderr.String())
+	edited, err := loadFile(ies)
+	assert.NoError(t, err)
+	assert.Equal(t, "Acme", edited.ies.Keywords["MANUFAC"])
+	assert.Equal(t, "42", edited.ies.Keywords["_SKU"])
+	assert.Equal(t, "X200", edited.ies.Keywords["LUMINAIRE"])
+
+	assert.Equal(t, 0, run([]string{"set", "-keyword", "_SKU=", ies}, &stdout, &stderr))
+	edited, err = loadFile(ies)
+	assert.NoError(t, err)
+	assert.NotContains(t, edited.ies.Keywords, "_SKU")
+
+	assert.Equal(t, 1, run([]string{"set", "-keyword", "UNKNOWN=1", ies}, &stdout, &stderr))
+	assert.Contains(t, stderr.String(), "UNKNOWN")
+
+	assert.Equal(t, 0, run([]string{"set", "-luminaire-name", "X200", "-date", "", ldt}, &stdout, &stderr))
+	edited, err = loadFile(ldt)
+	assert.NoError(t, err)
+	assert.Equal(t, "X200", edited.eulumdat.LuminaireName)
+	assert.Equal(t, "", edited.eulumdat.DateUser)
+	assert.Equal(t, "9-00939-02", edited.eulumdat.LuminaireNumber)
+
+	stderr.Reset()
+	long := string(bytes.Repeat([]byte("x"), 79))
+	assert.Equal(t, 1, run([]string{"set", "-luminaire-name", long, ldt}, &stdout, &stderr))
+	assert.Contains(t, stderr.String(), "LuminaireName")
+	assert.Equal(t, 2, run([]string{"set", "-keyword", "MANUFAC=Acme", ldt}, &stdout, &stderr))
+}
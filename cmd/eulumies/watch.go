@@ -0,0 +1,167 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"time"
+
+	"github.com/h44z/eulumies"
+)
+
+// fileState is used to detect new and modified files.
+type fileState struct {
+	size    int64
+	modTime time.Time
+}
+
+// watcher validates and converts photometric files that appear in a directory. The directory is polled, so no
+// platform specific file system notifications are required and network shares are supported as well.
+type watcher struct {
+	dir     string
+	outDir  string
+	target  string // ldt or ies, empty to convert to the other format
+	convert bool
+	opts    eulumies.ConversionOptions
+	out     io.Writer
+
+	seen     map[string]fileState // state of the previous scan
+	handled  map[string]fileState // state of the processed version
+	produced map[string]bool      // files written by the watcher
+}
+
+func runWatch(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("watch", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	interval := flags.Duration("interval", 2*time.Second, "polling interval")
+	target := flags.String("to", "", "target format ldt or ies, defaults to the other format of each file")
+	outDir := flags.String("out", "", "output directory of converted files, defaults to the watched directory")
+	validateOnly := flags.Bool("validate-only", false, "only validate new files, do not convert them")
+	existing := flags.Bool("existing", false, "also process files that exist when the watch starts")
+	preserveSymmetry := flags.Bool("preserve-symmetry", false, "encode the symmetry of the distribution in the target file")
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "usage: eulumies watch [flags] <directory>")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 1 || !validFormat(*target) {
+		flags.Usage()
+		return 2
+	}
+	if info, err := os.Stat(flags.Arg(0)); err != nil || !info.IsDir() {
+		fmt.Fprintf(stderr, "%s is not a directory\n", flags.Arg(0))
+		return 1
+	}
+
+	w := newWatcher(flags.Arg(0), stdout)
+	w.outDir = *outDir
+	w.target = *target
+	w.convert = !*validateOnly
+	w.opts = eulumies.ConversionOptions{PreserveSymmetry: *preserveSymmetry}
+	if !*existing {
+		w.skipExisting()
+	}
+	fmt.Fprintf(stdout, "watching %s\n", w.dir)
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-interrupt:
+			return 0
+		case <-ticker.C:
+			w.scan()
+		}
+	}
+}
+
+func newWatcher(dir string, out io.Writer) *watcher {
+	return &watcher{
+		dir:      dir,
+		convert:  true,
+		out:      out,
+		seen:     make(map[string]fileState),
+		handled:  make(map[string]fileState),
+		produced: make(map[string]bool),
+	}
+}
+
+// files returns the current state of all photometric files below the watched directory.
+func (w *watcher) files() map[string]fileState {
+	files := make(map[string]fileState)
+	_ = filepath.Walk(w.dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			// files may vanish while walking, they are picked up again by the next scan
+			return nil
+		}
+		if info.IsDir() || fileFormat(path) == "" || w.produced[path] {
+			return nil
+		}
+		files[path] = fileState{size: info.Size(), modTime: info.ModTime()}
+		return nil
+	})
+
+	return files
+}
+
+// skipExisting marks all files currently present as processed.
+func (w *watcher) skipExisting() {
+	for path, state := range w.files() {
+		w.seen[path] = state
+		w.handled[path] = state
+	}
+}
+
+// scan processes all files that are new or modified and did not change since the previous scan, so files that
+// are still being written are not picked up.
+func (w *watcher) scan() {
+	files := w.files()
+	for path, state := range files {
+		if w.seen[path] != state || w.handled[path] == state {
+			continue
+		}
+		w.handled[path] = state
+		w.process(path)
+	}
+	w.seen = files
+}
+
+// process validates the file and converts it to the target format.
+func (w *watcher) process(path string) {
+	file, err := loadFile(path)
+	if err != nil {
+		fmt.Fprintf(w.out, "failed:    %s: %v\n", path, err)
+		return
+	}
+
+	issues := file.validate()
+	for _, issue := range issues {
+		fmt.Fprintf(w.out, "issue:     %s: %s\n", path, issue)
+	}
+	if len(issues) == 0 {
+		fmt.Fprintf(w.out, "valid:     %s\n", path)
+	}
+	if !w.convert || fileFormat(path) == w.target {
+		return
+	}
+
+	output, err := outputPath(w.dir, path, w.outDir)
+	if err != nil {
+		fmt.Fprintf(w.out, "failed:    %s: %v\n", path, err)
+		return
+	}
+	w.produced[output] = true
+	if err := convertFile(path, output, w.opts, nil, eulumies.KeywordTemplateData{}); err != nil {
+		fmt.Fprintf(w.out, "failed:    %s: %v\n", path, err)
+		return
+	}
+	fmt.Fprintf(w.out, "converted: %s -> %s\n", path, output)
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatcher_scan(t *testing.T) {
+	dir, err := ioutil.TempDir("", "eulumies")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	copyTestFile(t, "sample.ldt", dir, "existing.ldt")
+	var out bytes.Buffer
+	w := newWatcher(dir, &out)
+	w.outDir = filepath.Join(dir, "converted")
+	w.skipExisting()
+
+	copyTestFile(t, "sample2.ldt", dir, "lab/new.ldt")
+	w.scan()
+	assert.Empty(t, out.String(), "new files are processed once they did not change between two scans")
+
+	w.scan()
+	assert.Contains(t, out.String(), "valid:     "+filepath.Join(dir, "lab/new.ldt"))
+	assert.Contains(t, out.String(), "converted: "+filepath.Join(dir, "lab/new.ldt"))
+	assert.FileExists(t, filepath.Join(dir, "converted/lab/new.ies"))
+	assert.NoFileExists(t, filepath.Join(dir, "converted/existing.ies"))
+
+	out.Reset()
+	w.scan()
+	w.scan()
+	assert.Empty(t, out.String())
+}
+
+func TestRunWatch_Arguments(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	assert.Equal(t, 2, run([]string{"watch"}, &stdout, &stderr))
+	assert.Equal(t, 1, run([]string{"watch", "../../test/missing"}, &stdout, &stderr))
+}
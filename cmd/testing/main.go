@@ -2,16 +2,18 @@ package main
 
 import (
 	"fmt"
+	"os"
+
 	"github.com/h44z/eulumies"
 )
 
 func main() {
-	eulumdat, err := eulumies.NewEulumdat("test/sample.ldt", false)
+	eulumdat, err := parseEulumdat("test/sample.ldt")
 	if err != nil {
 		fmt.Println("Error parsing ldt:", err)
 	} else {
 		fmt.Println("Parsed LDT:", eulumdat.CompanyIdentification)
-		err = eulumdat.Export("test/out.ldt")
+		err = exportEulumdat(eulumdat, "test/out.ldt")
 		if err != nil {
 			fmt.Println(err)
 		}
@@ -29,7 +31,7 @@ func main() {
 		}
 	}
 
-	ies2, err := eulumies.ConvertEulumdatToIES(eulumdat)
+	ies2, err := eulumies.ConvertEulumdatToIES(&eulumdat, eulumies.ConversionOptions{})
 	if err != nil {
 		fmt.Println(err)
 	} else {
@@ -40,3 +42,23 @@ func main() {
 		}
 	}
 }
+
+func parseEulumdat(path string) (eulumies.Eulumdat, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return eulumies.Eulumdat{}, err
+	}
+	defer file.Close()
+
+	return eulumies.NewEulumdat(file, false)
+}
+
+func exportEulumdat(eulumdat eulumies.Eulumdat, path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return eulumdat.Export(file)
+}
@@ -0,0 +1,36 @@
+// Thin JavaScript wrapper around eulumies.wasm. Include wasm_exec.js of the Go distribution
+// ($(go env GOROOT)/misc/wasm/wasm_exec.js) before this module.
+//
+//   const eulumies = await load("eulumies.wasm");
+//   const svg = eulumies.plot(new Uint8Array(await file.arrayBuffer()));
+
+// unwrap throws the errors returned by the Go functions as {error: message}.
+function unwrap(result) {
+  if (result !== null && typeof result === "object" && typeof result.error === "string") {
+    throw new Error(result.error);
+  }
+  return result;
+}
+
+// load instantiates the WebAssembly module and returns the API. The format arguments are "ldt", "ies" or
+// undefined to detect the format from the content, data is a Uint8Array or a string.
+export async function load(url = "eulumies.wasm") {
+  const go = new Go();
+  const response = fetch(url);
+  const { instance } = WebAssembly.instantiateStreaming
+    ? await WebAssembly.instantiateStreaming(response, go.importObject)
+    : await WebAssembly.instantiate(await (await response).arrayBuffer(), go.importObject);
+  go.run(instance);
+
+  const api = globalThis.eulumies;
+  return {
+    // parse returns {format, data} with the parsed EULUMDAT or IES structure.
+    parse: (data, format) => unwrap(api.parse(data, format)),
+    // convert returns the content of the converted file, to defaults to the other format.
+    convert: (data, from, to) => unwrap(api.convert(data, from, to)),
+    // validate returns the list of validation issues {code, severity, field, message}.
+    validate: (data, format, strict = false) => unwrap(api.validate(data, format, strict)),
+    // plot returns an SVG document, kind is "polar", "cartesian" or "butterfly".
+    plot: (data, format, kind = "polar") => unwrap(api.plot(data, format, kind)),
+  };
+}
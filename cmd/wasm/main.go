@@ -0,0 +1,198 @@
+//go:build js && wasm
+// +build js,wasm
+
+// Command wasm exposes parsing, conversion, validation and plotting to JavaScript, so browser based tools can
+// process photometric files client side. Build it with
+//
+//	GOOS=js GOARCH=wasm go build -o eulumies.wasm ./cmd/wasm
+//
+// and load it with eulumies.js and the wasm_exec.js support file of the Go distribution. All functions are
+// registered on the global eulumies object and accept the file content as Uint8Array or string.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"syscall/js"
+
+	"github.com/h44z/eulumies/plot"
+	"github.com/h44z/eulumies/service"
+)
+
+func main() {
+	api := js.Global().Get("Object").New()
+	api.Set("parse", function(parse))
+	api.Set("convert", function(convert))
+	api.Set("validate", function(validate))
+	api.Set("plot", function(plotSVG))
+	js.Global().Set("eulumies", api)
+
+	// keep the exported functions alive
+	select {}
+}
+
+// function wraps a Go implementation, errors are returned as {error: message} and thrown by the JS wrapper.
+func function(implementation func(args []js.Value) (interface{}, error)) js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		result, err := implementation(args)
+		if err != nil {
+			return map[string]interface{}{"error": err.Error()}
+		}
+
+		return result
+	})
+}
+
+// parse(data, format) returns the parsed structure, format is "ldt", "ies" or empty to detect the format.
+func parse(args []js.Value) (interface{}, error) {
+	photometry, err := load(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return toJS(photometry)
+}
+
+// convert(data, from, to) returns the converted file content as string.
+func convert(args []js.Value) (interface{}, error) {
+	data, err := dataArgument(args)
+	if err != nil {
+		return nil, err
+	}
+	response := service.Convert(service.ConvertRequest{
+		Data: data,
+		From: formatArgument(args, 1),
+		To:   formatArgument(args, 2),
+	})
+	if response.Error != "" {
+		return nil, errors.New(response.Error)
+	}
+
+	return string(response.Data), nil
+}
+
+// validate(data, format, strict) returns the list of validation issues.
+func validate(args []js.Value) (interface{}, error) {
+	data, err := dataArgument(args)
+	if err != nil {
+		return nil, err
+	}
+	strict := len(args) > 2 && args[2].Truthy()
+	issues, err := service.Validate(data, formatArgument(args, 1), strict)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]interface{}, len(issues))
+	for i, issue := range issues {
+		result[i] = map[string]interface{}{
+			"code":     string(issue.Code),
+			"severity": issue.Severity.String(),
+			"field":    issue.Field,
+			"message":  issue.Message,
+		}
+	}
+
+	return result, nil
+}
+
+// plot(data, format, kind) returns an SVG document, kind is "polar" (default), "cartesian" or "butterfly".
+func plotSVG(args []js.Value) (interface{}, error) {
+	photometry, err := load(args)
+	if err != nil {
+		return nil, err
+	}
+	var source plot.Photometry = photometry.IES
+	if photometry.Eulumdat != nil {
+		source = *photometry.Eulumdat
+	}
+
+	kind := ""
+	if len(args) > 2 && args[2].Type() == js.TypeString {
+		kind = args[2].String()
+	}
+	var out bytes.Buffer
+	switch kind {
+	case "", "polar":
+		err = plot.PolarSVG(&out, source, plot.PolarOptions{})
+	case "cartesian":
+		err = plot.CartesianSVG(&out, source, plot.CartesianOptions{})
+	case "butterfly":
+		err = plot.ButterflySVG(&out, source, plot.ButterflyOptions{})
+	default:
+		err = errors.New("unknown plot kind " + kind)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return out.String(), nil
+}
+
+// load parses the data (first argument) in the format of the second argument.
+func load(args []js.Value) (service.Photometry, error) {
+	data, err := dataArgument(args)
+	if err != nil {
+		return service.Photometry{}, err
+	}
+
+	return service.Parse(data, formatArgument(args, 1), false)
+}
+
+// dataArgument returns the file content of the first argument, a Uint8Array or a string.
+func dataArgument(args []js.Value) ([]byte, error) {
+	if len(args) == 0 {
+		return nil, errors.New("file content required")
+	}
+	switch {
+	case args[0].Type() == js.TypeString:
+		return []byte(args[0].String()), nil
+	case args[0].InstanceOf(js.Global().Get("Uint8Array")):
+		data := make([]byte, args[0].Get("length").Int())
+		js.CopyBytesToGo(data, args[0])
+		return data, nil
+	default:
+		return nil, errors.New("file content must be a Uint8Array or a string")
+	}
+}
+
+// formatArgument returns the format given as "ldt" or "ies" at the given argument position.
+func formatArgument(args []js.Value, index int) service.Format {
+	if len(args) <= index || args[index].Type() != js.TypeString {
+		return service.FormatUnspecified
+	}
+	switch args[index].String() {
+	case "ldt":
+		return service.FormatLDT
+	case "ies":
+		return service.FormatIES
+	default:
+		return service.FormatUnspecified
+	}
+}
+
+// toJS converts the parsed structure to a plain JavaScript object via its JSON representation.
+func toJS(photometry service.Photometry) (interface{}, error) {
+	var value interface{} = photometry.IES
+	if photometry.Eulumdat != nil {
+		value = photometry.Eulumdat
+	}
+	data, err := json.Marshal(struct {
+		Format string      `json:"format"`
+		Data   interface{} `json:"data"`
+	}{Format: formatName(photometry.Format), Data: value})
+	if err != nil {
+		return nil, err
+	}
+
+	return js.Global().Get("JSON").Call("parse", string(data)), nil
+}
+
+func formatName(format service.Format) string {
+	if format == service.FormatIES {
+		return "ies"
+	}
+
+	return "ldt"
+}
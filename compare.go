@@ -0,0 +1,307 @@
+package eulumies
+
+import (
+	"math"
+	"sort"
+	"strconv"
+)
+
+// CompareOptions controls the comparison of two photometries.
+type CompareOptions struct {
+	// Tolerance is the allowed deviation of the intensities relative to the peak intensity, defaults to 0.01 (1 %).
+	Tolerance float64
+	// Normalize compares the distributions normalized to 1000 lm of luminaire flux instead of absolute candela values.
+	Normalize bool
+}
+
+// MetadataDifference describes a metadata field that differs between two photometries.
+type MetadataDifference struct {
+	Field string
+	A     string
+	B     string
+}
+
+// Comparison is the result of ComparePhotometries.
+type Comparison struct {
+	Metadata []MetadataDifference
+
+	MaxDeviation          float64 // maximum absolute deviation of the intensities (cd)
+	MaxDeviationC         float64 // C-plane of the maximum deviation
+	MaxDeviationGamma     float64 // gamma angle of the maximum deviation
+	RMSDeviation          float64 // root mean square deviation of the intensities (cd)
+	ExceedingPercentage   float64 // percentage of the compared directions exceeding the tolerance
+	ComparedDirections    int
+	PeakIntensityA        float64
+	PeakIntensityB        float64
+	RelativeMaxDeviation  float64 // maximum deviation relative to the larger peak intensity
+	DistributionsMatching bool    // true if no direction exceeds the tolerance
+}
+
+// ComparePhotometries compares two photometries, which may be given in different formats. The metadata is compared
+// field by field, the distributions are compared on the union of both angle grids after symmetry expansion.
+// Intensities are compared as absolute candela values, or normalized to the luminaire flux if requested.
+func ComparePhotometries(a, b PhotometricData, opts CompareOptions) Comparison {
+	if opts.Tolerance <= 0 {
+		opts.Tolerance = 0.01
+	}
+
+	photometryA := a.normalizedPhotometry()
+	photometryB := b.normalizedPhotometry()
+	if opts.Normalize {
+		photometryA.planes = normalizeToLuminaireFlux(photometryA)
+		photometryB.planes = normalizeToLuminaireFlux(photometryB)
+	}
+
+	comparison := Comparison{
+		Metadata:       compareMetadata(photometryA, photometryB),
+		PeakIntensityA: maxValue(photometryA.planes),
+		PeakIntensityB: maxValue(photometryB.planes),
+	}
+
+	cAngles := unionAngles(photometryA.cAngles, photometryB.cAngles)
+	gAngles := unionAngles(photometryA.gAngles, photometryB.gAngles)
+	threshold := opts.Tolerance * math.Max(comparison.PeakIntensityA, comparison.PeakIntensityB)
+	sum := 0.0
+	exceeding := 0
+	for _, c := range cAngles {
+		for _, g := range gAngles {
+			intensityA := interpolateIntensity(photometryA.cAngles, photometryA.gAngles, photometryA.planes, c, g)
+			intensityB := interpolateIntensity(photometryB.cAngles, photometryB.gAngles, photometryB.planes, c, g)
+			deviation := math.Abs(intensityA - intensityB)
+			if deviation > comparison.MaxDeviation {
+				comparison.MaxDeviation = deviation
+				comparison.MaxDeviationC = c
+				comparison.MaxDeviationGamma = g
+			}
+			if deviation > threshold {
+				exceeding++
+			}
+			sum += deviation * deviation
+			comparison.ComparedDirections++
+		}
+	}
+
+	if comparison.ComparedDirections > 0 {
+		comparison.RMSDeviation = math.Sqrt(sum / float64(comparison.ComparedDirections))
+		comparison.ExceedingPercentage = 100 * float64(exceeding) / float64(comparison.ComparedDirections)
+	}
+	if peak := math.Max(comparison.PeakIntensityA, comparison.PeakIntensityB); peak > 0 {
+		comparison.RelativeMaxDeviation = comparison.MaxDeviation / peak
+	}
+	comparison.DistributionsMatching = exceeding == 0
+
+	return comparison
+}
+
+// compareMetadata returns all metadata fields that differ. Numbers are compared with a relative tolerance of 0.1 %.
+func compareMetadata(a, b normalizedPhotometry) []MetadataDifference {
+	var differences []MetadataDifference
+	compareString := func(field, valueA, valueB string) {
+		if valueA != valueB {
+			differences = append(differences, MetadataDifference{Field: field, A: valueA, B: valueB})
+		}
+	}
+	compareNumber := func(field string, valueA, valueB float64) {
+		if math.Abs(valueA-valueB) > 1e-3*math.Max(math.Abs(valueA), math.Abs(valueB)) {
+			differences = append(differences, MetadataDifference{
+				Field: field,
+				A:     strconv.FormatFloat(valueA, 'f', -1, 64),
+				B:     strconv.FormatFloat(valueB, 'f', -1, 64),
+			})
+		}
+	}
+
+	compareString("Manufacturer", a.manufacturer, b.manufacturer)
+	compareString("Luminaire", a.luminaire, b.luminaire)
+	compareString("CatalogNumber", a.catalogNumber, b.catalogNumber)
+	compareString("Lamp", a.lamp, b.lamp)
+	compareString("TestReport", a.testReport, b.testReport)
+	compareString("Date", a.date, b.date)
+	compareNumber("LampFlux", a.lampFlux, b.lampFlux)
+	compareNumber("InputWatts", a.inputWatts, b.inputWatts)
+	compareNumber("Length", a.length, b.length)
+	compareNumber("Width", a.width, b.width)
+	compareNumber("Height", a.height, b.height)
+
+	return differences
+}
+
+// normalizeToLuminaireFlux returns the planes scaled to 1000 lm of luminaire flux.
+func normalizeToLuminaireFlux(photometry normalizedPhotometry) [][]float64 {
+	flux := zonalFlux(photometry.cAngles, photometry.gAngles, photometry.planes)
+	if flux <= 0 {
+		return photometry.planes
+	}
+
+	return scalePlanes(photometry.planes, 1000/flux)
+}
+
+// unionAngles returns the sorted union of both angle lists without duplicates.
+func unionAngles(a, b []float64) []float64 {
+	union := make([]float64, 0, len(a)+len(b))
+	union = append(union, a...)
+	union = append(union, b...)
+	sort.Float64s(union)
+
+	result := union[:0]
+	for _, angle := range union {
+		if len(result) == 0 || math.Abs(result[len(result)-1]-angle) > 1e-9 {
+			result = append(result, angle)
+		}
+	}
+
+	return result
+}
+
+// maxValue returns the maximum value of all planes.
+func maxValue(planes [][]float64) float64 {
+	max := 0.0
+	for _, plane := range planes {
+		for _, value := range plane {
+			max = math.Max(max, value)
+		}
+	}
+
+	return max
+}
+
+// Equals reports whether both EULUMDAT data sets are equal. Text and integer fields are compared exactly, numbers
+// with an absolute tolerance of epsilon.
+func (e Eulumdat) Equals(other Eulumdat, epsilon float64) bool {
+	textEqual := e.CompanyIdentification == other.CompanyIdentification &&
+		e.MeasurementReportNumber == other.MeasurementReportNumber &&
+		e.LuminaireName == other.LuminaireName &&
+		e.LuminaireNumber == other.LuminaireNumber &&
+		e.FileName == other.FileName &&
+		e.DateUser == other.DateUser &&
+		stringsEqual(e.TypeLamps, other.TypeLamps) &&
+		stringsEqual(e.ColorTemperature, other.ColorTemperature) &&
+		stringsEqual(e.ColorRenderingIndexCRI, other.ColorRenderingIndexCRI) &&
+		mapsEqual(e.Extensions, other.Extensions)
+	integersEqual := e.TypeIndicator == other.TypeIndicator &&
+		e.SymmetryIndicator == other.SymmetryIndicator &&
+		e.NumberMcCPlanes == other.NumberMcCPlanes &&
+		e.NumberNgIntensitiesCPlane == other.NumberNgIntensitiesCPlane &&
+		e.NumberStandardSetLamps == other.NumberStandardSetLamps &&
+		intsEqual(e.NumberLamps, other.NumberLamps)
+	if !textEqual || !integersEqual {
+		return false
+	}
+
+	numbers := []float64{e.DistanceDcCPlanes, e.DistanceDgCPlane, e.LengthDiameter, e.WidthLuminaire,
+		e.HeightLuminaire, e.LengthDiameterLuminousArea, e.WidthLuminousArea, e.HeightLuminousAreaC0,
+		e.HeightLuminousAreaC90, e.HeightLuminousAreaC180, e.HeightLuminousAreaC270, e.DownwardFluxFractionPhiu,
+		e.LightOutputRatioLuminaire, e.IntensityConversionFactor, e.MeasurementTiltLuminaire}
+	otherNumbers := []float64{other.DistanceDcCPlanes, other.DistanceDgCPlane, other.LengthDiameter,
+		other.WidthLuminaire, other.HeightLuminaire, other.LengthDiameterLuminousArea, other.WidthLuminousArea,
+		other.HeightLuminousAreaC0, other.HeightLuminousAreaC90, other.HeightLuminousAreaC180,
+		other.HeightLuminousAreaC270, other.DownwardFluxFractionPhiu, other.LightOutputRatioLuminaire,
+		other.IntensityConversionFactor, other.MeasurementTiltLuminaire}
+
+	return floatsEqual(numbers, otherNumbers, epsilon) &&
+		floatsEqual(e.TotalLuminousFluxLamps, other.TotalLuminousFluxLamps, epsilon) &&
+		floatsEqual(e.BallastWatts, other.BallastWatts, epsilon) &&
+		floatsEqual(e.DirectRatios[:], other.DirectRatios[:], epsilon) &&
+		floatsEqual(e.AnglesC, other.AnglesC, epsilon) &&
+		floatsEqual(e.AnglesG, other.AnglesG, epsilon) &&
+		floatsEqual(e.LuminousIntensityDistributionRaw, other.LuminousIntensityDistributionRaw, epsilon) &&
+		planesEqual(e.LuminousIntensityDistribution, other.LuminousIntensityDistribution, epsilon)
+}
+
+// Equals reports whether both IES data sets are equal. Text and integer fields are compared exactly, numbers with
+// an absolute tolerance of epsilon.
+func (i *IES) Equals(other *IES, epsilon float64) bool {
+	if i == nil || other == nil {
+		return i == other
+	}
+
+	textEqual := i.Format == other.Format && i.Tilt == other.Tilt && mapsEqual(i.Keywords, other.Keywords)
+	integersEqual := i.TiltLampToLuminaireGeometry == other.TiltLampToLuminaireGeometry &&
+		i.TiltAnglesAndFactors == other.TiltAnglesAndFactors &&
+		i.NumberLamps == other.NumberLamps &&
+		i.NumberVerticalAngles == other.NumberVerticalAngles &&
+		i.NumberHorizontalAngles == other.NumberHorizontalAngles &&
+		i.PhotometricType == other.PhotometricType &&
+		i.UnitsType == other.UnitsType
+	if !textEqual || !integersEqual {
+		return false
+	}
+
+	numbers := []float64{i.LumensPerLamp, i.CandelaMultiplier, i.LuminaireWidth, i.LuminaireLength,
+		i.LuminaireHeight, i.BallastFactor, i.FutureUse, i.InputWatts}
+	otherNumbers := []float64{other.LumensPerLamp, other.CandelaMultiplier, other.LuminaireWidth,
+		other.LuminaireLength, other.LuminaireHeight, other.BallastFactor, other.FutureUse, other.InputWatts}
+
+	return floatsEqual(numbers, otherNumbers, epsilon) &&
+		floatsEqual(i.TiltAngles, other.TiltAngles, epsilon) &&
+		floatsEqual(i.TiltMultiplierFactors, other.TiltMultiplierFactors, epsilon) &&
+		floatsEqual(i.VerticalAngles, other.VerticalAngles, epsilon) &&
+		floatsEqual(i.HorizontalAngles, other.HorizontalAngles, epsilon) &&
+		planesEqual(i.CandelaValues, other.CandelaValues, epsilon)
+}
+
+func floatsEqual(a, b []float64, epsilon float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if math.Abs(a[i]-b[i]) > epsilon {
+			return false
+		}
+	}
+
+	return true
+}
+
+func planesEqual(a, b [][]float64, epsilon float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !floatsEqual(a[i], b[i], epsilon) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func intsEqual(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func stringsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// mapsEqual compares two maps, a nil map equals an empty one.
+func mapsEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for key, value := range a {
+		if otherValue, ok := b[key]; !ok || otherValue != value {
+			return false
+		}
+	}
+
+	return true
+}
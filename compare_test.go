@@ -0,0 +1,74 @@
+package eulumies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComparePhotometries(t *testing.T) {
+	eulumdat := loadSample2(t)
+
+	ies, err := ConvertEulumdatToIES(&eulumdat, ConversionOptions{})
+	assert.NoError(t, err)
+
+	comparison := ComparePhotometries(eulumdat, ies, CompareOptions{})
+	assert.Empty(t, comparison.Metadata)
+	assert.True(t, comparison.DistributionsMatching)
+	assert.InDelta(t, 0, comparison.MaxDeviation, 1e-6)
+	assert.Equal(t, 0.0, comparison.ExceedingPercentage)
+	assert.InDelta(t, comparison.PeakIntensityA, comparison.PeakIntensityB, 1e-6)
+	assert.Greater(t, comparison.ComparedDirections, 0)
+
+	modified := loadSample2(t)
+	modified.LuminaireName = "modified"
+	modified.LuminousIntensityDistribution[0][0] *= 2
+
+	comparison = ComparePhotometries(eulumdat, modified, CompareOptions{Tolerance: 0.05})
+	assert.Equal(t, []MetadataDifference{{Field: "Luminaire", A: eulumdat.LuminaireName, B: "modified"}}, comparison.Metadata)
+	assert.False(t, comparison.DistributionsMatching)
+	assert.Greater(t, comparison.ExceedingPercentage, 0.0)
+	assert.Greater(t, comparison.RMSDeviation, 0.0)
+	assert.Equal(t, 0.0, comparison.MaxDeviationGamma)
+
+	// doubling the lamp flux doubles all absolute intensities, the normalized distributions are equal
+	modified = loadSample2(t)
+	modified.TotalLuminousFluxLamps[0] *= 2
+
+	comparison = ComparePhotometries(eulumdat, modified, CompareOptions{})
+	assert.False(t, comparison.DistributionsMatching)
+	assert.Equal(t, "LampFlux", comparison.Metadata[0].Field)
+	comparison = ComparePhotometries(eulumdat, modified, CompareOptions{Normalize: true})
+	assert.True(t, comparison.DistributionsMatching)
+	assert.InDelta(t, 0, comparison.RelativeMaxDeviation, 1e-9)
+}
+
+func TestEulumdat_Equals(t *testing.T) {
+	eulumdat := loadSample2(t)
+	other, err := CopyEulumdat(eulumdat)
+	assert.NoError(t, err)
+	assert.True(t, eulumdat.Equals(other, 0))
+
+	other.LuminousIntensityDistribution[0][0] += 0.001
+	assert.False(t, eulumdat.Equals(other, 0))
+	assert.True(t, eulumdat.Equals(other, 0.01))
+
+	other.LuminaireName += " "
+	assert.False(t, eulumdat.Equals(other, 0.01))
+}
+
+func TestIES_Equals(t *testing.T) {
+	ies, err := NewIES("test/sample.ies", false)
+	assert.NoError(t, err)
+	other := ies.Clone()
+	assert.True(t, ies.Equals(other, 0))
+
+	other.CandelaValues[0][0] += 0.001
+	other.InputWatts += 0.001
+	assert.False(t, ies.Equals(other, 0))
+	assert.True(t, ies.Equals(other, 0.01))
+
+	other.Keywords["LAMP"] = "changed"
+	assert.False(t, ies.Equals(other, 0.01))
+	assert.False(t, ies.Equals(nil, 0.01))
+}
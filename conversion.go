@@ -1,8 +1,118 @@
 package eulumies
 
-func ConvertEulumdatToIES(eulumdat *Eulumdat) (*IES, error) {
+import (
+	"errors"
+	"fmt"
+	"math"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// measurementTiltKeyword is the user defined IES keyword holding the EULUMDAT tilt during measurement (field 25).
+const measurementTiltKeyword = "_MEASUREMENT_TILT"
+
+var unsafeFileNameRegex = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// ConversionOptions controls the conversion between EULUMDAT and IES files. The zero value selects the defaults.
+type ConversionOptions struct {
+	// Format is the IES format of converted files, defaults to LM-63-2002.
+	Format IESFormat
+	// PhotometricType is the IES photometric type of converted files, defaults to 1 (type C).
+	// EULUMDAT uses the C-plane system, so only type C photometry can be converted.
+	PhotometricType int
+	// UnitsType is the IES unit of the luminaire dimensions of converted files, defaults to IESUnitsMeters.
+	UnitsType int
+	// LampSet is the index of the EULUMDAT standard set of lamps (fields 26a-f) that describes the IES lamps.
+	LampSet int
+	// PreserveSymmetry encodes the symmetry of the distribution in the target file instead of expanding it to the
+	// full 0-360 degrees range. For IES files the EULUMDAT symmetry (I_sym 2, 3 and 4) is encoded in the horizontal
+	// angle range (0-180, 90-270 or 0-90 degrees), for EULUMDAT files the symmetry of the IES horizontal angle range
+	// is mapped to the symmetry indicator.
+	PreserveSymmetry bool
+	// CandelaMultiplier selects whether the scaling between the relative EULUMDAT intensities (cd/klm) and the IES
+	// candela values is applied to the values or kept in the IES candela multiplier and the EULUMDAT intensity
+	// conversion factor.
+	CandelaMultiplier CandelaMultiplierMode
+	// Keywords overrides or extends the IES keywords. For the conversion to IES they are added to the generated
+	// keywords, for the conversion to EULUMDAT they replace the keywords of the source file. An empty value removes
+	// the keyword.
+	Keywords map[string]string
+	// KeywordMapper is called during the conversion to IES and returns keywords (for example LUMCAT, BALLASTCAT,
+	// SEARCH or internal IDs) that override or extend the generated keywords. The Keywords option is applied
+	// afterwards. An empty value removes the keyword.
+	KeywordMapper func(eulumdat Eulumdat) map[string]string
+	// EulumdatMapper is called at the end of the conversion to EULUMDAT with the source IES data and may
+	// override or extend the header fields populated from the keywords.
+	EulumdatMapper func(ies IES, eulumdat *Eulumdat)
+	// Provenance records the source format, the source file name, the library version, the conversion options and
+	// the conversion time in the _CONVERTED keyword of IES files or the CONVERTED extension of EULUMDAT files, see
+	// Provenance. An existing record of the source file is replaced.
+	Provenance bool
+	// SourceFile is the name of the source file recorded in the provenance. For EULUMDAT sources it defaults to the
+	// file name field.
+	SourceFile string
+	// ProvenanceTime is the conversion time recorded in the provenance, defaults to the current time.
+	ProvenanceTime time.Time
+}
+
+// CandelaMultiplierMode selects how luminous intensities are scaled during conversion.
+type CandelaMultiplierMode int
+
+const (
+	// CandelaMultiplierUnity scales the converted intensities, the IES candela multiplier and the EULUMDAT intensity
+	// conversion factor are set to 1. IES files contain absolute candela values, EULUMDAT files contain cd/klm.
+	CandelaMultiplierUnity CandelaMultiplierMode = iota
+	// CandelaMultiplierNormalized keeps the intensity values of the source file and stores the scaling in the IES
+	// candela multiplier (lamp flux / 1000 lm) or the EULUMDAT intensity conversion factor. A conversion factor of
+	// a thousandth of the lamp flux would mark absolute EULUMDAT photometry, the intensities are scaled instead.
+	CandelaMultiplierNormalized
+)
+
+// withDefaults returns a copy of the options with all unset fields replaced by their default values.
+func (o ConversionOptions) withDefaults() ConversionOptions {
+	if o.Format == "" || o.Format == IESFormatUnknown {
+		o.Format = IESFormatLM_63_2002
+	}
+	if o.PhotometricType == 0 {
+		o.PhotometricType = 1
+	}
+	if o.UnitsType == 0 {
+		o.UnitsType = IESUnitsMeters
+	}
+
+	return o
+}
+
+// applyKeywords applies the keyword overrides to the given keywords. An empty value removes the keyword.
+func applyKeywords(keywords, overrides map[string]string) {
+	for keyword, value := range overrides {
+		if value == "" {
+			delete(keywords, keyword)
+		} else {
+			keywords[keyword] = value
+		}
+	}
+}
+
+// ConvertEulumdatToIES converts the EULUMDAT data to an IES file using the given options.
+func ConvertEulumdatToIES(eulumdat *Eulumdat, opts ConversionOptions) (*IES, error) {
+	opts = opts.withDefaults()
+	if opts.PhotometricType != 1 {
+		return nil, fmt.Errorf("unsupported photometric type %d, only type C can be converted", opts.PhotometricType)
+	}
+	if opts.UnitsType != IESUnitsFeet && opts.UnitsType != IESUnitsMeters {
+		return nil, fmt.Errorf("unsupported units type %d", opts.UnitsType)
+	}
+	if opts.LampSet < 0 || opts.LampSet >= len(eulumdat.NumberLamps) || opts.LampSet >= len(eulumdat.TypeLamps) ||
+		opts.LampSet >= len(eulumdat.TotalLuminousFluxLamps) || opts.LampSet >= len(eulumdat.BallastWatts) {
+		return nil, fmt.Errorf("lamp set %d does not exist", opts.LampSet)
+	}
+
 	ies := &IES{
-		Format: IESFormatLM_63_2002,
+		Format: opts.Format,
 		Tilt:   IESTiltNone,
 	}
 	ies.Keywords = make(map[string]string)
@@ -12,29 +122,397 @@ func ConvertEulumdatToIES(eulumdat *Eulumdat) (*IES, error) {
 	ies.Keywords["MANUFAC"] = eulumdat.CompanyIdentification
 	ies.Keywords["LUMINAIRE"] = eulumdat.LuminaireName
 	ies.Keywords["LUMCAT"] = eulumdat.LuminaireNumber
-	ies.Keywords["LAMP"] = eulumdat.TypeLamps[0]
+	ies.Keywords["LAMP"] = eulumdat.TypeLamps[opts.LampSet]
 	ies.Keywords["OTHER"] = "converted using eulumies: " + eulumdat.FileName
+	if eulumdat.MeasurementTiltLuminaire != 0 {
+		// TILT=INCLUDE describes the lamp output depending on the tilt of the luminaire, it cannot express the
+		// orientation of the luminaire during the measurement. So the tilt is recorded as a user defined keyword.
+		ies.Keywords[measurementTiltKeyword] = strconv.FormatFloat(eulumdat.MeasurementTiltLuminaire, 'f', -1, 64)
+	}
+	if value, ok := eulumdat.Extensions[nearFieldKeyword]; ok {
+		ies.Keywords[nearFieldKeyword] = value
+	}
+	if value, ok := convertSpectralPowerDistribution(eulumdat.Extensions[spdExtension], spdPairsPerLine); ok {
+		ies.Keywords[spdKeyword] = value
+	}
+	switch opts.Format {
+	case IESFormatLM_63_1986:
+		ies.Keywords = make(map[string]string) // this format does not contain any keywords
+	case IESFormatLM_63_1991, IESFormatLM_63_1995:
+		ies.Keywords["DATE"] = eulumdat.DateUser
+	}
+	if opts.KeywordMapper != nil {
+		applyKeywords(ies.Keywords, opts.KeywordMapper(*eulumdat))
+	}
+	applyKeywords(ies.Keywords, opts.Keywords)
+	for keyword := range ies.Keywords {
+		if !ies.isKeywordAllowed(keyword) {
+			delete(ies.Keywords, keyword)
+		}
+	}
+
+	if opts.Provenance && opts.Format != IESFormatLM_63_1986 {
+		sourceFile := opts.SourceFile
+		if sourceFile == "" {
+			sourceFile = eulumdat.FileName
+		}
+		// wrapped at word boundaries, the record usually exceeds the keyword line length
+		ies.SetKeyword(provenanceKeyword, newProvenance("EULUMDAT", sourceFile, opts).String(), KeywordOverflowSplit)
+	}
 
-	ies.NumberLamps = eulumdat.NumberLamps[0]
-	ies.LumensPerLamp = eulumdat.TotalLuminousFluxLamps[0]
-	ies.CandelaMultiplier = 1 // TODO
-	ies.NumberVerticalAngles = len(eulumdat.AnglesG)
-	ies.NumberHorizontalAngles = 1 // TODO
-	ies.PhotometricType = 1        // TODO
-	ies.UnitsType = 2
-	ies.LuminaireWidth = eulumdat.WidthLuminaire
-	ies.LuminaireLength = eulumdat.LengthDiameter
-	ies.LuminaireHeight = eulumdat.HeightLuminaire
+	// EULUMDAT stores cd/klm related to the flux of the lamp set, scale converts them to absolute candela values
+	scale := eulumdat.intensityScale(opts.LampSet)
+	ies.NumberLamps = eulumdat.NumberLamps[opts.LampSet]
+	ies.LumensPerLamp = eulumdat.TotalLuminousFluxLamps[opts.LampSet]
+	if eulumdat.isAbsoluteSet(opts.LampSet) {
+		// Absolute photometry: EULUMDAT relates the intensities to the luminaire flux stored in the lamp set,
+		// IES marks absolute candela values with -1 lumens per lamp.
+		ies.NumberLamps = int(math.Max(1, math.Abs(float64(ies.NumberLamps))))
+		ies.LumensPerLamp = -1
+	} else if ies.NumberLamps > 0 {
+		ies.LumensPerLamp /= float64(ies.NumberLamps) // EULUMDAT stores the total flux of all lamps
+	}
+	ies.CandelaMultiplier = 1
+	if opts.CandelaMultiplier == CandelaMultiplierNormalized {
+		ies.CandelaMultiplier = scale
+		scale = 1
+	}
+	ies.PhotometricType = opts.PhotometricType
+	ies.UnitsType = opts.UnitsType
+	ies.LuminaireWidth = millimetersToIESUnits(eulumdat.WidthLuminaire, ies.UnitsType)
+	ies.LuminaireLength = millimetersToIESUnits(eulumdat.LengthDiameter, ies.UnitsType)
+	ies.LuminaireHeight = millimetersToIESUnits(eulumdat.HeightLuminaire, ies.UnitsType)
 	ies.BallastFactor = 1
 	ies.FutureUse = 1
-	ies.InputWatts = eulumdat.BallastWatts[0]
-	ies.VerticalAngles = eulumdat.AnglesG
-	ies.HorizontalAngles = []float64{0.0}
-	ies.CandelaValues = eulumdat.LuminousIntensityDistribution
+	ies.InputWatts = eulumdat.BallastWatts[opts.LampSet]
+
+	// Expand the symmetry to the full set of C-planes, IES stores the candela values per horizontal angle.
+	cAngles, planes := eulumdat.expandedDistribution()
+	if len(cAngles) == 0 {
+		return nil, errors.New("eulumdat contains no luminous intensity distribution")
+	}
+	if from, to, ok := symmetricHorizontalRange(eulumdat.SymmetryIndicator); ok && opts.PreserveSymmetry {
+		cAngles, planes = selectPlanes(cAngles, planes, from, to)
+	} else if len(cAngles) > 1 {
+		// close the full circle, LM-63 expects the last horizontal angle to be 360 degrees
+		cAngles = append(cAngles, 360)
+		planes = append(planes, planes[0])
+	}
+	ies.HorizontalAngles = cAngles
+	ies.VerticalAngles = make([]float64, len(eulumdat.AnglesG))
+	copy(ies.VerticalAngles, eulumdat.AnglesG)
+	ies.CandelaValues = make([][]float64, len(planes))
+	for h := range planes {
+		ies.CandelaValues[h] = make([]float64, len(planes[h]))
+		for v := range planes[h] {
+			ies.CandelaValues[h][v] = planes[h][v] * scale
+		}
+	}
+	ies.NumberHorizontalAngles = len(ies.HorizontalAngles)
+	ies.NumberVerticalAngles = len(ies.VerticalAngles)
 
 	return ies, nil
 }
 
-func ConvertIESToEulumdat(ies *IES) (*Eulumdat, error) {
-	return nil, nil
+// IESAssembly is the IES file generated for one EULUMDAT standard set of lamps.
+type IESAssembly struct {
+	Name string // file name derived from the EULUMDAT file name and the lamp set data
+	IES  *IES
+}
+
+// ConvertEulumdatToIESAssemblies converts every standard set of lamps (fields 26a-f) of the EULUMDAT data to a
+// separate IES file, since IES can only describe one set of lamps. The LampSet option is ignored.
+func ConvertEulumdatToIESAssemblies(eulumdat *Eulumdat, opts ConversionOptions) ([]IESAssembly, error) {
+	if eulumdat.NumberStandardSetLamps == 0 {
+		return nil, errors.New("eulumdat contains no standard set of lamps")
+	}
+
+	assemblies := make([]IESAssembly, eulumdat.NumberStandardSetLamps)
+	for set := range assemblies {
+		opts.LampSet = set
+		ies, err := ConvertEulumdatToIES(eulumdat, opts)
+		if err != nil {
+			return nil, fmt.Errorf("lamp set %d: %w", set, err)
+		}
+		assemblies[set] = IESAssembly{
+			Name: assemblyFileName(eulumdat, set),
+			IES:  ies,
+		}
+	}
+
+	return assemblies, nil
+}
+
+// assemblyFileName returns the IES file name for the given standard set of lamps, for example
+// "LUM123_2_3000lm_25.5W_4000K.ies".
+func assemblyFileName(eulumdat *Eulumdat, set int) string {
+	base := strings.TrimSuffix(eulumdat.FileName, filepath.Ext(eulumdat.FileName))
+	if strings.TrimSpace(base) == "" {
+		base = eulumdat.LuminaireNumber
+	}
+	if strings.TrimSpace(base) == "" {
+		base = "luminaire"
+	}
+
+	parts := []string{
+		strings.TrimSpace(base),
+		strconv.Itoa(set + 1),
+		strconv.FormatFloat(math.Abs(eulumdat.TotalLuminousFluxLamps[set]), 'f', -1, 64) + "lm",
+		strconv.FormatFloat(eulumdat.BallastWatts[set], 'f', -1, 64) + "W",
+	}
+	if set < len(eulumdat.ColorTemperature) && strings.TrimSpace(eulumdat.ColorTemperature[set]) != "" {
+		parts = append(parts, strings.TrimSpace(eulumdat.ColorTemperature[set]))
+	}
+
+	name := unsafeFileNameRegex.ReplaceAllString(strings.Join(parts, "_"), "-")
+	return name + ".ies"
+}
+
+// symmetricHorizontalRange returns the IES horizontal angle range that encodes the given EULUMDAT symmetry.
+func symmetricHorizontalRange(symmetryIndicator int) (float64, float64, bool) {
+	switch symmetryIndicator {
+	case 2:
+		return 0, 180, true // symmetry to plane C0-C180
+	case 3:
+		return 90, 270, true // symmetry to plane C90-C270
+	case 4:
+		return 0, 90, true // symmetry to both planes
+	}
+
+	return 0, 0, false
+}
+
+// selectPlanes returns all planes whose angle lies within the given range. The angles must be sorted.
+func selectPlanes(angles []float64, planes [][]float64, from, to float64) ([]float64, [][]float64) {
+	var selectedAngles []float64
+	var selectedPlanes [][]float64
+	for i := range angles {
+		if angles[i] >= from && angles[i] <= to {
+			selectedAngles = append(selectedAngles, angles[i])
+			selectedPlanes = append(selectedPlanes, planes[i])
+		}
+	}
+
+	return selectedAngles, selectedPlanes
+}
+
+// ConvertIESToEulumdat converts the IES data to an EULUMDAT file using the given options.
+// The candela values are related to the rated lamp lumens and stored as cd/klm. Type A and B photometry is resampled
+// onto a type C grid with IES.ToTypeC and the default options first.
+func ConvertIESToEulumdat(ies *IES, opts ConversionOptions) (*Eulumdat, error) {
+	opts = opts.withDefaults()
+	if ies.PhotometricType == 2 || ies.PhotometricType == 3 {
+		typeC, err := ies.ToTypeC(TypeCOptions{})
+		if err != nil {
+			return nil, err
+		}
+		ies = typeC
+	}
+	if ies.PhotometricType != 1 {
+		return nil, fmt.Errorf("unsupported photometric type %d, only type A, B and C can be converted",
+			ies.PhotometricType)
+	}
+
+	hAngles, planes := ies.expandedDistribution()
+	if len(hAngles) == 0 {
+		return nil, errors.New("ies contains no candela values")
+	}
+
+	keywords := make(map[string]string, len(ies.Keywords))
+	for keyword, value := range ies.Keywords {
+		keywords[keyword] = value
+	}
+	applyKeywords(keywords, opts.Keywords)
+	date := keywords["ISSUEDATE"]
+	if date == "" {
+		date = keywords["DATE"]
+	}
+
+	eulumdat := &Eulumdat{
+		CompanyIdentification:     keywords["MANUFAC"],
+		MeasurementReportNumber:   keywords["TEST"],
+		LuminaireName:             keywords["LUMINAIRE"],
+		LuminaireNumber:           keywords["LUMCAT"],
+		DateUser:                  date,
+		IntensityConversionFactor: 1,
+		NumberStandardSetLamps:    1,
+		NumberLamps:               []int{ies.NumberLamps},
+		TypeLamps:                 []string{keywords["LAMP"]},
+		TotalLuminousFluxLamps:    []float64{ies.lampLumens()},
+		ColorTemperature:          []string{""},
+		ColorRenderingIndexCRI:    []string{""},
+		BallastWatts:              []float64{ies.InputWatts},
+	}
+
+	// IES describes the luminous opening, negative width and length denote a circular opening
+	length := math.Abs(iesUnitsToMillimeters(ies.LuminaireLength, ies.UnitsType))
+	width := math.Abs(iesUnitsToMillimeters(ies.LuminaireWidth, ies.UnitsType))
+	height := math.Abs(iesUnitsToMillimeters(ies.LuminaireHeight, ies.UnitsType))
+	if ies.LuminaireWidth < 0 && ies.LuminaireLength < 0 {
+		width = 0
+	}
+	eulumdat.LengthDiameter = length
+	eulumdat.WidthLuminaire = width
+	eulumdat.HeightLuminaire = height
+	eulumdat.LengthDiameterLuminousArea = length
+	eulumdat.WidthLuminousArea = width
+	eulumdat.HeightLuminousAreaC0 = height
+	eulumdat.HeightLuminousAreaC90 = height
+	eulumdat.HeightLuminousAreaC180 = height
+	eulumdat.HeightLuminousAreaC270 = height
+
+	// EULUMDAT stores cd/klm related to the lamp flux, scale converts the IES candela values to them
+	scale := ies.candelaScale()
+	if lampFlux := ies.lampLumens(); lampFlux > 0 {
+		scale *= 1000 / lampFlux
+	} else if ies.IsAbsolutePhotometry() {
+		// Absolute photometry: EULUMDAT marks it with a negative number of lamps and relates the intensities to
+		// the luminaire flux, which is stored as the flux of the lamp set.
+		luminaireFlux := ies.ComputeTotalFlux()
+		if luminaireFlux <= 0 {
+			return nil, errors.New("absolute photometry without luminous flux")
+		}
+		scale *= 1000 / luminaireFlux
+		eulumdat.NumberLamps[0] = -int(math.Max(1, math.Abs(float64(ies.NumberLamps))))
+		eulumdat.TotalLuminousFluxLamps[0] = math.Round(luminaireFlux*10) / 10
+	}
+	if opts.CandelaMultiplier == CandelaMultiplierNormalized {
+		eulumdat.IntensityConversionFactor = scale
+		if eulumdat.absoluteByConversionFactor(0) {
+			// a lamp flux of 1000 times the factor marks absolute photometry, keep the scaling in the intensities
+			eulumdat.IntensityConversionFactor = 1
+		} else {
+			scale = 1
+		}
+	}
+
+	eulumdat.SymmetryIndicator = 0
+	if len(hAngles) == 1 {
+		eulumdat.SymmetryIndicator = 1
+	} else if opts.PreserveSymmetry {
+		eulumdat.SymmetryIndicator = iesSymmetryIndicator(ies.HorizontalAngles)
+	}
+	eulumdat.TypeIndicator = 3
+	if eulumdat.SymmetryIndicator == 1 {
+		eulumdat.TypeIndicator = 1
+	}
+	eulumdat.NumberMcCPlanes = len(hAngles)
+	eulumdat.AnglesC = hAngles
+	eulumdat.DistanceDcCPlanes = angleDistance(hAngles)
+	eulumdat.NumberNgIntensitiesCPlane = len(ies.VerticalAngles)
+	eulumdat.AnglesG = make([]float64, len(ies.VerticalAngles))
+	copy(eulumdat.AnglesG, ies.VerticalAngles)
+	eulumdat.DistanceDgCPlane = angleDistance(eulumdat.AnglesG)
+
+	eulumdat.calcMc1andMc2()
+	if !storedPlanesMatchSymmetry(eulumdat) {
+		eulumdat.SymmetryIndicator = 0 // the angles do not allow to encode the symmetry
+		eulumdat.calcMc1andMc2()
+	}
+	for c := eulumdat.mc1 - 1; c < eulumdat.mc2; c++ {
+		for _, value := range planes[c%len(planes)] {
+			eulumdat.LuminousIntensityDistributionRaw = append(eulumdat.LuminousIntensityDistributionRaw, value*scale)
+		}
+	}
+	if err := eulumdat.CalcLuminousIntensityDistributionFromRaw(); err != nil {
+		return nil, err
+	}
+
+	eulumdat.MeasurementTiltLuminaire = iesMeasurementTilt(ies, keywords)
+	if value, ok := keywords[nearFieldKeyword]; ok {
+		eulumdat.Extensions = map[string]string{nearFieldKeyword: value}
+	}
+	if value, ok := convertSpectralPowerDistribution(keywords[spdKeyword], 0); ok {
+		if eulumdat.Extensions == nil {
+			eulumdat.Extensions = make(map[string]string)
+		}
+		eulumdat.Extensions[spdExtension] = value
+	}
+	if opts.Provenance {
+		if eulumdat.Extensions == nil {
+			eulumdat.Extensions = make(map[string]string)
+		}
+		eulumdat.Extensions[provenanceExtension] = newProvenance(string(ies.Format), opts.SourceFile, opts).String()
+	}
+	eulumdat.LightOutputRatioLuminaire = eulumdat.ComputeLightOutputRatio()
+	eulumdat.DownwardFluxFractionPhiu = 100 * (1 - eulumdat.ComputeUpwardLightRatio())
+	eulumdat.FillDirectRatios()
+
+	if opts.EulumdatMapper != nil {
+		opts.EulumdatMapper(*ies, eulumdat)
+	}
+
+	return eulumdat, nil
+}
+
+// iesMeasurementTilt returns the tilt of the luminaire during the measurement. It is read from the measurement tilt
+// keyword, or from the TILT=INCLUDE data, where the measurement tilt is the angle with a multiplying factor of 1.
+func iesMeasurementTilt(ies *IES, keywords map[string]string) float64 {
+	if value, ok := keywords[measurementTiltKeyword]; ok {
+		if tilt, err := strconv.ParseFloat(strings.TrimSpace(value), 64); err == nil {
+			return tilt
+		}
+	}
+	if ies.Tilt != IESTiltInclude || len(ies.TiltAngles) == 0 || len(ies.TiltAngles) != len(ies.TiltMultiplierFactors) {
+		return 0
+	}
+
+	tilt := ies.TiltAngles[0]
+	deviation := math.Abs(ies.TiltMultiplierFactors[0] - 1)
+	for i := 1; i < len(ies.TiltAngles); i++ {
+		current := math.Abs(ies.TiltMultiplierFactors[i] - 1)
+		if current < deviation || (current == deviation && math.Abs(ies.TiltAngles[i]) < math.Abs(tilt)) {
+			tilt = ies.TiltAngles[i]
+			deviation = current
+		}
+	}
+
+	return tilt
+}
+
+// iesSymmetryIndicator returns the EULUMDAT symmetry indicator encoded by the IES horizontal angle range.
+func iesSymmetryIndicator(horizontalAngles []float64) int {
+	first := horizontalAngles[0]
+	last := horizontalAngles[len(horizontalAngles)-1]
+	switch {
+	case len(horizontalAngles) == 1:
+		return 1
+	case first == 0 && last == 90:
+		return 4
+	case first == 0 && last == 180:
+		return 2
+	case first == 90 && last == 270:
+		return 3
+	}
+
+	return 0
+}
+
+// storedPlanesMatchSymmetry reports whether the C-planes stored for the symmetry indicator span the symmetric range.
+func storedPlanesMatchSymmetry(eulumdat *Eulumdat) bool {
+	from, to, ok := symmetricHorizontalRange(eulumdat.SymmetryIndicator)
+	if !ok {
+		return true
+	}
+	if eulumdat.SymmetryIndicator == 3 {
+		from, to = 270, 90 // EULUMDAT stores the planes from C270 to C90
+	}
+	count := len(eulumdat.AnglesC)
+
+	return count > 0 && eulumdat.AnglesC[(eulumdat.mc1-1)%count] == from && eulumdat.AnglesC[(eulumdat.mc2-1)%count] == to
+}
+
+// angleDistance returns the distance between equidistant angles, or 0 if the angles deviate from the equidistant grid
+// by more than angleGridTolerance.
+func angleDistance(angles []float64) float64 {
+	if len(angles) < 2 {
+		return 0
+	}
+
+	distance := angles[1] - angles[0]
+	if distance <= 0 || angleSpacingMismatch(angles, distance) >= 0 {
+		return 0
+	}
+
+	return distance
 }
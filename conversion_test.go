@@ -0,0 +1,292 @@
+package eulumies
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertEulumdatToIES(t *testing.T) {
+	eulumdat := loadSample2(t)
+
+	ies, err := ConvertEulumdatToIES(&eulumdat, ConversionOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, eulumdat.NumberMcCPlanes+1, ies.NumberHorizontalAngles)
+	assert.Equal(t, 0.0, ies.HorizontalAngles[0])
+	assert.Equal(t, 360.0, ies.HorizontalAngles[ies.NumberHorizontalAngles-1])
+	assert.Len(t, ies.CandelaValues, ies.NumberHorizontalAngles)
+	assert.NoError(t, ies.Validate(false).Err())
+
+	// I_sym = 4: C45 is stored, C135, C225 and C315 are mirrored
+	c45 := eulumdat.GetCPlaneIndex(45)
+	for _, angle := range []float64{135, 225, 315} {
+		for h := range ies.HorizontalAngles {
+			if ies.HorizontalAngles[h] == angle {
+				assert.Equal(t, ies.CandelaValues[c45], ies.CandelaValues[h])
+			}
+		}
+	}
+	assert.Equal(t, ies.CandelaValues[0], ies.CandelaValues[ies.NumberHorizontalAngles-1])
+}
+
+func TestConvertEulumdatToIES_PreserveSymmetry(t *testing.T) {
+	eulumdat := loadSample2(t)
+
+	ies, err := ConvertEulumdatToIES(&eulumdat, ConversionOptions{PreserveSymmetry: true})
+	assert.NoError(t, err)
+	assert.Equal(t, 0.0, ies.HorizontalAngles[0])
+	assert.Equal(t, 90.0, ies.HorizontalAngles[ies.NumberHorizontalAngles-1])
+	assert.Equal(t, eulumdat.NumberMcCPlanes/4+1, ies.NumberHorizontalAngles)
+	scale := eulumdat.TotalLuminousFluxLamps[0] / 1000
+	for v := range ies.CandelaValues[1] {
+		assert.InDelta(t, eulumdat.LuminousIntensityDistribution[1][v]*scale, ies.CandelaValues[1][v], 1e-9)
+	}
+
+	// the preserved symmetry must describe the same distribution
+	assert.InDelta(t, eulumdat.ComputeTotalFlux(), ies.ComputeTotalFlux(), 1e-6)
+
+	eulumdat.SymmetryIndicator = 2
+	eulumdat.LuminousIntensityDistributionRaw = make([]float64, (eulumdat.NumberMcCPlanes/2+1)*eulumdat.NumberNgIntensitiesCPlane)
+	assert.NoError(t, eulumdat.CalcLuminousIntensityDistributionFromRaw())
+	ies, err = ConvertEulumdatToIES(&eulumdat, ConversionOptions{PreserveSymmetry: true})
+	assert.NoError(t, err)
+	assert.Equal(t, 180.0, ies.HorizontalAngles[ies.NumberHorizontalAngles-1])
+}
+
+func TestConvertEulumdatToIES_Options(t *testing.T) {
+	eulumdat := loadSample2(t)
+
+	ies, err := ConvertEulumdatToIES(&eulumdat, ConversionOptions{
+		Format:    IESFormatLM_63_1991,
+		UnitsType: IESUnitsFeet,
+		Keywords:  map[string]string{"LUMCAT": "4711", "OTHER": ""},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, IESFormatLM_63_1991, ies.Format)
+	assert.Equal(t, IESUnitsFeet, ies.UnitsType)
+	assert.InDelta(t, eulumdat.LengthDiameter/304.8, ies.LuminaireLength, 1e-9)
+	assert.Equal(t, "4711", ies.Keywords["LUMCAT"])
+	assert.Equal(t, eulumdat.DateUser, ies.Keywords["DATE"])
+	assert.NotContains(t, ies.Keywords, "ISSUEDATE")
+	assert.NotContains(t, ies.Keywords, "OTHER")
+	assert.NoError(t, ies.Validate(false).Err())
+
+	_, err = ConvertEulumdatToIES(&eulumdat, ConversionOptions{LampSet: eulumdat.NumberStandardSetLamps})
+	assert.Error(t, err)
+	_, err = ConvertEulumdatToIES(&eulumdat, ConversionOptions{PhotometricType: 2})
+	assert.Error(t, err)
+}
+
+func TestConvertIESToEulumdat(t *testing.T) {
+	ies, err := NewIES("test/sample.ies", false)
+	assert.NoError(t, err)
+
+	eulumdat, err := ConvertIESToEulumdat(ies, ConversionOptions{Keywords: map[string]string{"LUMINAIRE": "Renamed"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, eulumdat.SymmetryIndicator)
+	assert.Equal(t, "Sample Company", eulumdat.CompanyIdentification)
+	assert.Equal(t, "Renamed", eulumdat.LuminaireName)
+	assert.Equal(t, ies.NumberVerticalAngles, eulumdat.NumberNgIntensitiesCPlane)
+	assert.Equal(t, 1.0, eulumdat.DistanceDgCPlane)
+	assert.InDelta(t, ies.ComputeTotalFlux(), eulumdat.ComputeTotalFlux(), 1e-6)
+	assert.NoError(t, eulumdat.Validate(false).Err())
+}
+
+func TestConvertIESToEulumdat_PreserveSymmetry(t *testing.T) {
+	original := loadSample2(t)
+
+	ies, err := ConvertEulumdatToIES(&original, ConversionOptions{PreserveSymmetry: true})
+	assert.NoError(t, err)
+
+	eulumdat, err := ConvertIESToEulumdat(ies, ConversionOptions{PreserveSymmetry: true})
+	assert.NoError(t, err)
+	assert.Equal(t, original.SymmetryIndicator, eulumdat.SymmetryIndicator)
+	assert.Equal(t, original.NumberMcCPlanes, eulumdat.NumberMcCPlanes)
+	assert.Equal(t, original.AnglesC, eulumdat.AnglesC)
+	assert.Len(t, eulumdat.LuminousIntensityDistributionRaw, len(original.LuminousIntensityDistributionRaw))
+	assert.InDeltaSlice(t, original.LuminousIntensityDistributionRaw, eulumdat.LuminousIntensityDistributionRaw, 1e-9)
+
+	expanded, err := ConvertIESToEulumdat(ies, ConversionOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, expanded.SymmetryIndicator)
+	assert.Equal(t, original.NumberMcCPlanes, expanded.NumberMcCPlanes)
+	assert.InDelta(t, eulumdat.ComputeRelativeFlux(), expanded.ComputeRelativeFlux(), 1e-6)
+}
+
+func TestConvertAbsolutePhotometry(t *testing.T) {
+	ies, err := NewIES("test/ADL110.XTM5M.9540.61 - S1.ies", false)
+	assert.NoError(t, err)
+	assert.True(t, ies.IsAbsolutePhotometry())
+
+	eulumdat, err := ConvertIESToEulumdat(ies, ConversionOptions{PreserveSymmetry: true})
+	assert.NoError(t, err)
+	assert.True(t, eulumdat.IsAbsolutePhotometry())
+	assert.Equal(t, -1, eulumdat.NumberLamps[0])
+	assert.InDelta(t, ies.ComputeTotalFlux(), eulumdat.TotalLuminousFluxLamps[0], 0.1)
+	assert.InDelta(t, 100, eulumdat.LightOutputRatioLuminaire, 1e-6)
+	assert.InDelta(t, ies.ComputeTotalFlux(), eulumdat.ComputeTotalFlux(), 0.1)
+
+	converted, err := ConvertEulumdatToIES(eulumdat, ConversionOptions{PreserveSymmetry: true})
+	assert.NoError(t, err)
+	assert.True(t, converted.IsAbsolutePhotometry())
+	assert.Equal(t, 1, converted.NumberLamps)
+	assert.Equal(t, ies.HorizontalAngles, converted.HorizontalAngles)
+	peak, _, _ := ies.GetPeakIntensity()
+	convertedPeak, _, _ := converted.GetPeakIntensity()
+	assert.InDelta(t, peak, convertedPeak, peak*1e-4)
+	assert.InDelta(t, ies.ComputeTotalFlux(), converted.ComputeTotalFlux(), 0.1)
+}
+
+func TestConvertEulumdatToIESAssemblies(t *testing.T) {
+	eulumdat := loadSample2(t)
+
+	ApplyEulumdatAssemblies([]EulumdatAssembly{
+		{NumberOfLamps: 1, TypeOfLamps: "LED", TotalLuminousFlux: 520, Power: 3.19, ColorTemperature: "4000K"},
+		{NumberOfLamps: 2, TypeOfLamps: "LED HO", TotalLuminousFlux: 1000, Power: 6.5, ColorTemperature: "3000 K"},
+	}, &eulumdat)
+
+	assemblies, err := ConvertEulumdatToIESAssemblies(&eulumdat, ConversionOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, assemblies, 2)
+	assert.NotEqual(t, assemblies[0].Name, assemblies[1].Name)
+	assert.Regexp(t, `_2_1000lm_6\.5W_3000-K\.ies$`, assemblies[1].Name)
+	assert.Equal(t, "LED HO", assemblies[1].IES.Keywords["LAMP"])
+	assert.Equal(t, 2, assemblies[1].IES.NumberLamps)
+	assert.Equal(t, 500.0, assemblies[1].IES.LumensPerLamp)
+	assert.Equal(t, 6.5, assemblies[1].IES.InputWatts)
+	assert.Equal(t, 520.0, assemblies[0].IES.LumensPerLamp)
+}
+
+func TestConvert_CandelaMultiplierNormalized(t *testing.T) {
+	original := loadSample2(t)
+	original.IntensityConversionFactor = 2
+
+	ies, err := ConvertEulumdatToIES(&original, ConversionOptions{CandelaMultiplier: CandelaMultiplierNormalized})
+	assert.NoError(t, err)
+	assert.InDelta(t, original.TotalLuminousFluxLamps[0]/1000*2, ies.CandelaMultiplier, 1e-9)
+	assert.Equal(t, original.LuminousIntensityDistribution[0], ies.CandelaValues[0])
+	assert.InDelta(t, original.ComputeTotalFlux(), ies.ComputeTotalFlux(), 1e-6)
+
+	unity, err := ConvertEulumdatToIES(&original, ConversionOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1.0, unity.CandelaMultiplier)
+	assert.InDelta(t, original.ComputeTotalFlux(), unity.ComputeTotalFlux(), 1e-6)
+
+	eulumdat, err := ConvertIESToEulumdat(ies, ConversionOptions{CandelaMultiplier: CandelaMultiplierNormalized})
+	assert.NoError(t, err)
+	assert.InDelta(t, 2, eulumdat.IntensityConversionFactor, 1e-9)
+	assert.Equal(t, ies.CandelaValues[0], eulumdat.LuminousIntensityDistribution[0])
+	assert.InDelta(t, original.ComputeTotalFlux(), eulumdat.ComputeTotalFlux(), 1e-6)
+
+	eulumdat, err = ConvertIESToEulumdat(unity, ConversionOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1.0, eulumdat.IntensityConversionFactor)
+	assert.InDelta(t, original.ComputeTotalFlux(), eulumdat.ComputeTotalFlux(), 1e-6)
+}
+
+func TestConvert_CandelaMultiplierNormalizedRelative(t *testing.T) {
+	ies, err := NewIES("test/sample.ies", false)
+	assert.NoError(t, err)
+	ies.NumberLamps = 1
+	ies.LumensPerLamp = 2000
+	ies.CandelaMultiplier = 4
+
+	// a conversion factor of 2 with 2000 lm of lamp flux would mark absolute photometry
+	eulumdat, err := ConvertIESToEulumdat(ies, ConversionOptions{CandelaMultiplier: CandelaMultiplierNormalized})
+	assert.NoError(t, err)
+	assert.False(t, eulumdat.IsAbsolutePhotometry())
+	assert.Equal(t, 1.0, eulumdat.IntensityConversionFactor)
+	assert.InDelta(t, ies.ComputeTotalFlux(), eulumdat.ComputeTotalFlux(), 1e-6)
+}
+
+func TestConvert_MeasurementTilt(t *testing.T) {
+	original := loadSample2(t)
+	original.MeasurementTiltLuminaire = 15
+
+	ies, err := ConvertEulumdatToIES(&original, ConversionOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, IESTiltNone, ies.Tilt)
+	assert.Equal(t, "15", ies.Keywords[measurementTiltKeyword])
+
+	eulumdat, err := ConvertIESToEulumdat(ies, ConversionOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 15.0, eulumdat.MeasurementTiltLuminaire)
+
+	delete(ies.Keywords, measurementTiltKeyword)
+	ies.Tilt = IESTiltInclude
+	ies.TiltLampToLuminaireGeometry = 1
+	ies.TiltAnglesAndFactors = 4
+	ies.TiltAngles = []float64{0, 10, 20, 30}
+	ies.TiltMultiplierFactors = []float64{0.95, 1, 0.98, 0.9}
+	eulumdat, err = ConvertIESToEulumdat(ies, ConversionOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 10.0, eulumdat.MeasurementTiltLuminaire)
+}
+
+func TestConvert_NearField(t *testing.T) {
+	ies, err := NewIES("test/sample.ies", false)
+	assert.NoError(t, err)
+	ies.SetNearField(1, 2, 3)
+
+	eulumdat, err := ConvertIESToEulumdat(ies, ConversionOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"NEARFIELD": "1 2 3"}, eulumdat.Extensions)
+
+	var buffer bytes.Buffer
+	assert.NoError(t, eulumdat.Export(&buffer))
+	assert.True(t, strings.HasSuffix(buffer.String(), "\r\n[NEARFIELD] 1 2 3\r\n"))
+	parsed, err := NewEulumdat(&buffer, false)
+	assert.NoError(t, err)
+	assert.Equal(t, eulumdat.Extensions, parsed.Extensions)
+
+	converted, err := ConvertEulumdatToIES(&parsed, ConversionOptions{})
+	assert.NoError(t, err)
+	d1, d2, d3, err := converted.NearField()
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{1, 2, 3}, []float64{d1, d2, d3})
+
+	parsed.Extensions["NEARFIELD"] = "1 2"
+	assert.Equal(t, ValidationInvalidKeyword, parsed.Validate(true).Errors()[0].Code)
+	converted.Keywords["NEARFIELD"] = "x"
+	assert.False(t, converted.Validate(true).Valid())
+}
+
+func TestConvert_KeywordMapper(t *testing.T) {
+	original := loadSample2(t)
+
+	ies, err := ConvertEulumdatToIES(&original, ConversionOptions{
+		KeywordMapper: func(eulumdat Eulumdat) map[string]string {
+			return map[string]string{
+				"LUMCAT":     "ID-" + eulumdat.LuminaireNumber,
+				"BALLASTCAT": "DRV-1",
+				"SEARCH":     "downlight",
+				"OTHER":      "",
+			}
+		},
+		Keywords: map[string]string{"SEARCH": "spot"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "ID-"+original.LuminaireNumber, ies.Keywords["LUMCAT"])
+	assert.Equal(t, "DRV-1", ies.Keywords["BALLASTCAT"])
+	assert.Equal(t, "spot", ies.Keywords["SEARCH"])
+	assert.NotContains(t, ies.Keywords, "OTHER")
+
+	eulumdat, err := ConvertIESToEulumdat(ies, ConversionOptions{
+		EulumdatMapper: func(ies IES, eulumdat *Eulumdat) {
+			eulumdat.LuminaireNumber = ies.Keywords["BALLASTCAT"]
+			eulumdat.ColorTemperature[0] = "4000K"
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "DRV-1", eulumdat.LuminaireNumber)
+	assert.Equal(t, "4000K", eulumdat.ColorTemperature[0])
+}
+
+func Test_angleDistance(t *testing.T) {
+	assert.Equal(t, 2.5, angleDistance([]float64{0, 2.5, 5.005, 7.5}))
+	assert.Equal(t, 0.0, angleDistance([]float64{0, 2.5, 5.1}))
+	assert.Equal(t, 0.0, angleDistance([]float64{10, 5}))
+	assert.Equal(t, 0.0, angleDistance([]float64{0}))
+}
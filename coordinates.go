@@ -0,0 +1,165 @@
+package eulumies
+
+import "math"
+
+// Direction is a unit vector in the luminaire coordinate system: C0 points along +x, C90 along +y and nadir
+// (gamma 0) along -z. All angles of the conversions are given in degrees.
+//
+// The photometric coordinate systems of CIE 121 and IES LM-63 share this luminaire orientation, they differ in the
+// polar axis of their planes:
+//
+//	type C (C, gamma): vertical polar axis, gamma is measured in the C-plane from nadir (0 - 180)
+//	type B (B, beta):  polar axis along C90-C270, B-planes rotate from nadir towards C0, beta is measured in the
+//	                   B-plane from the equator towards C90 (-90 - 90)
+//	type A (A, alpha): polar axis along C0-C180, A-planes rotate from nadir towards C90, alpha is measured in the
+//	                   A-plane from the equator towards C0 (-90 - 90)
+type Direction [3]float64
+
+// CGammaToDirection returns the direction of the type C coordinates.
+func CGammaToDirection(c, gamma float64) Direction {
+	sinC, cosC := sincosDeg(c)
+	sinG, cosG := sincosDeg(gamma)
+
+	return Direction{sinG * cosC, sinG * sinC, -cosG}
+}
+
+// CGamma returns the type C coordinates of the direction. C is normalized to [0, 360), it is 0 for nadir and zenith.
+func (d Direction) CGamma() (float64, float64) {
+	d = d.normalized()
+	gamma := radToDeg(math.Acos(clampUnit(-d[2])))
+	c := 0.0
+	if math.Hypot(d[0], d[1]) > 1e-12 {
+		c = normalizeAngle(radToDeg(math.Atan2(d[1], d[0])))
+	}
+
+	return c, gamma
+}
+
+// BBetaToDirection returns the direction of the type B coordinates.
+func BBetaToDirection(b, beta float64) Direction {
+	sinB, cosB := sincosDeg(b)
+	sinBeta, cosBeta := sincosDeg(beta)
+
+	return Direction{cosBeta * sinB, sinBeta, -cosBeta * cosB}
+}
+
+// BBeta returns the type B coordinates of the direction, B is in (-180, 180]. B is 0 for both poles.
+func (d Direction) BBeta() (float64, float64) {
+	d = d.normalized()
+	beta := radToDeg(math.Asin(clampUnit(d[1])))
+	b := 0.0
+	if math.Hypot(d[0], d[2]) > 1e-12 {
+		b = radToDeg(math.Atan2(d[0], -d[2]))
+	}
+
+	return signedAngle(b), beta
+}
+
+// AAlphaToDirection returns the direction of the type A coordinates.
+func AAlphaToDirection(a, alpha float64) Direction {
+	sinA, cosA := sincosDeg(a)
+	sinAlpha, cosAlpha := sincosDeg(alpha)
+
+	return Direction{sinAlpha, cosAlpha * sinA, -cosAlpha * cosA}
+}
+
+// AAlpha returns the type A coordinates of the direction, A is in (-180, 180]. A is 0 for both poles.
+func (d Direction) AAlpha() (float64, float64) {
+	d = d.normalized()
+	alpha := radToDeg(math.Asin(clampUnit(d[0])))
+	a := 0.0
+	if math.Hypot(d[1], d[2]) > 1e-12 {
+		a = radToDeg(math.Atan2(d[1], -d[2]))
+	}
+
+	return signedAngle(a), alpha
+}
+
+// SphericalToDirection returns the direction of the mathematical spherical coordinates: the polar angle theta is
+// measured from zenith (+z), the azimuth phi from +x towards +y.
+func SphericalToDirection(theta, phi float64) Direction {
+	sinT, cosT := math.Sincos(degToRad(theta))
+	sinP, cosP := math.Sincos(degToRad(phi))
+
+	return Direction{sinT * cosP, sinT * sinP, cosT}
+}
+
+// Spherical returns the mathematical spherical coordinates (theta, phi) of the direction, phi is normalized to
+// [0, 360).
+func (d Direction) Spherical() (float64, float64) {
+	c, gamma := d.CGamma()
+
+	return 180 - gamma, c
+}
+
+// CGammaToBBeta converts type C coordinates to type B coordinates.
+func CGammaToBBeta(c, gamma float64) (float64, float64) {
+	return CGammaToDirection(c, gamma).BBeta()
+}
+
+// BBetaToCGamma converts type B coordinates to type C coordinates.
+func BBetaToCGamma(b, beta float64) (float64, float64) {
+	return BBetaToDirection(b, beta).CGamma()
+}
+
+// CGammaToAAlpha converts type C coordinates to type A coordinates.
+func CGammaToAAlpha(c, gamma float64) (float64, float64) {
+	return CGammaToDirection(c, gamma).AAlpha()
+}
+
+// AAlphaToCGamma converts type A coordinates to type C coordinates.
+func AAlphaToCGamma(a, alpha float64) (float64, float64) {
+	return AAlphaToDirection(a, alpha).CGamma()
+}
+
+// AAlphaToBBeta converts type A coordinates to type B coordinates.
+func AAlphaToBBeta(a, alpha float64) (float64, float64) {
+	return AAlphaToDirection(a, alpha).BBeta()
+}
+
+// BBetaToAAlpha converts type B coordinates to type A coordinates.
+func BBetaToAAlpha(b, beta float64) (float64, float64) {
+	return BBetaToDirection(b, beta).AAlpha()
+}
+
+// normalized returns the direction scaled to unit length, the zero vector is returned unchanged.
+func (d Direction) normalized() Direction {
+	length := math.Sqrt(d[0]*d[0] + d[1]*d[1] + d[2]*d[2])
+	if length == 0 {
+		return d
+	}
+
+	return Direction{d[0] / length, d[1] / length, d[2] / length}
+}
+
+// sincosDeg returns the sine and cosine of the angle (in degrees). Multiples of 90 degrees yield exact values, so
+// directions in the horizontal plane or on the axes carry no rounding errors.
+func sincosDeg(angle float64) (float64, float64) {
+	switch normalizeAngle(angle) {
+	case 0:
+		return 0, 1
+	case 90:
+		return 1, 0
+	case 180:
+		return 0, -1
+	case 270:
+		return -1, 0
+	}
+
+	return math.Sincos(degToRad(angle))
+}
+
+// clampUnit limits the value to [-1, 1], which protects the inverse trigonometric functions from rounding errors.
+func clampUnit(value float64) float64 {
+	return math.Max(-1, math.Min(1, value))
+}
+
+// signedAngle maps the given angle (in degrees) to the range (-180, 180].
+func signedAngle(angle float64) float64 {
+	angle = normalizeAngle(angle)
+	if angle > 180 {
+		angle -= 360
+	}
+
+	return angle
+}
@@ -0,0 +1,82 @@
+package eulumies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// assertDirection compares the directions component wise.
+func assertDirection(t *testing.T, expected, actual Direction) {
+	for i := range expected {
+		assert.InDelta(t, expected[i], actual[i], 1e-9, "component %d of %v", i, actual)
+	}
+}
+
+func TestCGammaToDirection(t *testing.T) {
+	assertDirection(t, Direction{0, 0, -1}, CGammaToDirection(0, 0))
+	assertDirection(t, Direction{1, 0, 0}, CGammaToDirection(0, 90))
+	assertDirection(t, Direction{0, 1, 0}, CGammaToDirection(90, 90))
+	assertDirection(t, Direction{0, 0, 1}, CGammaToDirection(45, 180))
+
+	c, gamma := CGammaToDirection(300, 35).CGamma()
+	assert.InDelta(t, 300, c, 1e-9)
+	assert.InDelta(t, 35, gamma, 1e-9)
+
+	assert.Equal(t, Direction{0, -1, 0}, CGammaToDirection(270, 90))
+	assert.Equal(t, Direction{0, 0, 1}, CGammaToDirection(0, 180))
+
+	c, gamma = Direction{0, 0, -2}.CGamma()
+	assert.Equal(t, 0.0, c)
+	assert.InDelta(t, 0, gamma, 1e-9)
+}
+
+func TestDirection_BBeta(t *testing.T) {
+	assertDirection(t, Direction{0, 0, -1}, BBetaToDirection(0, 0))
+	assertDirection(t, Direction{1, 0, 0}, BBetaToDirection(90, 0))
+	assertDirection(t, Direction{0, 1, 0}, BBetaToDirection(30, 90))
+
+	b, beta := CGammaToBBeta(90, 30)
+	assert.InDelta(t, 0, b, 1e-9)
+	assert.InDelta(t, 30, beta, 1e-9)
+	b, beta = CGammaToBBeta(180, 40)
+	assert.InDelta(t, -40, b, 1e-9)
+	assert.InDelta(t, 0, beta, 1e-9)
+
+	c, gamma := BBetaToCGamma(CGammaToBBeta(215, 70))
+	assert.InDelta(t, 215, c, 1e-9)
+	assert.InDelta(t, 70, gamma, 1e-9)
+}
+
+func TestDirection_AAlpha(t *testing.T) {
+	assertDirection(t, Direction{0, 0, -1}, AAlphaToDirection(0, 0))
+	assertDirection(t, Direction{0, 1, 0}, AAlphaToDirection(90, 0))
+	assertDirection(t, Direction{1, 0, 0}, AAlphaToDirection(-60, 90))
+
+	a, alpha := CGammaToAAlpha(0, 30)
+	assert.InDelta(t, 0, a, 1e-9)
+	assert.InDelta(t, 30, alpha, 1e-9)
+	a, alpha = CGammaToAAlpha(90, 120)
+	assert.InDelta(t, 120, a, 1e-9)
+	assert.InDelta(t, 0, alpha, 1e-9)
+
+	c, gamma := AAlphaToCGamma(CGammaToAAlpha(100, 150))
+	assert.InDelta(t, 100, c, 1e-9)
+	assert.InDelta(t, 150, gamma, 1e-9)
+
+	b, beta := AAlphaToBBeta(0, 90)
+	assert.InDelta(t, 90, b, 1e-9)
+	assert.InDelta(t, 0, beta, 1e-9)
+	a, alpha = BBetaToAAlpha(AAlphaToBBeta(-35, 20))
+	assert.InDelta(t, -35, a, 1e-9)
+	assert.InDelta(t, 20, alpha, 1e-9)
+}
+
+func TestDirection_Spherical(t *testing.T) {
+	assertDirection(t, Direction{0, 0, 1}, SphericalToDirection(0, 0))
+	assertDirection(t, CGammaToDirection(120, 60), SphericalToDirection(120, 120))
+
+	theta, phi := CGammaToDirection(120, 60).Spherical()
+	assert.InDelta(t, 120, theta, 1e-9)
+	assert.InDelta(t, 120, phi, 1e-9)
+}
@@ -0,0 +1,233 @@
+package eulumies
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DateOrder is the order of day and month assumed for numeric dates like 03/04/2021, where both are possible.
+type DateOrder int
+
+const (
+	DateOrderDayMonth DateOrder = iota // 03/04/2021 is the 3rd of April, the convention of EULUMDAT files.
+	DateOrderMonthDay                  // 03/04/2021 is the 4th of March, the convention of IES files.
+)
+
+// DateConfidence rates how reliable a date found by ParseDate is.
+type DateConfidence int
+
+const (
+	DateConfidenceNone   DateConfidence = iota // No date found.
+	DateConfidenceLow                          // Only the year or month and year found, the missing parts are 1.
+	DateConfidenceMedium                       // Complete date, but the order of day and month or the century is assumed.
+	DateConfidenceHigh                         // Complete and unambiguous date.
+)
+
+// String returns the name of the confidence.
+func (c DateConfidence) String() string {
+	switch c {
+	case DateConfidenceLow:
+		return "low"
+	case DateConfidenceMedium:
+		return "medium"
+	case DateConfidenceHigh:
+		return "high"
+	default:
+		return "none"
+	}
+}
+
+// datePattern finds dates of one notation, parse returns the date and confidence of a match or false if the
+// matched numbers do not form a date.
+type datePattern struct {
+	regex *regexp.Regexp
+	parse func(groups []string, order DateOrder) (time.Time, DateConfidence, bool)
+}
+
+// datePatterns are tried in order, complete dates before partial ones. The first valid match wins.
+var datePatterns = []datePattern{
+	{ // 2021-03-15, 2021/03/15, 2021.03.15
+		regexp.MustCompile(`\b(\d{4})[-/.](\d{1,2})[-/.](\d{1,2})\b`),
+		func(groups []string, _ DateOrder) (time.Time, DateConfidence, bool) {
+			return makeDate(groups[1], groups[2], groups[3], DateConfidenceHigh)
+		},
+	},
+	{ // 15 Mar 2021, 15-March-21, 15. März 2021, 15th of March 2021
+		regexp.MustCompile(`(?i)\b(\d{1,2})(?:st|nd|rd|th)?\.?[\s\-/]*(?:of\s+)?([a-zä]{3,9})\.?[\s\-/,]*(\d{4}|\d{2})\b`),
+		func(groups []string, _ DateOrder) (time.Time, DateConfidence, bool) {
+			month, ok := monthByName(groups[2])
+			if !ok {
+				return time.Time{}, DateConfidenceNone, false
+			}
+			return makeDate(groups[3], strconv.Itoa(month), groups[1], DateConfidenceHigh)
+		},
+	},
+	{ // March 15, 2021, Mar 15th 2021
+		regexp.MustCompile(`(?i)\b([a-zä]{3,9})\.?\s*(\d{1,2})(?:st|nd|rd|th)?,?[\s\-/]+(\d{4})\b`),
+		func(groups []string, _ DateOrder) (time.Time, DateConfidence, bool) {
+			month, ok := monthByName(groups[1])
+			if !ok {
+				return time.Time{}, DateConfidenceNone, false
+			}
+			return makeDate(groups[3], strconv.Itoa(month), groups[2], DateConfidenceHigh)
+		},
+	},
+	{ // 15.03.2021, 03/15/2021, 15-03-21
+		regexp.MustCompile(`\b(\d{1,2})([./-])(\d{1,2})[./-](\d{4}|\d{2})\b`),
+		func(groups []string, order DateOrder) (time.Time, DateConfidence, bool) {
+			first, _ := strconv.Atoi(groups[1])
+			second, _ := strconv.Atoi(groups[3])
+			day, month := groups[1], groups[3]
+			confidence := DateConfidenceHigh
+			switch {
+			case groups[2] == ".": // dots are only used in day.month.year
+			case first > 12:
+			case second > 12:
+				day, month = month, day
+			case first == second:
+			default:
+				confidence = DateConfidenceMedium
+				if order == DateOrderMonthDay {
+					day, month = month, day
+				}
+			}
+			return makeDate(groups[4], month, day, confidence)
+		},
+	},
+	{ // 20210315
+		regexp.MustCompile(`\b((?:19|20)\d{2})(\d{2})(\d{2})\b`),
+		func(groups []string, _ DateOrder) (time.Time, DateConfidence, bool) {
+			return makeDate(groups[1], groups[2], groups[3], DateConfidenceMedium)
+		},
+	},
+	{ // March 2021, Mar. 2021
+		regexp.MustCompile(`(?i)\b([a-zä]{3,9})\.?[\s\-/,]*(\d{4})\b`),
+		func(groups []string, _ DateOrder) (time.Time, DateConfidence, bool) {
+			month, ok := monthByName(groups[1])
+			if !ok {
+				return time.Time{}, DateConfidenceNone, false
+			}
+			return makeDate(groups[2], strconv.Itoa(month), "1", DateConfidenceLow)
+		},
+	},
+	{ // 03/2021, 3.2021
+		regexp.MustCompile(`\b(\d{1,2})[./-](\d{4})\b`),
+		func(groups []string, _ DateOrder) (time.Time, DateConfidence, bool) {
+			return makeDate(groups[2], groups[1], "1", DateConfidenceLow)
+		},
+	},
+	{ // 2021
+		regexp.MustCompile(`\b((?:19|20)\d{2})\b`),
+		func(groups []string, _ DateOrder) (time.Time, DateConfidence, bool) {
+			return makeDate(groups[1], "1", "1", DateConfidenceLow)
+		},
+	},
+}
+
+// monthNames are the English and German month names, abbreviations are matched by prefix.
+var monthNames = [...][]string{
+	{"january", "januar"}, {"february", "februar"}, {"march", "märz", "maerz", "mrz"}, {"april"}, {"may", "mai"},
+	{"june", "juni"}, {"july", "juli"}, {"august"}, {"september"}, {"october", "oktober"}, {"november"},
+	{"december", "dezember"},
+}
+
+// ParseDate searches the free-form text for a date, e.g. in the EULUMDAT "Date / User" field or the IES ISSUEDATE
+// keyword. Numeric, ISO and named month notations (English and German) are recognized, the order resolves numeric
+// dates where day and month could be swapped. The date is returned in UTC with the confidence of the result,
+// DateConfidenceNone if the text contains no date.
+func ParseDate(text string, order DateOrder) (time.Time, DateConfidence) {
+	date, confidence, _, _ := findDate(text, order)
+	return date, confidence
+}
+
+// findDate returns the date found in the text and the position of its notation.
+func findDate(text string, order DateOrder) (date time.Time, confidence DateConfidence, start, end int) {
+	for _, pattern := range datePatterns {
+		for _, match := range pattern.regex.FindAllStringSubmatchIndex(text, -1) {
+			groups := make([]string, len(match)/2)
+			for n := range groups {
+				if match[2*n] >= 0 {
+					groups[n] = text[match[2*n]:match[2*n+1]]
+				}
+			}
+			if date, confidence, ok := pattern.parse(groups, order); ok {
+				return date, confidence, match[0], match[1]
+			}
+		}
+	}
+
+	return time.Time{}, DateConfidenceNone, 0, 0
+}
+
+// makeDate returns the date of the given numbers. Two digit years are mapped to 1970 - 2069, which lowers the
+// confidence to DateConfidenceMedium. Reports false for invalid dates and years outside of 1900 - 2099.
+func makeDate(year, month, day string, confidence DateConfidence) (time.Time, DateConfidence, bool) {
+	y, _ := strconv.Atoi(year)
+	m, _ := strconv.Atoi(month)
+	d, _ := strconv.Atoi(day)
+	if len(year) == 2 {
+		y += 1900
+		if y < 1970 {
+			y += 100
+		}
+		if confidence > DateConfidenceMedium {
+			confidence = DateConfidenceMedium
+		}
+	}
+	if y < 1900 || y > 2099 || m < 1 || m > 12 || d < 1 {
+		return time.Time{}, DateConfidenceNone, false
+	}
+
+	date := time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.UTC)
+	if date.Day() != d {
+		return time.Time{}, DateConfidenceNone, false // day beyond the end of the month
+	}
+
+	return date, confidence, true
+}
+
+// monthByName returns the month of the English or German name or abbreviation of at least three letters.
+func monthByName(name string) (int, bool) {
+	name = strings.ToLower(name)
+	for month, names := range monthNames {
+		for _, full := range names {
+			if strings.HasPrefix(full, name) {
+				return month + 1, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// GuessDate parses the date of the "Date / User" field (12) with ParseDate, day.month order is assumed.
+func (e Eulumdat) GuessDate() (time.Time, DateConfidence) {
+	return ParseDate(e.DateUser, DateOrderDayMonth)
+}
+
+// GuessIssueDate parses the ISSUEDATE keyword, or DATE if it is missing, with ParseDate. Unlike IssueDate it accepts
+// any notation and text around the date, month/day order is assumed.
+func (i *IES) GuessIssueDate() (time.Time, DateConfidence) {
+	text, ok := i.Keywords["ISSUEDATE"]
+	if !ok {
+		text = i.Keywords["DATE"]
+	}
+
+	return ParseDate(text, DateOrderMonthDay)
+}
+
+// formatDate replaces the date found in the text with the date formatted with the layout of the options. The text is
+// returned unchanged if no layout is set or no complete date is found.
+func (o ExportOptions) formatDate(text string, order DateOrder) string {
+	if o.DateLayout == "" {
+		return text
+	}
+	date, confidence, start, end := findDate(text, order)
+	if confidence < DateConfidenceMedium {
+		return text
+	}
+
+	return text[:start] + date.Format(o.DateLayout) + text[end:]
+}
@@ -0,0 +1,64 @@
+package eulumies
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDate(t *testing.T) {
+	tests := []struct {
+		text       string
+		order      DateOrder
+		date       string
+		confidence DateConfidence
+	}{
+		{"2021-03-15", DateOrderDayMonth, "2021-03-15", DateConfidenceHigh},
+		{"15.03.2021 / J. Doe", DateOrderMonthDay, "2021-03-15", DateConfidenceHigh},
+		{"03/15/2021", DateOrderDayMonth, "2021-03-15", DateConfidenceHigh},
+		{"04/03/2021", DateOrderDayMonth, "2021-03-04", DateConfidenceMedium},
+		{"04/03/2021", DateOrderMonthDay, "2021-04-03", DateConfidenceMedium},
+		{"15-Mar-2021", DateOrderDayMonth, "2021-03-15", DateConfidenceHigh},
+		{"15. März 2021", DateOrderDayMonth, "2021-03-15", DateConfidenceHigh},
+		{"Measured on the 2nd of Dezember 2019", DateOrderDayMonth, "2019-12-02", DateConfidenceHigh},
+		{"March 15th, 2021", DateOrderDayMonth, "2021-03-15", DateConfidenceHigh},
+		{"15.03.21", DateOrderDayMonth, "2021-03-15", DateConfidenceMedium},
+		{"20210315", DateOrderDayMonth, "2021-03-15", DateConfidenceMedium},
+		{"Sept. 2018", DateOrderDayMonth, "2018-09-01", DateConfidenceLow},
+		{"06/2018", DateOrderDayMonth, "2018-06-01", DateConfidenceLow},
+		{"Report 2017, Lab 4", DateOrderDayMonth, "2017-01-01", DateConfidenceLow},
+		{"31.02.2021", DateOrderDayMonth, "2021-02-01", DateConfidenceLow}, // invalid day, month and year remain
+	}
+	for _, test := range tests {
+		date, confidence := ParseDate(test.text, test.order)
+		assert.Equal(t, test.date, date.Format("2006-01-02"), test.text)
+		assert.Equal(t, test.confidence, confidence, test.text)
+	}
+
+	date, confidence := ParseDate("unknown", DateOrderDayMonth)
+	assert.True(t, date.IsZero())
+	assert.Equal(t, DateConfidenceNone, confidence)
+	assert.Equal(t, "none", confidence.String())
+}
+
+func TestExportOptions_DateLayout(t *testing.T) {
+	eulumdat := loadSample2(t)
+
+	eulumdat.DateUser = "4.3.2021 / J. Doe"
+	var buffer bytes.Buffer
+	assert.NoError(t, eulumdat.ExportWithOptions(&buffer, ExportOptions{DateLayout: "2006-01-02"}))
+	assert.Contains(t, buffer.String(), "\r\n2021-03-04 / J. Doe\r\n")
+
+	ies, err := NewIES("test/sample.ies", false)
+	assert.NoError(t, err)
+	ies.Keywords["DATE"] = "03/04/21"
+	date, confidence := ies.GuessIssueDate()
+	assert.Equal(t, time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC), date)
+	assert.Equal(t, DateConfidenceMedium, confidence)
+
+	buffer.Reset()
+	assert.NoError(t, ies.ExportTo(&buffer, ExportOptions{DateLayout: "02 Jan 2006"}))
+	assert.Contains(t, buffer.String(), "[DATE] 04 Mar 2021\r\n")
+}
@@ -2,6 +2,7 @@ package eulumies
 
 import (
 	"bufio"
+	"compress/gzip"
 	"fmt"
 	"io"
 	"math"
@@ -15,6 +16,9 @@ import (
 // Reference: http://www.helios32.com/Eulumdat.htm
 // Reference: https://docs.agi32.com/PhotometricToolbox/Content/Open_Tool/eulumdat_file_format.htm
 
+// DirectRatioRoomIndices contains the room indices k for the direct ratios of field 27.
+var DirectRatioRoomIndices = [10]float64{0.6, 0.8, 1.0, 1.25, 1.5, 2.0, 2.5, 3.0, 4.0, 5.0}
+
 // Eulumdat data structure
 type Eulumdat struct {
 	/* 01 */ CompanyIdentification string // 78 char - Company identification/data bank/version/format identification max.
@@ -68,6 +72,15 @@ type Eulumdat struct {
 	 * 4        1            M_c/4+1
 	 */
 
+	// Extension block after field 30, one "[KEY] value" line per entry. It records information without an EULUMDAT
+	// field losslessly, for example the IES NEARFIELD keyword. Readers expecting the fixed field count ignore it.
+	Extensions map[string]string
+
+	// Truncated is set if the data ended within the fields 27 to 30 and was parsed with ParseOptions.AllowTruncated,
+	// ValuesRead is the number of luminous intensities read then. Both are not part of the file format.
+	Truncated  bool `json:"-"`
+	ValuesRead int  `json:"-"`
+
 	// Internal variables, used for calculation only
 	mc1 int
 	mc2 int
@@ -85,163 +98,209 @@ type EulumdatAssembly struct {
 	ColorRenderingIndex string
 }
 
+// assemblyCurrentsKeyword holds the operating currents of the standard sets of lamps, there is no EULUMDAT field for
+// them. ApplyEulumdatAssemblies records them in the extension block, DeriveAssembly interpolates between them.
+const assemblyCurrentsKeyword = "CURRENTS"
+
 // NewEulumdat reads the given input file and parses it to the Eulumdat data structure.
 func NewEulumdat(in io.Reader, strict bool) (Eulumdat, error) {
+	return NewEulumdatWithOptions(in, ParseOptions{Strict: strict})
+}
+
+// NewEulumdatWithOptions reads the given input file and parses it to the Eulumdat data structure, warnings are sent
+// to the logger of the options.
+func NewEulumdatWithOptions(in io.Reader, opts ParseOptions) (Eulumdat, error) {
 	var eulumdat Eulumdat
-	var err error
+	in, err := decompressReader(in)
+	if err != nil {
+		return Eulumdat{}, err
+	}
 	scanner := bufio.NewScanner(in)
 
-	// First load all Header fields, 1 to 26
-	if eulumdat.CompanyIdentification, err = validateStringFromLine(scanner, 78, strict); err != nil {
+	if err = eulumdat.parseHeader(scanner, opts); err != nil {
 		return Eulumdat{}, err
 	}
-	if eulumdat.TypeIndicator, err = validateIntFromLine(scanner); err != nil {
-		return Eulumdat{}, err
+
+	// Now load the 10 ratios from field 27
+	for i := 0; i < 10; i++ {
+		if eulumdat.DirectRatios[i], err = validateFloatFromLine(scanner); opts.truncated(err) {
+			eulumdat.DirectRatios[i] = 0
+			return eulumdat.truncated(), nil
+		} else if err != nil {
+			return Eulumdat{}, err
+		}
 	}
-	if eulumdat.SymmetryIndicator, err = validateIntFromLine(scanner); err != nil {
+
+	// Load all C angles, field 28 and all G angles, field 29
+	if eulumdat.AnglesC, err = readFloatLines(scanner, eulumdat.NumberMcCPlanes); opts.truncated(err) {
+		return eulumdat.truncated(), nil
+	} else if err != nil {
 		return Eulumdat{}, err
 	}
-	if eulumdat.NumberMcCPlanes, err = validateIntFromLine(scanner); err != nil {
+	if eulumdat.AnglesG, err = readFloatLines(scanner, eulumdat.NumberNgIntensitiesCPlane); opts.truncated(err) {
+		return eulumdat.truncated(), nil
+	} else if err != nil {
 		return Eulumdat{}, err
 	}
-	if eulumdat.DistanceDcCPlanes, err = validateFloatFromLine(scanner); err != nil {
+
+	// Calculate M_c1 and M_c2 to load the luminous intensity distribution data from field 30
+	eulumdat.calcMc1andMc2()
+	dataLength := (eulumdat.mc2 - eulumdat.mc1 + 1) * eulumdat.NumberNgIntensitiesCPlane
+	eulumdat.LuminousIntensityDistributionRaw, err = readFloatLines(scanner, dataLength)
+	if opts.truncated(err) {
+		return eulumdat.truncated(), nil
+	} else if err != nil {
 		return Eulumdat{}, err
 	}
-	if eulumdat.NumberNgIntensitiesCPlane, err = validateIntFromLine(scanner); err != nil {
+
+	// Split luminous intensities into planes
+	// Details can be found in QLumEdit Source (eulumdat.cpp, line 234)
+	if err = eulumdat.CalcLuminousIntensityDistributionFromRaw(); err != nil {
 		return Eulumdat{}, err
 	}
-	if eulumdat.DistanceDgCPlane, err = validateFloatFromLine(scanner); err != nil {
+
+	eulumdat.Extensions = parseExtensions(scanner)
+
+	if err := scanner.Err(); err != nil {
 		return Eulumdat{}, err
 	}
-	if eulumdat.MeasurementReportNumber, err = validateStringFromLine(scanner, 78, strict); err != nil {
-		return Eulumdat{}, err
+
+	return eulumdat, nil
+}
+
+// truncated marks the partially parsed data as truncated. The intensities are not split into planes, as the planes
+// are incomplete.
+func (e Eulumdat) truncated() Eulumdat {
+	e.Truncated = true
+	e.ValuesRead = len(e.LuminousIntensityDistributionRaw)
+
+	return e
+}
+
+// parseHeader reads the fields 1 to 26f.
+func (e *Eulumdat) parseHeader(scanner *bufio.Scanner, opts ParseOptions) error {
+	var err error
+	// First load all Header fields, 1 to 26
+	if e.CompanyIdentification, err = validateStringFromLine(scanner, 78, opts); err != nil {
+		return err
 	}
-	if eulumdat.LuminaireName, err = validateStringFromLine(scanner, 78, strict); err != nil {
-		return Eulumdat{}, err
+	if e.TypeIndicator, err = validateIntFromLine(scanner); err != nil {
+		return err
 	}
-	if eulumdat.LuminaireNumber, err = validateStringFromLine(scanner, 78, strict); err != nil {
-		return Eulumdat{}, err
+	if e.SymmetryIndicator, err = validateIntFromLine(scanner); err != nil {
+		return err
 	}
-	if eulumdat.FileName, err = validateStringFromLine(scanner, 8, strict); err != nil {
-		return Eulumdat{}, err
+	if e.SymmetryIndicator < 0 || e.SymmetryIndicator > 4 {
+		return fmt.Errorf("symmetry indicator %d out of range (0 - 4)", e.SymmetryIndicator)
 	}
-	if eulumdat.DateUser, err = validateStringFromLine(scanner, 78, strict); err != nil {
-		return Eulumdat{}, err
+	if e.NumberMcCPlanes, err = validateIntFromLine(scanner); err != nil {
+		return err
 	}
-	if eulumdat.LengthDiameter, err = validateFloatFromLine(scanner); err != nil {
-		return Eulumdat{}, err
+	if err = validateCount("number of C-planes", e.NumberMcCPlanes); err != nil {
+		return err
 	}
-	if eulumdat.WidthLuminaire, err = validateFloatFromLine(scanner); err != nil {
-		return Eulumdat{}, err
+	if e.DistanceDcCPlanes, err = validateFloatFromLine(scanner); err != nil {
+		return err
 	}
-	if eulumdat.HeightLuminaire, err = validateFloatFromLine(scanner); err != nil {
-		return Eulumdat{}, err
+	if e.NumberNgIntensitiesCPlane, err = validateIntFromLine(scanner); err != nil {
+		return err
 	}
-	if eulumdat.LengthDiameterLuminousArea, err = validateFloatFromLine(scanner); err != nil {
-		return Eulumdat{}, err
+	if err = validateCount("number of luminous intensities per C-plane", e.NumberNgIntensitiesCPlane); err != nil {
+		return err
 	}
-	if eulumdat.WidthLuminousArea, err = validateFloatFromLine(scanner); err != nil {
-		return Eulumdat{}, err
+	if e.DistanceDgCPlane, err = validateFloatFromLine(scanner); err != nil {
+		return err
 	}
-	if eulumdat.HeightLuminousAreaC0, err = validateFloatFromLine(scanner); err != nil {
-		return Eulumdat{}, err
+	if e.MeasurementReportNumber, err = validateStringFromLine(scanner, 78, opts); err != nil {
+		return err
 	}
-	if eulumdat.HeightLuminousAreaC90, err = validateFloatFromLine(scanner); err != nil {
-		return Eulumdat{}, err
+	if e.LuminaireName, err = validateStringFromLine(scanner, 78, opts); err != nil {
+		return err
 	}
-	if eulumdat.HeightLuminousAreaC180, err = validateFloatFromLine(scanner); err != nil {
-		return Eulumdat{}, err
+	if e.LuminaireNumber, err = validateStringFromLine(scanner, 78, opts); err != nil {
+		return err
 	}
-	if eulumdat.HeightLuminousAreaC270, err = validateFloatFromLine(scanner); err != nil {
-		return Eulumdat{}, err
+	if e.FileName, err = validateStringFromLine(scanner, 8, opts); err != nil {
+		return err
 	}
-	if eulumdat.DownwardFluxFractionPhiu, err = validateFloatFromLine(scanner); err != nil {
-		return Eulumdat{}, err
+	if e.DateUser, err = validateStringFromLine(scanner, 78, opts); err != nil {
+		return err
 	}
-	if eulumdat.LightOutputRatioLuminaire, err = validateFloatFromLine(scanner); err != nil {
-		return Eulumdat{}, err
+	if e.LengthDiameter, err = validateFloatFromLine(scanner); err != nil {
+		return err
 	}
-	if eulumdat.IntensityConversionFactor, err = validateFloatFromLine(scanner); err != nil {
-		return Eulumdat{}, err
+	if e.WidthLuminaire, err = validateFloatFromLine(scanner); err != nil {
+		return err
 	}
-	if eulumdat.MeasurementTiltLuminaire, err = validateFloatFromLine(scanner); err != nil {
-		return Eulumdat{}, err
+	if e.HeightLuminaire, err = validateFloatFromLine(scanner); err != nil {
+		return err
 	}
-	if eulumdat.NumberStandardSetLamps, err = validateIntFromLine(scanner); err != nil {
-		return Eulumdat{}, err
+	if e.LengthDiameterLuminousArea, err = validateFloatFromLine(scanner); err != nil {
+		return err
+	}
+	if e.WidthLuminousArea, err = validateFloatFromLine(scanner); err != nil {
+		return err
+	}
+	if e.HeightLuminousAreaC0, err = validateFloatFromLine(scanner); err != nil {
+		return err
+	}
+	if e.HeightLuminousAreaC90, err = validateFloatFromLine(scanner); err != nil {
+		return err
+	}
+	if e.HeightLuminousAreaC180, err = validateFloatFromLine(scanner); err != nil {
+		return err
+	}
+	if e.HeightLuminousAreaC270, err = validateFloatFromLine(scanner); err != nil {
+		return err
+	}
+	if e.DownwardFluxFractionPhiu, err = validateFloatFromLine(scanner); err != nil {
+		return err
+	}
+	if e.LightOutputRatioLuminaire, err = validateFloatFromLine(scanner); err != nil {
+		return err
+	}
+	if e.IntensityConversionFactor, err = validateFloatFromLine(scanner); err != nil {
+		return err
+	}
+	if e.MeasurementTiltLuminaire, err = validateFloatFromLine(scanner); err != nil {
+		return err
+	}
+	if e.NumberStandardSetLamps, err = validateIntFromLine(scanner); err != nil {
+		return err
+	}
+	if err = validateCount("number of standard sets of lamps", e.NumberStandardSetLamps); err != nil {
+		return err
 	}
 
 	// Now load measurement data 26a to 26f
-	eulumdat.NumberLamps = make([]int, eulumdat.NumberStandardSetLamps)
-	eulumdat.TypeLamps = make([]string, eulumdat.NumberStandardSetLamps)
-	eulumdat.TotalLuminousFluxLamps = make([]float64, eulumdat.NumberStandardSetLamps)
-	eulumdat.ColorTemperature = make([]string, eulumdat.NumberStandardSetLamps)
-	eulumdat.ColorRenderingIndexCRI = make([]string, eulumdat.NumberStandardSetLamps)
-	eulumdat.BallastWatts = make([]float64, eulumdat.NumberStandardSetLamps)
-	for i := 0; i < eulumdat.NumberStandardSetLamps; i++ {
-		if eulumdat.NumberLamps[i], err = validateIntFromLine(scanner); err != nil {
-			return Eulumdat{}, err
-		}
-		if eulumdat.TypeLamps[i], err = validateStringFromLine(scanner, 24, strict); err != nil {
-			return Eulumdat{}, err
-		}
-		if eulumdat.TotalLuminousFluxLamps[i], err = validateFloatFromLine(scanner); err != nil {
-			return Eulumdat{}, err
-		}
-		if eulumdat.ColorTemperature[i], err = validateStringFromLine(scanner, 16, strict); err != nil {
-			return Eulumdat{}, err
-		}
-		if eulumdat.ColorRenderingIndexCRI[i], err = validateStringFromLine(scanner, 6, strict); err != nil {
-			return Eulumdat{}, err
+	e.NumberLamps = make([]int, e.NumberStandardSetLamps)
+	e.TypeLamps = make([]string, e.NumberStandardSetLamps)
+	e.TotalLuminousFluxLamps = make([]float64, e.NumberStandardSetLamps)
+	e.ColorTemperature = make([]string, e.NumberStandardSetLamps)
+	e.ColorRenderingIndexCRI = make([]string, e.NumberStandardSetLamps)
+	e.BallastWatts = make([]float64, e.NumberStandardSetLamps)
+	for i := 0; i < e.NumberStandardSetLamps; i++ {
+		if e.NumberLamps[i], err = validateIntFromLine(scanner); err != nil {
+			return err
 		}
-		if eulumdat.BallastWatts[i], err = validateFloatFromLine(scanner); err != nil {
-			return Eulumdat{}, err
+		if e.TypeLamps[i], err = validateStringFromLine(scanner, 24, opts); err != nil {
+			return err
 		}
-	}
-
-	// Now load the 10 ratios from field 27
-	for i := 0; i < 10; i++ {
-		if eulumdat.DirectRatios[i], err = validateFloatFromLine(scanner); err != nil {
-			return Eulumdat{}, err
+		if e.TotalLuminousFluxLamps[i], err = validateFloatFromLine(scanner); err != nil {
+			return err
 		}
-	}
-
-	// Load all C angles, field 28 and all G angles, field 29
-	eulumdat.AnglesC = make([]float64, eulumdat.NumberMcCPlanes)
-	for i := 0; i < eulumdat.NumberMcCPlanes; i++ {
-		if eulumdat.AnglesC[i], err = validateFloatFromLine(scanner); err != nil {
-			return Eulumdat{}, err
+		if e.ColorTemperature[i], err = validateStringFromLine(scanner, 16, opts); err != nil {
+			return err
 		}
-	}
-	eulumdat.AnglesG = make([]float64, eulumdat.NumberNgIntensitiesCPlane)
-	for i := 0; i < eulumdat.NumberNgIntensitiesCPlane; i++ {
-		if eulumdat.AnglesG[i], err = validateFloatFromLine(scanner); err != nil {
-			return Eulumdat{}, err
+		if e.ColorRenderingIndexCRI[i], err = validateStringFromLine(scanner, 6, opts); err != nil {
+			return err
 		}
-	}
-
-	// Calculate M_c1 and M_c2 to load the luminous intensity distribution data from field 30
-	eulumdat.calcMc1andMc2()
-	dataLength := (eulumdat.mc2 - eulumdat.mc1 + 1) * eulumdat.NumberNgIntensitiesCPlane
-	eulumdat.LuminousIntensityDistributionRaw = make([]float64, dataLength)
-	for i := 0; i < dataLength; i++ {
-		// All luminous intensities
-		if eulumdat.LuminousIntensityDistributionRaw[i], err = validateFloatFromLine(scanner); err != nil {
-			return Eulumdat{}, err
+		if e.BallastWatts[i], err = validateFloatFromLine(scanner); err != nil {
+			return err
 		}
 	}
 
-	// Split luminous intensities into planes
-	// Details can be found in QLumEdit Source (eulumdat.cpp, line 234)
-	if err = eulumdat.CalcLuminousIntensityDistributionFromRaw(); err != nil {
-		return Eulumdat{}, err
-	}
-
-	if err := scanner.Err(); err != nil {
-		return Eulumdat{}, err
-	}
-
-	return eulumdat, nil
+	return nil
 }
 
 // CopyEulumdat creates a deep copy of the given Eulumdat instance.
@@ -274,14 +333,34 @@ func CopyEulumdat(source Eulumdat) (Eulumdat, error) {
 		copyObject.LuminousIntensityDistribution[i] = make([]float64, len(source.LuminousIntensityDistribution[i]))
 		copy(copyObject.LuminousIntensityDistribution[i], source.LuminousIntensityDistribution[i])
 	}
+	if source.Extensions != nil {
+		copyObject.Extensions = make(map[string]string, len(source.Extensions))
+		for key, value := range source.Extensions {
+			copyObject.Extensions[key] = value
+		}
+	}
 
 	return copyObject, nil
 }
 
 // Export writes the Eulumdat instance to a file.
 func (e Eulumdat) Export(out io.StringWriter) error {
-	if ok, msg := e.Validate(false); !ok {
-		return errors.New(msg)
+	return e.ExportWithOptions(out, ExportOptions{})
+}
+
+// ExportWithOptions writes the Eulumdat instance to a file, numbers are formatted according to the given options.
+func (e Eulumdat) ExportWithOptions(out io.StringWriter, opts ExportOptions) error {
+	if err := e.Validate(false).Err(); err != nil {
+		return err
+	}
+
+	if opts.Gzip {
+		compressed := gzip.NewWriter(writer{out})
+		opts.Gzip = false
+		if err := e.ExportWithOptions(stringWriter{compressed}, opts); err != nil {
+			return err
+		}
+		return compressed.Close()
 	}
 
 	var err error
@@ -297,13 +376,13 @@ func (e Eulumdat) Export(out io.StringWriter) error {
 	if _, err = out.WriteString(strconv.Itoa(e.NumberMcCPlanes) + "\r\n"); err != nil {
 		return err
 	}
-	if _, err = out.WriteString(fmt.Sprintf("%f", e.DistanceDcCPlanes) + "\r\n"); err != nil {
+	if _, err = out.WriteString(opts.formatFloat(e.DistanceDcCPlanes, 6) + "\r\n"); err != nil {
 		return err
 	}
 	if _, err = out.WriteString(strconv.Itoa(e.NumberNgIntensitiesCPlane) + "\r\n"); err != nil {
 		return err
 	}
-	if _, err = out.WriteString(fmt.Sprintf("%f", e.DistanceDgCPlane) + "\r\n"); err != nil {
+	if _, err = out.WriteString(opts.formatFloat(e.DistanceDgCPlane, 6) + "\r\n"); err != nil {
 		return err
 	}
 	if _, err = out.WriteString(e.MeasurementReportNumber + "\r\n"); err != nil {
@@ -318,46 +397,46 @@ func (e Eulumdat) Export(out io.StringWriter) error {
 	if _, err = out.WriteString(e.FileName + "\r\n"); err != nil {
 		return err
 	}
-	if _, err = out.WriteString(e.DateUser + "\r\n"); err != nil {
+	if _, err = out.WriteString(opts.formatDate(e.DateUser, DateOrderDayMonth) + "\r\n"); err != nil {
 		return err
 	}
-	if _, err = out.WriteString(fmt.Sprintf("%f", e.LengthDiameter) + "\r\n"); err != nil {
+	if _, err = out.WriteString(opts.formatFloat(e.LengthDiameter, 6) + "\r\n"); err != nil {
 		return err
 	}
-	if _, err = out.WriteString(fmt.Sprintf("%f", e.WidthLuminaire) + "\r\n"); err != nil {
+	if _, err = out.WriteString(opts.formatFloat(e.WidthLuminaire, 6) + "\r\n"); err != nil {
 		return err
 	}
-	if _, err = out.WriteString(fmt.Sprintf("%f", e.HeightLuminaire) + "\r\n"); err != nil {
+	if _, err = out.WriteString(opts.formatFloat(e.HeightLuminaire, 6) + "\r\n"); err != nil {
 		return err
 	}
-	if _, err = out.WriteString(fmt.Sprintf("%f", e.LengthDiameterLuminousArea) + "\r\n"); err != nil {
+	if _, err = out.WriteString(opts.formatFloat(e.LengthDiameterLuminousArea, 6) + "\r\n"); err != nil {
 		return err
 	}
-	if _, err = out.WriteString(fmt.Sprintf("%f", e.WidthLuminousArea) + "\r\n"); err != nil {
+	if _, err = out.WriteString(opts.formatFloat(e.WidthLuminousArea, 6) + "\r\n"); err != nil {
 		return err
 	}
-	if _, err = out.WriteString(fmt.Sprintf("%f", e.HeightLuminousAreaC0) + "\r\n"); err != nil {
+	if _, err = out.WriteString(opts.formatFloat(e.HeightLuminousAreaC0, 6) + "\r\n"); err != nil {
 		return err
 	}
-	if _, err = out.WriteString(fmt.Sprintf("%f", e.HeightLuminousAreaC90) + "\r\n"); err != nil {
+	if _, err = out.WriteString(opts.formatFloat(e.HeightLuminousAreaC90, 6) + "\r\n"); err != nil {
 		return err
 	}
-	if _, err = out.WriteString(fmt.Sprintf("%f", e.HeightLuminousAreaC180) + "\r\n"); err != nil {
+	if _, err = out.WriteString(opts.formatFloat(e.HeightLuminousAreaC180, 6) + "\r\n"); err != nil {
 		return err
 	}
-	if _, err = out.WriteString(fmt.Sprintf("%f", e.HeightLuminousAreaC270) + "\r\n"); err != nil {
+	if _, err = out.WriteString(opts.formatFloat(e.HeightLuminousAreaC270, 6) + "\r\n"); err != nil {
 		return err
 	}
-	if _, err = out.WriteString(fmt.Sprintf("%f", e.DownwardFluxFractionPhiu) + "\r\n"); err != nil {
+	if _, err = out.WriteString(opts.formatFloat(e.DownwardFluxFractionPhiu, 6) + "\r\n"); err != nil {
 		return err
 	}
-	if _, err = out.WriteString(fmt.Sprintf("%f", e.LightOutputRatioLuminaire) + "\r\n"); err != nil {
+	if _, err = out.WriteString(opts.formatFloat(e.LightOutputRatioLuminaire, 6) + "\r\n"); err != nil {
 		return err
 	}
-	if _, err = out.WriteString(fmt.Sprintf("%f", e.IntensityConversionFactor) + "\r\n"); err != nil {
+	if _, err = out.WriteString(opts.formatFloat(e.IntensityConversionFactor, 6) + "\r\n"); err != nil {
 		return err
 	}
-	if _, err = out.WriteString(fmt.Sprintf("%f", e.MeasurementTiltLuminaire) + "\r\n"); err != nil {
+	if _, err = out.WriteString(opts.formatFloat(e.MeasurementTiltLuminaire, 6) + "\r\n"); err != nil {
 		return err
 	}
 	if _, err = out.WriteString(strconv.Itoa(e.NumberStandardSetLamps) + "\r\n"); err != nil {
@@ -372,7 +451,7 @@ func (e Eulumdat) Export(out io.StringWriter) error {
 		if _, err = out.WriteString(e.TypeLamps[i] + "\r\n"); err != nil {
 			return err
 		}
-		if _, err = out.WriteString(fmt.Sprintf("%f", e.TotalLuminousFluxLamps[i]) + "\r\n"); err != nil {
+		if _, err = out.WriteString(opts.formatFloat(e.TotalLuminousFluxLamps[i], 6) + "\r\n"); err != nil {
 			return err
 		}
 		if _, err = out.WriteString(e.ColorTemperature[i] + "\r\n"); err != nil {
@@ -381,28 +460,28 @@ func (e Eulumdat) Export(out io.StringWriter) error {
 		if _, err = out.WriteString(e.ColorRenderingIndexCRI[i] + "\r\n"); err != nil {
 			return err
 		}
-		if _, err = out.WriteString(fmt.Sprintf("%f", e.BallastWatts[i]) + "\r\n"); err != nil {
+		if _, err = out.WriteString(opts.formatFloat(e.BallastWatts[i], 6) + "\r\n"); err != nil {
 			return err
 		}
 	}
 
 	// 27
 	for i := 0; i < 10; i++ {
-		if _, err = out.WriteString(fmt.Sprintf("%f", e.DirectRatios[i]) + "\r\n"); err != nil {
+		if _, err = out.WriteString(opts.formatFloat(e.DirectRatios[i], 6) + "\r\n"); err != nil {
 			return err
 		}
 	}
 
 	// 28
 	for i := 0; i < e.NumberMcCPlanes; i++ {
-		if _, err = out.WriteString(fmt.Sprintf("%f", e.AnglesC[i]) + "\r\n"); err != nil {
+		if _, err = out.WriteString(opts.formatFloat(e.AnglesC[i], 6) + "\r\n"); err != nil {
 			return err
 		}
 	}
 
 	// 29
 	for i := 0; i < e.NumberNgIntensitiesCPlane; i++ {
-		if _, err = out.WriteString(fmt.Sprintf("%f", e.AnglesG[i]) + "\r\n"); err != nil {
+		if _, err = out.WriteString(opts.formatFloat(e.AnglesG[i], 6) + "\r\n"); err != nil {
 			return err
 		}
 	}
@@ -411,7 +490,19 @@ func (e Eulumdat) Export(out io.StringWriter) error {
 	e.calcMc1andMc2()
 	dataLength := (e.mc2 - e.mc1 + 1) * e.NumberNgIntensitiesCPlane
 	for i := 0; i < dataLength; i++ {
-		if _, err = out.WriteString(fmt.Sprintf("%f", e.LuminousIntensityDistributionRaw[i]) + "\r\n"); err != nil {
+		if _, err = out.WriteString(opts.formatFloat(e.LuminousIntensityDistributionRaw[i], 6) + "\r\n"); err != nil {
+			return err
+		}
+	}
+
+	// Extension block
+	keys := make([]string, 0, len(e.Extensions))
+	for key := range e.Extensions {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if _, err = out.WriteString("[" + key + "] " + e.Extensions[key] + "\r\n"); err != nil {
 			return err
 		}
 	}
@@ -481,44 +572,225 @@ func (e *Eulumdat) CalcLuminousIntensityDistributionFromRaw() error {
 	return nil
 }
 
-// Validate the EULUMDAT Data structure
-func (e Eulumdat) Validate(strict bool) (bool, string) {
-	if strict {
-		// TODO: length checks on all fields
-	}
+// Validate the EULUMDAT Data structure. Strict validation additionally runs the StrictDefault checks, e.g. of the
+// field lengths and value ranges defined by the format. All issues found are returned, use Valid or Err to check for
+// errors.
+func (e Eulumdat) Validate(strict bool) ValidationIssues {
+	return e.ValidateChecks(strictChecks(strict))
+}
 
-	if e.NumberStandardSetLamps != len(e.NumberLamps) {
-		return false, "NumberLamps length mismatch"
-	}
-	if e.NumberStandardSetLamps != len(e.TypeLamps) {
-		return false, "TypeLamps length mismatch"
-	}
-	if e.NumberStandardSetLamps != len(e.TotalLuminousFluxLamps) {
-		return false, "TotalLuminousFluxLamps length mismatch"
-	}
-	if e.NumberStandardSetLamps != len(e.ColorTemperature) {
-		return false, "ColorTemperature length mismatch"
-	}
-	if e.NumberStandardSetLamps != len(e.ColorRenderingIndexCRI) {
-		return false, "ColorRenderingIndexCRI length mismatch"
-	}
-	if e.NumberStandardSetLamps != len(e.BallastWatts) {
-		return false, "BallastWatts length mismatch"
+// ValidateChecks validates the EULUMDAT Data structure like Validate, running only the given strict checks.
+func (e Eulumdat) ValidateChecks(checks StrictChecks) ValidationIssues {
+	var issues ValidationIssues
+	if checks.has(CheckLineLength) {
+		e.validateFieldLengths(&issues)
+	}
+	if checks.has(CheckFieldWidths) {
+		e.validateFieldWidths(&issues)
+	}
+	if checks.has(CheckAngleMonotonicity) {
+		validateAngleOrder(&issues, "AnglesC", e.AnglesC)
+		validateAngleOrder(&issues, "AnglesG", e.AnglesG)
+	}
+	if checks.has(CheckKeywordLegality) {
+		e.validateExtensions(&issues)
+	}
+	if checks.has(CheckValueRanges) {
+		e.validateValueRanges(&issues)
+	}
+	if checks.has(CheckFluxConsistency) {
+		_, fluxIssues := e.CheckFluxConsistency(DefaultFluxTolerance)
+		issues = append(issues, fluxIssues...)
+	}
+
+	lampSets := []struct {
+		field  string
+		length int
+	}{
+		{"NumberLamps", len(e.NumberLamps)},
+		{"TypeLamps", len(e.TypeLamps)},
+		{"TotalLuminousFluxLamps", len(e.TotalLuminousFluxLamps)},
+		{"ColorTemperature", len(e.ColorTemperature)},
+		{"ColorRenderingIndexCRI", len(e.ColorRenderingIndexCRI)},
+		{"BallastWatts", len(e.BallastWatts)},
+	}
+	for _, set := range lampSets {
+		if e.NumberStandardSetLamps != set.length {
+			issues.add(ValidationLengthMismatch, SeverityError, set.field,
+				"%d values for %d standard sets of lamps", set.length, e.NumberStandardSetLamps)
+		}
 	}
 	if e.NumberMcCPlanes != len(e.AnglesC) {
-		return false, "AnglesC length mismatch"
+		issues.add(ValidationLengthMismatch, SeverityError, "AnglesC",
+			"%d angles for %d C-planes", len(e.AnglesC), e.NumberMcCPlanes)
 	}
 	if e.NumberNgIntensitiesCPlane != len(e.AnglesG) {
-		return false, "AnglesG length mismatch"
+		issues.add(ValidationLengthMismatch, SeverityError, "AnglesG",
+			"%d angles for %d luminous intensities per C-plane", len(e.AnglesG), e.NumberNgIntensitiesCPlane)
 	}
 
 	e.calcMc1andMc2()
 	dataLength := (e.mc2 - e.mc1 + 1) * e.NumberNgIntensitiesCPlane
 	if dataLength != len(e.LuminousIntensityDistributionRaw) {
-		return false, "LuminousIntensityDistributionRaw length mismatch"
+		issues.add(ValidationLengthMismatch, SeverityError, "LuminousIntensityDistributionRaw",
+			"%d luminous intensities, expected %d", len(e.LuminousIntensityDistributionRaw), dataLength)
 	}
 
-	return true, ""
+	return issues
+}
+
+// validateFieldLengths checks the lengths of the text fields defined by the EULUMDAT format.
+func (e Eulumdat) validateFieldLengths(issues *ValidationIssues) {
+	headerFields := []struct {
+		name      string
+		value     string
+		maxLength int
+	}{
+		{"CompanyIdentification", e.CompanyIdentification, 78},
+		{"MeasurementReportNumber", e.MeasurementReportNumber, 78},
+		{"LuminaireName", e.LuminaireName, 78},
+		{"LuminaireNumber", e.LuminaireNumber, 78},
+		{"FileName", e.FileName, 8},
+		{"DateUser", e.DateUser, 78},
+	}
+	for _, field := range headerFields {
+		if len(field.value) > field.maxLength {
+			issues.add(ValidationFieldTooLong, SeverityError, field.name, "exceeds %d characters", field.maxLength)
+		}
+	}
+	for i := range e.TypeLamps {
+		if len(e.TypeLamps[i]) > 24 {
+			issues.add(ValidationFieldTooLong, SeverityError, fmt.Sprintf("TypeLamps[%d]", i), "exceeds 24 characters")
+		}
+	}
+	for i := range e.ColorTemperature {
+		if len(e.ColorTemperature[i]) > 16 {
+			issues.add(ValidationFieldTooLong, SeverityError, fmt.Sprintf("ColorTemperature[%d]", i), "exceeds 16 characters")
+		}
+	}
+	for i := range e.ColorRenderingIndexCRI {
+		if len(e.ColorRenderingIndexCRI[i]) > 6 {
+			issues.add(ValidationFieldTooLong, SeverityError, fmt.Sprintf("ColorRenderingIndexCRI[%d]", i), "exceeds 6 characters")
+		}
+	}
+}
+
+// validateFieldWidths checks that the numeric header values fit into the field widths of the EULUMDAT format. Many
+// files exceed them, readers parsing the lines instead of fixed columns accept that, so only warnings are reported.
+func (e Eulumdat) validateFieldWidths(issues *ValidationIssues) {
+	type numericField struct {
+		name  string
+		value float64
+		width int
+	}
+	// the counts are allowed up to 721 and 361, which needs a third digit
+	fields := []numericField{
+		{"NumberMcCPlanes", float64(e.NumberMcCPlanes), 3},
+		{"DistanceDcCPlanes", e.DistanceDcCPlanes, 5},
+		{"NumberNgIntensitiesCPlane", float64(e.NumberNgIntensitiesCPlane), 3},
+		{"DistanceDgCPlane", e.DistanceDgCPlane, 5},
+		{"LengthDiameter", e.LengthDiameter, 4},
+		{"WidthLuminaire", e.WidthLuminaire, 4},
+		{"HeightLuminaire", e.HeightLuminaire, 4},
+		{"LengthDiameterLuminousArea", e.LengthDiameterLuminousArea, 4},
+		{"WidthLuminousArea", e.WidthLuminousArea, 4},
+		{"HeightLuminousAreaC0", e.HeightLuminousAreaC0, 4},
+		{"HeightLuminousAreaC90", e.HeightLuminousAreaC90, 4},
+		{"HeightLuminousAreaC180", e.HeightLuminousAreaC180, 4},
+		{"HeightLuminousAreaC270", e.HeightLuminousAreaC270, 4},
+		{"DownwardFluxFractionPhiu", e.DownwardFluxFractionPhiu, 4},
+		{"LightOutputRatioLuminaire", e.LightOutputRatioLuminaire, 4},
+		{"IntensityConversionFactor", e.IntensityConversionFactor, 6},
+		{"MeasurementTiltLuminaire", e.MeasurementTiltLuminaire, 6},
+		{"NumberStandardSetLamps", float64(e.NumberStandardSetLamps), 4},
+	}
+	for i := range e.NumberLamps {
+		fields = append(fields, numericField{fmt.Sprintf("NumberLamps[%d]", i), float64(e.NumberLamps[i]), 4})
+	}
+	for i := range e.TotalLuminousFluxLamps {
+		fields = append(fields, numericField{fmt.Sprintf("TotalLuminousFluxLamps[%d]", i), e.TotalLuminousFluxLamps[i], 12})
+	}
+	for i := range e.BallastWatts {
+		fields = append(fields, numericField{fmt.Sprintf("BallastWatts[%d]", i), e.BallastWatts[i], 8})
+	}
+
+	for _, field := range fields {
+		if text := strconv.FormatFloat(field.value, 'f', -1, 64); len(text) > field.width {
+			issues.add(ValidationFieldTooWide, SeverityWarning, field.name, "%s exceeds the field width of %d",
+				text, field.width)
+		}
+	}
+}
+
+// validateExtensions checks the keys and values of the extension block.
+func (e Eulumdat) validateExtensions(issues *ValidationIssues) {
+	for key, value := range e.Extensions {
+		if strings.ContainsAny(key, "[]\r\n") || strings.ContainsAny(value, "\r\n") {
+			issues.add(ValidationInvalidKeyword, SeverityError, "Extensions", "invalid extension %s", key)
+		}
+	}
+	if value, ok := e.Extensions[nearFieldKeyword]; ok {
+		validateNearField(issues, "Extensions", value)
+	}
+	if value, ok := e.Extensions[assemblyCurrentsKeyword]; ok {
+		if _, err := parseKeywordNumbers(assemblyCurrentsKeyword, value, e.NumberStandardSetLamps); err != nil {
+			issues.add(ValidationInvalidKeyword, SeverityError, "Extensions", "%v", err)
+		}
+	}
+}
+
+// validateValueRanges checks the value ranges and the consistency of the values defined by the EULUMDAT format.
+func (e Eulumdat) validateValueRanges(issues *ValidationIssues) {
+	if e.TypeIndicator < 1 || e.TypeIndicator > 3 {
+		issues.add(ValidationOutOfRange, SeverityError, "TypeIndicator", "%d out of range (1 - 3)", e.TypeIndicator)
+	}
+	if e.SymmetryIndicator < 0 || e.SymmetryIndicator > 4 {
+		issues.add(ValidationOutOfRange, SeverityError, "SymmetryIndicator", "%d out of range (0 - 4)", e.SymmetryIndicator)
+	}
+	if e.NumberMcCPlanes < 1 || e.NumberMcCPlanes > 721 {
+		issues.add(ValidationOutOfRange, SeverityError, "NumberMcCPlanes", "%d out of range (1 - 721)", e.NumberMcCPlanes)
+	}
+	if e.NumberNgIntensitiesCPlane < 1 || e.NumberNgIntensitiesCPlane > 361 {
+		issues.add(ValidationOutOfRange, SeverityError, "NumberNgIntensitiesCPlane",
+			"%d out of range (1 - 361)", e.NumberNgIntensitiesCPlane)
+	}
+	if e.DistanceDcCPlanes < 0 {
+		issues.add(ValidationOutOfRange, SeverityError, "DistanceDcCPlanes", "negative angular distance")
+	} else if e.NumberMcCPlanes > 1 && e.DistanceDcCPlanes > 0 &&
+		math.Abs(float64(e.NumberMcCPlanes)*e.DistanceDcCPlanes-360) > 1e-6 {
+		issues.add(ValidationSpacingMismatch, SeverityError, "DistanceDcCPlanes",
+			"%d C-planes with a distance of %g degrees do not cover 360 degrees", e.NumberMcCPlanes, e.DistanceDcCPlanes)
+	} else if index := angleSpacingMismatch(e.AnglesC, e.DistanceDcCPlanes); e.DistanceDcCPlanes > 0 && index >= 0 {
+		issues.add(ValidationSpacingMismatch, SeverityWarning, "AnglesC",
+			"angle %g at index %d does not match the distance of %g degrees", e.AnglesC[index], index, e.DistanceDcCPlanes)
+	}
+	if e.DistanceDgCPlane < 0 {
+		issues.add(ValidationOutOfRange, SeverityError, "DistanceDgCPlane", "negative angular distance")
+	} else if e.NumberNgIntensitiesCPlane > 1 && e.DistanceDgCPlane > 0 {
+		coverage := float64(e.NumberNgIntensitiesCPlane-1) * e.DistanceDgCPlane
+		if math.Abs(coverage-90) > 1e-6 && math.Abs(coverage-180) > 1e-6 {
+			issues.add(ValidationSpacingMismatch, SeverityError, "DistanceDgCPlane",
+				"%d intensities with a distance of %g degrees do not cover 90 or 180 degrees",
+				e.NumberNgIntensitiesCPlane, e.DistanceDgCPlane)
+		} else if index := angleSpacingMismatch(e.AnglesG, e.DistanceDgCPlane); index >= 0 {
+			issues.add(ValidationSpacingMismatch, SeverityWarning, "AnglesG",
+				"angle %g at index %d does not match the distance of %g degrees", e.AnglesG[index], index, e.DistanceDgCPlane)
+		}
+	}
+	if e.DownwardFluxFractionPhiu < 0 || e.DownwardFluxFractionPhiu > 100 {
+		issues.add(ValidationOutOfRange, SeverityWarning, "DownwardFluxFractionPhiu",
+			"%g out of range (0 - 100)", e.DownwardFluxFractionPhiu)
+	}
+	if e.LightOutputRatioLuminaire < 0 {
+		issues.add(ValidationOutOfRange, SeverityWarning, "LightOutputRatioLuminaire", "negative light output ratio")
+	}
+	if e.DirectRatios == [10]float64{} {
+		issues.add(ValidationOutOfRange, SeverityWarning, "DirectRatios", "all direct ratios are zero")
+	}
+	if e.IsAbsolutePhotometry() && math.Abs(e.LightOutputRatioLuminaire-100) > 0.5 {
+		issues.add(ValidationInconsistentValues, SeverityWarning, "LightOutputRatioLuminaire",
+			"absolute photometry requires a light output ratio of 100%%, got %g", e.LightOutputRatioLuminaire)
+	}
 }
 
 // GetMaximumLuminousIntensity returns the maximum luminous intensity for the given C-Plane
@@ -532,6 +804,66 @@ func (e Eulumdat) GetMaximumLuminousIntensity(planeIndex int) float64 {
 	return max
 }
 
+// GetPlaneFlux returns the luminous flux (lm) of the sector represented by the given C-plane, obtained by integrating
+// over all gamma angles. Planes standing for mirrored planes due to the symmetry include the flux of their mirrored
+// sectors, so the flux of all planes sums up to ComputeTotalFlux. Returns -1 if the plane does not exist.
+func (e Eulumdat) GetPlaneFlux(planeIndex int) float64 {
+	if planeIndex < 0 || planeIndex >= len(e.LuminousIntensityDistribution) ||
+		len(e.LuminousIntensityDistribution[planeIndex]) == 0 {
+		return -1
+	}
+
+	cAngles, planes := e.expandedDistribution()
+	flux := 0.0
+	for c, angle := range cAngles {
+		if e.storedPlaneIndex(angle) == planeIndex {
+			flux += planeFlux(cAngles, e.AnglesG, planes, c)
+		}
+	}
+
+	return flux * e.intensityScale(0)
+}
+
+// storedPlaneIndex returns the index of the stored C-plane (LuminousIntensityDistribution) representing the C-plane
+// at the given angle according to the symmetry indicator. Returns -1 if no stored plane matches the angle.
+func (e Eulumdat) storedPlaneIndex(angle float64) int {
+	angle = normalizeAngle(angle)
+	switch e.SymmetryIndicator {
+	case 1:
+		return 0
+	case 2: // C180 - C360 mirror C180 - C0
+		if angle > 180 {
+			angle = 360 - angle
+		}
+	case 3: // C90 - C270 mirror C90 - C-90, the stored planes range from C270 to C90
+		if angle > 90 && angle < 270 {
+			angle = normalizeAngle(180 - angle)
+		}
+	case 4: // each quadrant mirrors C0 - C90
+		switch {
+		case angle > 270:
+			angle = 360 - angle
+		case angle > 180:
+			angle -= 180
+		case angle > 90:
+			angle = 180 - angle
+		}
+	}
+
+	if len(e.AnglesC) == 0 {
+		return -1
+	}
+	e.calcMc1andMc2()
+	for i := range e.LuminousIntensityDistribution {
+		stored := e.AnglesC[(e.mc1-1+i)%len(e.AnglesC)]
+		if math.Abs(normalizeAngle(stored)-angle) < 1e-9 {
+			return i
+		}
+	}
+
+	return -1
+}
+
 // GetOverallMaximumLuminousIntensity returns the maximum luminous intensity of all C-Planes
 func (e Eulumdat) GetOverallMaximumLuminousIntensity() float64 {
 	max := 0.0
@@ -542,42 +874,397 @@ func (e Eulumdat) GetOverallMaximumLuminousIntensity() float64 {
 	return max
 }
 
-// GetFwhm returns the full width at half maximum angle.
-func (e Eulumdat) GetFwhm(planeIndex int) float64 {
-	if planeIndex == -1 || planeIndex >= e.mc {
-		return -1 // plane does not exist
+// expandedDistribution returns the C-plane angles and luminous intensities with the symmetry resolved.
+// For rotationally symmetric luminaires (I_sym = 1) only the single C0 plane is returned.
+func (e Eulumdat) expandedDistribution() ([]float64, [][]float64) {
+	if len(e.LuminousIntensityDistribution) == 0 || len(e.AnglesC) == 0 {
+		return nil, nil
 	}
 
-	// only makes sense if luminaire field is symmetric
-	if e.SymmetryIndicator != 1 && e.SymmetryIndicator != 4 {
-		return -1
+	e.calcMc1andMc2()
+	angles := make([]float64, len(e.LuminousIntensityDistribution))
+	for i := range angles {
+		angles[i] = e.AnglesC[(e.mc1-1+i)%len(e.AnglesC)]
 	}
+	planes := e.LuminousIntensityDistribution
 
-	maxIntensity := e.GetMaximumLuminousIntensity(planeIndex)
-	targetIntensity := maxIntensity / 2
+	switch e.SymmetryIndicator {
+	case 1:
+		return []float64{0}, planes[:1]
+	case 2:
+		return mirrorPlanes(angles, planes, func(c float64) float64 { return 360 - c })
+	case 3:
+		return mirrorPlanes(angles, planes, func(c float64) float64 { return 180 - c })
+	case 4:
+		angles, planes = mirrorPlanes(angles, planes, func(c float64) float64 { return 180 - c })
+		return mirrorPlanes(angles, planes, func(c float64) float64 { return 360 - c })
+	}
 
-	// find the closest angle to halfMaxI
-	minDiff := math.MaxFloat64 // init as large as possible as we want to find the minimum
+	return normalizePlanes(angles, planes)
+}
 
-	angle := -1.0
-	planeIntensities := e.LuminousIntensityDistribution[planeIndex]
-	for intensityIndex, intensity := range planeIntensities {
-		diff := math.Abs(intensity - targetIntensity)
-		if diff < minDiff && e.AnglesG[intensityIndex] <= 90 { // <= 90, assume that ivmax is located between 0 and 90 °
-			minDiff = diff
-			angle = e.AnglesG[intensityIndex]
+// GetPeakIntensity returns the maximum luminous intensity (cd) of the first standard set of lamps together with the
+// C-plane and gamma angle where it occurs. If the maximum occurs multiple times, the first occurrence is returned.
+func (e Eulumdat) GetPeakIntensity() (intensity, c, gamma float64) {
+	if len(e.AnglesC) == 0 {
+		return 0, 0, 0
+	}
+
+	e.calcMc1andMc2()
+	intensity = -1
+	for planeIndex, plane := range e.LuminousIntensityDistribution {
+		for gammaIndex, value := range plane {
+			if value > intensity && gammaIndex < len(e.AnglesG) {
+				intensity = value
+				c = e.AnglesC[(e.mc1-1+planeIndex)%len(e.AnglesC)]
+				gamma = e.AnglesG[gammaIndex]
+			}
 		}
 	}
+	if intensity < 0 {
+		return 0, 0, 0
+	}
 
-	if angle < 0 {
-		return -1
+	return intensity * e.intensityScale(0), c, gamma
+}
+
+// MaxIntensityDirection returns the direction of the maximum luminous intensity after symmetry expansion, as unit
+// vector and C-plane and gamma angle. Maxima occurring in multiple directions, e.g. mirrored by the symmetry, resolve
+// to the lowest C-plane angle. The zero vector is returned if there is no distribution.
+func (e Eulumdat) MaxIntensityDirection() (direction Direction, c, gamma float64) {
+	cAngles, planes := e.expandedDistribution()
+	c, gamma, ok := peakPosition(cAngles, e.AnglesG, planes)
+	if !ok {
+		return Direction{}, 0, 0
+	}
+
+	return CGammaToDirection(c, gamma), c, gamma
+}
+
+// Fingerprint returns a stable hash of the luminous intensity distribution after symmetry expansion. All metadata is
+// ignored, so duplicate measurements published under different names (or in different formats) share a fingerprint.
+func (e Eulumdat) Fingerprint() string {
+	cAngles, planes := e.expandedDistribution()
+	return distributionFingerprint(cAngles, e.AnglesG, planes)
+}
+
+// GetBeamCentroid returns the direction (C-plane and gamma angle) of the flux weighted centroid of the luminous
+// intensity distribution. The gamma angle is the tilt of the beam axis from nadir, useful to check the aiming
+// of asymmetric luminaires.
+func (e Eulumdat) GetBeamCentroid() (c, gamma float64) {
+	cAngles, planes := e.expandedDistribution()
+	if len(cAngles) == 0 {
+		return 0, 0
 	}
 
-	return angle * 2
+	return beamCentroid(cAngles, e.AnglesG, planes)
+}
+
+// ComputeRelativeFlux integrates the luminous intensity distribution over the sphere using the zonal method.
+// The result is the luminaire flux in lumen per 1000 lumen of lamp flux.
+func (e Eulumdat) ComputeRelativeFlux() float64 {
+	cAngles, planes := e.expandedDistribution()
+	if len(cAngles) == 0 {
+		return 0
+	}
+
+	return zonalFlux(cAngles, e.AnglesG, planes) * e.relativeScale()
+}
+
+// ComputeTotalFlux returns the luminaire flux in lumen, obtained by integrating the luminous intensity distribution.
+// The relative intensities are scaled by the total luminous flux of the first standard set of lamps.
+func (e Eulumdat) ComputeTotalFlux() float64 {
+	return e.ComputeRelativeFlux() * e.lampFlux() / 1000
+}
+
+// GetLuminaireFlux returns the rated luminaire flux in lumen. For absolute photometry this is the flux stored in the
+// first standard set of lamps, otherwise the lamp flux reduced by the light output ratio (field 23).
+func (e Eulumdat) GetLuminaireFlux() float64 {
+	if e.IsAbsolutePhotometry() {
+		return e.lampFlux()
+	}
+
+	return e.lampFlux() * e.LightOutputRatioLuminaire / 100
+}
+
+// GetAbsoluteLuminousIntensityDistribution returns the stored C-planes in candela instead of cd/klm.
+func (e Eulumdat) GetAbsoluteLuminousIntensityDistribution() [][]float64 {
+	return scalePlanes(e.LuminousIntensityDistribution, e.intensityScale(0))
+}
+
+// lampFlux returns the total luminous flux of the first standard set of lamps.
+// If no lamp set is defined, 1000 lumen are assumed so that relative values are returned.
+func (e Eulumdat) lampFlux() float64 {
+	if len(e.TotalLuminousFluxLamps) > 0 && e.TotalLuminousFluxLamps[0] != 0 {
+		return math.Abs(e.TotalLuminousFluxLamps[0])
+	}
+
+	return 1000
+}
+
+// relativeScale returns the factor converting the stored values to cd/klm related to the lamp flux.
+func (e Eulumdat) relativeScale() float64 {
+	if e.absoluteByConversionFactor(0) || e.IntensityConversionFactor <= 0 {
+		return 1
+	}
+
+	return e.IntensityConversionFactor
+}
+
+// intensityScale returns the factor converting the stored values to candela for the given standard set of lamps.
+func (e Eulumdat) intensityScale(set int) float64 {
+	flux := 1000.0
+	if set < len(e.TotalLuminousFluxLamps) && e.TotalLuminousFluxLamps[set] != 0 {
+		flux = math.Abs(e.TotalLuminousFluxLamps[set])
+	}
+	if e.absoluteByConversionFactor(set) {
+		return e.IntensityConversionFactor
+	}
+
+	return e.relativeScale() * flux / 1000
+}
+
+// Scale multiplies the luminous flux of all standard sets of lamps by the given factor, which scales the absolute
+// luminous intensities as well. The light output ratio stays unchanged. If the conversion factor carries the
+// luminaire flux of absolute photometry, it is scaled too.
+func (e *Eulumdat) Scale(factor float64) error {
+	if factor <= 0 || math.IsInf(factor, 0) || math.IsNaN(factor) {
+		return fmt.Errorf("invalid scale factor %g", factor)
+	}
+
+	if e.absoluteByConversionFactor(0) {
+		e.IntensityConversionFactor *= factor
+	}
+	for i := range e.TotalLuminousFluxLamps {
+		e.TotalLuminousFluxLamps[i] *= factor
+	}
+
+	return nil
+}
+
+// ComputeLightOutputRatio returns the light output ratio (%) obtained by integrating the luminous intensity distribution.
+// The value can be used to verify field 23 (LightOutputRatioLuminaire).
+func (e Eulumdat) ComputeLightOutputRatio() float64 {
+	return e.ComputeRelativeFlux() / 10
+}
+
+// ComputeUpwardLightRatio returns the upward light ratio ULR, the share of the luminaire flux emitted above the
+// horizontal plane.
+func (e Eulumdat) ComputeUpwardLightRatio() float64 {
+	cAngles, planes := e.expandedDistribution()
+	if len(cAngles) == 0 {
+		return 0
+	}
+
+	total := zonalFlux(cAngles, e.AnglesG, planes)
+	if total <= 0 {
+		return 0
+	}
+
+	return zonalFluxBetween(cAngles, e.AnglesG, planes, 90, 180) / total
+}
+
+// ComputeUpwardLightOutputRatio returns the upward light output ratio ULOR, the flux emitted above the horizontal
+// plane relative to the lamp flux.
+func (e Eulumdat) ComputeUpwardLightOutputRatio() float64 {
+	cAngles, planes := e.expandedDistribution()
+	if len(cAngles) == 0 {
+		return 0
+	}
+
+	return zonalFluxBetween(cAngles, e.AnglesG, planes, 90, 180) * e.relativeScale() / 1000
+}
+
+// IsDarkSkyCompliant reports whether the luminaire emits no light at or above the horizontal plane.
+func (e Eulumdat) IsDarkSkyCompliant() bool {
+	return !emitsAboveHorizontal(e.AnglesG, e.LuminousIntensityDistribution)
+}
+
+// IsAbsolutePhotometry reports whether the first standard set of lamps describes absolute photometry. Absolute
+// photometry is marked by a negative number of lamps, the lamp flux field then holds the luminaire flux. LED files
+// often use a second convention: the conversion factor (field 24) carries the luminaire flux in klm and the lamp
+// flux field repeats it as 1000 times the conversion factor.
+func (e Eulumdat) IsAbsolutePhotometry() bool {
+	return e.isAbsoluteSet(0)
+}
+
+func (e Eulumdat) isAbsoluteSet(set int) bool {
+	return (set < len(e.NumberLamps) && e.NumberLamps[set] < 0) || e.absoluteByConversionFactor(set)
+}
+
+// absoluteByConversionFactor reports whether the lamp flux of the given set is 1000 times the conversion factor.
+func (e Eulumdat) absoluteByConversionFactor(set int) bool {
+	if e.IntensityConversionFactor <= 0 || e.IntensityConversionFactor == 1 || set >= len(e.TotalLuminousFluxLamps) {
+		return false
+	}
+
+	flux := 1000 * e.IntensityConversionFactor
+	return math.Abs(math.Abs(e.TotalLuminousFluxLamps[set])-flux) <= flux*1e-4
+}
+
+// Efficacy returns the luminous efficacy (lm/W) of the given standard set of lamps, calculated from the total
+// luminous flux of the lamps and the wattage including ballast. For absolute photometry (negative number of lamps)
+// the flux field holds the luminaire flux, so the result is the luminaire efficacy.
+func (e Eulumdat) Efficacy(assemblyIndex int) (float64, error) {
+	if assemblyIndex < 0 || assemblyIndex >= len(e.TotalLuminousFluxLamps) || assemblyIndex >= len(e.BallastWatts) {
+		return 0, fmt.Errorf("lamp set %d does not exist", assemblyIndex)
+	}
+	if e.BallastWatts[assemblyIndex] <= 0 {
+		return 0, fmt.Errorf("lamp set %d has no wattage", assemblyIndex)
+	}
+
+	return math.Abs(e.TotalLuminousFluxLamps[assemblyIndex]) / e.BallastWatts[assemblyIndex], nil
+}
+
+// ComputeDirectRatio returns the direct ratio (share of the downward flux falling directly onto the working plane)
+// for the given room index.
+func (e Eulumdat) ComputeDirectRatio(roomIndex float64) float64 {
+	cAngles, planes := e.expandedDistribution()
+	if len(cAngles) == 0 {
+		return 0
+	}
+
+	return directRatios(cAngles, e.AnglesG, planes, []float64{roomIndex})[0]
+}
+
+// ComputeDirectRatios returns the direct ratios for the room indices k = 0.6 ... 5 of field 27.
+func (e Eulumdat) ComputeDirectRatios() [10]float64 {
+	var ratios [10]float64
+	cAngles, planes := e.expandedDistribution()
+	if len(cAngles) == 0 {
+		return ratios
+	}
+
+	copy(ratios[:], directRatios(cAngles, e.AnglesG, planes, DirectRatioRoomIndices[:]))
+	return ratios
+}
+
+// FillDirectRatios calculates the direct ratios from the luminous intensity distribution and stores them in field 27.
+func (e *Eulumdat) FillDirectRatios() {
+	ratios := e.ComputeDirectRatios()
+	for i := range ratios {
+		e.DirectRatios[i] = math.Round(ratios[i]*1000) / 1000
+	}
+}
+
+// ComputeUtilizationFactor returns the utilization factor for the given room index, assuming a room without
+// any reflections (all reflectances zero). Only the flux falling directly onto the working plane is taken into account.
+func (e Eulumdat) ComputeUtilizationFactor(roomIndex float64) float64 {
+	return e.ComputeDirectRatio(roomIndex) * e.DownwardFluxFractionPhiu / 100 * e.LightOutputRatioLuminaire / 100
+}
+
+// GetSpacingCriterion returns the spacing criterion (maximum spacing to mounting height ratio) for luminaires
+// aligned along the C0-C180 plane, the C90-C270 plane and the diagonal.
+func (e Eulumdat) GetSpacingCriterion() SpacingCriterion {
+	cAngles, planes := e.expandedDistribution()
+	if len(cAngles) == 0 {
+		return SpacingCriterion{}
+	}
+
+	return computeSpacingCriterion(cAngles, e.AnglesG, planes)
+}
+
+// AverageLuminanceAt returns the average luminance (cd/m²) of the luminous area seen from the given direction
+// (C-plane and gamma angle in degrees). The luminous intensities are scaled by the flux of the first lamp set.
+func (e Eulumdat) AverageLuminanceAt(c, gamma float64) float64 {
+	area := e.luminousArea().projectedArea(c, gamma)
+	if area <= 0 {
+		return 0
+	}
+
+	return e.IntensityFunc()(c, gamma) / area
+}
+
+// ComputeGlareLuminances returns the maximum average luminance (cd/m²) of all C-planes at the gamma angles 65, 70, 75,
+// 80 and 85 degrees, which EN 12464-1 limits for luminaires illuminating screen work areas. The luminous intensities
+// are scaled by the flux of the first lamp set.
+func (e Eulumdat) ComputeGlareLuminances() [5]float64 {
+	return computeGlareLuminances(e.IntensityFunc(), e.luminousArea())
+}
+
+// IlluminanceGrid calculates the horizontal illuminance on a ground grid for the given installation.
+// The luminous intensities are scaled by the flux of the first lamp set.
+func (e Eulumdat) IlluminanceGrid(opts IlluminanceGridOptions) (IlluminanceGrid, error) {
+	return computeIlluminanceGrid(e.IntensityFunc(), opts)
+}
+
+// ThresholdIncrement calculates the threshold increment TI (%) for the given road lighting installation.
+// The luminous intensities are scaled by the flux of the first lamp set.
+func (e Eulumdat) ThresholdIncrement(opts ThresholdIncrementOptions) (float64, error) {
+	return computeThresholdIncrement(e.IntensityFunc(), opts)
+}
+
+// ComputeUGR calculates the unified glare rating for the given room. The luminous intensities are scaled by the flux
+// of the first lamp set, the luminous area (fields 16 to 21) is required.
+func (e Eulumdat) ComputeUGR(opts UGROptions) (float64, error) {
+	return computeUGR(e.IntensityFunc(), e.luminousArea(), e.ComputeTotalFlux(), opts)
+}
+
+// GetIntensityClass returns the strictest luminous intensity class (G*1 to G*6) of EN 13201-2 met by the
+// luminaire, or an empty string if no class is met.
+func (e Eulumdat) GetIntensityClass() string {
+	planes := make([][]float64, len(e.LuminousIntensityDistribution))
+	for i, plane := range e.LuminousIntensityDistribution {
+		planes[i] = make([]float64, len(plane))
+		for j := range plane {
+			planes[i][j] = plane[j] * e.relativeScale()
+		}
+	}
+
+	return computeIntensityClass(e.AnglesG, planes)
+}
+
+// ComputeCutOffAngles returns the cut-off angles of the principal C-planes, the gamma angles beyond which the
+// luminous intensity stays below the given threshold (cd/klm). A threshold of zero uses DefaultCutOffThreshold.
+func (e Eulumdat) ComputeCutOffAngles(threshold float64) (CutOffAngles, error) {
+	cAngles, planes := e.expandedDistribution()
+	return computeCutOffAngles(cAngles, e.AnglesG, planes, e.relativeScale(), threshold)
+}
+
+// ComputeShapeMetrics returns the metrics and the classification of the shape of the luminous intensity distribution.
+func (e Eulumdat) ComputeShapeMetrics() (ShapeMetrics, error) {
+	cAngles, planes := e.expandedDistribution()
+	return computeShapeMetrics(cAngles, e.AnglesG, planes)
+}
+
+// IntensityFunc returns a function yielding the absolute luminous intensity (cd) in a given direction.
+func (e Eulumdat) IntensityFunc() IntensityFunc {
+	cAngles, planes := e.expandedDistribution()
+	factor := e.intensityScale(0)
+	return func(c, gamma float64) float64 {
+		return interpolateIntensity(cAngles, e.AnglesG, planes, c, gamma) * factor
+	}
+}
+
+// luminousArea returns the luminous area geometry from fields 16 to 21.
+func (e Eulumdat) luminousArea() luminousArea {
+	return luminousArea{
+		length:     e.LengthDiameterLuminousArea / 1000,
+		width:      e.WidthLuminousArea / 1000,
+		circular:   e.WidthLuminousArea == 0,
+		heightC0:   e.HeightLuminousAreaC0 / 1000,
+		heightC90:  e.HeightLuminousAreaC90 / 1000,
+		heightC180: e.HeightLuminousAreaC180 / 1000,
+		heightC270: e.HeightLuminousAreaC270 / 1000,
+	}
+}
+
+// GetFwhm returns the full width at half maximum angle.
+// The half maximum crossings are interpolated on both sides of the peak, using the opposite C-plane (C+180) for
+// the second half of the beam.
+func (e Eulumdat) GetFwhm(planeIndex int) float64 {
+	return e.getBeamWidth(planeIndex, 0.5)
 }
 
 // GetFwtm returns the full width at 1/10 maximum angle.
+// The 1/10 maximum crossings are interpolated on both sides of the peak, using the opposite C-plane (C+180) for
+// the second half of the beam.
 func (e Eulumdat) GetFwtm(planeIndex int) float64 {
+	return e.getBeamWidth(planeIndex, 0.1)
+}
+
+// getBeamWidth returns the full beam width at the given fraction of the maximum intensity for the given C-plane.
+func (e Eulumdat) getBeamWidth(planeIndex int, fraction float64) float64 {
 	if planeIndex == -1 || planeIndex >= e.mc {
 		return -1 // plane does not exist
 	}
@@ -587,27 +1274,33 @@ func (e Eulumdat) GetFwtm(planeIndex int) float64 {
 		return -1
 	}
 
-	maxIntensity := e.GetMaximumLuminousIntensity(planeIndex)
-	targetIntensity := maxIntensity / 10
+	cAngles, planes := e.expandedDistribution()
+	if len(cAngles) == 0 {
+		return -1
+	}
+
+	e.calcMc1andMc2()
+	planeAngle := e.AnglesC[(e.mc1-1+planeIndex)%len(e.AnglesC)]
+	angles, values := planeProfile(cAngles, e.AnglesG, planes, planeAngle)
 
-	// find the closest angle to halfMaxI
-	minDiff := math.MaxFloat64 // init as large as possible as we want to find the minimum
+	return beamWidth(angles, values, fraction)
+}
 
-	angle := -1.0
-	planeIntensities := e.LuminousIntensityDistribution[planeIndex]
-	for intensityIndex, intensity := range planeIntensities {
-		diff := math.Abs(intensity - targetIntensity)
-		if diff < minDiff && e.AnglesG[intensityIndex] <= 90 { // <= 90, assume that ivmax is located between 0 and 90 °
-			minDiff = diff
-			angle = e.AnglesG[intensityIndex]
-		}
+// GetBeamDivergence returns the beam at the given fraction (0 - 1, exclusive) of the peak intensity in the C-plane at
+// the given angle, for example 0.5 for the half-peak divergence. Unlike GetFwhm it works for any symmetry: the profile
+// through the plane and its opposite plane (C+180) is evaluated on both sides of the actual peak, which is not
+// necessarily at gamma 0 for asymmetric optics.
+func (e Eulumdat) GetBeamDivergence(c, fraction float64) (BeamDivergence, error) {
+	if fraction <= 0 || fraction >= 1 {
+		return BeamDivergence{}, fmt.Errorf("fraction %g out of range (0 - 1)", fraction)
 	}
-
-	if angle < 0 {
-		return -1
+	cAngles, planes := e.expandedDistribution()
+	if len(cAngles) == 0 || len(e.AnglesG) == 0 {
+		return BeamDivergence{}, errors.New("no luminous intensity distribution")
 	}
 
-	return angle * 2
+	angles, values := planeProfile(cAngles, e.AnglesG, planes, c)
+	return beamDivergence(angles, values, fraction), nil
 }
 
 // GetCPlaneIndex returns the internal index of the C-Plane for the given angle.
@@ -622,69 +1315,35 @@ func (e Eulumdat) GetCPlaneIndex(angle float64) int {
 	return -1
 }
 
-func validateStringFromLine(scanner *bufio.Scanner, maxLength int, strict bool) (string, error) {
-	if !scanner.Scan() {
-		if err := scanner.Err(); err != nil {
-			return "", err
-		} else {
-			return "", errors.New("unexpected EOF")
-		}
-	}
-	cleanLine := strings.TrimSpace(scanner.Text())
-	if len(cleanLine) > maxLength && strict {
-		return "", errors.New("line exceeds maximum allowed length: " + cleanLine)
-	} else if len(cleanLine) > maxLength && !strict {
-		//logrus.Tracef("[EULUM] line exceeds maximum allowed length: %d > %d, %s", len(cleanLine), maxLength, cleanLine)
-	}
-	return cleanLine, nil
-}
-
-func validateIntFromLine(scanner *bufio.Scanner) (int, error) {
-	if !scanner.Scan() {
-		if err := scanner.Err(); err != nil {
-			return -1, err
-		} else {
-			return -1, errors.New("unexpected EOF")
-		}
-	}
-
-	cleanLine := strings.TrimSpace(scanner.Text())
-	// also replace spaces and underscores
-	cleanLine = strings.ReplaceAll(cleanLine, " ", "")
-	cleanLine = strings.ReplaceAll(cleanLine, "_", "")
-
-	if len(cleanLine) == 0 {
-		return -1, errors.New("line contains no integer")
+// GetPlane returns the luminous intensities (cd/klm) of the C-plane at the given angle. The symmetry is resolved, so
+// a C315 request on a file with symmetry indicator 4 returns the stored C45 data. Angles between two C-planes are
+// interpolated linearly. Returns nil if the file contains no luminous intensity distribution.
+func (e Eulumdat) GetPlane(angle float64) []float64 {
+	cAngles, planes := e.expandedDistribution()
+	if len(cAngles) == 0 {
+		return nil
 	}
 
-	value, err := strconv.Atoi(cleanLine)
-
-	return value, err
+	return interpolatePlane(cAngles, planes, angle)
 }
 
-func validateFloatFromLine(scanner *bufio.Scanner) (float64, error) {
-	if !scanner.Scan() {
-		if err := scanner.Err(); err != nil {
-			return -1, err
-		} else {
-			return -1, errors.New("unexpected EOF")
+// parseExtensions reads the "[KEY] value" lines of the extension block after field 30, other lines are ignored.
+// Returns nil if the file contains no extension block.
+func parseExtensions(scanner *bufio.Scanner) map[string]string {
+	var extensions map[string]string
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		end := strings.Index(line, "]")
+		if !strings.HasPrefix(line, "[") || end < 2 {
+			continue
 		}
+		if extensions == nil {
+			extensions = make(map[string]string)
+		}
+		extensions[line[1:end]] = strings.TrimSpace(line[end+1:])
 	}
 
-	cleanLine := strings.TrimSpace(scanner.Text())
-	// replace all commas if present with dots
-	cleanLine = strings.ReplaceAll(cleanLine, ",", ".")
-	// also replace spaces and underscores
-	cleanLine = strings.ReplaceAll(cleanLine, " ", "")
-	cleanLine = strings.ReplaceAll(cleanLine, "_", "")
-
-	if len(cleanLine) == 0 {
-		return -1, errors.New("line contains no float")
-	}
-
-	value, err := strconv.ParseFloat(cleanLine, 64)
-
-	return value, err
+	return extensions
 }
 
 // CalculateEulumdatAssemblies returns an ordered list of assemblies, the assembly with the highest current is the first element.
@@ -725,4 +1384,116 @@ func ApplyEulumdatAssemblies(assemblies []EulumdatAssembly, eulumdat *Eulumdat)
 		eulumdat.TotalLuminousFluxLamps[i] = assemblies[i].TotalLuminousFlux
 		eulumdat.ColorRenderingIndexCRI[i] = assemblies[i].ColorRenderingIndex
 	}
+
+	currents := make([]string, len(assemblies))
+	for i := range assemblies {
+		if assemblies[i].Current <= 0 {
+			delete(eulumdat.Extensions, assemblyCurrentsKeyword)
+			return
+		}
+		currents[i] = strconv.FormatFloat(assemblies[i].Current, 'f', -1, 64)
+	}
+	if eulumdat.Extensions == nil {
+		eulumdat.Extensions = make(map[string]string)
+	}
+	eulumdat.Extensions[assemblyCurrentsKeyword] = strings.Join(currents, " ")
+}
+
+// SelectAssembly reduces the standard sets of lamps (fields 26a-f) to the set with the given index. The luminous
+// intensity distribution is relative (cd/klm), so it stays valid for the remaining set.
+func (e *Eulumdat) SelectAssembly(index int) error {
+	assemblies, err := e.assemblies()
+	if err != nil {
+		return err
+	}
+	if index < 0 || index >= len(assemblies) {
+		return fmt.Errorf("lamp set %d does not exist", index)
+	}
+
+	ApplyEulumdatAssemblies(assemblies[index:index+1], e)
+
+	return nil
+}
+
+// DeriveAssembly returns a set of lamps for the given operating current. Flux and wattage are interpolated linearly
+// between the two sets with the nearest currents, the remaining values are taken from the set with the nearest
+// current. The currents of the sets are read from the extension block written by ApplyEulumdatAssemblies, currents
+// outside of the defined range are rejected.
+func (e Eulumdat) DeriveAssembly(current float64) (EulumdatAssembly, error) {
+	assemblies, err := e.assemblies()
+	if err != nil {
+		return EulumdatAssembly{}, err
+	}
+	for i := range assemblies {
+		if assemblies[i].Current <= 0 {
+			return EulumdatAssembly{}, fmt.Errorf("lamp set %d has no current", i)
+		}
+	}
+
+	sort.Slice(assemblies, func(i, j int) bool {
+		return assemblies[i].Current < assemblies[j].Current
+	})
+	if current < assemblies[0].Current || current > assemblies[len(assemblies)-1].Current {
+		return EulumdatAssembly{}, fmt.Errorf("current %g out of range (%g - %g)", current,
+			assemblies[0].Current, assemblies[len(assemblies)-1].Current)
+	}
+
+	upper := sort.Search(len(assemblies), func(i int) bool {
+		return assemblies[i].Current >= current
+	})
+	if assemblies[upper].Current == current {
+		return assemblies[upper], nil
+	}
+	lower := upper - 1
+	weight := (current - assemblies[lower].Current) / (assemblies[upper].Current - assemblies[lower].Current)
+
+	derived := assemblies[lower]
+	if weight > 0.5 {
+		derived = assemblies[upper]
+	}
+	derived.Current = current
+	derived.TotalLuminousFlux = assemblies[lower].TotalLuminousFlux*(1-weight) + assemblies[upper].TotalLuminousFlux*weight
+	derived.Power = assemblies[lower].Power*(1-weight) + assemblies[upper].Power*weight
+
+	return derived, nil
+}
+
+// assemblies returns the standard sets of lamps, the currents are set if the extension block records them for every
+// set and are -1 otherwise.
+func (e Eulumdat) assemblies() ([]EulumdatAssembly, error) {
+	count := e.NumberStandardSetLamps
+	if count == 0 {
+		return nil, errors.New("eulumdat contains no standard set of lamps")
+	}
+	if len(e.NumberLamps) < count || len(e.TypeLamps) < count || len(e.TotalLuminousFluxLamps) < count ||
+		len(e.ColorTemperature) < count || len(e.ColorRenderingIndexCRI) < count || len(e.BallastWatts) < count {
+		return nil, fmt.Errorf("fields 26a-f do not contain %d standard sets of lamps", count)
+	}
+
+	var currents []float64
+	if value, ok := e.Extensions[assemblyCurrentsKeyword]; ok {
+		parsed, err := parseKeywordNumbers(assemblyCurrentsKeyword, value, count)
+		if err != nil {
+			return nil, err
+		}
+		currents = parsed
+	}
+
+	assemblies := make([]EulumdatAssembly, count)
+	for i := range assemblies {
+		assemblies[i] = EulumdatAssembly{
+			Current:             -1,
+			NumberOfLamps:       e.NumberLamps[i],
+			TypeOfLamps:         e.TypeLamps[i],
+			TotalLuminousFlux:   e.TotalLuminousFluxLamps[i],
+			Power:               e.BallastWatts[i],
+			ColorTemperature:    e.ColorTemperature[i],
+			ColorRenderingIndex: e.ColorRenderingIndexCRI[i],
+		}
+		if currents != nil && currents[i] > 0 {
+			assemblies[i].Current = currents[i]
+		}
+	}
+
+	return assemblies, nil
 }
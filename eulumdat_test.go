@@ -4,11 +4,26 @@ import (
 	"bytes"
 	"encoding/base64"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
+// loadSample2 returns the parsed test/sample2.ldt.
+func loadSample2(t *testing.T) Eulumdat {
+	file, err := os.Open("test/sample2.ldt")
+	require.NoError(t, err)
+	defer file.Close()
+	eulumdat, err := NewEulumdat(file, false)
+	require.NoError(t, err)
+
+	return eulumdat
+}
+
 var eulumDataStr = `SW5nZW1hbm4KMgowCjIwCjE4CjM3CjUKVkZSLTE5MDkyNi0wMjM2LU1TClByb2xpY2h0IEUzMC0wMDE5IC0gQUdQICsgSS1EaWZmIDIwIENvbWJpbmVkCgpQcm9saWNodCBFMzAtMDAxOSAtIEFHUCArIEktRGlmZiAyMCBDb21iaW5lZC5sZHQKMjYvMDkvMjAxOSAtIFZpc28gTGFiU3Bpb24KMTI0NQoyNDAKMTAKMTE5NQoyMTAKMAowCjAKMAoxMDAuMAoxMDAKMS4wCjAuMAoxCjEKCjUxMzQKMzAwOAo4My40CjYwLjIyCjEuMAoxLjAKMS4wCjEuMAoxLjAKMS4wCjEuMAoxLjAKMS4wCjEuMAowCjE4CjM2CjU0CjcyCjkwCjEwOAoxMjYKMTQ0CjE2MgoxODAKMTk4CjIxNgoyMzQKMjUyCjI3MAoyODgKMzA2CjMyNAozNDIKMAo1CjEwCjE1CjIwCjI1CjMwCjM1CjQwCjQ1CjUwCjU1CjYwCjY1CjcwCjc1CjgwCjg1CjkwCjk1CjEwMAoxMDUKMTEwCjExNQoxMjAKMTI1CjEzMAoxMzUKMTQwCjE0NQoxNTAKMTU1CjE2MAoxNjUKMTcwCjE3NQoxODAKMjcwLjEyMjIKMjczLjgyOTQ2CjI3Ni45OTM5MgoyNzcuNDY5MjcKMjcxLjIyMTcxCjI1Ny4yNTQ1OAoyMzUuODU0MTMKMjA4LjgzNDcyCjE3Ny45MTMxNgoxMzcuNDEyMjEKOTcuODcyNTcKNzQuMzYxNzEKNTUuNTc0OTgKNDEuOTQwMTMKMzMuNjI5NDcKMjYuOTg0NzQKMjEuMzI1MzEKMTEuNjEzMDMKNC40MTE0OQoxOS4zODM3NQo0Ni4zMzQ4Mgo2My43NjE2Mgo3My43NDcxOAo3OS4xNzM5NQo4NC45MjQ2NAo5Mi4zMzM0NAo5OC4zMDIyNwo5NC4wMDA1NAo5MS4yNzY4Nwo5Mi4xOTIxOQo5NS4xMzYyMQo5Ni4xOTIyNwo5Ny41MTE3OAo5Ny4zMzk5MQo5OC4wODQ4NAo5OC4zNzEyNAo5Ny40NTU3NAoyNzAuMTIyMgoyNzIuODY1NjkKMjc1LjI5NTE4CjI3NC4wOTU4OAoyNjcuMzU4NjIKMjUzLjEzNjI4CjIzMS41MTk0NQoyMDUuMzEzMDkKMTc1LjE5Mzc4CjEzNy45NzU4NAo5OC42MDU3Ngo3Mi4xMDgxNQo1Mi41Njk4NgozOS43ODM0NAozMi40ODc4NQoyNS44NTM1OAoxOC43OTc4NQoxMC41ODYKNC42NDA2NAo2LjE0NzAyCjI1LjcxNTAyCjQ2Ljk4MjI5CjYxLjk4MjA1CjY4Ljk1MTI1Cjc0LjcwNDE3CjgyLjQ5NTc4CjkxLjcxNzYKOTIuOTQxNjcKODkuMzc0NAo4OS44NTk1MQo5MS45OTIwMgo5NS4xNzQ4Ngo5Ni4xOTQ3OAo5Ny45Njc3MQo5Ny41Nzk0Mgo5OC41NjI3OQo5Ny40NTU3NAoyNzAuMTIyMgoyNzEuNjM2OQoyNzIuMDg1ODgKMjY5LjQyNDk0CjI2MS40NTAxNAoyNDYuODA3NjcKMjI1Ljc4NDU5CjIwMC42MzQ2NgoxNzIuNTg3MjEKMTM2LjY1NTYyCjk4LjAwMzM1CjcwLjAwOTQ2CjUyLjg5MzY3CjQwLjUwNTQ4CjMxLjMxNTcxCjI0LjQzOTA0CjE3LjU4OTA5CjkuNjM0NzkKMy45NTc0OAo2LjQwMjk0CjIwLjk2ODE4CjM3LjU1MTc2CjUxLjY5MTMxCjYzLjEzNzE0CjcyLjI2MDY3CjgzLjQ3MzQyCjg5LjA0OTIzCjkwLjcxNTc0Cjg5LjUyNzYKOTAuMDU2NjQKOTEuOTY5MzEKOTMuMjM3MTMKOTUuODk5MjIKOTYuMjQ4NzIKOTcuNjI0Mwo5OC4zOTM4NQo5Ny40NTU3NAoyNzAuMTIyMgoyNzAuMzg3NzQKMjY4Ljk1OTQ5CjI2NC4xMDk4NAoyNTQuODQ2MgoyMzkuNDE1ODgKMjE4LjQxMTU4CjE5NC40OTE2OQoxNjcuNzk5MjMKMTMzLjAzMjk4Cjk0LjkzMTQ1CjcxLjAwNDM1CjUyLjcxMDUzCjM4LjY4MzU2CjMwLjU5NTc1CjIzLjg1MzY0CjE2LjU5NjM3CjkuMzkyNTUKMy4xMDU5Ngo3LjAxNzMxCjE5LjgyOTk1CjMzLjgyNDYyCjQ4LjQ2OTcyCjY2LjE4NDA0CjgyLjkyNDc3Cjg5Ljc3NjU4CjkxLjY3OTM4CjkxLjk5NDczCjkwLjg4NTEyCjg5Ljg4ODgzCjkxLjAzOTYyCjkyLjk2MDcyCjk0LjI5NDI2Cjk2LjA1MTMxCjk3LjY2ODczCjk3Ljg4MDk2Cjk3LjQ1NTc0CjI3MC4xMjIyCjI2OS4wMDk4NwoyNjUuNDM3MjYKMjU4LjY1MTA5CjI0Ny43MzE3MQoyMzIuMTM5NzIKMjEyLjEyNDIzCjE4OS43Njc2NwoxNjUuMzI1NDkKMTMyLjEzOTk4Cjk2LjU3MjA3CjcxLjUwMTg3CjUzLjEwMDUxCjM5LjY4NDQ1CjMxLjE0Mzg5CjI0LjEyODEyCjE2Ljc2MjA4CjguOTUxNgoyLjk1OTQ5CjkuMTk1NzgKMjEuNjYxMjIKNDAuOTg1NzcKNjIuMDg3MTgKNzkuMDE4Mwo4OS43Mzg0Mgo5Mi40MjI0OAo5MS44OTM3OAo5MC44NTA3Mwo4OS44NTc4Cjg5LjA2OTEyCjkwLjE5NjMxCjkyLjE3ODkyCjk0LjgzNTc1Cjk2LjAyNTQKOTYuNzgyMzIKOTYuODczNzYKOTcuNDU1NzQKMjcwLjEyMjIKMjY3LjU0NzY3CjI2Mi43ODA2NwoyNTQuNjk1MzMKMjQyLjc4NjM3CjIyNi44MjkwNAoyMDYuNzczNTYKMTg1LjI1MTMxCjE2MS45NjY5NQoxMzIuOTc2NDgKOTYuNzY4Mgo2OS4yNDc2MQo1Mi4xMTI5MQo0MS4xMTQzCjMxLjg2NTA2CjI0LjEwNjI0CjE3LjI2NjM3CjkuMTM1NzMKNC43MTYxNAoxMy44NTczMgozMi4wMjA3Nwo1MC41NjE2NAo2Ni45ODA4OQo4MC4xMTYKODguMDM3MjEKOTAuMjE5NTEKODkuOTc5OQo4OS4yODg0Mgo4OS4yMDk4Cjg4Ljk0ODQzCjg5LjYwMzM1CjkxLjA2NzM1CjkzLjAwOTU4Cjk0LjY3OTU2Cjk1Ljk0MTI3Cjk2Ljc1NDI4Cjk3LjQ1NTc0CjI3MC4xMjIyCjI2Ni45MjMzOAoyNjEuNDk5MDgKMjUzLjM3NjE3CjI0Mi4wNjUzMwoyMjYuNDg4NjcKMjA3LjI2NTY5CjE4NS41MTU2MQoxNjIuNTY5NTMKMTMxLjgwMTczCjk2Ljk0ODk3CjcxLjQ0OTM0CjUyLjkxMTU1CjM5LjQzMTc0CjMxLjAyODYKMjQuMjU0NDgKMTYuOTgyOTIKOS41NjMzNQozLjkxODQ3CjEwLjY2MTM2CjIzLjM2Mjk1CjQyLjAwODg1CjYxLjMxMzc1Cjc2LjU5MDU3Cjg1LjY0NTEKODcuODY2MjQKODguMTM0MDUKODcuODc4NzgKODcuMDgwMjYKODcuMDQ5OQo4OC4wMzk4Nwo5MC4zMDYzNQo5Mi45OTk0Nwo5NC45MzgwNgo5Ni40NDMyMQo5Ny4wMjM4NQo5Ny40NTU3NAoyNzAuMTIyMgoyNjYuMzk0MjgKMjYxLjQ5ODAxCjI1NC41MDc1MgoyNDQuMzk0MjMKMjI5LjcwOTcKMjEwLjU4OTM2CjE4OC42NTI5NgoxNjQuMDIzMgoxMzIuMjEyODQKOTYuNTUyMjEKNzEuMjIzODUKNTMuNDg1CjQwLjQyNDUKMzEuMzcxMjMKMjQuNjMzMjcKMTcuNjY3NDQKMTAuNTQ3NzUKNi4xMTQ2OAoxMC4wOTIwMQoyMy4yMzI4NQozNi40OTc1NAo1MC4xNDA1NAo2Ni41OTM4Mwo4MC45MTk5MQo4Ni4yMDUzNAo4OC4wNTE0NQo4OC4yMDMzMQo4Ny42NjIwNQo4Ny4yNjU1Ngo4OC4yOTczMwo5MS4wNDE0Nwo5Mi40ODU0Mgo5My43OTc1NQo5Ni4wMzIzMgo5Ny40NDgxMQo5Ny40NTU3NAoyNzAuMTIyMgoyNjYuNjk2ODYKMjYzLjExOTcxCjI1Ny45Nzg4MgoyNDkuNDQ1OQoyMzUuOTYwNjgKMjE2LjkzNDUKMTk0LjE5NzIyCjE2OS4zMDQyOAoxMzYuMzQ5OTkKOTguODI4NzUKNzAuOTk5MjQKNTMuMjM0MDMKNDAuODI4NjEKMzEuNzU4MjcKMjUuMDcyMDMKMTguNzgwMTMKMTEuMzQ0OTMKNC45OTEyNAoxMC44OTA0NAoyNy41Mjk3NAo0NC4yNTQ3Mwo1Ni42NTA4Nwo2NS40NjEzNQo3My45MzY2Nwo4NC40MTA3OAo4OS44OTU1CjkwLjcyNDk5Cjg5LjMxNTY5Cjg5LjI3MzU1CjkwLjUxOTQ4CjkxLjY1MTg3CjkzLjgwMDEyCjk0Ljk4MzQxCjk1LjcyMDM2Cjk3LjU3Nzk5Cjk3LjQ1NTc0CjI3MC4xMjIyCjI2Ny4xNTQwOQoyNjQuNTQ0NzUKMjYwLjg4MjIxCjI1NC4wMTQwMQoyNDEuMTAxOAoyMjIuMjY3NTkKMTk4Ljc0MjI1CjE3MS43NzM5NwoxMzcuNTkxNDQKOTguNzkzODEKNzIuMTE4OAo1My4yMzE1NAo0MC41NDA4CjMyLjcwODUyCjI2LjQwOTczCjE5Ljg3NjgxCjEyLjE5MzQ4CjUuOTM1OTYKMTAuODk4NDQKMzIuODU0NjcKNTQuNjYxMjkKNjkuMzQzMjQKNzUuMTQ2NjgKNzguMjY4NjIKODUuMjE0MDMKOTMuMTkzMzUKOTUuNzg5MjEKOTMuODQyODgKOTIuMjA4NjQKOTIuNjYwMzMKOTQuNTQ5NjMKOTQuOTc5NjMKOTUuNzA2MTQKOTUuOTIyMjYKOTcuMDE5NjYKOTcuNDU1NzQKMjcwLjEyMjIKMjY3LjY1OTQ5CjI2Ni4yMzIKMjYzLjgwNQoyNTcuMzc5NjQKMjQ0Ljc2NTk4CjIyNS42NDAxNQoyMDEuOTY1NzQKMTc1LjIwOTc1CjEzNy40OTA5Cjk4Ljc5NzIyCjc0LjMyNAo1NS43MzIKNDIuOTcwNDMKMzQuMzg2MzQKMjguNjU3MzYKMjMuMTEwNzUKMTQuMDkyNjYKNi4zMDMyMwo3LjExMzgKMzUuMTA1MTMKNTkuNTY5MTgKNzQuNTQ1MjMKODAuNzA4NAo4Mi4xMjE3MQo4OC4xNzQwNAo5NS44MzEyMwo5OS4zNTY0NQo5Ny40NzMwOAo5NS4zNzQwOAo5NC42MjkwNQo5Ni42ODg3Mgo5Ni40MzA5Mgo5Ny4zMTcxOQo5Ni45NzY3NAo5Ny43ODQyMQo5Ny40NTU3NAoyNzAuMTIyMgoyNjcuOTA1MTgKMjY2LjI2NjgxCjI2My4xOTIwNgoyNTYuMTcyMTQKMjQzLjM3NTI1CjIyNC4wNzEyMQoyMDAuNDAyODQKMTczLjQ1Mjg2CjEzNy45Mzc3NQoxMDAuNDA4MzUKNzIuOTg5OTMKNTQuMDU0MjIKNDEuODE1NzMKMzMuNzU1ODIKMjcuNzIwOTIKMjEuNTAwMDcKMTIuNTc5NjcKNS4wODg1NwoyMC4zMTY4Nwo0NS4yODE3Mgo2NC45NTk5Mgo3NS44OTE0NAo3OS44MTUzOAo4NS4zNTI1OAo5Mi44MzM4Mwo5OC40MDMzOQo5Ny4zNDc1Mwo5NS4zNDY3CjkzLjMxNzA1Cjk0LjI1ODMKOTQuNzU5NzMKOTUuNDI1NAo5NS43NTUzNwo5Ni40MjMwOQo5Ny41ODIzNgo5Ny40NTU3NAoyNzAuMTIyMgoyNjguMjY3ODcKMjY1Ljc4MDgxCjI2MS4yMDc1CjI1Mi42MDc3CjIzOC42MjU5NwoyMTkuMzc5ODIKMTk2LjI5MTM4CjE3MC4xOTM4MwoxMzYuNjQ2NjEKOTkuNDQyMDMKNzIuMTU1MzMKNTQuNDMxMjcKNDEuNTU5NgozMi44NjEyNgoyNi4wODMxNgoxOS4wNTM2OAoxMS4yNTA2OAo4LjAyNzczCjEzLjY1NDgKMzIuMTQxMzYKNDguNzI3NTkKNjEuMzU5MTcKNzEuNDA0MjgKNzkuNzQ0MDYKODguMjUyODQKOTIuMzAzNwo5Mi40ODQxMQo5MS4yNTg1Ngo5MS4yMzE5CjkyLjA1OTI2CjkyLjc2NDExCjk0LjQ4MzA5Cjk0Ljk1NDI5Cjk2LjYzMzMxCjk3Ljk1Mzg5Cjk3LjQ1NTc0CjI3MC4xMjIyCjI2OC41OTcyNwoyNjUuMzUwMzgKMjU5LjQ2MzQKMjQ5LjQ5NTc2CjIzNC43NzY4NQoyMTUuMjE4NzMKMTkyLjYwMzQ3CjE2Ny41MTkzOAoxMzMuMjg2ODQKOTcuNTMyOTQKNzMuMTUyNjgKNTQuNjM0NzcKNDEuMDEzNDgKMzIuMzk2ODYKMjUuMTIzNjkKMTguNTMwNzgKMTEuMDM4NjgKNC4zMTc0CjExLjQ2MTMxCjIzLjgzNzA3CjM2Ljg2ODUKNTEuNTAwNjUKNjkuNTE4MzIKODIuMjgyMjcKODYuNzQwNjMKODguMzc2MjcKODkuMzE5MzYKODkuMjIyMzQKODkuMzg2MjIKOTAuNTcwMQo5MS45OTI0NAo5My4wNjQyNAo5NC45MzYzNQo5Ni43MzYzMQo5Ny40OTA4OAo5Ny40NTU3NAoyNzAuMTIyMgoyNjguODY3MjEKMjY0LjkzMjg2CjI1Ny42NDczOQoyNDYuNzMxMTQKMjMxLjEwOTM1CjIxMC45ODgyNAoxODguNDk4MDYKMTYzLjYzMjM4CjEzMi4wMzIxNwo5Ny4yMjY1CjcxLjg0OTcKNTMuMTc2NzkKMzkuNDQKMzEuNTUzOTkKMjQuNDE4ODIKMTcuMTgxNzkKOS44OTczMQo0Ljg0OTU5CjkuMDk4MTcKMjAuODcwMDEKMzcuNjM4NTcKNTcuMzQ5NDQKNzMuNTExMQo4My41MDQ5Cjg3LjIxNzQxCjg3LjIyOTY0Cjg3LjQ1Njg5Cjg4LjA1Mzk0Cjg4LjQzNzE0Cjg5LjQ4Mzg4CjkxLjE2MTAxCjkzLjU2MDgzCjk1LjU5MTkKOTYuODIxMTIKOTcuMDYzNjUKOTcuNDU1NzQKMjcwLjEyMjIKMjY5LjkyODQyCjI2Ni41NDY1OQoyNTkuNTI5MTkKMjQ4LjAyODQyCjIzMi4yNDY3MwoyMTIuMDMwMDcKMTg5LjE5MDMKMTY0LjczOTU3CjEzMy4yNTk4OQo5Ni44MDIyCjY5LjgwMTk4CjUzLjQ5NTkyCjQxLjAxMzcyCjMxLjcwOTM2CjI0LjM3MTE1CjE2Ljk3ODAxCjguOTA3ODQKMi45NTkyNgoxMi4wMDg2OQoyOC43NTY0NAo0Ni45MTUzNgo2My43ODE3Mgo3Ny4xOTMxMwo4NS45ODMzMwo4OS4wNDkzNAo4OC44MDQ3Nwo4OC44NDY2Cjg5LjI5ODUxCjg5LjgwMzA0CjkwLjgwOTY5CjkxLjg4NTc4CjkzLjQ3NDI1Cjk1LjAzNjQxCjk2LjEyMDY0Cjk2Ljg2NzI1Cjk3LjQ1NTc0CjI3MC4xMjIyCjI3MS4wMjUyNwoyNjguODQ3OTMKMjYyLjY4NTk0CjI1MS44NDY0OQoyMzUuNjgzNzIKMjE1LjIxOTU3CjE5MS45NjczOAoxNjUuNDEzNjIKMTMxLjE5NDY4Cjk1LjY0ODY3CjcwLjkwMTI5CjUyLjUzNjYxCjM5LjI1NDI2CjMxLjU4OTYKMjQuMzEzMTIKMTYuOTgzOTgKOS4yNzA0Ngo0LjA5ODIzCjcuOTc3NDYKMjAuMDcyNTkKMzcuNDMzOTQKNTcuMjY2MTMKNzQuMzMxNzcKODYuMDA0NTIKOTAuMzg4MTgKOTAuMDMxOQo4OS42NDcyOQo4OS43MTg5Ngo5MC4yNjA0Mwo5MS4yMTkwOAo5Mi45MTE4Mgo5NC41ODM2NQo5Ni4wNTEzNwo5Ni42OTA5Cjk2LjgxMwo5Ny40NTU3NAoyNzAuMTIyMgoyNzIuMzgwMTcKMjcxLjk3MTk5CjI2OC4wNTI2NAoyNTguNTA0MjIKMjQyLjc2MzMzCjIyMS44MTc5NwoxOTcuNDQ0NjEKMTY5LjE0OTk3CjEzMi4wNzE3Mgo5Ny4zMzQwNwo3Mi43ODIzMwo1My43MTY3Mgo0MC40ODY1MgozMS43ODIwNgoyNC44NjQxNgoxNy43NjUwOQo5LjY0MDI0CjMuMzQ3MjkKOC4zODgxCjIwLjcxMzczCjM0LjIzNjY5CjQ5LjQ1MjkyCjY4LjgzMzUKODQuMzgzMDcKODkuMjMyMDcKOTAuOTkxNDkKOTEuOTU1NTkKOTEuNzczMTQKOTEuODA2NTIKOTIuMzA0OAo5Mi45OTkzMgo5NC42MjAzNgo5Ni44MDEwMQo5Ny42NjY1NQo5Ny41NDI1OAo5Ny40NTU3NAoyNzAuMTIyMgoyNzMuNDA3NjEKMjc0Ljc0MDA5CjI3Mi40MTQ0MwoyNjQuMjA1NjkKMjQ5LjIyMDU3CjIyNy44NDIxNQoyMDEuNzgwMjMKMTcyLjQzMTYKMTM2LjEyMzgxCjk2LjY2NzU4CjY5Ljg2ODEyCjUyLjk0NTQxCjQwLjIwMzc5CjMxLjY4NDM1CjI1LjA4Mjg1CjE4LjA2NDc3CjEwLjE2OTY4CjUuMDA5MDEKOS40OTk3OAoyNi4wNDQ3Ngo0Mi40NjI2NAo1Ni4yODUyNAo2Ny43NTc1MQo3Ny44ODU3NQo4Ny4xMTg0Mwo5MS41NDUzNwo5Mi4xMzc5OQo5MS44NDM0NAo5Mi42MTI5Ngo5My4xNDMwMwo5NC43NTM3OQo5Ni40MDE5Cjk3LjExNzc2Cjk4LjQzNDM4Cjk4LjM3Mjc0Cjk3LjQ1NTc0CjI3MC4xMjIyCjI3My41MjI4NAoyNzYuNTQxMDIKMjc1LjkyMDYzCjI2OS4xODQ1MQoyNTQuODM4MzcKMjMzLjI3NTgyCjIwNi42MDE4MgoxNzYuMjk3OTgKMTM3LjYxNzI4Cjk5LjcxMzE0CjcyLjM4NTAxCjUyLjg1MjY4CjQwLjI4MjA2CjMyLjcxMzExCjI2LjM2OTgxCjE5Ljc3NzM4CjExLjA2NzE0CjUuMzY2MDQKMTUuOTQwMjcKMzcuOTAyNgo1Ny43NDA4CjY5Ljg4NzkxCjc1LjI5NTI0CjgwLjc1NTgxCjg5LjE4NDUxCjk0LjYwNjkKOTMuOTYyMgo5Mi40MjE2Mgo5Mi43MzgyNwo5NC44NzU3OAo5NS45MDAyNAo5Ny40MjU0MQo5Ny43MzM0Ngo5OC40ODQyOQo5OC4xNzYyNQo5Ny40NTU3NA==`
 var eulumDataStr2 = `UHJvbGljaHQKMgo0CjcyCjUuMDAwMDAwCjE4MQoxLjAwMDAwMApXNDYKUklDTwo3LTAxMTM5LTAyLVc0Ngo3LTAxMTM5LTAyLVc0Ni5sZHQKMDgvMDUvMjAyMS9wbG0KNzA1LjAwMDAwMAozNS4wMDAwMDAKNTAuMDAwMDAwCjcwNS4wMDAwMDAKMzUuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjEwMC4wMDAwMDAKNzcuODAwMDAwCjEuMDAwMDAwCjAuMDAwMDAwCjEKNQpMRUQKMjAyOC40MDAwMDAKMjcwMEsKOTAKMTUuNTY1MDAwCjAuNjg0MDAwCjAuNzE5MDAwCjAuNzg0MDAwCjAuODY0MDAwCjAuODc5MDAwCjAuOTE5MDAwCjAuOTM4MDAwCjAuOTU4MDAwCjAuOTYxMDAwCjAuOTcyMDAwCjAuMDAwMDAwCjUuMDAwMDAwCjEwLjAwMDAwMAoxNS4wMDAwMDAKMjAuMDAwMDAwCjI1LjAwMDAwMAozMC4wMDAwMDAKMzUuMDAwMDAwCjQwLjAwMDAwMAo0NS4wMDAwMDAKNTAuMDAwMDAwCjU1LjAwMDAwMAo2MC4wMDAwMDAKNjUuMDAwMDAwCjcwLjAwMDAwMAo3NS4wMDAwMDAKODAuMDAwMDAwCjg1LjAwMDAwMAo5MC4wMDAwMDAKOTUuMDAwMDAwCjEwMC4wMDAwMDAKMTA1LjAwMDAwMAoxMTAuMDAwMDAwCjExNS4wMDAwMDAKMTIwLjAwMDAwMAoxMjUuMDAwMDAwCjEzMC4wMDAwMDAKMTM1LjAwMDAwMAoxNDAuMDAwMDAwCjE0NS4wMDAwMDAKMTUwLjAwMDAwMAoxNTUuMDAwMDAwCjE2MC4wMDAwMDAKMTY1LjAwMDAwMAoxNzAuMDAwMDAwCjE3NS4wMDAwMDAKMTgwLjAwMDAwMAoxODUuMDAwMDAwCjE5MC4wMDAwMDAKMTk1LjAwMDAwMAoyMDAuMDAwMDAwCjIwNS4wMDAwMDAKMjEwLjAwMDAwMAoyMTUuMDAwMDAwCjIyMC4wMDAwMDAKMjI1LjAwMDAwMAoyMzAuMDAwMDAwCjIzNS4wMDAwMDAKMjQwLjAwMDAwMAoyNDUuMDAwMDAwCjI1MC4wMDAwMDAKMjU1LjAwMDAwMAoyNjAuMDAwMDAwCjI2NS4wMDAwMDAKMjcwLjAwMDAwMAoyNzUuMDAwMDAwCjI4MC4wMDAwMDAKMjg1LjAwMDAwMAoyOTAuMDAwMDAwCjI5NS4wMDAwMDAKMzAwLjAwMDAwMAozMDUuMDAwMDAwCjMxMC4wMDAwMDAKMzE1LjAwMDAwMAozMjAuMDAwMDAwCjMyNS4wMDAwMDAKMzMwLjAwMDAwMAozMzUuMDAwMDAwCjM0MC4wMDAwMDAKMzQ1LjAwMDAwMAozNTAuMDAwMDAwCjM1NS4wMDAwMDAKMC4wMDAwMDAKMS4wMDAwMDAKMi4wMDAwMDAKMy4wMDAwMDAKNC4wMDAwMDAKNS4wMDAwMDAKNi4wMDAwMDAKNy4wMDAwMDAKOC4wMDAwMDAKOS4wMDAwMDAKMTAuMDAwMDAwCjExLjAwMDAwMAoxMi4wMDAwMDAKMTMuMDAwMDAwCjE0LjAwMDAwMAoxNS4wMDAwMDAKMTYuMDAwMDAwCjE3LjAwMDAwMAoxOC4wMDAwMDAKMTkuMDAwMDAwCjIwLjAwMDAwMAoyMS4wMDAwMDAKMjIuMDAwMDAwCjIzLjAwMDAwMAoyNC4wMDAwMDAKMjUuMDAwMDAwCjI2LjAwMDAwMAoyNy4wMDAwMDAKMjguMDAwMDAwCjI5LjAwMDAwMAozMC4wMDAwMDAKMzEuMDAwMDAwCjMyLjAwMDAwMAozMy4wMDAwMDAKMzQuMDAwMDAwCjM1LjAwMDAwMAozNi4wMDAwMDAKMzcuMDAwMDAwCjM4LjAwMDAwMAozOS4wMDAwMDAKNDAuMDAwMDAwCjQxLjAwMDAwMAo0Mi4wMDAwMDAKNDMuMDAwMDAwCjQ0LjAwMDAwMAo0NS4wMDAwMDAKNDYuMDAwMDAwCjQ3LjAwMDAwMAo0OC4wMDAwMDAKNDkuMDAwMDAwCjUwLjAwMDAwMAo1MS4wMDAwMDAKNTIuMDAwMDAwCjUzLjAwMDAwMAo1NC4wMDAwMDAKNTUuMDAwMDAwCjU2LjAwMDAwMAo1Ny4wMDAwMDAKNTguMDAwMDAwCjU5LjAwMDAwMAo2MC4wMDAwMDAKNjEuMDAwMDAwCjYyLjAwMDAwMAo2My4wMDAwMDAKNjQuMDAwMDAwCjY1LjAwMDAwMAo2Ni4wMDAwMDAKNjcuMDAwMDAwCjY4LjAwMDAwMAo2OS4wMDAwMDAKNzAuMDAwMDAwCjcxLjAwMDAwMAo3Mi4wMDAwMDAKNzMuMDAwMDAwCjc0LjAwMDAwMAo3NS4wMDAwMDAKNzYuMDAwMDAwCjc3LjAwMDAwMAo3OC4wMDAwMDAKNzkuMDAwMDAwCjgwLjAwMDAwMAo4MS4wMDAwMDAKODIuMDAwMDAwCjgzLjAwMDAwMAo4NC4wMDAwMDAKODUuMDAwMDAwCjg2LjAwMDAwMAo4Ny4wMDAwMDAKODguMDAwMDAwCjg5LjAwMDAwMAo5MC4wMDAwMDAKOTEuMDAwMDAwCjkyLjAwMDAwMAo5My4wMDAwMDAKOTQuMDAwMDAwCjk1LjAwMDAwMAo5Ni4wMDAwMDAKOTcuMDAwMDAwCjk4LjAwMDAwMAo5OS4wMDAwMDAKMTAwLjAwMDAwMAoxMDEuMDAwMDAwCjEwMi4wMDAwMDAKMTAzLjAwMDAwMAoxMDQuMDAwMDAwCjEwNS4wMDAwMDAKMTA2LjAwMDAwMAoxMDcuMDAwMDAwCjEwOC4wMDAwMDAKMTA5LjAwMDAwMAoxMTAuMDAwMDAwCjExMS4wMDAwMDAKMTEyLjAwMDAwMAoxMTMuMDAwMDAwCjExNC4wMDAwMDAKMTE1LjAwMDAwMAoxMTYuMDAwMDAwCjExNy4wMDAwMDAKMTE4LjAwMDAwMAoxMTkuMDAwMDAwCjEyMC4wMDAwMDAKMTIxLjAwMDAwMAoxMjIuMDAwMDAwCjEyMy4wMDAwMDAKMTI0LjAwMDAwMAoxMjUuMDAwMDAwCjEyNi4wMDAwMDAKMTI3LjAwMDAwMAoxMjguMDAwMDAwCjEyOS4wMDAwMDAKMTMwLjAwMDAwMAoxMzEuMDAwMDAwCjEzMi4wMDAwMDAKMTMzLjAwMDAwMAoxMzQuMDAwMDAwCjEzNS4wMDAwMDAKMTM2LjAwMDAwMAoxMzcuMDAwMDAwCjEzOC4wMDAwMDAKMTM5LjAwMDAwMAoxNDAuMDAwMDAwCjE0MS4wMDAwMDAKMTQyLjAwMDAwMAoxNDMuMDAwMDAwCjE0NC4wMDAwMDAKMTQ1LjAwMDAwMAoxNDYuMDAwMDAwCjE0Ny4wMDAwMDAKMTQ4LjAwMDAwMAoxNDkuMDAwMDAwCjE1MC4wMDAwMDAKMTUxLjAwMDAwMAoxNTIuMDAwMDAwCjE1My4wMDAwMDAKMTU0LjAwMDAwMAoxNTUuMDAwMDAwCjE1Ni4wMDAwMDAKMTU3LjAwMDAwMAoxNTguMDAwMDAwCjE1OS4wMDAwMDAKMTYwLjAwMDAwMAoxNjEuMDAwMDAwCjE2Mi4wMDAwMDAKMTYzLjAwMDAwMAoxNjQuMDAwMDAwCjE2NS4wMDAwMDAKMTY2LjAwMDAwMAoxNjcuMDAwMDAwCjE2OC4wMDAwMDAKMTY5LjAwMDAwMAoxNzAuMDAwMDAwCjE3MS4wMDAwMDAKMTcyLjAwMDAwMAoxNzMuMDAwMDAwCjE3NC4wMDAwMDAKMTc1LjAwMDAwMAoxNzYuMDAwMDAwCjE3Ny4wMDAwMDAKMTc4LjAwMDAwMAoxNzkuMDAwMDAwCjE4MC4wMDAwMDAKOTYxLjA5MDAwMAo5NTguMjkwMDAwCjk0Ny4xNzAwMDAKOTMyLjQyMDAwMAo5MTcuNTYwMDAwCjkwMi43NDAwMDAKODg2Ljc4MDAwMAo4NjkuOTQwMDAwCjg1Ni4yMDAwMDAKODM3LjEwMDAwMAo4MTYuMDkwMDAwCjc5Mi4xODAwMDAKNzY5LjIwMDAwMAo3NDUuOTkwMDAwCjcyNi4xMTAwMDAKNzA2LjE3MDAwMAo2ODIuOTMwMDAwCjY1Ni44NjAwMDAKNjMwLjY2MDAwMAo1OTguNTkwMDAwCjU2Mi41OTAwMDAKNTMxLjgwMDAwMAo1MDEuNDkwMDAwCjQ3MC44MjAwMDAKNDM3LjE0MDAwMAo0MDEuNDAwMDAwCjM2MS44OTAwMDAKMzIwLjcxMDAwMAoyODAuNTEwMDAwCjIzOS43MDAwMDAKMTc3LjE3MDAwMAoxMTguNzcwMDAwCjgxLjM1MDAwMAo2MC42NTAwMDAKNDYuNjQwMDAwCjM0LjY5MDAwMAoyMS4yOTAwMDAKOS4yMjAwMDAKNS44MTAwMDAKMy44NDAwMDAKMy4xNDAwMDAKMi40OTAwMDAKMS42ODAwMDAKMS4wOTAwMDAKMC4zMjAwMDAKMC4wNTAwMDAKMC4wNTAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wNzAwMDAKMC4wMzAwMDAKMC4wMzAwMDAKMC4wMTAwMDAKMC4wMTAwMDAKMC4wNjAwMDAKMC4wNTAwMDAKMC4xMTAwMDAKMC4wNzAwMDAKMC4wMzAwMDAKMC4wMTAwMDAKMC4wMDAwMDAKMC4wMTAwMDAKMC4wMjAwMDAKMC4wMTAwMDAKMC4wMDAwMDAKMC4wMTAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMTAwMDAKMC4wMTAwMDAKMC4wMTAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMTAwMDAKMC4wMzAwMDAKMC4wMTAwMDAKMC4wMTAwMDAKMC4wMDAwMDAKMC4wNTAwMDAKMC4wNzAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMjAwMDAKMC4wMDAwMDAKMC4wMTAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMzAwMDAKMC4wNjAwMDAKMC4wMTAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKOTYxLjA5MDAwMAo5NTguMzUwMDAwCjk0Ny4zMTAwMDAKOTMyLjc0MDAwMAo5MTcuOTEwMDAwCjkwMy4zMDAwMDAKODg3LjY5MDAwMAo4NzAuOTkwMDAwCjg1Ni4yMDAwMDAKODM4LjQ1MDAwMAo4MTguNzYwMDAwCjc5NC4yMzAwMDAKNzcxLjg1MDAwMAo3NDcuMTEwMDAwCjcyOS4yNjAwMDAKNzA4LjkyMDAwMAo2ODUuMjcwMDAwCjY2Mi4xMjAwMDAKNjM0LjUzMDAwMAo2MDEuNDAwMDAwCjU2Ny4xOTAwMDAKNTM1LjQyMDAwMAo1MDQuOTYwMDAwCjQ3Mi43NDAwMDAKNDQwLjQ1MDAwMAo0MDQuODgwMDAwCjM2Ni41MjAwMDAKMzI2LjM5MDAwMAoyODQuODUwMDAwCjI0MS43NjAwMDAKMTgzLjE1MDAwMAoxMjMuNzcwMDAwCjg0LjEyMDAwMAo2MS43NjAwMDAKNDcuMjAwMDAwCjM1LjI0MDAwMAoyMi41MzAwMDAKMTAuMDAwMDAwCjUuNzYwMDAwCjQuMjcwMDAwCjMuMjUwMDAwCjIuNDYwMDAwCjEuNzEwMDAwCjEuMTEwMDAwCjAuNDcwMDAwCjAuMjMwMDAwCjAuMDMwMDAwCjAuMDIwMDAwCjAuMDIwMDAwCjAuMDEwMDAwCjAuMDEwMDAwCjAuMDUwMDAwCjAuMDYwMDAwCjAuMDIwMDAwCjAuMDEwMDAwCjAuMDEwMDAwCjAuMDMwMDAwCjAuMDIwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDMwMDAwCjAuMDQwMDAwCjAuMDEwMDAwCjAuMDYwMDAwCjAuMDEwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDEwMDAwCjAuMDIwMDAwCjAuMTMwMDAwCjAuMDcwMDAwCjAuMDUwMDAwCjAuMDEwMDAwCjAuMDEwMDAwCjAuMDEwMDAwCjAuMTMwMDAwCjAuMTAwMDAwCjAuMDAwMDAwCjAuMDIwMDAwCjAuMDIwMDAwCjAuMDEwMDAwCjAuMDEwMDAwCjAuMDEwMDAwCjAuMDMwMDAwCjAuMDIwMDAwCjAuMDIwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDEwMDAwCjAuMDEwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDkwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjk2MS4wOTAwMDAKOTU4LjQ0MDAwMAo5NDcuNzIwMDAwCjkzMy41OTAwMDAKOTE4Ljc2MDAwMAo5MDQuNDMwMDAwCjg4OS41MDAwMDAKODc0LjYzMDAwMAo4NTguMTgwMDAwCjg0MS40MDAwMDAKODIzLjI5MDAwMAo4MDAuMjAwMDAwCjc3Ni4zMDAwMDAKNzUzLjEyMDAwMAo3MzQuNjgwMDAwCjcxNS42ODAwMDAKNjkzLjc0MDAwMAo2NzAuMDQwMDAwCjY0Mi45NzAwMDAKNjEzLjQ4MDAwMAo1NzkuMDQwMDAwCjU0Ni41ODAwMDAKNTE2Ljg5MDAwMAo0ODYuMjMwMDAwCjQ1My40NDAwMDAKNDE4LjE3MDAwMAozNzkuNjgwMDAwCjMzNi45NDAwMDAKMjk2LjYwMDAwMAoyNTUuNDkwMDAwCjIwMS4xNjAwMDAKMTM3Ljc1MDAwMAo5Mi42MDAwMDAKNjYuMzcwMDAwCjUwLjkzMDAwMAozOC4yOTAwMDAKMjYuNjYwMDAwCjEzLjE3MDAwMAo2LjY3MDAwMAo0LjU2MDAwMAozLjMwMDAwMAoyLjc2MDAwMAoxLjkyMDAwMAoxLjMyMDAwMAowLjczMDAwMAowLjE1MDAwMAowLjA0MDAwMAowLjAwMDAwMAowLjA2MDAwMAowLjAzMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAyMDAwMAowLjAzMDAwMAowLjAzMDAwMAowLjA1MDAwMAowLjAxMDAwMAowLjAzMDAwMAowLjAyMDAwMAowLjAwMDAwMAowLjAxMDAwMAowLjAxMDAwMAowLjAyMDAwMAowLjAyMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAxMDAwMAowLjAxMDAwMAowLjAyMDAwMAowLjAxMDAwMAowLjAyMDAwMAowLjAwMDAwMAowLjA2MDAwMAowLjAyMDAwMAowLjAyMDAwMAowLjAxMDAwMAowLjA4MDAwMAowLjA0MDAwMAowLjAwMDAwMAowLjAxMDAwMAowLjAxMDAwMAowLjAyMDAwMAowLjAzMDAwMAowLjAwMDAwMAowLjAyMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAyMDAwMAowLjAwMDAwMAowLjA3MDAwMAowLjAxMDAwMAowLjAwMDAwMAowLjA0MDAwMAowLjExMDAwMAowLjAyMDAwMAowLjAwMDAwMAowLjAxMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAo5NjEuMDkwMDAwCjk1OC41NjAwMDAKOTQ4LjQzMDAwMAo5MzQuODkwMDAwCjkyMC40NjAwMDAKOTA3LjA5MDAwMAo4OTEuOTgwMDAwCjg3OC40NjAwMDAKODYzLjQ2MDAwMAo4NDguMjkwMDAwCjgzMC4xMDAwMDAKODA4LjU3MDAwMAo3ODYuMDkwMDAwCjc2Mi43MDAwMDAKNzQ0LjI2MDAwMAo3MjYuMDYwMDAwCjcwNi4wNzAwMDAKNjgzLjA3MDAwMAo2NTguOTYwMDAwCjYyOS44OTAwMDAKNTk3LjUzMDAwMAo1NjQuMDcwMDAwCjUzNC42NTAwMDAKNTA0LjkzMDAwMAo0NzMuMDcwMDAwCjQzNy43NTAwMDAKNDAwLjE0MDAwMAozNTcuMzAwMDAwCjMxNS40NDAwMDAKMjc0LjE3MDAwMAoyMjkuNjkwMDAwCjE2Ni4xMTAwMDAKMTExLjk2MDAwMAo3Ny4wNjAwMDAKNTcuNzcwMDAwCjQ0LjQ0MDAwMAozMi44ODAwMDAKMTkuNjcwMDAwCjguOTgwMDAwCjUuNjIwMDAwCjQuMTEwMDAwCjMuMzQwMDAwCjIuNDMwMDAwCjEuNTQwMDAwCjEuMDIwMDAwCjAuMzgwMDAwCjAuMDcwMDAwCjAuMDYwMDAwCjAuMDMwMDAwCjAuMDYwMDAwCjAuMDMwMDAwCjAuMDMwMDAwCjAuMDIwMDAwCjAuMDEwMDAwCjAuMDcwMDAwCjAuMDgwMDAwCjAuMDIwMDAwCjAuMDMwMDAwCjAuMDEwMDAwCjAuMDIwMDAwCjAuMDAwMDAwCjAuMDEwMDAwCjAuMDEwMDAwCjAuMDAwMDAwCjAuMDEwMDAwCjAuMDEwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDMwMDAwCjAuMDMwMDAwCjAuMDUwMDAwCjAuMDMwMDAwCjAuMDgwMDAwCjAuMDIwMDAwCjAuMDEwMDAwCjAuMDUwMDAwCjAuMDgwMDAwCjAuMDMwMDAwCjAuMDEwMDAwCjAuMDAwMDAwCjAuMDEwMDAwCjAuMDEwMDAwCjAuMDEwMDAwCjAuMDEwMDAwCjAuMDEwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDUwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDEwMDAwCjAuMDEwMDAwCjAuMDEwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDEwMDAwCjAuMDEwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjk2MS4wOTAwMDAKOTU4Ljc0MDAwMAo5NDkuMzMwMDAwCjkzNi42MjAwMDAKOTIzLjE0MDAwMAo5MTAuNzUwMDAwCjg5Ni43ODAwMDAKODgzLjI2MDAwMAo4NjkuNjYwMDAwCjg1NC4yMjAwMDAKODM3LjgxMDAwMAo4MTguOTUwMDAwCjc5Ny45OTAwMDAKNzc2LjM5MDAwMAo3NTYuMzcwMDAwCjc0MC4zNjAwMDAKNzIyLjU4MDAwMAo3MDMuMTYwMDAwCjY4MS41ODAwMDAKNjU0Ljc5MDAwMAo2MjQuMzIwMDAwCjU5Mi4xNzAwMDAKNTU5LjgyMDAwMAo1MzEuMjAwMDAwCjQ5OS42MDAwMDAKNDY0LjczMDAwMAo0MjYuNjYwMDAwCjM4NS43NzAwMDAKMzQyLjQ4MDAwMAozMDAuNjYwMDAwCjI1OS41NjAwMDAKMjA5LjIyMDAwMAoxNDYuMTAwMDAwCjk4LjYwMDAwMAo2OS42MDAwMDAKNTMuMzgwMDAwCjQwLjg0MDAwMAozMC4wNzAwMDAKMTYuMjkwMDAwCjcuNDEwMDAwCjQuODgwMDAwCjMuNTIwMDAwCjIuNzYwMDAwCjEuOTcwMDAwCjEuNDIwMDAwCjAuNzQwMDAwCjAuNTMwMDAwCjAuMDUwMDAwCjAuMDMwMDAwCjAuMDMwMDAwCjAuMDEwMDAwCjAuMDIwMDAwCjAuMDAwMDAwCjAuMDEwMDAwCjAuMDMwMDAwCjAuMDEwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDEwMDAwCjAuMDEwMDAwCjAuMDIwMDAwCjAuMDQwMDAwCjAuMDQwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDMwMDAwCjAuMDIwMDAwCjAuMDEwMDAwCjAuMDEwMDAwCjAuMDIwMDAwCjAuMDEwMDAwCjAuMDEwMDAwCjAuMDIwMDAwCjAuMDMwMDAwCjAuMDEwMDAwCjAuMDIwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDIwMDAwCjAuMDMwMDAwCjAuMDEwMDAwCjAuMDMwMDAwCjAuMDIwMDAwCjAuMDAwMDAwCjAuMDEwMDAwCjAuMDIwMDAwCjAuMDIwMDAwCjAuMDEwMDAwCjAuMDEwMDAwCjAuMDEwMDAwCjAuMDEwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjk2MS4wOTAwMDAKOTU4LjkzMDAwMAo5NTAuNDkwMDAwCjkzOC44ODAwMDAKOTI2LjI5MDAwMAo5MTQuNDcwMDAwCjkwMi43OTAwMDAKODg5LjcyMDAwMAo4NzUuNjUwMDAwCjg2My4yMTAwMDAKODQ5LjQwMDAwMAo4MzMuMjkwMDAwCjgxMy4zODAwMDAKNzkyLjYxMDAwMAo3NzMuMzkwMDAwCjc1OC4xMDAwMDAKNzQyLjkwMDAwMAo3MjMuNzUwMDAwCjcwMy40OTAwMDAKNjgyLjE1MDAwMAo2NTMuOTEwMDAwCjYyNC4wNTAwMDAKNTkxLjA5MDAwMAo1NTkuNDMwMDAwCjUyNy44MDAwMDAKNDkzLjk4MDAwMAo0NTYuNTYwMDAwCjQxNy44MTAwMDAKMzc2Ljg5MDAwMAozMzQuNzQwMDAwCjI5NS4zODAwMDAKMjU0LjcxMDAwMAoyMDMuMzAwMDAwCjE0MS45MTAwMDAKOTUuODkwMDAwCjY4LjI3MDAwMAo1Mi44MDAwMDAKNDAuOTUwMDAwCjI5LjgyMDAwMAoxNi43MjAwMDAKNy43NTAwMDAKNC45NzAwMDAKMy41MzAwMDAKMi43NjAwMDAKMi4wOTAwMDAKMS40ODAwMDAKMC45NjAwMDAKMC40MzAwMDAKMC4wNDAwMDAKMC4wMjAwMDAKMC4wMjAwMDAKMC4wMzAwMDAKMC4wMTAwMDAKMC4wNDAwMDAKMC4wMzAwMDAKMC4wMTAwMDAKMC4wNDAwMDAKMC4wNjAwMDAKMC4wMjAwMDAKMC4wMzAwMDAKMC4wNTAwMDAKMC4wMjAwMDAKMC4wMTAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMjAwMDAKMC4wMTAwMDAKMC4wNjAwMDAKMC4wNjAwMDAKMC4wMTAwMDAKMC4wMzAwMDAKMC4wMjAwMDAKMC4wMDAwMDAKMC4wMTAwMDAKMC4wNTAwMDAKMC4wMTAwMDAKMC4wMjAwMDAKMC4wNDAwMDAKMC4wNDAwMDAKMC4wMzAwMDAKMC4wMTAwMDAKMC4wMzAwMDAKMC4wNDAwMDAKMC4wMzAwMDAKMC4wNTAwMDAKMC4wMjAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMTAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMjAwMDAKMC4wMDAwMDAKMC4wMTAwMDAKMC4wMTAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKOTYxLjA5MDAwMAo5NTkuMTMwMDAwCjk1MS43NjAwMDAKOTQxLjU4MDAwMAo5MjkuOTEwMDAwCjkxOS4wNzAwMDAKOTA4LjUzMDAwMAo4OTYuNzQwMDAwCjg4My44NzAwMDAKODcyLjg0MDAwMAo4NjEuNTQwMDAwCjg0Ny40NzAwMDAKODMxLjU5MDAwMAo4MTMuODEwMDAwCjc5NS45MDAwMDAKNzgwLjMyMDAwMAo3NjUuMzMwMDAwCjc0OC40MzAwMDAKNzMwLjAxMDAwMAo3MDkuNjgwMDAwCjY4NS4xNTAwMDAKNjU2LjY1MDAwMAo2MjUuNjEwMDAwCjU5MS42MTAwMDAKNTU5LjA2MDAwMAo1MjUuOTcwMDAwCjQ5MS44NTAwMDAKNDU3LjA4MDAwMAo0MTkuODIwMDAwCjM3OS4xMjAwMDAKMzM4LjM5MDAwMAoyOTkuMDkwMDAwCjI1OS4yOTAwMDAKMjExLjQ2MDAwMAoxNTIuMTAwMDAwCjEwMy40NzAwMDAKNzMuMjgwMDAwCjU1Ljg1MDAwMAo0My41MTAwMDAKMzIuNjIwMDAwCjIwLjU4MDAwMAo5LjkzMDAwMAo2LjAwMDAwMAo0LjQ5MDAwMAozLjAyMDAwMAoyLjM5MDAwMAoxLjYxMDAwMAowLjk1MDAwMAowLjYwMDAwMAowLjI4MDAwMAowLjE0MDAwMAowLjA0MDAwMAowLjAyMDAwMAowLjAwMDAwMAowLjAxMDAwMAowLjAxMDAwMAowLjAyMDAwMAowLjAxMDAwMAowLjAyMDAwMAowLjAzMDAwMAowLjAxMDAwMAowLjA0MDAwMAowLjAzMDAwMAowLjAxMDAwMAowLjAyMDAwMAowLjAyMDAwMAowLjAwMDAwMAowLjAzMDAwMAowLjA1MDAwMAowLjAxMDAwMAowLjA0MDAwMAowLjAwMDAwMAowLjAxMDAwMAowLjAxMDAwMAowLjAyMDAwMAowLjA2MDAwMAowLjA2MDAwMAowLjA1MDAwMAowLjA1MDAwMAowLjAxMDAwMAowLjAxMDAwMAowLjAxMDAwMAowLjAxMDAwMAowLjAxMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAxMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAxMDAwMAowLjAwMDAwMAowLjAxMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAo5NjEuMDkwMDAwCjk1OS4zMTAwMDAKOTUzLjA5MDAwMAo5NDQuMzgwMDAwCjkzMy45MjAwMDAKOTI0LjM1MDAwMAo5MTQuMDUwMDAwCjkwNC4wOTAwMDAKODkzLjE4MDAwMAo4ODQuNDQwMDAwCjg3NC43MDAwMDAKODYzLjc2MDAwMAo4NTEuMjIwMDAwCjgzNi4xNDAwMDAKODIxLjU1MDAwMAo4MDQuMTUwMDAwCjc4Ny42OTAwMDAKNzczLjgyMDAwMAo3NTYuMTEwMDAwCjczNS40NTAwMDAKNzEyLjI2MDAwMAo2ODguODMwMDAwCjY1OS4xNjAwMDAKNjI2Ljk4MDAwMAo1OTQuNjEwMDAwCjU2MS4yMTAwMDAKNTMwLjQ0MDAwMAo0OTcuMzUwMDAwCjQ2Mi4xODAwMDAKNDI3LjcwMDAwMAozOTAuMDgwMDAwCjM1MS4zODAwMDAKMzExLjY4MDAwMAoyNzIuNjQwMDAwCjIzMS41NDAwMDAKMTc3LjQwMDAwMAoxMjIuNzAwMDAwCjg0Ljk2MDAwMAo2Mi4zNjAwMDAKNDguMjcwMDAwCjM3LjE0MDAwMAoyNi45OTAwMDAKMTUuMDQwMDAwCjguMDUwMDAwCjUuNDIwMDAwCjQuMDcwMDAwCjIuNzMwMDAwCjEuOTEwMDAwCjEuMjUwMDAwCjAuNzQwMDAwCjAuNDkwMDAwCjAuMjgwMDAwCjAuMTcwMDAwCjAuMTUwMDAwCjAuMTAwMDAwCjAuMDgwMDAwCjAuMDQwMDAwCjAuMDIwMDAwCjAuMDIwMDAwCjAuMDMwMDAwCjAuMDIwMDAwCjAuMDUwMDAwCjAuMTIwMDAwCjAuMDUwMDAwCjAuMDUwMDAwCjAuMDcwMDAwCjAuMDIwMDAwCjAuMDMwMDAwCjAuMDQwMDAwCjAuMDEwMDAwCjAuMDIwMDAwCjAuMDIwMDAwCjAuMDEwMDAwCjAuMDIwMDAwCjAuMDUwMDAwCjAuMDQwMDAwCjAuMDAwMDAwCjAuMDEwMDAwCjAuMDMwMDAwCjAuMDEwMDAwCjAuMDAwMDAwCjAuMDEwMDAwCjAuMDEwMDAwCjAuMDIwMDAwCjAuMDIwMDAwCjAuMDEwMDAwCjAuMDAwMDAwCjAuMDIwMDAwCjAuMDIwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDMwMDAwCjAuMDQwMDAwCjAuMDEwMDAwCjAuMDEwMDAwCjAuMDIwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDIwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjk2MS4wOTAwMDAKOTU5LjUwMDAwMAo5NTQuNDEwMDAwCjk0Ni45NTAwMDAKOTM4LjAyMDAwMAo5MjkuNDAwMDAwCjkyMS4wODAwMDAKOTEyLjkzMDAwMAo5MDQuODEwMDAwCjg5Ni44MzAwMDAKODg4LjQzMDAwMAo4ODEuMTAwMDAwCjg3Mi41OTAwMDAKODYxLjYyMDAwMAo4NDYuNzgwMDAwCjgzMC40NDAwMDAKODE0LjY5MDAwMAo3OTYuODgwMDAwCjc4MC4zNTAwMDAKNzYyLjM3MDAwMAo3NDIuNDcwMDAwCjcyMC40NDAwMDAKNjk0LjQ3MDAwMAo2NjcuODcwMDAwCjYzNi42OTAwMDAKNjA0LjA1MDAwMAo1NjkuNzEwMDAwCjUzNy43MDAwMDAKNTA2LjA0MDAwMAo0NzQuNzQwMDAwCjQzOS43MjAwMDAKNDAzLjE5MDAwMAozNjYuODEwMDAwCjMyNy44OTAwMDAKMjg4LjkxMDAwMAoyNTAuMzYwMDAwCjIwOS41MzAwMDAKMTU4LjU1MDAwMAoxMDkuODQwMDAwCjc2LjYzMDAwMAo1Ni42NTAwMDAKNDMuNjUwMDAwCjMzLjMxMDAwMAoyMy44MzAwMDAKMTQuNTkwMDAwCjguMTYwMDAwCjUuMzkwMDAwCjMuNjAwMDAwCjIuODIwMDAwCjEuOTMwMDAwCjEuNDYwMDAwCjAuODIwMDAwCjAuNjAwMDAwCjAuNTAwMDAwCjAuNTEwMDAwCjAuMzcwMDAwCjAuMTcwMDAwCjAuMTAwMDAwCjAuMTQwMDAwCjAuMTYwMDAwCjAuMTgwMDAwCjAuMDUwMDAwCjAuMDEwMDAwCjAuMDEwMDAwCjAuMDQwMDAwCjAuMDQwMDAwCjAuMDMwMDAwCjAuMjEwMDAwCjAuNDkwMDAwCjAuNDEwMDAwCjAuNDMwMDAwCjAuMzgwMDAwCjAuNDMwMDAwCjAuMzIwMDAwCjAuMjMwMDAwCjAuMDIwMDAwCjAuMDMwMDAwCjAuMDMwMDAwCjAuMDIwMDAwCjAuMDkwMDAwCjAuMDMwMDAwCjAuMDMwMDAwCjAuMTAwMDAwCjAuMDQwMDAwCjAuMDAwMDAwCjAuMDIwMDAwCjAuMDIwMDAwCjAuMDIwMDAwCjAuMDcwMDAwCjAuMDYwMDAwCjAuMDYwMDAwCjAuMDQwMDAwCjAuMDAwMDAwCjAuMDEwMDAwCjAuMDEwMDAwCjAuMDEwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjk2MS4wOTAwMDAKOTU5LjY3MDAwMAo5NTUuNjcwMDAwCjk0OS41NjAwMDAKOTQyLjExMDAwMAo5MzUuMDUwMDAwCjkyOC4yOTAwMDAKOTIxLjg0MDAwMAo5MTUuNjcwMDAwCjkwOS43MDAwMDAKOTA0Ljc4MDAwMAo5MDAuMTEwMDAwCjg5Mi40MTAwMDAKODgzLjI1MDAwMAo4NzEuNjcwMDAwCjg1Ny4zMjAwMDAKODQwLjM1MDAwMAo4MjIuNDEwMDAwCjgwNS43NTAwMDAKNzg3LjU3MDAwMAo3NjguNjYwMDAwCjc0OS45NDAwMDAKNzI5LjczMDAwMAo3MDQuMzIwMDAwCjY3Ny4wNjAwMDAKNjQ2LjYwMDAwMAo2MTQuMTQwMDAwCjU3OS44NTAwMDAKNTQ2LjA1MDAwMAo1MTIuOTEwMDAwCjQ4MC4yNTAwMDAKNDQ2LjA2MDAwMAo0MTAuNzAwMDAwCjM3NC4yMTAwMDAKMzM2LjQ1MDAwMAoyOTguNjIwMDAwCjI2Mi4zNjAwMDAKMjI2LjkyMDAwMAoxODkuNjcwMDAwCjE0Ni4xNDAwMDAKMTAyLjI2MDAwMAo3MC41NDAwMDAKNTEuMDAwMDAwCjM4Ljg5MDAwMAoyOS41OTAwMDAKMjEuNTkwMDAwCjE0LjI2MDAwMAo4LjA3MDAwMAo1LjAzMDAwMAozLjU1MDAwMAoyLjcyMDAwMAoxLjc4MDAwMAoxLjM1MDAwMAowLjk0MDAwMAowLjYyMDAwMAowLjQ0MDAwMAowLjQ4MDAwMAowLjQ1MDAwMAowLjM0MDAwMAowLjM0MDAwMAowLjA4MDAwMAowLjE4MDAwMAowLjA4MDAwMAowLjA0MDAwMAowLjAzMDAwMAowLjIzMDAwMAowLjM2MDAwMAowLjY4MDAwMAowLjk5MDAwMAoxLjMwMDAwMAoxLjQyMDAwMAoxLjM2MDAwMAoxLjE4MDAwMAowLjg5MDAwMAowLjY4MDAwMAowLjQ4MDAwMAowLjMyMDAwMAowLjIyMDAwMAowLjA2MDAwMAowLjA0MDAwMAowLjAyMDAwMAowLjAxMDAwMAowLjAwMDAwMAowLjAyMDAwMAowLjAzMDAwMAowLjAzMDAwMAowLjAxMDAwMAowLjA2MDAwMAowLjA1MDAwMAowLjAwMDAwMAowLjAyMDAwMAowLjAwMDAwMAowLjAyMDAwMAowLjAyMDAwMAowLjA2MDAwMAowLjAxMDAwMAowLjAxMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAo5NjEuMDkwMDAwCjk1OS44MjAwMDAKOTU2Ljk4MDAwMAo5NTIuNjAwMDAwCjk0Ni40MTAwMDAKOTQxLjIxMDAwMAo5MzUuNTMwMDAwCjkzMC44NTAwMDAKOTI3LjY5MDAwMAo5MjIuOTIwMDAwCjkyMS4wOTAwMDAKOTE2LjI5MDAwMAo5MTEuMjAwMDAwCjkwNC44MTAwMDAKODk2LjA1MDAwMAo4ODMuNjUwMDAwCjg2Ny42MTAwMDAKODUwLjA0MDAwMAo4MzIuMTgwMDAwCjgxNC41NDAwMDAKNzk2LjYxMDAwMAo3NzguNjMwMDAwCjc1Ny4wNDAwMDAKNzMyLjkzMDAwMAo3MDcuOTkwMDAwCjY4Mi42NzAwMDAKNjUzLjUwMDAwMAo2MjEuMTIwMDAwCjU4Ni42NDAwMDAKNTUwLjAyMDAwMAo1MTMuOTgwMDAwCjQ3OC41MTAwMDAKNDQyLjIzMDAwMAo0MDYuNDEwMDAwCjM2OS43MTAwMDAKMzMzLjQyMDAwMAoyOTguMzIwMDAwCjI2MS40OTAwMDAKMjI1Ljk1MDAwMAoxOTIuODEwMDAwCjE2MS44MTAwMDAKMTI4LjEzMDAwMAo5My4wNDAwMDAKNjQuMDkwMDAwCjQ0LjM3MDAwMAozMi4zNTAwMDAKMjQuMTcwMDAwCjE4LjE4MDAwMAoxMi44MTAwMDAKOC4yNDAwMDAKNS4xNjAwMDAKMy44MTAwMDAKMi44MzAwMDAKMS43NjAwMDAKMS4wODAwMDAKMC42MzAwMDAKMC41NDAwMDAKMC40NTAwMDAKMC4zODAwMDAKMC4yNTAwMDAKMC4xNTAwMDAKMC4yNzAwMDAKMC4zNzAwMDAKMC4zMjAwMDAKMC4zOTAwMDAKMC42MzAwMDAKMS4xODAwMDAKMS42ODAwMDAKMi4xNTAwMDAKMi4yODAwMDAKMi4yNTAwMDAKMi4zMTAwMDAKMS44OTAwMDAKMS42ODAwMDAKMS41MzAwMDAKMS4xODAwMDAKMC44ODAwMDAKMC43MTAwMDAKMC41NjAwMDAKMC4zMzAwMDAKMC4yNDAwMDAKMC4yNDAwMDAKMC4yNjAwMDAKMC4xOTAwMDAKMC4wNzAwMDAKMC4xMTAwMDAKMC4wNTAwMDAKMC4xOTAwMDAKMC4yMzAwMDAKMC4wMzAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKOTYxLjA5MDAwMAo5NTkuOTAwMDAwCjk1OC4yNzAwMDAKOTU1Ljg5MDAwMAo5NTEuMDQwMDAwCjk0Ny4yODAwMDAKOTQzLjQzMDAwMAo5NDAuNDEwMDAwCjkzOC44MTAwMDAKOTM3LjUxMDAwMAo5MzUuMTEwMDAwCjkzMy4yNjAwMDAKOTI5LjE3MDAwMAo5MjQuMDcwMDAwCjkxNi41MTAwMDAKOTA2LjcwMDAwMAo4OTQuMDYwMDAwCjg3OS43NzAwMDAKODYzLjQyMDAwMAo4NDUuMjQwMDAwCjgyNC42NTAwMDAKODAyLjI3MDAwMAo3ODAuMjUwMDAwCjc1Ny43MDAwMDAKNzM0LjM3MDAwMAo3MDkuMDEwMDAwCjY3OS40MDAwMDAKNjQ4LjgzMDAwMAo2MTcuMjAwMDAwCjU4My45NjAwMDAKNTQ2LjExMDAwMAo1MDUuNTQwMDAwCjQ2Ni45NzAwMDAKNDI3LjkxMDAwMAozODkuNDcwMDAwCjM1MC45ODAwMDAKMzEyLjQ4MDAwMAoyNzYuMDcwMDAwCjI0MS4zNjAwMDAKMjA4LjEwMDAwMAoxNzcuNDYwMDAwCjE0OC4xMzAwMDAKMTIwLjQwMDAwMAo5NS4xNTAwMDAKNzIuODgwMDAwCjUxLjc0MDAwMAozNC43NzAwMDAKMjMuNjgwMDAwCjE3LjE4MDAwMAoxMy4wNzAwMDAKMTAuMDIwMDAwCjcuMTIwMDAwCjQuOTAwMDAwCjMuMTYwMDAwCjIuMDIwMDAwCjEuMzQwMDAwCjEuMTAwMDAwCjAuNzEwMDAwCjAuNTkwMDAwCjAuNTgwMDAwCjAuNTMwMDAwCjAuNDgwMDAwCjAuNDUwMDAwCjAuNTIwMDAwCjAuOTcwMDAwCjEuNDcwMDAwCjIuMTcwMDAwCjMuMDIwMDAwCjMuNjIwMDAwCjQuMTcwMDAwCjQuMjQwMDAwCjMuOTcwMDAwCjQuMDMwMDAwCjMuNTUwMDAwCjMuMTUwMDAwCjIuODUwMDAwCjIuNTEwMDAwCjIuMTEwMDAwCjEuOTIwMDAwCjEuNDUwMDAwCjEuMTAwMDAwCjEuMDQwMDAwCjAuODUwMDAwCjAuNjUwMDAwCjAuNDgwMDAwCjAuNTEwMDAwCjAuNTgwMDAwCjAuNDkwMDAwCjAuNjMwMDAwCjAuMTIwMDAwCjAuMDMwMDAwCjAuMDEwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDIwMDAwCjAuMDEwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjk2MS4wOTAwMDAKOTU5LjkzMDAwMAo5NTkuMzQwMDAwCjk1OC44MjAwMDAKOTU1Ljk4MDAwMAo5NTMuMjIwMDAwCjk1MC42OTAwMDAKOTUwLjQ2MDAwMAo5NTAuODAwMDAwCjk1MS43MzAwMDAKOTUxLjEwMDAwMAo5NTAuMDIwMDAwCjk0Ni43MDAwMDAKOTQyLjM0MDAwMAo5MzUuODMwMDAwCjkyNy42MDAwMDAKOTE3LjU4MDAwMAo5MDYuMTQwMDAwCjg5MC4yMTAwMDAKODcyLjY2MDAwMAo4NTMuMTkwMDAwCjgzMi4zMDAwMDAKODA5Ljk3MDAwMAo3ODMuMDIwMDAwCjc1Ni40MTAwMDAKNzI2LjMxMDAwMAo2OTUuMTIwMDAwCjY2NC4wMzAwMDAKNjMwLjkyMDAwMAo1OTUuMzIwMDAwCjU1OS4zMDAwMDAKNTIyLjkwMDAwMAo0ODMuNjIwMDAwCjQ0Mi43MjAwMDAKMzk5LjgzMDAwMAozNTcuMTcwMDAwCjMxNy4wMzAwMDAKMjc5LjUyMDAwMAoyNDMuODUwMDAwCjIwOS42NTAwMDAKMTc4LjA4MDAwMAoxNDcuNzgwMDAwCjEyMS4zMDAwMDAKOTguMTUwMDAwCjc4LjEwMDAwMAo2MS40NTAwMDAKNDguMDMwMDAwCjM2LjkwMDAwMAoyNy44MDAwMDAKMjAuMjEwMDAwCjE0LjIyMDAwMAoxMC40NDAwMDAKOC4xMDAwMDAKNi4wOTAwMDAKNC45MTAwMDAKMy45ODAwMDAKMi45MTAwMDAKMS45OTAwMDAKMS4yOTAwMDAKMC45MjAwMDAKMC43MjAwMDAKMC43MTAwMDAKMC42OTAwMDAKMS4wMjAwMDAKMS40NTAwMDAKMi4yNjAwMDAKMi45NjAwMDAKMy42MTAwMDAKMy45NTAwMDAKNC4xMjAwMDAKNC4xODAwMDAKNC4xNDAwMDAKMy44NzAwMDAKMy43NzAwMDAKMy4yNTAwMDAKMy4wMzAwMDAKMi43NDAwMDAKMi40MzAwMDAKMi4xNDAwMDAKMS44NjAwMDAKMS42MDAwMDAKMS4zMTAwMDAKMS4wODAwMDAKMC44ODAwMDAKMC43MDAwMDAKMC43NDAwMDAKMC43MjAwMDAKMC41MTAwMDAKMC41NTAwMDAKMC4xNTAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMTAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMTAwMDAKMC4wMTAwMDAKMC4wMTAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMTAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMTAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKOTYxLjA5MDAwMAo5NTkuOTEwMDAwCjk2MC4wNzAwMDAKOTYxLjEzMDAwMAo5NjAuNTcwMDAwCjk1OS4yMTAwMDAKOTU4LjEzMDAwMAo5NTkuOTAwMDAwCjk2MS4xODAwMDAKOTYzLjU1MDAwMAo5NjQuNTkwMDAwCjk2NC4xNjAwMDAKOTYzLjkxMDAwMAo5NjAuMDAwMDAwCjk1NS4wODAwMDAKOTQ3Ljg4MDAwMAo5MzguODcwMDAwCjkyNy45OTAwMDAKOTEzLjM4MDAwMAo4OTcuOTEwMDAwCjg3OS4yNzAwMDAKODU3LjQzMDAwMAo4MzEuODMwMDAwCjgwNS45MTAwMDAKNzc1Ljg3MDAwMAo3NDMuODIwMDAwCjcwOS42MTAwMDAKNjczLjc5MDAwMAo2MzYuODQwMDAwCjU5OC44MTAwMDAKNTYxLjQ5MDAwMAo1MjEuMjgwMDAwCjQ3OS41ODAwMDAKNDM3LjIyMDAwMAozOTQuMjcwMDAwCjM1Mi45MDAwMDAKMzEzLjkzMDAwMAoyNzUuMTcwMDAwCjIzNy42MTAwMDAKMjAyLjIxMDAwMAoxNjkuNTgwMDAwCjE0MC42NjAwMDAKMTE1LjgzMDAwMAo5NS41NjAwMDAKNzguMjYwMDAwCjY0LjQ5MDAwMAo1Mi45ODAwMDAKNDMuNTYwMDAwCjM1LjUwMDAwMAoyOC43NzAwMDAKMjMuNDkwMDAwCjE5LjA5MDAwMAoxNS4xMzAwMDAKMTIuMTYwMDAwCjkuODIwMDAwCjcuNzgwMDAwCjYuMDAwMDAwCjQuODAwMDAwCjMuNTYwMDAwCjIuODIwMDAwCjIuMTUwMDAwCjEuNjgwMDAwCjEuNzQwMDAwCjEuNzQwMDAwCjIuMDkwMDAwCjIuODMwMDAwCjMuNTMwMDAwCjMuNjgwMDAwCjMuNzQwMDAwCjMuODMwMDAwCjMuNTgwMDAwCjMuNDUwMDAwCjMuMjMwMDAwCjMuMDIwMDAwCjIuNzYwMDAwCjIuNTgwMDAwCjIuMzMwMDAwCjIuMjQwMDAwCjEuOTMwMDAwCjEuNzIwMDAwCjEuNTEwMDAwCjEuMzUwMDAwCjEuMjAwMDAwCjEuMTEwMDAwCjEuMDQwMDAwCjEuMDcwMDAwCjEuMjAwMDAwCjEuMDUwMDAwCjEuMDEwMDAwCjAuMTMwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDEwMDAwCjAuMDEwMDAwCjAuMDEwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDEwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjk2MS4wOTAwMDAKOTU5Ljg1MDAwMAo5NjAuNDQwMDAwCjk2Mi45OTAwMDAKOTY0Ljc3MDAwMAo5NjUuNTIwMDAwCjk2Ni41NTAwMDAKOTY5LjI5MDAwMAo5NzEuOTkwMDAwCjk3NS43MDAwMDAKOTc3Ljg2MDAwMAo5NzkuNDUwMDAwCjk3OC42NzAwMDAKOTc3LjE4MDAwMAo5NzMuMDMwMDAwCjk2Ny4wODAwMDAKOTU4Ljk2MDAwMAo5NDguNTkwMDAwCjkzNS45NDAwMDAKOTIxLjA2MDAwMAo5MDIuNjcwMDAwCjg4MC4wMzAwMDAKODU1Ljc1MDAwMAo4MjUuNjYwMDAwCjc5My45ODAwMDAKNzU4Ljk1MDAwMAo3MjIuNDUwMDAwCjY4NC4wNzAwMDAKNjQzLjY4MDAwMAo2MDEuODcwMDAwCjU1OC42NjAwMDAKNTEzLjU0MDAwMAo0NjkuMTgwMDAwCjQyNC4yNDAwMDAKMzgxLjM1MDAwMAozNDAuMjEwMDAwCjMwMC4yNTAwMDAKMjYyLjI2MDAwMAoyMjUuMjEwMDAwCjE5MS4yODAwMDAKMTYwLjkyMDAwMAoxMzQuNzIwMDAwCjExMi45ODAwMDAKOTQuMTcwMDAwCjc4Ljk0MDAwMAo2NS44MzAwMDAKNTQuNjkwMDAwCjQ2LjI0MDAwMAozOC44NjAwMDAKMzIuNzEwMDAwCjI3Ljg2MDAwMAoyMy42MzAwMDAKMjAuNjAwMDAwCjE3LjkyMDAwMAoxNS42MjAwMDAKMTIuNTAwMDAwCjkuMTcwMDAwCjYuNTkwMDAwCjUuMTEwMDAwCjMuOTAwMDAwCjIuODEwMDAwCjIuMjQwMDAwCjEuOTkwMDAwCjEuOTAwMDAwCjIuMDUwMDAwCjIuNDIwMDAwCjIuNzUwMDAwCjIuOTkwMDAwCjIuODkwMDAwCjIuOTMwMDAwCjIuNzIwMDAwCjIuNjEwMDAwCjIuNDcwMDAwCjIuMjIwMDAwCjIuMDIwMDAwCjEuODkwMDAwCjEuNzcwMDAwCjEuNjcwMDAwCjEuNzIwMDAwCjEuNTUwMDAwCjEuMjgwMDAwCjEuMTYwMDAwCjEuMDIwMDAwCjAuOTcwMDAwCjAuOTMwMDAwCjAuOTIwMDAwCjEuMTUwMDAwCjAuOTkwMDAwCjAuOTYwMDAwCjAuMTYwMDAwCjAuMDIwMDAwCjAuMDIwMDAwCjAuMDEwMDAwCjAuMDEwMDAwCjAuMDAwMDAwCjAuMDIwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjk2MS4wOTAwMDAKOTU5Ljc1MDAwMAo5NjAuNTEwMDAwCjk2NC43NzAwMDAKOTY4Ljk5MDAwMAo5NzAuOTMwMDAwCjk3My4yNzAwMDAKOTc2LjY5MDAwMAo5ODEuMzAwMDAwCjk4NS44OTAwMDAKOTg5LjQ2MDAwMAo5OTEuNzYwMDAwCjk5Mi44NjAwMDAKOTkyLjM1MDAwMAo5OTAuODkwMDAwCjk4Ni45ODAwMDAKOTc5LjM5MDAwMAo5NjguNTQwMDAwCjk1NC43MzAwMDAKOTM4LjE0MDAwMAo5MTkuNzIwMDAwCjg5Ny4yNzAwMDAKODY5LjYzMDAwMAo4MzkuNTYwMDAwCjgwNS4xNDAwMDAKNzY5LjI2MDAwMAo3MzEuNzcwMDAwCjY4OS4xODAwMDAKNjQ2LjYyMDAwMAo2MDIuMjQwMDAwCjU1Ni4xMDAwMDAKNTA3Ljg0MDAwMAo0NjAuMDgwMDAwCjQxMi41NTAwMDAKMzY4LjQ0MDAwMAozMjYuMjMwMDAwCjI4NS43MTAwMDAKMjQ3LjAxMDAwMAoyMDkuOTkwMDAwCjE3Ny41MDAwMDAKMTQ5LjY0MDAwMAoxMjYuMjcwMDAwCjEwNy4yMTAwMDAKOTEuNDcwMDAwCjc2LjYwMDAwMAo2My43NzAwMDAKNTMuOTcwMDAwCjQ2LjA5MDAwMAozOS41NzAwMDAKMzMuNzQwMDAwCjI4Ljc0MDAwMAoyNC42MTAwMDAKMjEuNzAwMDAwCjE5LjI1MDAwMAoxNS45MzAwMDAKMTEuNjQwMDAwCjguMTQwMDAwCjYuMzQwMDAwCjUuMTMwMDAwCjQuMDEwMDAwCjMuMDUwMDAwCjIuNDkwMDAwCjIuMTIwMDAwCjIuMTgwMDAwCjIuMTkwMDAwCjIuMjQwMDAwCjIuMzYwMDAwCjIuMjcwMDAwCjIuMDIwMDAwCjEuOTgwMDAwCjEuOTgwMDAwCjIuMDEwMDAwCjEuNzUwMDAwCjEuNjkwMDAwCjEuNjYwMDAwCjEuNDgwMDAwCjEuNDYwMDAwCjEuMzkwMDAwCjEuMjMwMDAwCjEuMDMwMDAwCjAuOTgwMDAwCjAuOTIwMDAwCjAuODUwMDAwCjAuODcwMDAwCjAuODcwMDAwCjEuMDAwMDAwCjEuMDUwMDAwCjAuOTgwMDAwCjAuODIwMDAwCjAuMTUwMDAwCjAuMDAwMDAwCjAuMDEwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjAuMDAwMDAwCjk2MS4wOTAwMDAKOTU5LjY2MDAwMAo5NjAuMzYwMDAwCjk2Ni4yMjAwMDAKOTcyLjM2MDAwMAo5NzQuNzEwMDAwCjk3Ni41MzAwMDAKOTgyLjA0MDAwMAo5OTEuNjYwMDAwCjk5OC44NTAwMDAKMTAwMi41MDAwMDAKMTAwNS41MDAwMDAKMTAwNy4zMDAwMDAKMTAwNi4zMDAwMDAKMTAwNS4xMDAwMDAKMTAwMC44MDAwMDAKOTk0Ljg1MDAwMAo5ODYuMzEwMDAwCjk3My43MDAwMDAKOTU2Ljg2MDAwMAo5MzguMDIwMDAwCjkxNC4wOTAwMDAKODg2LjYyMDAwMAo4NTQuNjcwMDAwCjgxNy40NzAwMDAKNzc4Ljk2MDAwMAo3MzcuMDMwMDAwCjY5My43MjAwMDAKNjQ4LjIwMDAwMAo2MDMuMDUwMDAwCjU1NC43NDAwMDAKNTAzLjU2MDAwMAo0NTIuOTEwMDAwCjQwNC4zNjAwMDAKMzU3Ljk1MDAwMAozMTQuMjIwMDAwCjI3Mi4yOTAwMDAKMjMzLjEzMDAwMAoxOTcuMTMwMDAwCjE2Ni4xMzAwMDAKMTQwLjU2MDAwMAoxMTkuODAwMDAwCjk5Ljk1MDAwMAo4NC4yMzAwMDAKNzAuMDQwMDAwCjU5LjA2MDAwMAo1MC4zNjAwMDAKNDMuMzYwMDAwCjM3LjE4MDAwMAozMS44NTAwMDAKMjcuNzAwMDAwCjI0Ljk5MDAwMAoyMi4zMDAwMDAKMTkuNTgwMDAwCjE1LjA3MDAwMAoxMC40ODAwMDAKNy43OTAwMDAKNi40NjAwMDAKNS4zMDAwMDAKNC4xNTAwMDAKMy4yODAwMDAKMi44MDAwMDAKMi40NTAwMDAKMi40MzAwMDAKMi4yNjAwMDAKMS45MzAwMDAKMS44NTAwMDAKMS45NzAwMDAKMS43NjAwMDAKMS43MjAwMDAKMS43MDAwMDAKMS42NDAwMDAKMS42ODAwMDAKMS40ODAwMDAKMS4zMjAwMDAKMS4yMDAwMDAKMS4wMzAwMDAKMC45MjAwMDAKMC45NzAwMDAKMS4wNDAwMDAKMC44NDAwMDAKMC44NjAwMDAKMC43MTAwMDAKMC44NDAwMDAKMC45MjAwMDAKMS4xODAwMDAKMS4xNzAwMDAKMS4xMDAwMDAKMS4wNDAwMDAKMC4yMjAwMDAKMC4wMTAwMDAKMC4wMjAwMDAKMC4wMjAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMTAwMDAKMC4wMjAwMDAKMC4wNDAwMDAKMC4wNDAwMDAKMC4wNDAwMDAKMC4wMTAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKMC4wMDAwMDAKOTYxLjA5MDAwMAo5NTkuNTkwMDAwCjk2MC4xOTAwMDAKOTY2Ljk0MDAwMAo5NzQuMDEwMDAwCjk3Ny40OTAwMDAKOTc4Ljk2MDAwMAo5ODUuNjgwMDAwCjEwMDAuMTAwMDAwCjEwMDkuMjAwMDAwCjEwMTEuNjAwMDAwCjEwMTQuMjAwMDAwCjEwMTkuMzAwMDAwCjEwMjAuNzAwMDAwCjEwMTkuODAwMDAwCjEwMTcuMzAwMDAwCjEwMTEuNzAwMDAwCjEwMDIuNjAwMDAwCjk4OS4wODAwMDAKOTcxLjA0MDAwMAo5NTEuNTMwMDAwCjkyNy40NzAwMDAKODk4Ljc5MDAwMAo4NjUuMjMwMDAwCjgyOC4xNDAwMDAKNzg3LjgyMDAwMAo3NDcuMTkwMDAwCjcwMi44OTAwMDAKNjU1LjQwMDAwMAo2MDcuOTQwMDAwCjU1OS4zMTAwMDAKNTA1LjM2MDAwMAo0NTMuMDAwMDAwCjQwMy4zMDAwMDAKMzU1LjA2MDAwMAozMTEuMDEwMDAwCjI2OC43NDAwMDAKMjI4LjkxMDAwMAoxOTQuMzgwMDAwCjE2NC4xMDAwMDAKMTM4LjM5MDAwMAoxMTYuOTEwMDAwCjk3LjY3MDAwMAo4MC44MDAwMDAKNjcuNTAwMDAwCjU2LjY4MDAwMAo0OC4xNzAwMDAKNDEuODAwMDAwCjM2LjY0MDAwMAozMi4yMjAwMDAKMjguMjUwMDAwCjI0LjcxMDAwMAoyMS45NjAwMDAKMTguOTcwMDAwCjE0LjM0MDAwMAo5Ljk1MDAwMAo3LjkxMDAwMAo2LjYyMDAwMAo1LjMxMDAwMAo0LjE2MDAwMAozLjMyMDAwMAoyLjk4MDAwMAoyLjUzMDAwMAoyLjY0MDAwMAoyLjA1MDAwMAoxLjgxMDAwMAoxLjYzMDAwMAoxLjYzMDAwMAoxLjk5MDAwMAoyLjA1MDAwMAoxLjg1MDAwMAoxLjY5MDAwMAoxLjQwMDAwMAoxLjEyMDAwMAoxLjM1MDAwMAoxLjIxMDAwMAoxLjAxMDAwMAoxLjAyMDAwMAoxLjE5MDAwMAoxLjAxMDAwMAowLjg5MDAwMAowLjk5MDAwMAowLjk3MDAwMAowLjgyMDAwMAowLjg3MDAwMAoxLjE3MDAwMAoxLjI2MDAwMAoxLjA2MDAwMAowLjk0MDAwMAowLjI0MDAwMAowLjAwMDAwMAowLjAyMDAwMAowLjAxMDAwMAowLjAwMDAwMAowLjA0MDAwMAowLjAyMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAxMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAo5NjEuMDkwMDAwCjk1OS41NDAwMDAKOTYwLjEyMDAwMAo5NjcuMTIwMDAwCjk3NC4zNDAwMDAKOTc4LjcyMDAwMAo5ODAuMzYwMDAwCjk4Ni40NTAwMDAKMTAwMi4yMDAwMDAKMTAxMS40MDAwMDAKMTAxMi4yMDAwMDAKMTAxMy45MDAwMDAKMTAyMi4zMDAwMDAKMTAyNS44MDAwMDAKMTAyNS44MDAwMDAKMTAyNC45MDAwMDAKMTAyMC4wMDAwMDAKMTAxMS43MDAwMDAKMTAwMS41MDAwMDAKOTg1Ljc4MDAwMAo5NjUuMzAwMDAwCjk0Mi41NzAwMDAKOTEyLjM5MDAwMAo4NzguODkwMDAwCjg0Mi41MTAwMDAKODAwLjU3MDAwMAo3NTcuMzAwMDAwCjcxMS44ODAwMDAKNjY0LjYwMDAwMAo2MTQuODUwMDAwCjU2Mi44NDAwMDAKNTA5LjI2MDAwMAo0NTQuNzcwMDAwCjQwNS41MzAwMDAKMzU2LjY3MDAwMAozMDkuNjMwMDAwCjI2NS4xOTAwMDAKMjI2LjMyMDAwMAoxOTIuMDIwMDAwCjE2Mi4yOTAwMDAKMTM3LjE1MDAwMAoxMTUuMTUwMDAwCjk2Ljc1MDAwMAo3OS44MjAwMDAKNjYuNTgwMDAwCjU1LjQ2MDAwMAo0Ny4wODAwMDAKNDAuNzEwMDAwCjM1LjY2MDAwMAozMS4zNDAwMDAKMjcuNzUwMDAwCjI0LjEzMDAwMAoyMS4wNjAwMDAKMTcuNTYwMDAwCjEyLjk1MDAwMAo5LjI3MDAwMAo3LjU3MDAwMAo2LjQxMDAwMAo1LjE4MDAwMAo0LjExMDAwMAozLjE3MDAwMAoyLjcyMDAwMAoyLjMwMDAwMAoxLjg5MDAwMAoxLjkwMDAwMAoxLjY2MDAwMAoxLjk4MDAwMAoxLjg1MDAwMAoxLjY3MDAwMAoxLjYwMDAwMAoxLjQ5MDAwMAoxLjE3MDAwMAoxLjEyMDAwMAoxLjA3MDAwMAowLjk2MDAwMAoxLjA0MDAwMAowLjk4MDAwMAoxLjE0MDAwMAoxLjE5MDAwMAoxLjE5MDAwMAoxLjAwMDAwMAowLjc4MDAwMAowLjc3MDAwMAowLjkwMDAwMAowLjk3MDAwMAowLjc2MDAwMAowLjgzMDAwMAowLjk5MDAwMAowLjg5MDAwMAowLjIwMDAwMAowLjAxMDAwMAowLjAxMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMAowLjAwMDAwMA==`
 
@@ -23,6 +38,29 @@ func TestNewEulumdat(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestNewEulumdat_MalformedCounts(t *testing.T) {
+	data, err := ioutil.ReadFile("test/sample2.ldt")
+	assert.NoError(t, err)
+	lines := strings.Split(string(data), "\n")
+
+	for _, test := range []struct {
+		line  int
+		value string
+		err   string
+	}{
+		{2, "7", "symmetry indicator 7 out of range (0 - 4)"},
+		{3, "-1", "number of C-planes -1 out of range (0 - 100000)"},
+		{5, "1000000000", "number of luminous intensities per C-plane 1000000000 out of range (0 - 100000)"},
+		{25, "-3", "number of standard sets of lamps -3 out of range (0 - 100000)"},
+		{5, "99999", "unexpected EOF"}, // the data is read before the memory is allocated
+	} {
+		malformed := append([]string{}, lines...)
+		malformed[test.line] = test.value
+		_, err := NewEulumdat(strings.NewReader(strings.Join(malformed, "\n")), false)
+		assert.EqualError(t, err, test.err)
+	}
+}
+
 func TestCopyEulumdat(t *testing.T) {
 	eulumData, _ := base64.StdEncoding.DecodeString(eulumDataStr)
 	eulumdat, _ := NewEulumdat(bytes.NewBuffer(eulumData), false)
@@ -81,8 +119,8 @@ func TestEulumdat_GetFwhm(t *testing.T) {
 	eulum2, _ := NewEulumdat(bytes.NewBuffer(eulum2Data), false)
 	fwhmC0 = eulum2.GetFwhm(eulum2.GetCPlaneIndex(0))
 	fwhmC90 = eulum2.GetFwhm(eulum2.GetCPlaneIndex(90))
-	assert.Equal(t, 46.0, fwhmC0)
-	assert.Equal(t, 62.0, fwhmC90)
+	assert.InDelta(t, 45.37, fwhmC0, 0.01)
+	assert.InDelta(t, 61.86, fwhmC90, 0.01)
 }
 
 func TestEulumdat_GetFwtm(t *testing.T) {
@@ -97,8 +135,29 @@ func TestEulumdat_GetFwtm(t *testing.T) {
 	eulum2, _ := NewEulumdat(bytes.NewBuffer(eulum2Data), false)
 	fwtmC0 = eulum2.GetFwtm(eulum2.GetCPlaneIndex(0))
 	fwtmC90 = eulum2.GetFwtm(eulum2.GetCPlaneIndex(90))
-	assert.Equal(t, 64.0, fwtmC0)
-	assert.Equal(t, 84.0, fwtmC90)
+	assert.InDelta(t, 63.21, fwtmC0, 0.01)
+	assert.InDelta(t, 83.37, fwtmC90, 0.01)
+}
+
+func TestEulumdat_GetBeamDivergence(t *testing.T) {
+	eulum1Data, _ := base64.StdEncoding.DecodeString(eulumDataStr)
+	eulum1, _ := NewEulumdat(bytes.NewBuffer(eulum1Data), false)
+	divergence, err := eulum1.GetBeamDivergence(0, 0.5)
+	assert.NoError(t, err) // symmetry indicator 0, GetFwhm refuses it
+	assert.Greater(t, divergence.Width, 0.0)
+	assert.LessOrEqual(t, divergence.Lower, divergence.Peak)
+	assert.GreaterOrEqual(t, divergence.Upper, divergence.Peak)
+
+	eulum2Data, _ := base64.StdEncoding.DecodeString(eulumDataStr2)
+	eulum2, _ := NewEulumdat(bytes.NewBuffer(eulum2Data), false)
+	divergence, err = eulum2.GetBeamDivergence(90, 0.5)
+	assert.NoError(t, err)
+	assert.InDelta(t, eulum2.GetFwhm(eulum2.GetCPlaneIndex(90)), divergence.Width, 1e-9)
+
+	_, err = eulum2.GetBeamDivergence(0, 1)
+	assert.Error(t, err)
+	_, err = Eulumdat{}.GetBeamDivergence(0, 0.5)
+	assert.Error(t, err)
 }
 
 func TestEulumdat_GetCPlaneIndex(t *testing.T) {
@@ -119,6 +178,27 @@ func TestEulumdat_GetCPlaneIndex(t *testing.T) {
 	assert.Equal(t, -1, eulum2.GetCPlaneIndex(360))
 }
 
+func TestEulumdat_GetPlane(t *testing.T) {
+	eulumdat := loadSample2(t)
+	assert.Equal(t, 4, eulumdat.SymmetryIndicator)
+
+	c0 := eulumdat.LuminousIntensityDistribution[0]
+	c90 := eulumdat.LuminousIntensityDistribution[len(eulumdat.LuminousIntensityDistribution)-1]
+	assert.Equal(t, c0, eulumdat.GetPlane(0))
+	assert.Equal(t, c0, eulumdat.GetPlane(180))
+	assert.Equal(t, c90, eulumdat.GetPlane(270))
+	assert.Equal(t, eulumdat.GetPlane(45), eulumdat.GetPlane(315))
+
+	step := eulumdat.AnglesC[1]
+	between := eulumdat.GetPlane(step / 2)
+	for g := range between {
+		expected := (eulumdat.LuminousIntensityDistribution[0][g] + eulumdat.LuminousIntensityDistribution[1][g]) / 2
+		assert.InDelta(t, expected, between[g], 1e-9)
+	}
+
+	assert.Nil(t, Eulumdat{}.GetPlane(0))
+}
+
 func TestEulumdat_GetOverallMaximumLuminousIntensity(t *testing.T) {
 	eulum1Data, _ := base64.StdEncoding.DecodeString(eulumDataStr)
 	eulum1, _ := NewEulumdat(bytes.NewBuffer(eulum1Data), false)
@@ -140,3 +220,218 @@ func TestEulumdat_GetMaximumLuminousIntensity(t *testing.T) {
 	assert.Equal(t, 961.09, eulum2.GetMaximumLuminousIntensity(eulum2.GetCPlaneIndex(0)))
 	assert.Equal(t, 1025.8, eulum2.GetMaximumLuminousIntensity(eulum2.GetCPlaneIndex(90)))
 }
+
+func TestEulumdat_ComputeTotalFlux(t *testing.T) {
+	eulum1Data, _ := base64.StdEncoding.DecodeString(eulumDataStr)
+	eulum1, _ := NewEulumdat(bytes.NewBuffer(eulum1Data), false)
+	assert.InDelta(t, eulum1.LightOutputRatioLuminaire, eulum1.ComputeLightOutputRatio(), 0.1)
+	assert.InDelta(t, 5134.0, eulum1.ComputeTotalFlux(), 1)
+
+	eulum2Data, _ := base64.StdEncoding.DecodeString(eulumDataStr2)
+	eulum2, _ := NewEulumdat(bytes.NewBuffer(eulum2Data), false)
+	assert.InDelta(t, eulum2.LightOutputRatioLuminaire, eulum2.ComputeLightOutputRatio(), 0.1)
+	assert.InDelta(t, 2028.4*0.778, eulum2.ComputeTotalFlux(), 1)
+}
+
+func TestEulumdat_ComputeDirectRatios(t *testing.T) {
+	eulum2Data, _ := base64.StdEncoding.DecodeString(eulumDataStr2)
+	eulum2, _ := NewEulumdat(bytes.NewBuffer(eulum2Data), false)
+
+	ratios := eulum2.ComputeDirectRatios()
+	for i := range ratios {
+		assert.InDelta(t, eulum2.DirectRatios[i], ratios[i], 0.1)
+		if i > 0 {
+			assert.Greater(t, ratios[i], ratios[i-1])
+		}
+	}
+
+	eulum2.DirectRatios = [10]float64{}
+	eulum2.FillDirectRatios()
+	assert.InDelta(t, eulum2.DirectRatios[9], ratios[9], 0.001)
+}
+
+func TestEulumdat_GetPeakIntensity(t *testing.T) {
+	eulum1Data, _ := base64.StdEncoding.DecodeString(eulumDataStr)
+	eulum1, _ := NewEulumdat(bytes.NewBuffer(eulum1Data), false)
+	intensity, c, gamma := eulum1.GetPeakIntensity()
+	assert.InDelta(t, eulum1.GetOverallMaximumLuminousIntensity()*eulum1.TotalLuminousFluxLamps[0]/1000, intensity,
+		1e-9)
+	assert.Equal(t, 0.0, c)
+	assert.Equal(t, 15.0, gamma)
+}
+
+func TestEulumdat_MaxIntensityDirection(t *testing.T) {
+	eulum1Data, _ := base64.StdEncoding.DecodeString(eulumDataStr)
+	eulum1, _ := NewEulumdat(bytes.NewBuffer(eulum1Data), false)
+	direction, c, gamma := eulum1.MaxIntensityDirection()
+	assert.Equal(t, 0.0, c)
+	assert.Equal(t, 15.0, gamma)
+	assertDirection(t, CGammaToDirection(0, 15), direction)
+
+	direction, _, _ = Eulumdat{}.MaxIntensityDirection()
+	assert.Equal(t, Direction{}, direction)
+}
+
+func TestEulumdat_Validate_Strict(t *testing.T) {
+	eulum1 := loadSample2(t)
+	assert.Empty(t, eulum1.Validate(true))
+
+	invalid, _ := CopyEulumdat(eulum1)
+	invalid.FileName = "longer than eight characters"
+	invalid.SymmetryIndicator = 5
+	invalid.DistanceDcCPlanes = 15
+	invalid.DistanceDgCPlane = 10
+	invalid.DownwardFluxFractionPhiu = 120
+	issues := invalid.Validate(true)
+	assert.False(t, issues.Valid())
+	assert.Len(t, issues.Errors(), 4)
+	assert.Len(t, issues.Warnings(), 1)
+	fields := make([]string, len(issues))
+	for i := range issues {
+		fields[i] = issues[i].Field
+	}
+	assert.Equal(t, []string{"FileName", "SymmetryIndicator", "DistanceDcCPlanes", "DistanceDgCPlane",
+		"DownwardFluxFractionPhiu"}, fields)
+	assert.Equal(t, ValidationFieldTooLong, issues[0].Code)
+	assert.Equal(t, ValidationSpacingMismatch, issues[2].Code)
+	assert.Error(t, issues.Err())
+	assert.Contains(t, issues.Err().Error(), "SymmetryIndicator")
+
+	assert.Empty(t, invalid.Validate(false))
+}
+
+func TestEulumdat_Validate_AngleGrid(t *testing.T) {
+	eulumdat := loadSample2(t)
+
+	eulumdat.AnglesC[1] += 1
+	eulumdat.AnglesG[2] += 1
+	issues := eulumdat.Validate(true)
+	assert.True(t, issues.Valid())
+	assert.Len(t, issues.Warnings(), 2)
+	assert.Equal(t, ValidationSpacingMismatch, issues[0].Code)
+	assert.Equal(t, "AnglesC", issues[0].Field)
+	assert.Equal(t, "AnglesG", issues[1].Field)
+	assert.Contains(t, issues[1].Message, "index 2")
+
+	repaired := eulumdat.Repair()
+	assert.Len(t, repaired, 2)
+	assert.Equal(t, 0.0, eulumdat.DistanceDcCPlanes)
+	assert.Equal(t, 0.0, eulumdat.DistanceDgCPlane)
+	assert.Empty(t, eulumdat.Validate(true))
+}
+
+func TestEulumdat_SelectAssembly(t *testing.T) {
+	eulumdat := loadSample2(t)
+
+	ApplyEulumdatAssemblies([]EulumdatAssembly{
+		{Current: 700, NumberOfLamps: 1, TypeOfLamps: "LED", TotalLuminousFlux: 1400, Power: 14, ColorTemperature: "4000K", ColorRenderingIndex: "80"},
+		{Current: 350, NumberOfLamps: 1, TypeOfLamps: "LED", TotalLuminousFlux: 800, Power: 7, ColorTemperature: "3000K", ColorRenderingIndex: "90"},
+	}, &eulumdat)
+	assert.Equal(t, "700 350", eulumdat.Extensions["CURRENTS"])
+	assert.True(t, eulumdat.Validate(true).Valid())
+
+	derived, err := eulumdat.DeriveAssembly(600)
+	assert.NoError(t, err)
+	assert.Equal(t, 600.0, derived.Current)
+	assert.InDelta(t, 1228.57, derived.TotalLuminousFlux, 0.01)
+	assert.InDelta(t, 12, derived.Power, 1e-9)
+	assert.Equal(t, "4000K", derived.ColorTemperature)
+	_, err = eulumdat.DeriveAssembly(800)
+	assert.Error(t, err)
+
+	assert.Error(t, eulumdat.SelectAssembly(2))
+	assert.NoError(t, eulumdat.SelectAssembly(1))
+	assert.Equal(t, 1, eulumdat.NumberStandardSetLamps)
+	assert.Equal(t, []float64{800}, eulumdat.TotalLuminousFluxLamps)
+	assert.Equal(t, []float64{7}, eulumdat.BallastWatts)
+	assert.Equal(t, []string{"3000K"}, eulumdat.ColorTemperature)
+	assert.Equal(t, "350", eulumdat.Extensions["CURRENTS"])
+
+	delete(eulumdat.Extensions, "CURRENTS")
+	_, err = eulumdat.DeriveAssembly(350)
+	assert.Error(t, err)
+}
+
+func TestEulumdat_AbsolutePhotometry(t *testing.T) {
+	eulumdat := loadSample2(t)
+	assert.False(t, eulumdat.IsAbsolutePhotometry())
+	assert.InDelta(t, 520*0.548, eulumdat.GetLuminaireFlux(), 1e-9)
+
+	totalFlux := eulumdat.ComputeTotalFlux()
+	intensities := eulumdat.GetAbsoluteLuminousIntensityDistribution()
+	assert.InDelta(t, eulumdat.LuminousIntensityDistribution[0][0]*0.52, intensities[0][0], 1e-9)
+
+	// LED convention: the conversion factor carries the luminaire flux in klm
+	absolute, err := CopyEulumdat(eulumdat)
+	assert.NoError(t, err)
+	absolute.IntensityConversionFactor = 0.52
+	absolute.LightOutputRatioLuminaire = 100
+	assert.True(t, absolute.IsAbsolutePhotometry())
+	assert.InDelta(t, totalFlux, absolute.ComputeTotalFlux(), 1e-6)
+	assert.Equal(t, intensities, absolute.GetAbsoluteLuminousIntensityDistribution())
+	assert.Equal(t, 520.0, absolute.GetLuminaireFlux())
+	assert.Empty(t, absolute.Validate(true).Warnings())
+
+	ies, err := ConvertEulumdatToIES(&absolute, ConversionOptions{})
+	assert.NoError(t, err)
+	assert.True(t, ies.IsAbsolutePhotometry())
+	assert.InDelta(t, totalFlux, ies.ComputeTotalFlux(), 1e-6)
+
+	assert.NoError(t, absolute.Scale(2))
+	assert.True(t, absolute.IsAbsolutePhotometry())
+	assert.Equal(t, 1.04, absolute.IntensityConversionFactor)
+	assert.InDelta(t, 2*totalFlux, absolute.ComputeTotalFlux(), 1e-6)
+	assert.Error(t, absolute.Scale(0))
+
+	absolute.LightOutputRatioLuminaire = 54.8
+	assert.Len(t, absolute.Validate(true).Warnings(), 1)
+}
+
+func TestEulumdat_GetPlaneFlux(t *testing.T) {
+	for _, path := range []string{"test/sample.ldt", "test/sample2.ldt"} {
+		file, err := os.Open(path)
+		assert.NoError(t, err)
+		eulumdat, err := NewEulumdat(file, false)
+		file.Close()
+		assert.NoError(t, err)
+
+		total := 0.0
+		for c := range eulumdat.LuminousIntensityDistribution {
+			flux := eulumdat.GetPlaneFlux(c)
+			assert.True(t, flux >= 0)
+			total += flux
+		}
+		assert.InDelta(t, eulumdat.ComputeTotalFlux(), total, 1e-6, path)
+		assert.Equal(t, -1.0, eulumdat.GetPlaneFlux(len(eulumdat.LuminousIntensityDistribution)))
+	}
+
+	eulumdat := loadSample2(t)
+
+	// the same distribution stored with the symmetry about C0-C180 and about C90-C270
+	for _, symmetry := range []int{2, 3} {
+		mirrored := eulumdat
+		mirrored.SymmetryIndicator = symmetry
+		mirrored.calcMc1andMc2()
+		mirrored.LuminousIntensityDistribution = nil
+		for i := mirrored.mc1 - 1; i < mirrored.mc2; i++ {
+			plane := eulumdat.GetPlane(mirrored.AnglesC[i%len(mirrored.AnglesC)])
+			mirrored.LuminousIntensityDistribution = append(mirrored.LuminousIntensityDistribution, plane)
+		}
+
+		total := 0.0
+		for c := range mirrored.LuminousIntensityDistribution {
+			total += mirrored.GetPlaneFlux(c)
+		}
+		assert.InDelta(t, eulumdat.ComputeTotalFlux(), total, 1e-6, "symmetry %d", symmetry)
+	}
+
+	// planes sharing their data are still separate sectors
+	shared := eulumdat
+	shared.SymmetryIndicator = 0
+	shared.NumberMcCPlanes = 4
+	shared.AnglesC = []float64{0, 90, 180, 270}
+	shared.LuminousIntensityDistribution = [][]float64{eulumdat.LuminousIntensityDistribution[0],
+		eulumdat.LuminousIntensityDistribution[0], eulumdat.LuminousIntensityDistribution[0],
+		eulumdat.LuminousIntensityDistribution[0]}
+	assert.InDelta(t, shared.ComputeTotalFlux()/4, shared.GetPlaneFlux(1), 1e-6)
+}
@@ -0,0 +1,174 @@
+package eulumies
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ExportOptions controls the formatting of exported EULUMDAT and IES files. The zero value reproduces the default
+// output of Export.
+type ExportOptions struct {
+	// Precision is the number of decimal places of floating point values. 0 selects the default of the format
+	// (6 for EULUMDAT, 2 for IES data lines), a negative value selects the shortest exact representation.
+	Precision int
+	// TrimTrailingZeros removes trailing zeros after the decimal point, at least one decimal place is kept.
+	TrimTrailingZeros bool
+	// IntegersWithoutDecimalPoint writes whole numbers without decimal point and decimal places.
+	IntegersWithoutDecimalPoint bool
+	// DataLineLength is the maximum length of IES data lines, 0 selects the maximum allowed by the format.
+	// Values are never split, so a length of 1 writes one value per line.
+	DataLineLength int
+	// Gzip compresses the exported file with gzip.
+	Gzip bool
+	// NonASCII selects how non-ASCII characters in the keywords of IES files are written, LM-63 requires ASCII.
+	NonASCII NonASCIIPolicy
+	// NonASCIIChanged is called for every keyword value changed by the NonASCII policy, e.g. to report them.
+	NonASCIIChanged func(change NonASCIIChange)
+	// DateLayout reformats the date in the EULUMDAT "Date / User" field and the IES ISSUEDATE, DATE and TESTDATE
+	// keywords with the time layout, e.g. "2006-01-02". Only the date is replaced, surrounding text is kept. Texts
+	// without a complete date (DateConfidenceMedium or better, see ParseDate) are written unchanged.
+	DateLayout string
+}
+
+// NonASCIIPolicy defines the handling of non-ASCII characters in text written to ASCII only formats.
+type NonASCIIPolicy int
+
+const (
+	NonASCIIKeep          NonASCIIPolicy = iota // Write the text unchanged, even though the file is invalid.
+	NonASCIITransliterate                       // Replace characters by ASCII equivalents (ü = ue), remove the rest.
+	NonASCIIStrip                               // Remove all non-ASCII characters.
+	NonASCIIReject                              // Abort the export with an error.
+)
+
+// NonASCIIChange describes a text changed by the NonASCIIPolicy during the export.
+type NonASCIIChange struct {
+	Field    string // name of the struct field, keywords are named like Keywords[LUMINAIRE]
+	Original string
+	Changed  string
+}
+
+// transliterations are the ASCII equivalents of common non-ASCII characters.
+var transliterations = map[rune]string{
+	'ä': "ae", 'ö': "oe", 'ü': "ue", 'Ä': "Ae", 'Ö': "Oe", 'Ü': "Ue", 'ß': "ss", 'ẞ': "SS",
+	'à': "a", 'á': "a", 'â': "a", 'ã': "a", 'å': "a", 'ā': "a", 'ą': "a", 'ă': "a",
+	'À': "A", 'Á': "A", 'Â': "A", 'Ã': "A", 'Å': "A", 'Ā': "A", 'Ą': "A", 'Ă': "A",
+	'æ': "ae", 'Æ': "AE", 'œ': "oe", 'Œ': "OE", 'ø': "o", 'Ø': "O",
+	'ç': "c", 'ć': "c", 'č': "c", 'Ç': "C", 'Ć': "C", 'Č': "C", 'ď': "d", 'Ď': "D", 'đ': "d", 'Đ': "D",
+	'è': "e", 'é': "e", 'ê': "e", 'ë': "e", 'ē': "e", 'ę': "e", 'ě': "e",
+	'È': "E", 'É': "E", 'Ê': "E", 'Ë': "E", 'Ē': "E", 'Ę': "E", 'Ě': "E",
+	'ì': "i", 'í': "i", 'î': "i", 'ï': "i", 'Ì': "I", 'Í': "I", 'Î': "I", 'Ï': "I", 'ı': "i", 'İ': "I",
+	'ł': "l", 'Ł': "L", 'ñ': "n", 'ń': "n", 'ň': "n", 'Ñ': "N", 'Ń': "N", 'Ň': "N",
+	'ò': "o", 'ó': "o", 'ô': "o", 'õ': "o", 'ő': "o", 'Ò': "O", 'Ó': "O", 'Ô': "O", 'Õ': "O", 'Ő': "O",
+	'ř': "r", 'Ř': "R", 'ś': "s", 'š': "s", 'ş': "s", 'Ś': "S", 'Š': "S", 'Ş': "S", 'ť': "t", 'Ť': "T",
+	'ù': "u", 'ú': "u", 'û': "u", 'ů': "u", 'ű': "u", 'Ù': "U", 'Ú': "U", 'Û': "U", 'Ů': "U", 'Ű': "U",
+	'ý': "y", 'ÿ': "y", 'Ý': "Y", 'ź': "z", 'ż': "z", 'ž': "z", 'Ź': "Z", 'Ż': "Z", 'Ž': "Z",
+	'–': "-", '—': "-", '‘': "'", '’': "'", '‚': "'", '“': "\"", '”': "\"", '„': "\"", '«': "\"", '»': "\"",
+	'…': "...", '\u00a0': " ", '°': "deg", 'µ': "u", 'μ': "u", '²': "2", '³': "3", '×': "x", '±': "+/-",
+	'½': "1/2", '¼': "1/4", '¾': "3/4", '€': "EUR", '©': "(C)", '®': "(R)", '™': "(TM)", 'Ω': "Ohm",
+}
+
+// asciiText applies the NonASCII policy to the text of the given field.
+func (o ExportOptions) asciiText(field, text string) (string, error) {
+	if o.NonASCII == NonASCIIKeep || isASCII(text) {
+		return text, nil
+	}
+	if o.NonASCII == NonASCIIReject {
+		return "", fmt.Errorf("%s contains non-ASCII characters: %q", field, text)
+	}
+
+	var builder strings.Builder
+	for _, r := range text {
+		switch {
+		case r < utf8.RuneSelf:
+			builder.WriteRune(r)
+		case o.NonASCII == NonASCIITransliterate:
+			builder.WriteString(transliterations[r])
+		}
+	}
+	changed := builder.String()
+	if o.NonASCIIChanged != nil {
+		o.NonASCIIChanged(NonASCIIChange{Field: field, Original: text, Changed: changed})
+	}
+
+	return changed, nil
+}
+
+// isASCII reports whether the text consists of ASCII characters only.
+func isASCII(text string) bool {
+	for i := 0; i < len(text); i++ {
+		if text[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+
+	return true
+}
+
+// formatFloat formats the value according to the options. The default precision is used if no precision is set,
+// a negative default precision selects the shortest exact representation.
+func (o ExportOptions) formatFloat(value float64, defaultPrecision int) string {
+	if o.IntegersWithoutDecimalPoint && value == math.Trunc(value) && !math.IsInf(value, 0) {
+		return strconv.FormatFloat(value, 'f', 0, 64)
+	}
+
+	precision := o.Precision
+	if precision == 0 {
+		precision = defaultPrecision
+	}
+	if precision < 0 {
+		precision = -1
+	}
+
+	formatted := strconv.FormatFloat(value, 'f', precision, 64)
+	if o.TrimTrailingZeros && strings.Contains(formatted, ".") {
+		formatted = strings.TrimRight(formatted, "0")
+		if strings.HasSuffix(formatted, ".") {
+			formatted += "0"
+		}
+	}
+
+	return formatted
+}
+
+// gzipMagic are the first bytes of gzip compressed data.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// decompressReader returns a reader yielding the decompressed data if the input is gzip compressed, otherwise the
+// input is returned unchanged.
+func decompressReader(in io.Reader) (io.Reader, error) {
+	buffered := bufio.NewReader(in)
+	magic, err := buffered.Peek(len(gzipMagic))
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	if !bytes.Equal(magic, gzipMagic) {
+		return buffered, nil
+	}
+
+	return gzip.NewReader(buffered)
+}
+
+// stringWriter adapts an io.Writer to io.StringWriter.
+type stringWriter struct {
+	io.Writer
+}
+
+func (w stringWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// writer adapts an io.StringWriter to io.Writer.
+type writer struct {
+	io.StringWriter
+}
+
+func (w writer) Write(p []byte) (int, error) {
+	return w.WriteString(string(p))
+}
@@ -0,0 +1,199 @@
+package eulumies
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportOptions_formatFloat(t *testing.T) {
+	assert.Equal(t, "1.500000", ExportOptions{}.formatFloat(1.5, 6))
+	assert.Equal(t, "1.5", ExportOptions{}.formatFloat(1.5, -1))
+	assert.Equal(t, "1.500", ExportOptions{Precision: 3}.formatFloat(1.5, 6))
+	assert.Equal(t, "1.23457", ExportOptions{Precision: -1}.formatFloat(1.23457, 2))
+	assert.Equal(t, "1.5", ExportOptions{TrimTrailingZeros: true}.formatFloat(1.5, 6))
+	assert.Equal(t, "2.0", ExportOptions{TrimTrailingZeros: true}.formatFloat(2, 6))
+	assert.Equal(t, "2", ExportOptions{IntegersWithoutDecimalPoint: true}.formatFloat(2, 6))
+	assert.Equal(t, "-0.50", ExportOptions{IntegersWithoutDecimalPoint: true}.formatFloat(-0.5, 2))
+	assert.Equal(t, "0", ExportOptions{TrimTrailingZeros: true, IntegersWithoutDecimalPoint: true}.formatFloat(0, 6))
+}
+
+func TestEulumdat_ExportWithOptions(t *testing.T) {
+	eulumdat := loadSample2(t)
+
+	buffer := &strings.Builder{}
+	err := eulumdat.ExportWithOptions(buffer, ExportOptions{TrimTrailingZeros: true, IntegersWithoutDecimalPoint: true})
+	assert.NoError(t, err)
+	assert.NotContains(t, buffer.String(), "0.000000")
+	assert.Contains(t, buffer.String(), "\r\n3.19\r\n")
+
+	exported, err := NewEulumdat(strings.NewReader(buffer.String()), false)
+	assert.NoError(t, err)
+	assert.Equal(t, eulumdat.LuminousIntensityDistributionRaw, exported.LuminousIntensityDistributionRaw)
+	assert.Equal(t, eulumdat.AnglesG, exported.AnglesG)
+}
+
+func TestIES_ExportWithOptions(t *testing.T) {
+	ies, err := NewIES("test/sample.ies", false)
+	assert.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "eulumies")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "out.ies")
+
+	err = ies.ExportWithOptions(path, ExportOptions{Precision: 4, TrimTrailingZeros: true, IntegersWithoutDecimalPoint: true})
+	assert.NoError(t, err)
+	content, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), "\r\n0 1 2 3 4 5")
+	assert.NotContains(t, string(content), ".00 ")
+
+	exported, err := NewIES(path, false)
+	assert.NoError(t, err)
+	assert.Equal(t, ies.VerticalAngles, exported.VerticalAngles)
+	assert.InDeltaSlice(t, ies.CandelaValues[0], exported.CandelaValues[0], 1e-4)
+}
+
+func TestIES_ExportTo(t *testing.T) {
+	ies, err := NewIES("test/sample.ies", false)
+	assert.NoError(t, err)
+
+	var out bytes.Buffer
+	assert.NoError(t, ies.ExportTo(&out, ExportOptions{Gzip: true}))
+	exported, err := NewIESFromReader(&out, false)
+	assert.NoError(t, err)
+	assert.Equal(t, ies.Keywords, exported.Keywords)
+	assert.Equal(t, ies.CandelaValues, exported.CandelaValues)
+
+	ies.CandelaValues = nil
+	assert.Error(t, ies.ExportTo(&out, ExportOptions{}))
+}
+
+func TestIES_ExportWithOptions_DataLineLength(t *testing.T) {
+	ies, err := NewIES("test/sample.ies", false)
+	assert.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "eulumies")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "out.ies")
+
+	err = ies.ExportWithOptions(path, ExportOptions{DataLineLength: 1})
+	assert.NoError(t, err)
+	content, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\r\n")
+	tilt := 0
+	for l := range lines {
+		if strings.HasPrefix(lines[l], "TILT=") {
+			tilt = l
+		}
+	}
+	for _, line := range lines[tilt+1:] {
+		assert.Len(t, strings.Fields(line), 1, line)
+	}
+
+	exported, err := NewIES(path, false)
+	assert.NoError(t, err)
+	assert.Equal(t, ies.VerticalAngles, exported.VerticalAngles)
+	assert.Equal(t, ies.CandelaValues, exported.CandelaValues)
+
+	err = ies.ExportWithOptions(path, ExportOptions{DataLineLength: 1000})
+	assert.NoError(t, err)
+	content, err = ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	for _, line := range strings.Split(string(content), "\r\n") {
+		assert.LessOrEqual(t, len(line), 132)
+	}
+}
+
+func TestConvertFloatSliceToStringSlice(t *testing.T) {
+	lines := convertFloatSliceToStringSlice(11, ExportOptions{}, []float64{1, 2, 3, 4, 5})
+	assert.Equal(t, []string{"1.00 2.00", "3.00 4.00", "5.00"}, lines)
+
+	lines = convertFloatSliceToStringSlice(1, ExportOptions{}, []float64{1, 2})
+	assert.Equal(t, []string{"1.00", "2.00"}, lines)
+}
+
+func TestExportOptions_Gzip(t *testing.T) {
+	eulumdat := loadSample2(t)
+
+	buffer := &strings.Builder{}
+	assert.NoError(t, eulumdat.ExportWithOptions(buffer, ExportOptions{Gzip: true}))
+	assert.True(t, strings.HasPrefix(buffer.String(), "\x1f\x8b"))
+	compressed, err := NewEulumdat(strings.NewReader(buffer.String()), false)
+	assert.NoError(t, err)
+	assert.Equal(t, eulumdat.LuminousIntensityDistribution, compressed.LuminousIntensityDistribution)
+
+	ies, err := NewIES("test/sample.ies", false)
+	assert.NoError(t, err)
+	dir, err := ioutil.TempDir("", "eulumies")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "out.ies.gz")
+
+	assert.NoError(t, ies.ExportWithOptions(path, ExportOptions{Gzip: true}))
+	content, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, gzipMagic, content[:2])
+	exported, err := NewIES(path, false)
+	assert.NoError(t, err)
+	assert.Equal(t, ies.CandelaValues, exported.CandelaValues)
+}
+
+func TestExportOptions_asciiText(t *testing.T) {
+	text, err := ExportOptions{}.asciiText("field", "Lüfter")
+	assert.NoError(t, err)
+	assert.Equal(t, "Lüfter", text)
+
+	var changes []NonASCIIChange
+	opts := ExportOptions{NonASCII: NonASCIITransliterate, NonASCIIChanged: func(change NonASCIIChange) {
+		changes = append(changes, change)
+	}}
+	text, err = opts.asciiText("field", "Straßenleuchte Café – 90° 漢")
+	assert.NoError(t, err)
+	assert.Equal(t, "Strassenleuchte Cafe - 90deg ", text)
+	text, err = opts.asciiText("field", "ASCII")
+	assert.NoError(t, err)
+	assert.Equal(t, "ASCII", text)
+	assert.Equal(t, []NonASCIIChange{{Field: "field", Original: "Straßenleuchte Café – 90° 漢",
+		Changed: "Strassenleuchte Cafe - 90deg "}}, changes)
+
+	text, err = ExportOptions{NonASCII: NonASCIIStrip}.asciiText("field", "Lüfter")
+	assert.NoError(t, err)
+	assert.Equal(t, "Lfter", text)
+
+	_, err = ExportOptions{NonASCII: NonASCIIReject}.asciiText("field", "Lüfter")
+	assert.Error(t, err)
+}
+
+func TestIES_ExportTo_NonASCII(t *testing.T) {
+	ies, err := NewIES("test/sample.ies", false)
+	assert.NoError(t, err)
+	ies.Keywords["LUMINAIRE"] = "Pendelleuchte Zürich"
+
+	issues := ies.Validate(true)
+	assert.True(t, issues.Valid())
+	assert.Len(t, issues.filter(SeverityWarning), 1)
+	assert.Equal(t, ValidationNonASCII, issues.filter(SeverityWarning)[0].Code)
+
+	var changes []NonASCIIChange
+	buffer := &bytes.Buffer{}
+	err = ies.ExportTo(buffer, ExportOptions{NonASCII: NonASCIITransliterate,
+		NonASCIIChanged: func(change NonASCIIChange) { changes = append(changes, change) }})
+	assert.NoError(t, err)
+	assert.Contains(t, buffer.String(), "[LUMINAIRE] Pendelleuchte Zuerich\r\n")
+	assert.True(t, isASCII(buffer.String()))
+	assert.Equal(t, []NonASCIIChange{{Field: "Keywords[LUMINAIRE]", Original: "Pendelleuchte Zürich",
+		Changed: "Pendelleuchte Zuerich"}}, changes)
+
+	err = ies.ExportTo(&bytes.Buffer{}, ExportOptions{NonASCII: NonASCIIReject})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Keywords[LUMINAIRE]")
+}
@@ -0,0 +1,97 @@
+package eulumies
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// dosNameLength is the maximum length of the name part of DOS 8.3 file names and of the EULUMDAT file name field.
+const dosNameLength = 8
+
+// SafeFileName returns a file name that is valid on all common file systems, built from the given name (e.g. a
+// catalog number) and extension. Runs of characters other than letters, digits, dots, underscores and hyphens are
+// replaced by a hyphen, an empty name is replaced by "luminaire". Names reserved by Windows (CON, PRN, AUX, NUL,
+// COM1-9 and LPT1-9, also followed by an extension) get an underscore appended.
+//
+// With dos set the name follows the DOS 8.3 convention expected by old tools and by the EULUMDAT file name field:
+// at most 8 upper case characters, dots are replaced and the extension is cut to 3 characters.
+func SafeFileName(name, extension string, dos bool) string {
+	name = strings.Trim(unsafeFileNameRegex.ReplaceAllString(strings.TrimSpace(name), "-"), "-._")
+	extension = unsafeFileNameRegex.ReplaceAllString(strings.TrimPrefix(extension, "."), "")
+	if dos {
+		name = strings.ToUpper(strings.ReplaceAll(name, ".", "_"))
+		if len(name) > dosNameLength {
+			name = strings.TrimRight(name[:dosNameLength], "-_")
+		}
+		extension = strings.ToUpper(extension)
+		if len(extension) > 3 {
+			extension = extension[:3]
+		}
+	}
+	if name == "" {
+		name = "luminaire"
+		if dos {
+			name = "LUMINAIR"
+		}
+	}
+	if stem := strings.SplitN(name, ".", 2)[0]; reservedFileNames[strings.ToUpper(stem)] {
+		name = stem + "_" + name[len(stem):]
+	}
+	if extension == "" {
+		return name
+	}
+
+	return name + "." + extension
+}
+
+// reservedFileNames are the device names Windows does not allow as file name, regardless of the extension.
+var reservedFileNames = map[string]bool{
+	"CON": true, "PRN": true, "AUX": true, "NUL": true,
+	"COM1": true, "COM2": true, "COM3": true, "COM4": true, "COM5": true, "COM6": true, "COM7": true, "COM8": true,
+	"COM9": true,
+	"LPT1": true, "LPT2": true, "LPT3": true, "LPT4": true, "LPT5": true, "LPT6": true, "LPT7": true, "LPT8": true,
+	"LPT9": true,
+}
+
+// SuggestFileName returns a safe file name for the data built from the luminaire number (field 10), or the luminaire
+// name if there is none, with the extension ldt. See SafeFileName for the dos option.
+func (e Eulumdat) SuggestFileName(dos bool) string {
+	name := e.LuminaireNumber
+	if strings.TrimSpace(name) == "" {
+		name = e.LuminaireName
+	}
+
+	return SafeFileName(name, "ldt", dos)
+}
+
+// SuggestFileName returns a safe file name for the data built from the LUMCAT keyword, or the LUMINAIRE keyword if
+// there is none, with the extension ies. See SafeFileName for the dos option.
+func (i *IES) SuggestFileName(dos bool) string {
+	name := i.Keywords["LUMCAT"]
+	if strings.TrimSpace(name) == "" {
+		name = i.Keywords["LUMINAIRE"]
+	}
+
+	return SafeFileName(name, "ies", dos)
+}
+
+// SetFileName sets the file name field (11) to the name of the file the data is exported to, so both stay
+// consistent. The field holds 8 characters: longer names are stored without extension and cut if still too long.
+// Reports false if the field could not hold the file name or its name part, use SuggestFileName with the dos option
+// to generate names that fit.
+func (e *Eulumdat) SetFileName(path string) bool {
+	name := filepath.Base(path)
+	if len(name) <= dosNameLength {
+		e.FileName = name
+		return true
+	}
+
+	name = strings.TrimSuffix(name, filepath.Ext(name))
+	if len(name) <= dosNameLength {
+		e.FileName = name
+		return true
+	}
+	e.FileName = name[:dosNameLength]
+
+	return false
+}
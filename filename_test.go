@@ -0,0 +1,49 @@
+package eulumies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSafeFileName(t *testing.T) {
+	assert.Equal(t, "889-1551-H27.ldt", SafeFileName(" 889/1551 H27 ", "ldt", false))
+	assert.Equal(t, "Lamp_v1.2.ies", SafeFileName("Lamp_v1.2", ".ies", false))
+	assert.Equal(t, "luminaire.ldt", SafeFileName("äöü", "ldt", false))
+	assert.Equal(t, "luminaire", SafeFileName("", "", false))
+
+	assert.Equal(t, "889-1551.LDT", SafeFileName("889-1551-H27", "ldt", true))
+	assert.Equal(t, "LAMP_V1.IES", SafeFileName("lamp.v1", "ies", true))
+	assert.Equal(t, "AB.GZI", SafeFileName("ab", "gzip", true))
+	assert.Equal(t, "LUMINAIR.LDT", SafeFileName("", "ldt", true))
+
+	assert.Equal(t, "CON_.ldt", SafeFileName("CON", "ldt", false))
+	assert.Equal(t, "nul_.v1.ies", SafeFileName("nul.v1", "ies", false))
+	assert.Equal(t, "Com1_", SafeFileName("Com1", "", false))
+	assert.Equal(t, "COM10.ldt", SafeFileName("COM10", "ldt", false))
+	assert.Equal(t, "LPT9_.LDT", SafeFileName("lpt9", "ldt", true))
+	assert.Equal(t, "AUX_.IES", SafeFileName("aux_____x", "ies", true))
+}
+
+func TestSuggestFileName(t *testing.T) {
+	eulumdat := Eulumdat{LuminaireName: "Super Lamp", LuminaireNumber: "SL 100"}
+	assert.Equal(t, "SL-100.ldt", eulumdat.SuggestFileName(false))
+	eulumdat.LuminaireNumber = " "
+	assert.Equal(t, "SUPER-LA.LDT", eulumdat.SuggestFileName(true))
+
+	ies, err := NewIES("test/sample.ies", false)
+	assert.NoError(t, err)
+	assert.Equal(t, "889-1551-H27-K18-L00.ies", ies.SuggestFileName(false))
+	delete(ies.Keywords, "LUMCAT")
+	assert.Equal(t, "A-SUPER-LAMP.ies", ies.SuggestFileName(false))
+}
+
+func TestEulumdat_SetFileName(t *testing.T) {
+	var eulumdat Eulumdat
+	assert.True(t, eulumdat.SetFileName("out/LAMP.LDT"))
+	assert.Equal(t, "LAMP.LDT", eulumdat.FileName)
+	assert.True(t, eulumdat.SetFileName("out/SUPERLMP.LDT"))
+	assert.Equal(t, "SUPERLMP", eulumdat.FileName)
+	assert.False(t, eulumdat.SetFileName("out/super-lamp-100.ldt"))
+	assert.Equal(t, "super-la", eulumdat.FileName)
+}
@@ -3,24 +3,69 @@ package eulumies
 import (
 	"bufio"
 	"errors"
-	"log"
+	"fmt"
 	"strconv"
 	"strings"
 )
 
-func validateStringFromLine(scanner *bufio.Scanner, maxLength int, strict bool) (string, error) {
+// maxCount is the upper bound of the counts (planes, angles, lamp sets) read from a file. Larger counts are treated as
+// corrupt data, they would only lead to huge allocations.
+const maxCount = 100000
+
+// validateCount checks a count read from a file before it is used to allocate memory.
+func validateCount(name string, count int) error {
+	if count < 0 || count > maxCount {
+		return fmt.Errorf("%s %d out of range (0 - %d)", name, count, maxCount)
+	}
+
+	return nil
+}
+
+// initialCapacity limits the capacity preallocated for the given count. Slices grow with the data actually read, so
+// the memory stays bounded by the input size even if the counts of the header are wrong.
+func initialCapacity(count int) int {
+	const limit = 4096
+	if count > limit {
+		return limit
+	}
+
+	return count
+}
+
+// errUnexpectedEOF is returned by the line readers if the data ends before all values are read.
+var errUnexpectedEOF = errors.New("unexpected EOF")
+
+// readFloatLines reads the given number of lines holding one floating point value each. If the data ends early, the
+// values read so far are returned with errUnexpectedEOF.
+func readFloatLines(scanner *bufio.Scanner, count int) ([]float64, error) {
+	values := make([]float64, 0, initialCapacity(count))
+	for len(values) < count {
+		value, err := validateFloatFromLine(scanner)
+		if err == errUnexpectedEOF {
+			return values, err
+		}
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+func validateStringFromLine(scanner *bufio.Scanner, maxLength int, opts ParseOptions) (string, error) {
 	if !scanner.Scan() {
 		if err := scanner.Err(); err != nil {
 			return "", err
 		} else {
-			return "", errors.New("unexpected EOF")
+			return "", errUnexpectedEOF
 		}
 	}
 	cleanLine := strings.TrimSpace(scanner.Text())
-	if len(cleanLine) > maxLength && strict {
+	if len(cleanLine) > maxLength && opts.strictChecks().has(CheckLineLength) {
 		return "", errors.New("line exceeds maximum allowed length: " + cleanLine)
-	} else if len(cleanLine) > maxLength && !strict {
-		log.Printf("[W] line exceeds maximum allowed length: %d > %d, %s", len(cleanLine), maxLength, cleanLine)
+	} else if len(cleanLine) > maxLength {
+		opts.logger().Warnf("line exceeds maximum allowed length: %d > %d, %s", len(cleanLine), maxLength, cleanLine)
 	}
 	return cleanLine, nil
 }
@@ -30,7 +75,7 @@ func validateIntFromLine(scanner *bufio.Scanner) (int, error) {
 		if err := scanner.Err(); err != nil {
 			return -1, err
 		} else {
-			return -1, errors.New("unexpected EOF")
+			return -1, errUnexpectedEOF
 		}
 	}
 
@@ -53,7 +98,7 @@ func validateFloatFromLine(scanner *bufio.Scanner) (float64, error) {
 		if err := scanner.Err(); err != nil {
 			return -1, err
 		} else {
-			return -1, errors.New("unexpected EOF")
+			return -1, errUnexpectedEOF
 		}
 	}
 
@@ -0,0 +1,79 @@
+package eulumies
+
+import "math"
+
+// DefaultFluxTolerance is the relative deviation between the declared and the integrated flux accepted by the
+// CheckFluxConsistency validation. The zonal integration of coarse angle grids alone deviates by a few percent.
+const DefaultFluxTolerance = 0.05
+
+// FluxConsistency compares the luminous flux declared in the header with the flux obtained by integrating the
+// luminous intensity distribution.
+type FluxConsistency struct {
+	Declared   float64 // lm, 0 if the data declares no flux
+	Integrated float64 // lm
+	Deviation  float64 // relative deviation (Integrated - Declared) / Declared, 0 if no flux is declared
+}
+
+// CheckFluxConsistency compares the declared luminaire flux (GetLuminaireFlux: lamp flux and light output ratio, or
+// the luminaire flux of absolute photometry) with the integrated flux (ComputeTotalFlux). The intensity conversion
+// factor is taken into account. A warning is returned if both deviate by more than the tolerance, e.g. 0.05 for 5%.
+// An integrated light output ratio above 100% of relative photometry is reported as hint to absolute candela values
+// stored instead of cd/klm, a common vendor error.
+func (e Eulumdat) CheckFluxConsistency(tolerance float64) (FluxConsistency, ValidationIssues) {
+	result := FluxConsistency{Declared: e.GetLuminaireFlux(), Integrated: e.ComputeTotalFlux()}
+	if result.Declared <= 0 || result.Integrated <= 0 {
+		return result, nil
+	}
+	result.Deviation = result.Integrated/result.Declared - 1
+	if math.Abs(result.Deviation) <= tolerance {
+		return result, nil
+	}
+
+	field := "LightOutputRatioLuminaire"
+	if e.IsAbsolutePhotometry() {
+		field = "TotalLuminousFluxLamps"
+	}
+	var issues ValidationIssues
+	if ratio := e.ComputeLightOutputRatio(); !e.IsAbsolutePhotometry() && ratio > 100*(1+tolerance) {
+		issues.add(ValidationFluxMismatch, SeverityWarning, field,
+			"integrated flux %.1f lm deviates by %+.1f%% from the declared %.1f lm, the integrated light output "+
+				"ratio of %.0f%% suggests absolute candela instead of cd/klm", result.Integrated, 100*result.Deviation,
+			result.Declared, ratio)
+	} else {
+		issues.add(ValidationFluxMismatch, SeverityWarning, field,
+			"integrated flux %.1f lm deviates by %+.1f%% from the declared %.1f lm", result.Integrated,
+			100*result.Deviation, result.Declared)
+	}
+
+	return result, issues
+}
+
+// CheckFluxConsistency compares the rated lamp lumens, reduced by the ballast factor, with the integrated flux
+// (ComputeTotalFlux), which includes the candela multiplier. IES files declare no luminaire flux: the integrated flux
+// of relative photometry is the lamp flux reduced by the luminaire losses, so only an integrated flux exceeding the
+// lamp lumens by more than the tolerance (e.g. 0.05 for 5%) is reported. Absolute photometry declares no flux and is
+// not checked.
+func (i *IES) CheckFluxConsistency(tolerance float64) (FluxConsistency, ValidationIssues) {
+	result := FluxConsistency{Integrated: i.ComputeTotalFlux()}
+	if i.IsAbsolutePhotometry() {
+		return result, nil
+	}
+	result.Declared = i.lampLumens()
+	if i.BallastFactor > 0 {
+		result.Declared *= i.BallastFactor
+	}
+	if result.Declared <= 0 || result.Integrated <= 0 {
+		return result, nil
+	}
+	result.Deviation = result.Integrated/result.Declared - 1
+	if result.Deviation <= tolerance {
+		return result, nil
+	}
+
+	var issues ValidationIssues
+	issues.add(ValidationFluxMismatch, SeverityWarning, "LumensPerLamp",
+		"integrated flux %.1f lm exceeds the rated lamp lumens %.1f lm by %.1f%%, check the candela multiplier",
+		result.Integrated, result.Declared, 100*result.Deviation)
+
+	return result, issues
+}
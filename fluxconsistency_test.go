@@ -0,0 +1,60 @@
+package eulumies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEulumdat_CheckFluxConsistency(t *testing.T) {
+	eulumdat := loadSample2(t)
+
+	result, issues := eulumdat.CheckFluxConsistency(DefaultFluxTolerance)
+	assert.Empty(t, issues)
+	assert.InDelta(t, 284.96, result.Declared, 1e-9)
+	assert.InDelta(t, result.Declared, result.Integrated, 1)
+	assert.Less(t, result.Deviation, 0.01)
+
+	declaredRatio := eulumdat.LightOutputRatioLuminaire
+	eulumdat.LightOutputRatioLuminaire = 2 * declaredRatio
+	result, issues = eulumdat.CheckFluxConsistency(DefaultFluxTolerance)
+	require.Len(t, issues, 1)
+	assert.Equal(t, ValidationFluxMismatch, issues[0].Code)
+	assert.Equal(t, "LightOutputRatioLuminaire", issues[0].Field)
+	assert.InDelta(t, -0.5, result.Deviation, 0.01)
+	assert.NotContains(t, issues[0].Message, "cd/klm")
+	assert.Len(t, eulumdat.ValidateChecks(CheckFluxConsistency), 1)
+	for _, issue := range eulumdat.ValidateChecks(StrictDefault) {
+		assert.NotEqual(t, ValidationFluxMismatch, issue.Code)
+	}
+
+	// absolute candela values stored as cd/klm
+	eulumdat.LightOutputRatioLuminaire = declaredRatio
+	eulumdat.LuminousIntensityDistribution = scalePlanes(eulumdat.LuminousIntensityDistribution, 5)
+	_, issues = eulumdat.CheckFluxConsistency(DefaultFluxTolerance)
+	require.Len(t, issues, 1)
+	assert.Contains(t, issues[0].Message, "ratio of 274% suggests absolute candela instead of cd/klm")
+}
+
+func TestIES_CheckFluxConsistency(t *testing.T) {
+	ies, err := NewIES("test/sample.ies", false)
+	require.NoError(t, err)
+
+	result, issues := ies.CheckFluxConsistency(DefaultFluxTolerance)
+	assert.Empty(t, issues)
+	assert.Equal(t, 1016.0, result.Declared)
+	assert.InDelta(t, 898.4, result.Integrated, 0.1)
+
+	ies.CandelaMultiplier *= 2
+	result, issues = ies.CheckFluxConsistency(DefaultFluxTolerance)
+	require.Len(t, issues, 1)
+	assert.Equal(t, "LumensPerLamp", issues[0].Field)
+	assert.InDelta(t, 0.77, result.Deviation, 0.01)
+	assert.Len(t, ies.ValidateChecks(CheckFluxConsistency), 1)
+
+	ies.LumensPerLamp = -1
+	result, issues = ies.CheckFluxConsistency(DefaultFluxTolerance)
+	assert.Empty(t, issues)
+	assert.Zero(t, result.Declared)
+}
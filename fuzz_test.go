@@ -0,0 +1,77 @@
+//go:build go1.18
+// +build go1.18
+
+package eulumies
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/h44z/eulumies/samples"
+)
+
+// addSeeds adds the test files and the samples with the given extension to the fuzzing corpus.
+func addSeeds(f *testing.F, extension string) {
+	paths, err := filepath.Glob("test/*" + extension)
+	if err != nil {
+		f.Fatal(err)
+	}
+	for _, path := range paths {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(data)
+	}
+	for _, sample := range samples.All() {
+		if strings.HasSuffix(sample.Name, extension) {
+			f.Add(sample.Bytes())
+		}
+	}
+}
+
+func FuzzNewEulumdat(f *testing.F) {
+	addSeeds(f, ".ldt")
+	f.Fuzz(func(t *testing.T, data []byte) {
+		for _, strict := range []bool{false, true} {
+			eulumdat, err := NewEulumdat(bytes.NewReader(data), strict)
+			if err != nil {
+				continue
+			}
+
+			eulumdat.Validate(true)
+			eulumdat.ComputeTotalFlux()
+			eulumdat.GetPlane(45)
+			eulumdat.GetFwhm(0)
+			if eulumdat.Validate(false).Err() == nil {
+				var out strings.Builder
+				_ = eulumdat.Export(&out)
+			}
+			eulumdat.Repair()
+		}
+	})
+}
+
+func FuzzNewIES(f *testing.F) {
+	addSeeds(f, ".ies")
+	f.Fuzz(func(t *testing.T, data []byte) {
+		for _, strict := range []bool{false, true} {
+			ies, err := NewIESFromReader(bytes.NewReader(data), strict)
+			if err != nil {
+				continue
+			}
+
+			ies.Validate(true)
+			ies.ComputeTotalFlux()
+			ies.GetFwhm(0)
+			if ies.Validate(true).Err() == nil {
+				var out strings.Builder
+				_ = ies.ExportTo(&out, ExportOptions{})
+			}
+			ies.Repair()
+		}
+	})
+}
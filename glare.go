@@ -0,0 +1,307 @@
+package eulumies
+
+import (
+	"errors"
+	"math"
+)
+
+// ThresholdIncrementOptions describes the road lighting installation and the observer for a threshold increment
+// calculation. The road runs along the x axis, the observer looks along +x. All lengths are given in meters.
+type ThresholdIncrementOptions struct {
+	MountingHeight   float64 // height of the luminaires above the road surface
+	Spacing          float64 // distance between two luminaires of the row
+	LateralOffset    float64 // distance between the observer and the luminaire row, measured across the road
+	Tilt             float64 // rotation (in degrees) around the C0-C180 axis, positive values raise the C90 side
+	Rotation         float64 // rotation (in degrees) around the vertical axis, positive values turn C0 towards +y
+	EyeHeight        float64 // eye height of the observer, defaults to 1.5 m
+	AverageLuminance float64 // average road surface luminance (cd/m²)
+}
+
+const (
+	thresholdIncrementViewDistance = 500.0 // luminaires further away are ignored
+	thresholdIncrementScreenAngle  = 20.0  // luminaires above this elevation are screened by the vehicle roof
+	thresholdIncrementSightAngle   = 1.0   // line of sight below the horizontal
+	thresholdIncrementSteps        = 10    // observer positions within one luminaire spacing
+)
+
+// computeThresholdIncrement returns the maximum threshold increment TI (%) for the given installation.
+// The observer is moved in steps over one luminaire spacing, the veiling luminance is summed for all luminaires
+// in front of the observer up to 500 m, which are seen between 1.5 and 60 degrees from the line of sight.
+func computeThresholdIncrement(intensity IntensityFunc, opts ThresholdIncrementOptions) (float64, error) {
+	if opts.MountingHeight <= 0 || opts.Spacing <= 0 {
+		return 0, errors.New("mounting height and spacing must be positive")
+	}
+	if opts.AverageLuminance <= 0 {
+		return 0, errors.New("average luminance must be positive")
+	}
+	eyeHeight := opts.EyeHeight
+	if eyeHeight <= 0 {
+		eyeHeight = 1.5
+	}
+
+	sinSight, cosSight := math.Sincos(degToRad(thresholdIncrementSightAngle))
+	maxTI := 0.0
+	for step := 0; step < thresholdIncrementSteps; step++ {
+		observerX := -opts.Spacing * float64(step) / thresholdIncrementSteps
+
+		veilingLuminance := 0.0
+		for luminaireX := 0.0; luminaireX-observerX <= thresholdIncrementViewDistance; luminaireX += opts.Spacing {
+			dx := luminaireX - observerX
+			dy := opts.LateralOffset
+			dz := opts.MountingHeight - eyeHeight
+			if radToDeg(math.Atan2(dz, dx)) > thresholdIncrementScreenAngle {
+				continue
+			}
+
+			distance := math.Sqrt(dx*dx + dy*dy + dz*dz)
+			cosTheta := (dx*cosSight - dz*sinSight) / distance
+			theta := radToDeg(math.Acos(math.Max(-1, math.Min(1, cosTheta))))
+			if theta < 1.5 || theta > 60 {
+				continue
+			}
+
+			c, gamma, _ := installedDirection(opts.MountingHeight, opts.Tilt, opts.Rotation, -dx, -dy, eyeHeight)
+			eyeIlluminance := intensity(c, gamma) * cosTheta / (distance * distance)
+			veilingLuminance += 10 * eyeIlluminance / (theta * theta)
+		}
+
+		maxTI = math.Max(maxTI, thresholdIncrement(veilingLuminance, opts.AverageLuminance))
+	}
+
+	return maxTI, nil
+}
+
+// thresholdIncrement returns the threshold increment TI (%) for the given veiling and average road luminance.
+func thresholdIncrement(veilingLuminance, averageLuminance float64) float64 {
+	if averageLuminance > 5 {
+		return 95 * veilingLuminance / math.Pow(averageLuminance, 1.05)
+	}
+
+	return 65 * veilingLuminance / math.Pow(averageLuminance, 0.8)
+}
+
+// GlareLuminanceAngles contains the gamma angles of the luminance limits of EN 12464-1 for screen work areas.
+var GlareLuminanceAngles = [5]float64{65, 70, 75, 80, 85}
+
+const glareLuminanceCStep = 15.0 // C-plane step (degrees) of the luminance evaluation
+
+// computeGlareLuminances returns the maximum average luminance (cd/m²) of all C-planes at each of the gamma angles
+// of GlareLuminanceAngles. Directions without visible luminous area yield zero.
+func computeGlareLuminances(intensity IntensityFunc, area luminousArea) [5]float64 {
+	var luminances [5]float64
+	for i, gamma := range GlareLuminanceAngles {
+		for c := 0.0; c < 360; c += glareLuminanceCStep {
+			projected := area.projectedArea(c, gamma)
+			if projected <= 0 {
+				continue
+			}
+			luminances[i] = math.Max(luminances[i], intensity(c, gamma)/projected)
+		}
+	}
+
+	return luminances
+}
+
+// intensityClass holds the limits (cd/klm) of a luminous intensity class of EN 13201-2, negative limits are not
+// restricted. The limits apply to all intensities at and above the given gamma angle.
+type intensityClass struct {
+	name    string
+	at70    float64
+	at80    float64
+	at90    float64
+	above95 float64
+}
+
+// intensityClasses contains the luminous intensity classes G*1 to G*6, ordered from the strictest to the least
+// strict class.
+var intensityClasses = []intensityClass{
+	{"G*6", 350, 100, 0, 0},
+	{"G*5", 350, 100, 10, 0},
+	{"G*4", 500, 100, 10, 0},
+	{"G*3", -1, 100, 20, -1},
+	{"G*2", -1, 150, 30, -1},
+	{"G*1", -1, 200, 50, -1},
+}
+
+// computeIntensityClass returns the strictest luminous intensity class (G*1 to G*6) met by the given relative
+// intensity distribution (cd/klm). An empty string is returned if no class is met.
+func computeIntensityClass(gAngles []float64, planes [][]float64) string {
+	at70 := maxIntensityFrom(gAngles, planes, 70, false)
+	at80 := maxIntensityFrom(gAngles, planes, 80, false)
+	at90 := maxIntensityFrom(gAngles, planes, 90, false)
+	above95 := maxIntensityFrom(gAngles, planes, 95, true)
+
+	withinLimit := func(value, limit float64) bool {
+		return limit < 0 || value <= limit
+	}
+	for _, class := range intensityClasses {
+		if withinLimit(at70, class.at70) && withinLimit(at80, class.at80) &&
+			withinLimit(at90, class.at90) && withinLimit(above95, class.above95) {
+			return class.name
+		}
+	}
+
+	return ""
+}
+
+// maxIntensityFrom returns the maximum intensity of all planes at and above (or strictly above) the given gamma angle.
+func maxIntensityFrom(gAngles []float64, planes [][]float64, gamma float64, exclusive bool) float64 {
+	max := 0.0
+	for _, plane := range planes {
+		for g, value := range plane {
+			if g >= len(gAngles) || gAngles[g] < gamma || (exclusive && gAngles[g] == gamma) {
+				continue
+			}
+			max = math.Max(max, value)
+		}
+	}
+
+	return max
+}
+
+// CutOffAngles holds the cut-off angles (gamma, in degrees) of the four principal C-planes. Beyond the cut-off angle
+// the luminous intensity stays below the threshold of the calculation.
+type CutOffAngles struct {
+	C0   float64
+	C90  float64
+	C180 float64
+	C270 float64
+}
+
+// DefaultCutOffThreshold is the luminous intensity (cd/klm) used by the cut-off calculation if no threshold is given.
+const DefaultCutOffThreshold = 10.0
+
+// computeCutOffAngles returns the cut-off angles of the principal planes for the given distribution. The values of
+// the planes are multiplied by scale to obtain cd/klm before they are compared to the threshold.
+func computeCutOffAngles(cAngles, gAngles []float64, planes [][]float64, scale, threshold float64) (CutOffAngles,
+	error) {
+	if len(cAngles) == 0 || len(gAngles) == 0 {
+		return CutOffAngles{}, errors.New("no luminous intensity distribution")
+	}
+	if threshold <= 0 {
+		threshold = DefaultCutOffThreshold
+	}
+
+	angle := func(c float64) float64 {
+		plane := interpolatePlane(cAngles, planes, c)
+		values := make([]float64, len(plane))
+		for g := range plane {
+			values[g] = plane[g] * scale
+		}
+		return cutOffAngle(gAngles, values, threshold)
+	}
+
+	return CutOffAngles{
+		C0:   angle(0),
+		C90:  angle(90),
+		C180: angle(180),
+		C270: angle(270),
+	}, nil
+}
+
+// cutOffAngle returns the gamma angle beyond which all values of the plane are below the threshold. The crossing is
+// interpolated linearly, zero is returned if no value reaches the threshold.
+func cutOffAngle(gAngles, values []float64, threshold float64) float64 {
+	for g := len(values) - 1; g >= 0; g-- {
+		if values[g] < threshold {
+			continue
+		}
+		if g == len(values)-1 || g >= len(gAngles)-1 {
+			return gAngles[len(gAngles)-1]
+		}
+		return interpolateCrossing(gAngles[g], gAngles[g+1], values[g], values[g+1], threshold)
+	}
+
+	return 0
+}
+
+// UGROptions describes the room and the observer of a unified glare rating calculation. The luminaires are arranged
+// on a regular grid below the ceiling, the observer sits at the middle of one wall and looks horizontally along +x
+// into the room. Room dimensions and the spacing are given in multiples of the height H of the luminaires above
+// the eye of the observer.
+type UGROptions struct {
+	Width    float64 // room dimension across the line of sight (X in the CIE tables)
+	Length   float64 // room dimension along the line of sight (Y in the CIE tables)
+	Height   float64 // height H of the luminaires above the eye (m), defaults to 2 m
+	Spacing  float64 // spacing to height ratio of the luminaire grid, defaults to 0.25
+	Rotation float64 // rotation (in degrees) around the vertical axis, 0 = C0 along the line of sight
+
+	// Reflectances of the room surfaces, defaults to 0.7 (ceiling), 0.5 (walls) and 0.2 (floor) if all are zero.
+	CeilingReflectance float64
+	WallReflectance    float64
+	FloorReflectance   float64
+}
+
+const ugrEyeHeight = 1.2 // eye height of the seated observer above the floor
+
+// computeUGR returns the unified glare rating UGR = 8 log(0.25 / Lb * Σ L² ω / p²) (CIE 117) using the Guth position
+// index. The background luminance Lb is approximated from the indirect illuminance of a room with uniformly
+// inter-reflected light, so the result may deviate slightly from tables calculated with the full CIE 190 method.
+func computeUGR(intensity IntensityFunc, area luminousArea, flux float64, opts UGROptions) (float64, error) {
+	if opts.Width <= 0 || opts.Length <= 0 {
+		return 0, errors.New("room dimensions must be positive")
+	}
+	if flux <= 0 {
+		return 0, errors.New("luminaire flux must be positive")
+	}
+	height := opts.Height
+	if height <= 0 {
+		height = 2
+	}
+	spacing := opts.Spacing
+	if spacing <= 0 {
+		spacing = 0.25
+	}
+	ceiling, walls, floor := opts.CeilingReflectance, opts.WallReflectance, opts.FloorReflectance
+	if ceiling == 0 && walls == 0 && floor == 0 {
+		ceiling, walls, floor = 0.7, 0.5, 0.2
+	}
+
+	width := opts.Width * height
+	length := opts.Length * height
+	distance := spacing * height
+	sum := 0.0
+	count := 0
+	for x := distance / 2; x < length; x += distance {
+		for y := -width/2 + distance/2; y < width/2; y += distance {
+			count++
+			c, gamma, d := installedDirection(height, 0, opts.Rotation, -x, -y, 0)
+			projected := area.projectedArea(c, gamma)
+			if projected <= 0 {
+				continue
+			}
+			value := intensity(c, gamma)
+			luminance := value / projected
+			solidAngle := projected / (d * d)
+			p := guthPositionIndex(x, y, height)
+			sum += luminance * luminance * solidAngle / (p * p)
+		}
+	}
+	if sum <= 0 {
+		return 0, errors.New("no luminance visible to the observer, luminous area required")
+	}
+
+	// indirect illuminance of an integrating room, the walls extend from the floor to the luminaire plane
+	wallHeight := height + ugrEyeHeight
+	ceilingArea := width * length
+	wallArea := 2 * (width + length) * wallHeight
+	totalArea := 2*ceilingArea + wallArea
+	reflectance := (ceiling*ceilingArea + walls*wallArea + floor*ceilingArea) / totalArea
+	indirectIlluminance := float64(count) * flux * reflectance / (totalArea * (1 - reflectance))
+	background := indirectIlluminance / math.Pi
+
+	return 8 * math.Log10(0.25/background*sum), nil
+}
+
+// guthPositionIndex returns the position index of a light source at the given offset (x along the horizontal line
+// of sight, y across it and z above the eye).
+func guthPositionIndex(x, y, z float64) float64 {
+	// tau is the angle of the plane through the line of sight and the source from the vertical,
+	// sigma the angle between the line of sight and the source
+	tau := radToDeg(math.Atan2(math.Abs(y), z))
+	sigma := radToDeg(math.Acos(x / math.Sqrt(x*x+y*y+z*z)))
+	exponent := (35.2-0.31889*tau-1.22*math.Exp(-2*tau/9))*1e-3*sigma +
+		(21+0.26667*tau-0.002963*tau*tau)*1e-5*sigma*sigma
+
+	return math.Exp(exponent)
+}
@@ -0,0 +1,141 @@
+package eulumies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_computeThresholdIncrement(t *testing.T) {
+	opts := ThresholdIncrementOptions{MountingHeight: 10, Spacing: 30, LateralOffset: 3, AverageLuminance: 1}
+
+	weak, err := computeThresholdIncrement(func(c, gamma float64) float64 { return 100 }, opts)
+	assert.NoError(t, err)
+	assert.Greater(t, weak, 0.0)
+
+	strong, err := computeThresholdIncrement(func(c, gamma float64) float64 { return 1000 }, opts)
+	assert.NoError(t, err)
+	assert.InDelta(t, 10*weak, strong, 1e-9)
+
+	// no light towards the observer, no glare
+	cutoff, err := computeThresholdIncrement(func(c, gamma float64) float64 {
+		if gamma > 70 {
+			return 0
+		}
+		return 1000
+	}, opts)
+	assert.NoError(t, err)
+	assert.Less(t, cutoff, strong)
+
+	opts.AverageLuminance = 0
+	_, err = computeThresholdIncrement(func(c, gamma float64) float64 { return 100 }, opts)
+	assert.Error(t, err)
+}
+
+func Test_thresholdIncrement(t *testing.T) {
+	assert.InDelta(t, 65*0.1, thresholdIncrement(0.1, 1), 1e-9)
+	assert.Less(t, thresholdIncrement(0.1, 2), thresholdIncrement(0.1, 1))
+}
+
+func Test_computeIntensityClass(t *testing.T) {
+	gAngles := []float64{0, 70, 80, 90, 95, 100}
+
+	assert.Equal(t, "G*6", computeIntensityClass(gAngles, [][]float64{{500, 300, 90, 0, 0, 0}}))
+	assert.Equal(t, "G*4", computeIntensityClass(gAngles, [][]float64{{500, 450, 90, 5, 0, 0}}))
+	assert.Equal(t, "G*3", computeIntensityClass(gAngles, [][]float64{{500, 600, 90, 15, 5, 0}}))
+	assert.Equal(t, "G*1", computeIntensityClass(gAngles, [][]float64{{500, 600, 180, 40, 5, 5}}))
+	assert.Equal(t, "", computeIntensityClass(gAngles, [][]float64{{500, 600, 250, 40, 5, 5}}))
+}
+
+func TestEulumdat_ComputeUGR(t *testing.T) {
+	eulumdat := loadSample2(t)
+
+	small, err := eulumdat.ComputeUGR(UGROptions{Width: 2, Length: 2})
+	assert.NoError(t, err)
+	large, err := eulumdat.ComputeUGR(UGROptions{Width: 8, Length: 8})
+	assert.NoError(t, err)
+	assert.Greater(t, large, small)
+	assert.Greater(t, large, 0.0)
+	assert.Less(t, large, 40.0)
+
+	dark, err := eulumdat.ComputeUGR(UGROptions{Width: 4, Length: 4, CeilingReflectance: 0.3, WallReflectance: 0.3, FloorReflectance: 0.2})
+	assert.NoError(t, err)
+	bright, err := eulumdat.ComputeUGR(UGROptions{Width: 4, Length: 4, CeilingReflectance: 0.7, WallReflectance: 0.5, FloorReflectance: 0.2})
+	assert.NoError(t, err)
+	assert.Greater(t, dark, bright)
+
+	_, err = eulumdat.ComputeUGR(UGROptions{Width: 0, Length: 4})
+	assert.Error(t, err)
+
+	eulumdat.LengthDiameterLuminousArea = 0
+	eulumdat.WidthLuminousArea = 0
+	_, err = eulumdat.ComputeUGR(UGROptions{Width: 4, Length: 4})
+	assert.Error(t, err)
+}
+
+func Test_computeGlareLuminances(t *testing.T) {
+	area := luminousArea{length: 0.5, width: 0.2}
+	luminances := computeGlareLuminances(func(c, gamma float64) float64 { return 100 }, area)
+	for i, gamma := range GlareLuminanceAngles {
+		assert.InDelta(t, 100/area.projectedArea(0, gamma), luminances[i], 1e-9)
+	}
+	assert.Less(t, luminances[0], luminances[4])
+
+	assert.Equal(t, [5]float64{}, computeGlareLuminances(func(c, gamma float64) float64 { return 100 }, luminousArea{}))
+}
+
+func TestEulumdat_ComputeGlareLuminances(t *testing.T) {
+	eulumdat := loadSample2(t)
+
+	luminances := eulumdat.ComputeGlareLuminances()
+	for i, gamma := range GlareLuminanceAngles {
+		assert.GreaterOrEqual(t, luminances[i], eulumdat.AverageLuminanceAt(0, gamma))
+		assert.GreaterOrEqual(t, luminances[i], eulumdat.AverageLuminanceAt(90, gamma))
+	}
+	assert.Greater(t, luminances[0], 0.0)
+}
+
+func Test_guthPositionIndex(t *testing.T) {
+	assert.InDelta(t, 1, guthPositionIndex(1e6, 0, 1), 1e-3)
+	assert.Greater(t, guthPositionIndex(1, 1, 1), guthPositionIndex(4, 0, 1))
+}
+
+func Test_cutOffAngle(t *testing.T) {
+	gAngles := []float64{0, 30, 60, 90}
+	assert.Equal(t, 45.0, cutOffAngle(gAngles, []float64{100, 20, 0, 0}, 10))
+	assert.Equal(t, 60.0, cutOffAngle(gAngles, []float64{100, 20, 10, 0}, 10))
+	assert.Equal(t, 90.0, cutOffAngle(gAngles, []float64{100, 20, 10, 10}, 10))
+	assert.Equal(t, 0.0, cutOffAngle(gAngles, []float64{5, 0, 0, 0}, 10))
+}
+
+func TestEulumdat_ComputeCutOffAngles(t *testing.T) {
+	eulumdat := loadSample2(t)
+
+	angles, err := eulumdat.ComputeCutOffAngles(0)
+	assert.NoError(t, err)
+	assert.Greater(t, angles.C0, 0.0)
+	assert.Equal(t, angles.C90, angles.C270) // symmetry indicator 4
+	plane := eulumdat.GetPlane(0)
+	for g, gamma := range eulumdat.AnglesG {
+		if gamma > angles.C0 {
+			assert.Less(t, plane[g]*eulumdat.relativeScale(), DefaultCutOffThreshold)
+		}
+	}
+
+	strict, err := eulumdat.ComputeCutOffAngles(100)
+	assert.NoError(t, err)
+	assert.LessOrEqual(t, strict.C0, angles.C0)
+
+	_, err = Eulumdat{}.ComputeCutOffAngles(10)
+	assert.Error(t, err)
+}
+
+func TestIES_ComputeCutOffAngles(t *testing.T) {
+	ies, err := NewIES("test/sample.ies", false)
+	assert.NoError(t, err)
+
+	angles, err := ies.ComputeCutOffAngles(10)
+	assert.NoError(t, err)
+	assert.Greater(t, angles.C0, 0.0)
+	assert.LessOrEqual(t, angles.C0, 180.0)
+}
@@ -2,10 +2,14 @@ package eulumies
 
 import (
 	"bufio"
+	"compress/gzip"
 	"errors"
 	"fmt"
+	"io"
+	"math"
 	"os"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -31,6 +35,13 @@ const (
 	IESTiltNone    IESTilt = "NONE"    // The lamp output (presumably) does not vary as a function of the luminaire tilt angle.
 )
 
+const (
+	IESUnitsFeet   = 1 // Luminaire dimensions are given in feet.
+	IESUnitsMeters = 2 // Luminaire dimensions are given in meters.
+)
+
+const feetToMeters = 0.3048
+
 var (
 	keywordRegex      = regexp.MustCompile(`^\[(_*\w*)\]\s+(.*)$`)
 	keywordExtraRegex = regexp.MustCompile(`^\s+(.*)$`)
@@ -63,10 +74,17 @@ type IES struct {
 	HorizontalAngles            []float64
 	CandelaValues               [][]float64 // candela values for all vertical angles per	horizontal angle
 
+	// Truncated is set if the data ended within the angles or candela values and was parsed with
+	// ParseOptions.AllowTruncated, ValuesRead is the number of candela values read then. The last plane of truncated
+	// data may be incomplete.
+	Truncated  bool `json:"-"`
+	ValuesRead int  `json:"-"`
+
 	// internal parser values
-	insideBlock   bool
-	lastKeyword   string
-	strictParsing bool
+	insideBlock bool
+	lastKeyword string
+	checks      StrictChecks // strict checks of the parser run
+	logger      Logger       // nil selects the logger set with SetLogger
 }
 
 // NewIES reads the given input file and parses it to the IESNA LM-63 data structure.
@@ -77,82 +95,195 @@ func NewIES(filepath string, strict bool) (*IES, error) {
 	}
 	defer file.Close()
 
+	return NewIESFromReader(file, strict)
+}
+
+// NewIESFromReader parses the IESNA LM-63 data of the given reader, for example standard input or a network stream.
+// Gzip compressed data is detected and decompressed automatically.
+func NewIESFromReader(in io.Reader, strict bool) (*IES, error) {
+	return NewIESFromReaderWithOptions(in, ParseOptions{Strict: strict})
+}
+
+// NewIESFromReaderWithOptions parses the IESNA LM-63 data of the given reader, warnings are sent to the logger of the
+// options.
+func NewIESFromReaderWithOptions(in io.Reader, opts ParseOptions) (*IES, error) {
 	var ies IES
-	ies.strictParsing = strict
+	ies.checks = opts.strictChecks()
+	ies.logger = opts.Logger
 	ies.Format = IESFormatUnknown
 
-	scanner := bufio.NewScanner(file)
-
-	// First load all Header fields, 1 to 26
-	line, err := validateStringFromLine(scanner, 16, strict)
+	in, err := decompressReader(in)
 	if err != nil {
 		return nil, err
 	}
-	if err = ies.parseFormatVersion(line); err != nil {
+	scanner := bufio.NewScanner(in)
+
+	if err = ies.parseHeader(scanner); err != nil {
 		return nil, err
 	}
 
-	line, err = ies.fetchValidLineFromFile(scanner)
+	// Parse vertical angles.
+	words, err := getWordListFromInput(scanner, ies.NumberVerticalAngles, false)
+	if ies.VerticalAngles, err = ies.parseValues(words, err, opts); err != nil {
+		return nil, err
+	} else if ies.Truncated {
+		return &ies, nil
+	}
+
+	// Parse horizontal angles.
+	words, err = getWordListFromInput(scanner, ies.NumberHorizontalAngles, false)
+	if ies.HorizontalAngles, err = ies.parseValues(words, err, opts); err != nil {
+		return nil, err
+	} else if ies.Truncated {
+		return &ies, nil
+	}
+
+	// Parse candela values.
+	words, err = getWordListFromInput(scanner, ies.NumberVerticalAngles*ies.NumberHorizontalAngles, true)
+	candelaValues, err := ies.parseValues(words, err, opts)
 	if err != nil {
 		return nil, err
 	}
+	if ies.Truncated {
+		ies.ValuesRead = len(candelaValues)
+		for len(candelaValues) > 0 {
+			plane := candelaValues
+			if len(plane) > ies.NumberVerticalAngles {
+				plane = plane[:ies.NumberVerticalAngles]
+			}
+			ies.CandelaValues = append(ies.CandelaValues, plane)
+			candelaValues = candelaValues[len(plane):]
+		}
+		return &ies, nil
+	}
+	c := 0
+	ies.CandelaValues = make([][]float64, ies.NumberHorizontalAngles)
+	for i := 0; i < ies.NumberHorizontalAngles; i++ {
+		ies.CandelaValues[i] = make([]float64, ies.NumberVerticalAngles)
+		for j := 0; j < ies.NumberVerticalAngles; j++ {
+			ies.CandelaValues[i][j] = candelaValues[c]
+			c++
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return &ies, nil
+}
+
+// parseValues converts the values read by getWordListFromInput. If the data ended early and the options allow
+// truncated data, the values read so far are returned and the IES data is marked as truncated.
+func (i *IES) parseValues(words []string, err error, opts ParseOptions) ([]float64, error) {
+	if opts.truncated(err) {
+		i.Truncated = true
+	} else if err != nil {
+		return nil, err
+	}
+
+	return convertStringSliceToFloat(words)
+}
+
+// Clone returns a deep copy of the IES data, the copy shares no keywords, angles or candela values with the original.
+func (i *IES) Clone() *IES {
+	clone := *i
+
+	if i.Keywords != nil {
+		clone.Keywords = make(map[string]string, len(i.Keywords))
+		for keyword, value := range i.Keywords {
+			clone.Keywords[keyword] = value
+		}
+	}
+	clone.TiltAngles = make([]float64, len(i.TiltAngles))
+	copy(clone.TiltAngles, i.TiltAngles)
+	clone.TiltMultiplierFactors = make([]float64, len(i.TiltMultiplierFactors))
+	copy(clone.TiltMultiplierFactors, i.TiltMultiplierFactors)
+	clone.VerticalAngles = make([]float64, len(i.VerticalAngles))
+	copy(clone.VerticalAngles, i.VerticalAngles)
+	clone.HorizontalAngles = make([]float64, len(i.HorizontalAngles))
+	copy(clone.HorizontalAngles, i.HorizontalAngles)
+	clone.CandelaValues = make([][]float64, len(i.CandelaValues))
+	for h := range i.CandelaValues {
+		clone.CandelaValues[h] = make([]float64, len(i.CandelaValues[h]))
+		copy(clone.CandelaValues[h], i.CandelaValues[h])
+	}
+
+	return &clone
+}
+
+// parseHeader reads the format version, the keywords, the TILT data and the lines 10 and 11.
+func (i *IES) parseHeader(scanner *bufio.Scanner) error {
+	// First load all Header fields, 1 to 26
+	line, err := validateStringFromLine(scanner, 16, i.parseOptions())
+	if err != nil {
+		return err
+	}
+	if err = i.parseFormatVersion(line); err != nil {
+		return err
+	}
+
+	line, err = i.fetchValidLineFromFile(scanner)
+	if err != nil {
+		return err
+	}
 
 	// Parse keywords and tilt information.
 	tiltReached := false
-	ies.Keywords = make(map[string]string)
+	i.Keywords = make(map[string]string)
 	for !tiltReached {
 		if isKeywordLine(line) {
-			if err = ies.parseKeywordLine(line); err != nil {
-				return nil, err
+			if err = i.parseKeywordLine(line); err != nil {
+				return err
 			}
 		} else if isTiltLine(line) {
-			if !ies.ContainsRequiredKeywords() {
-				return nil, fmt.Errorf("required keywords are missing")
+			if !i.ContainsRequiredKeywords() {
+				return fmt.Errorf("required keywords are missing")
 			}
 			tiltReached = true
 
-			if err = ies.parseTiltLine(line); err != nil {
-				return nil, err
+			if err = i.parseTiltLine(line); err != nil {
+				return err
 			}
 		} else if isKeywordExtraLine(line) {
-			if err = ies.parseKeywordExtraLine(line); err != nil {
-				return nil, err
+			if err = i.parseKeywordExtraLine(line); err != nil {
+				return err
 			}
 		} else {
-			return nil, fmt.Errorf("expected keyword or tilt line, not %s", line)
+			return fmt.Errorf("expected keyword or tilt line, not %s", line)
 		}
 
-		line, err = ies.fetchValidLineFromFile(scanner)
+		line, err = i.fetchValidLineFromFile(scanner)
 		if err != nil {
-			return nil, err
+			return err
 		}
 	}
 
 	// Parse tilt values.
-	if ies.Tilt == IESTiltInclude {
-		if ies.TiltLampToLuminaireGeometry, err = getIntFromLine(line); err != nil {
-			return nil, err
+	if i.Tilt == IESTiltInclude {
+		if i.TiltLampToLuminaireGeometry, err = getIntFromLine(line); err != nil {
+			return err
 		}
-		line, err = ies.fetchValidLineFromFile(scanner)
+		line, err = i.fetchValidLineFromFile(scanner)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		if ies.TiltAnglesAndFactors, err = getIntFromLine(line); err != nil {
-			return nil, err
+		if i.TiltAnglesAndFactors, err = getIntFromLine(line); err != nil {
+			return err
 		}
 
-		if words, err := getWordListFromInput(scanner, ies.TiltAnglesAndFactors, false); err != nil {
-			return nil, err
+		if words, err := getWordListFromInput(scanner, i.TiltAnglesAndFactors, false); err != nil {
+			return err
 		} else {
-			if ies.TiltAngles, err = convertStringSliceToFloat(words); err != nil {
-				return nil, err
+			if i.TiltAngles, err = convertStringSliceToFloat(words); err != nil {
+				return err
 			}
 		}
-		if words, err := getWordListFromInput(scanner, ies.TiltAnglesAndFactors, false); err != nil {
-			return nil, err
+		if words, err := getWordListFromInput(scanner, i.TiltAnglesAndFactors, false); err != nil {
+			return err
 		} else {
-			if ies.TiltMultiplierFactors, err = convertStringSliceToFloat(words); err != nil {
-				return nil, err
+			if i.TiltMultiplierFactors, err = convertStringSliceToFloat(words); err != nil {
+				return err
 			}
 		}
 
@@ -160,120 +291,130 @@ func NewIES(filepath string, strict bool) (*IES, error) {
 
 	// Parse line 10.
 	if words, err := getWordListFromInput(scanner, 10, false); err != nil {
-		return nil, err
+		return err
 	} else {
-		if ies.NumberLamps, err = strconv.Atoi(words[0]); err != nil {
-			return nil, err
+		if i.NumberLamps, err = strconv.Atoi(words[0]); err != nil {
+			return err
 		}
-		if ies.LumensPerLamp, err = strconv.ParseFloat(words[1], 64); err != nil {
-			return nil, err
+		if i.LumensPerLamp, err = strconv.ParseFloat(words[1], 64); err != nil {
+			return err
 		}
-		if ies.CandelaMultiplier, err = strconv.ParseFloat(words[2], 64); err != nil {
-			return nil, err
+		if i.CandelaMultiplier, err = strconv.ParseFloat(words[2], 64); err != nil {
+			return err
 		}
-		if ies.NumberVerticalAngles, err = strconv.Atoi(words[3]); err != nil {
-			return nil, err
+		if i.NumberVerticalAngles, err = strconv.Atoi(words[3]); err != nil {
+			return err
 		}
-		if ies.NumberHorizontalAngles, err = strconv.Atoi(words[4]); err != nil {
-			return nil, err
+		if i.NumberHorizontalAngles, err = strconv.Atoi(words[4]); err != nil {
+			return err
 		}
-		if ies.PhotometricType, err = strconv.Atoi(words[5]); err != nil {
-			return nil, err
+		if err = validateCount("number of vertical angles", i.NumberVerticalAngles); err != nil {
+			return err
 		}
-		if ies.UnitsType, err = strconv.Atoi(words[6]); err != nil {
-			return nil, err
+		if err = validateCount("number of horizontal angles", i.NumberHorizontalAngles); err != nil {
+			return err
 		}
-		if ies.LuminaireWidth, err = strconv.ParseFloat(words[7], 64); err != nil {
-			return nil, err
+		if i.PhotometricType, err = strconv.Atoi(words[5]); err != nil {
+			return err
 		}
-		if ies.LuminaireLength, err = strconv.ParseFloat(words[8], 64); err != nil {
-			return nil, err
+		if i.UnitsType, err = strconv.Atoi(words[6]); err != nil {
+			return err
 		}
-		if ies.LuminaireHeight, err = strconv.ParseFloat(words[9], 64); err != nil {
-			return nil, err
+		if i.LuminaireWidth, err = strconv.ParseFloat(words[7], 64); err != nil {
+			return err
+		}
+		if i.LuminaireLength, err = strconv.ParseFloat(words[8], 64); err != nil {
+			return err
+		}
+		if i.LuminaireHeight, err = strconv.ParseFloat(words[9], 64); err != nil {
+			return err
 		}
 	}
 
 	// Parse line 11.
 	if words, err := getWordListFromInput(scanner, 3, false); err != nil {
-		return nil, err
+		return err
 	} else {
-		if ies.BallastFactor, err = strconv.ParseFloat(words[1], 64); err != nil {
-			return nil, err
+		if i.BallastFactor, err = strconv.ParseFloat(words[0], 64); err != nil {
+			return err
 		}
-		if ies.FutureUse, err = strconv.ParseFloat(words[1], 64); err != nil {
-			return nil, err
+		if i.FutureUse, err = strconv.ParseFloat(words[1], 64); err != nil {
+			return err
 		}
-		if ies.InputWatts, err = strconv.ParseFloat(words[2], 64); err != nil {
-			return nil, err
+		if i.InputWatts, err = strconv.ParseFloat(words[2], 64); err != nil {
+			return err
 		}
 	}
 
-	// Parse vertical angles.
-	if words, err := getWordListFromInput(scanner, ies.NumberVerticalAngles, false); err != nil {
-		return nil, err
-	} else {
-		if ies.VerticalAngles, err = convertStringSliceToFloat(words); err != nil {
-			return nil, err
-		}
+	return nil
+}
+
+// Export writes the IESNA LM-63 instance to a file.
+func (i *IES) Export(filepath string) error {
+	return i.ExportWithOptions(filepath, ExportOptions{})
+}
+
+// ExportWithOptions writes the IESNA LM-63 instance to a file, numbers are formatted according to the given options.
+func (i *IES) ExportWithOptions(filepath string, opts ExportOptions) error {
+	if err := i.Validate(true).Err(); err != nil {
+		return err
 	}
 
-	// Parse horizontal angles.
-	if words, err := getWordListFromInput(scanner, ies.NumberHorizontalAngles, false); err != nil {
-		return nil, err
-	} else {
-		if ies.HorizontalAngles, err = convertStringSliceToFloat(words); err != nil {
-			return nil, err
-		}
+	file, err := os.Create(filepath)
+	if err != nil {
+		return err
 	}
+	defer file.Close()
 
-	// Parse candela values.
-	if words, err := getWordListFromInput(scanner, ies.NumberVerticalAngles*ies.NumberHorizontalAngles, true); err != nil {
-		return nil, err
-	} else {
-		if candelaValues, err := convertStringSliceToFloat(words); err != nil {
-			return nil, err
-		} else {
-			c := 0
-			ies.CandelaValues = make([][]float64, ies.NumberHorizontalAngles)
-			for i := 0; i < ies.NumberHorizontalAngles; i++ {
-				ies.CandelaValues[i] = make([]float64, ies.NumberVerticalAngles)
-				for j := 0; j < ies.NumberVerticalAngles; j++ {
-					ies.CandelaValues[i][j] = candelaValues[c]
-					c++
-				}
-			}
-		}
+	if err = i.exportTo(file, opts); err != nil {
+		return err
 	}
 
-	if err := scanner.Err(); err != nil {
-		return nil, err
+	return file.Sync()
+}
+
+// ExportTo writes the IESNA LM-63 instance to the given output, numbers are formatted according to the given options.
+func (i *IES) ExportTo(out io.StringWriter, opts ExportOptions) error {
+	if err := i.Validate(true).Err(); err != nil {
+		return err
 	}
 
-	return &ies, nil
+	return i.exportTo(out, opts)
 }
 
-// Export writes the IESNA LM-63 instance to a file.
-func (i *IES) Export(filepath string) error {
-	if ok, msg := i.Validate(true); !ok {
-		return errors.New(msg)
+// exportTo writes the data, compressed if requested, without validating it.
+func (i *IES) exportTo(out io.StringWriter, opts ExportOptions) error {
+	if !opts.Gzip {
+		return i.write(out, opts)
 	}
 
-	file, err := os.Create(filepath)
-	if err != nil {
+	compressed := gzip.NewWriter(writer{out})
+	if err := i.write(stringWriter{compressed}, opts); err != nil {
 		return err
 	}
-	defer file.Close()
 
+	return compressed.Close()
+}
+
+// write writes the IESNA LM-63 data to the given output.
+func (i *IES) write(out io.StringWriter, opts ExportOptions) error {
+	var err error
 	lineLength := i.maxKeywordLineLength()
 
 	// Format
-	if _, err = file.WriteString(i.convertFormatToString() + "\r\n"); err != nil {
+	if _, err = out.WriteString(i.convertFormatToString() + "\r\n"); err != nil {
 		return err
 	}
 
 	// Keywords
-	for keyword, value := range i.Keywords {
+	for _, keyword := range i.sortedKeywords() {
+		value, err := opts.asciiText("Keywords["+keyword+"]", i.Keywords[keyword])
+		if err != nil {
+			return err
+		}
+		if keyword == "ISSUEDATE" || keyword == "DATE" || keyword == "TESTDATE" {
+			value = opts.formatDate(value, DateOrderMonthDay)
+		}
 		var cleanKeywordLines []string
 		var splitValue = strings.Split(strings.Replace(value, "\r\n", "\n", -1), "\n")
 		maxLineLength := lineLength - len(keyword) - 3 // -3: [ ] and space
@@ -310,17 +451,17 @@ func (i *IES) Export(filepath string) error {
 		}
 
 		// Write first line
-		if _, err = file.WriteString("[" + keyword + "] " + cleanKeywordLines[0] + "\r\n"); err != nil {
+		if _, err = out.WriteString("[" + keyword + "] " + cleanKeywordLines[0] + "\r\n"); err != nil {
 			return err
 		}
 		if len(cleanKeywordLines) > 1 {
 			for l := 1; l < len(cleanKeywordLines); l++ {
 				if i.Format == IESFormatLM_63_2002 {
-					if _, err = file.WriteString("[MORE] " + cleanKeywordLines[l] + "\r\n"); err != nil {
+					if _, err = out.WriteString("[MORE] " + cleanKeywordLines[l] + "\r\n"); err != nil {
 						return err
 					}
 				} else {
-					if _, err = file.WriteString(" " + cleanKeywordLines[l] + "\r\n"); err != nil {
+					if _, err = out.WriteString(" " + cleanKeywordLines[l] + "\r\n"); err != nil {
 						return err
 					}
 				}
@@ -329,88 +470,87 @@ func (i *IES) Export(filepath string) error {
 	}
 
 	// Tilt Information
-	if _, err = file.WriteString("TILT=" + string(i.Tilt) + "\r\n"); err != nil {
+	if _, err = out.WriteString("TILT=" + string(i.Tilt) + "\r\n"); err != nil {
 		return err
 	}
 
 	// Tilt Data
 	lineLength = i.maxDataLineLength()
+	if opts.DataLineLength > 0 && (lineLength == 0 || opts.DataLineLength < lineLength) {
+		lineLength = opts.DataLineLength
+	}
 	if i.Tilt == IESTiltInclude {
-		if _, err = file.WriteString(strconv.Itoa(i.TiltLampToLuminaireGeometry) + "\r\n"); err != nil {
+		if _, err = out.WriteString(strconv.Itoa(i.TiltLampToLuminaireGeometry) + "\r\n"); err != nil {
 			return err
 		}
-		if _, err = file.WriteString(strconv.Itoa(i.TiltAnglesAndFactors) + "\r\n"); err != nil {
+		if _, err = out.WriteString(strconv.Itoa(i.TiltAnglesAndFactors) + "\r\n"); err != nil {
 			return err
 		}
-		angleLines := convertFloatSliceToStringSlice(lineLength, i.TiltAngles)
+		angleLines := convertFloatSliceToStringSlice(lineLength, opts, i.TiltAngles)
 		for _, line := range angleLines {
-			if _, err = file.WriteString(line + "\r\n"); err != nil {
+			if _, err = out.WriteString(line + "\r\n"); err != nil {
 				return err
 			}
 		}
-		multiplierLines := convertFloatSliceToStringSlice(lineLength, i.TiltMultiplierFactors)
+		multiplierLines := convertFloatSliceToStringSlice(lineLength, opts, i.TiltMultiplierFactors)
 		for _, line := range multiplierLines {
-			if _, err = file.WriteString(line + "\r\n"); err != nil {
+			if _, err = out.WriteString(line + "\r\n"); err != nil {
 				return err
 			}
 		}
 	}
 
 	// Line 10
-	lines := convertValuesToStringSlice(lineLength, i.NumberLamps, i.LumensPerLamp, i.CandelaMultiplier,
+	lines := convertValuesToStringSlice(lineLength, opts, i.NumberLamps, i.LumensPerLamp, i.CandelaMultiplier,
 		i.NumberVerticalAngles, i.NumberHorizontalAngles, i.PhotometricType, i.UnitsType, i.LuminaireWidth,
 		i.LuminaireLength, i.LuminaireHeight)
 	for _, line := range lines {
-		if _, err = file.WriteString(line + "\r\n"); err != nil {
+		if _, err = out.WriteString(line + "\r\n"); err != nil {
 			return err
 		}
 	}
 
 	// Line 10
-	lines = convertValuesToStringSlice(lineLength, i.BallastFactor, i.FutureUse, i.InputWatts)
+	lines = convertValuesToStringSlice(lineLength, opts, i.BallastFactor, i.FutureUse, i.InputWatts)
 	for _, line := range lines {
-		if _, err = file.WriteString(line + "\r\n"); err != nil {
+		if _, err = out.WriteString(line + "\r\n"); err != nil {
 			return err
 		}
 	}
 
 	// Vertival angles
-	lines = convertFloatSliceToStringSlice(lineLength, i.VerticalAngles)
+	lines = convertFloatSliceToStringSlice(lineLength, opts, i.VerticalAngles)
 	for _, line := range lines {
-		if _, err = file.WriteString(line + "\r\n"); err != nil {
+		if _, err = out.WriteString(line + "\r\n"); err != nil {
 			return err
 		}
 	}
 
 	// Horizontal angles
-	lines = convertFloatSliceToStringSlice(lineLength, i.HorizontalAngles)
+	lines = convertFloatSliceToStringSlice(lineLength, opts, i.HorizontalAngles)
 	for _, line := range lines {
-		if _, err = file.WriteString(line + "\r\n"); err != nil {
+		if _, err = out.WriteString(line + "\r\n"); err != nil {
 			return err
 		}
 	}
 
 	// Candela values
 	for _, vertAngles := range i.CandelaValues {
-		lines = convertFloatSliceToStringSlice(lineLength, vertAngles)
+		lines = convertFloatSliceToStringSlice(lineLength, opts, vertAngles)
 		for _, line := range lines {
-			if _, err = file.WriteString(line + "\r\n"); err != nil {
+			if _, err = out.WriteString(line + "\r\n"); err != nil {
 				return err
 			}
 		}
 	}
 
-	if err = file.Sync(); err != nil {
-		return err
-	}
-
 	return nil
 }
 
 // Upgrade sets the format version of the IESNA LM-63 instance to a IESFormatLM_63_2002. It also fixes the required keywords.
 func (i *IES) Upgrade() error {
-	if ok, msg := i.Validate(true); !ok {
-		return errors.New(msg)
+	if err := i.Validate(true).Err(); err != nil {
+		return err
 	}
 
 	i.Format = IESFormatLM_63_2002
@@ -445,35 +585,672 @@ func (i *IES) Upgrade() error {
 	return nil
 }
 
-// Validate the IESNA LM-63 Data structure
-func (i *IES) Validate(strict bool) (bool, string) {
-	if strict {
-		// TODO: length checks on all fields
+// ConvertUnits converts the luminaire dimensions to the given units type (IESUnitsFeet or IESUnitsMeters) and updates UnitsType.
+func (i *IES) ConvertUnits(target int) error {
+	if target != IESUnitsFeet && target != IESUnitsMeters {
+		return fmt.Errorf("invalid units type %d", target)
+	}
+	if i.UnitsType != IESUnitsFeet && i.UnitsType != IESUnitsMeters {
+		return fmt.Errorf("invalid source units type %d", i.UnitsType)
+	}
+	if i.UnitsType == target {
+		return nil
 	}
 
-	if !i.ContainsRequiredKeywords() {
-		return false, "required keywords not present"
+	factor := feetToMeters
+	if target == IESUnitsFeet {
+		factor = 1 / feetToMeters
+	}
+
+	i.LuminaireWidth *= factor
+	i.LuminaireLength *= factor
+	i.LuminaireHeight *= factor
+	i.UnitsType = target
+
+	return nil
+}
+
+// expandedDistribution returns the horizontal angles and candela values with the symmetry of the
+// horizontal angle range resolved (0, 0-90, 0-180, 90-270 or a full 0-360 range).
+func (i *IES) expandedDistribution() ([]float64, [][]float64) {
+	if len(i.HorizontalAngles) == 0 || len(i.CandelaValues) != len(i.HorizontalAngles) {
+		return nil, nil
+	}
+
+	angles := i.HorizontalAngles
+	planes := i.CandelaValues
+	first := angles[0]
+	last := angles[len(angles)-1]
+
+	switch {
+	case len(angles) == 1:
+		return []float64{0}, planes[:1]
+	case first == 0 && last == 90:
+		angles, planes = mirrorPlanes(angles, planes, func(c float64) float64 { return 180 - c })
+		return mirrorPlanes(angles, planes, func(c float64) float64 { return 360 - c })
+	case first == 0 && last == 180:
+		return mirrorPlanes(angles, planes, func(c float64) float64 { return 360 - c })
+	case first == 90 && last == 270:
+		return mirrorPlanes(angles, planes, func(c float64) float64 { return 180 - c })
+	}
+
+	return normalizePlanes(angles, planes)
+}
+
+// typeCDistribution returns the horizontal angles, vertical angles and candela values of the type C distribution with
+// the symmetry resolved. Type A and B photometry is resampled with ToTypeC and the default options first.
+func (i *IES) typeCDistribution() ([]float64, []float64, [][]float64) {
+	ies := i
+	if i.PhotometricType == 2 || i.PhotometricType == 3 {
+		typeC, err := i.ToTypeC(TypeCOptions{})
+		if err != nil {
+			return nil, nil, nil
+		}
+		ies = typeC
+	}
+	hAngles, planes := ies.expandedDistribution()
+
+	return hAngles, ies.VerticalAngles, planes
+}
+
+// GetPeakIntensity returns the maximum candela value (scaled by the CandelaMultiplier and BallastFactor) together
+// with the horizontal and vertical angle where it occurs. If the maximum occurs multiple times, the first
+// occurrence is returned.
+func (i *IES) GetPeakIntensity() (intensity, horizontal, vertical float64) {
+	intensity = -1
+	for h, plane := range i.CandelaValues {
+		for v, value := range plane {
+			if value > intensity && h < len(i.HorizontalAngles) && v < len(i.VerticalAngles) {
+				intensity = value
+				horizontal = i.HorizontalAngles[h]
+				vertical = i.VerticalAngles[v]
+			}
+		}
+	}
+	if intensity < 0 {
+		return 0, 0, 0
+	}
+
+	return intensity * i.candelaScale(), horizontal, vertical
+}
+
+// MaxIntensityDirection returns the direction of the maximum candela value after symmetry expansion, as unit vector
+// and C-plane and gamma angle. Type A and B photometry is converted, its photometric axis (0, 0) points to nadir,
+// see Direction. Maxima occurring in multiple directions resolve to the lowest horizontal angle. The zero vector is
+// returned if there is no distribution.
+func (i *IES) MaxIntensityDirection() (direction Direction, c, gamma float64) {
+	if i.PhotometricType != 2 && i.PhotometricType != 3 {
+		hAngles, planes := i.expandedDistribution()
+		c, gamma, ok := peakPosition(hAngles, i.VerticalAngles, planes)
+		if !ok {
+			return Direction{}, 0, 0
+		}
+		return CGammaToDirection(c, gamma), c, gamma
+	}
+
+	hAngles, planes := i.typeABDistribution()
+	horizontal, vertical, ok := peakPosition(hAngles, i.VerticalAngles, planes)
+	if !ok {
+		return Direction{}, 0, 0
+	}
+	direction = BBetaToDirection(horizontal, vertical)
+	if i.PhotometricType == 3 {
+		direction = AAlphaToDirection(horizontal, vertical)
+	}
+	c, gamma = direction.CGamma()
+
+	return direction, c, gamma
+}
+
+// GetMaximumLuminousIntensity returns the maximum candela value (scaled by the CandelaMultiplier and BallastFactor)
+// of the given horizontal plane. If the plane does not exist, -1 is returned.
+func (i *IES) GetMaximumLuminousIntensity(planeIndex int) float64 {
+	if planeIndex < 0 || planeIndex >= len(i.CandelaValues) {
+		return -1
+	}
+
+	max := 0.0
+	for _, value := range i.CandelaValues[planeIndex] {
+		max = math.Max(max, value)
+	}
+
+	return max * i.candelaScale()
+}
+
+// GetOverallMaximumLuminousIntensity returns the maximum candela value (scaled by the CandelaMultiplier and
+// BallastFactor) of all horizontal planes.
+func (i *IES) GetOverallMaximumLuminousIntensity() float64 {
+	max := 0.0
+	for planeIndex := range i.CandelaValues {
+		max = math.Max(max, i.GetMaximumLuminousIntensity(planeIndex))
+	}
+
+	return max
+}
+
+// GetHorizontalPlaneIndex returns the index of the horizontal plane with the given angle.
+// If no such plane was found, -1 is returned.
+func (i *IES) GetHorizontalPlaneIndex(angle float64) int {
+	for index, planeAngle := range i.HorizontalAngles {
+		if planeAngle == angle {
+			return index
+		}
+	}
+
+	return -1
+}
+
+// GetFwhm returns the full width at half maximum angle of the given horizontal plane.
+// For type C photometry the opposite plane (horizontal angle + 180) forms the second half of the beam, for type A
+// and B photometry the vertical angles of the plane already cover both sides. The crossings are interpolated.
+func (i *IES) GetFwhm(planeIndex int) float64 {
+	return i.getBeamWidth(planeIndex, 0.5)
+}
+
+// GetFwtm returns the full width at 1/10 maximum angle of the given horizontal plane, see GetFwhm.
+func (i *IES) GetFwtm(planeIndex int) float64 {
+	return i.getBeamWidth(planeIndex, 0.1)
+}
+
+// getBeamWidth returns the full beam width at the given fraction of the maximum intensity for the given plane.
+func (i *IES) getBeamWidth(planeIndex int, fraction float64) float64 {
+	if planeIndex < 0 || planeIndex >= len(i.HorizontalAngles) || planeIndex >= len(i.CandelaValues) {
+		return -1 // plane does not exist
+	}
+
+	if i.PhotometricType == 2 || i.PhotometricType == 3 {
+		values := i.CandelaValues[planeIndex]
+		if len(values) != len(i.VerticalAngles) {
+			return -1
+		}
+		return beamWidth(i.VerticalAngles, values, fraction)
+	}
+
+	hAngles, planes := i.expandedDistribution()
+	if len(hAngles) == 0 {
+		return -1
+	}
+	angles, values := planeProfile(hAngles, i.VerticalAngles, planes, i.HorizontalAngles[planeIndex])
+
+	return beamWidth(angles, values, fraction)
+}
+
+// GetBeamDivergence returns the beam at the given fraction (0 - 1, exclusive) of the peak intensity in the horizontal
+// plane at the given angle, evaluated on both sides of the actual peak. For type C photometry the plane is
+// interpolated and the opposite plane (horizontal angle + 180) forms the second half of the beam, for type A and B
+// photometry the plane must exist in the file.
+func (i *IES) GetBeamDivergence(horizontal, fraction float64) (BeamDivergence, error) {
+	if fraction <= 0 || fraction >= 1 {
+		return BeamDivergence{}, fmt.Errorf("fraction %g out of range (0 - 1)", fraction)
+	}
+
+	if i.PhotometricType == 2 || i.PhotometricType == 3 {
+		planeIndex := i.GetHorizontalPlaneIndex(horizontal)
+		if planeIndex < 0 || planeIndex >= len(i.CandelaValues) ||
+			len(i.CandelaValues[planeIndex]) != len(i.VerticalAngles) || len(i.VerticalAngles) == 0 {
+			return BeamDivergence{}, fmt.Errorf("no horizontal plane at %g degrees", horizontal)
+		}
+		return beamDivergence(i.VerticalAngles, i.CandelaValues[planeIndex], fraction), nil
+	}
+
+	hAngles, planes := i.expandedDistribution()
+	if len(hAngles) == 0 || len(i.VerticalAngles) == 0 {
+		return BeamDivergence{}, errors.New("no candela distribution")
+	}
+	angles, values := planeProfile(hAngles, i.VerticalAngles, planes, horizontal)
+
+	return beamDivergence(angles, values, fraction), nil
+}
+
+// Fingerprint returns a stable hash of the candela distribution after symmetry expansion. All metadata is ignored,
+// so duplicate measurements published under different names (or in different formats) share a fingerprint. Type A
+// and B photometry is hashed after resampling with ToTypeC.
+func (i *IES) Fingerprint() string {
+	hAngles, vAngles, planes := i.typeCDistribution()
+	return distributionFingerprint(hAngles, vAngles, planes)
+}
+
+// GetBeamCentroid returns the direction (horizontal and vertical angle) of the flux weighted centroid of the
+// candela distribution. The vertical angle is the tilt of the beam axis from nadir.
+func (i *IES) GetBeamCentroid() (horizontal, vertical float64) {
+	hAngles, planes := i.expandedDistribution()
+	if len(hAngles) == 0 {
+		return 0, 0
+	}
+
+	return beamCentroid(hAngles, i.VerticalAngles, planes)
+}
+
+// ComputeTotalFlux integrates the candela distribution over the sphere using the zonal method and returns
+// the luminous flux in lumen. The candela values are scaled by the CandelaMultiplier and BallastFactor.
+func (i *IES) ComputeTotalFlux() float64 {
+	if i.PhotometricType == 2 || i.PhotometricType == 3 {
+		return i.ComputeZonalLumens(-90, 90)
+	}
+
+	return i.ComputeZonalLumens(0, 180)
+}
+
+// ComputeZonalLumens returns the luminous flux (lm) emitted between the given vertical angles. The vertical angles
+// are measured from nadir for type C photometry and from the horizontal plane (-90 to 90) for type A and B.
+func (i *IES) ComputeZonalLumens(from, to float64) float64 {
+	if i.PhotometricType == 2 || i.PhotometricType == 3 {
+		return zonalFluxTypeAB(i.HorizontalAngles, i.VerticalAngles, i.CandelaValues, from, to) * i.candelaScale()
+	}
+
+	hAngles, planes := i.expandedDistribution()
+	if len(hAngles) == 0 {
+		return 0
+	}
+
+	return zonalFluxBetween(hAngles, i.VerticalAngles, planes, from, to) * i.candelaScale()
+}
+
+// ComputeDownwardFluxFraction returns the share of the luminaire flux emitted below the horizontal plane (0 - 1).
+// The orientation of type A and B photometry is unknown, so -1 is returned for them.
+func (i *IES) ComputeDownwardFluxFraction() float64 {
+	if i.PhotometricType == 2 || i.PhotometricType == 3 {
+		return -1
+	}
+
+	total := i.ComputeTotalFlux()
+	if total <= 0 {
+		return 0
+	}
+
+	return i.ComputeZonalLumens(0, 90) / total
+}
+
+// candelaScale returns the factor converting the stored candela values to the operating candela values.
+// A ballast factor of 0 (not set) is ignored.
+func (i *IES) candelaScale() float64 {
+	if i.BallastFactor > 0 {
+		return i.CandelaMultiplier * i.BallastFactor
+	}
+
+	return i.CandelaMultiplier
+}
+
+// ComputeUpwardLightRatio returns the upward light ratio ULR, the share of the luminaire flux emitted above the
+// horizontal plane. Type A and B photometry is resampled with ToTypeC.
+func (i *IES) ComputeUpwardLightRatio() float64 {
+	hAngles, vAngles, planes := i.typeCDistribution()
+	if len(hAngles) == 0 {
+		return 0
+	}
+
+	total := zonalFlux(hAngles, vAngles, planes)
+	if total <= 0 {
+		return 0
+	}
+
+	return zonalFluxBetween(hAngles, vAngles, planes, 90, 180) / total
+}
+
+// ComputeUpwardLightOutputRatio returns the upward light output ratio ULOR, the flux emitted above the horizontal
+// plane relative to the rated lamp lumens. For absolute photometry it equals the upward light ratio. Type A and B
+// photometry is resampled with ToTypeC.
+func (i *IES) ComputeUpwardLightOutputRatio() float64 {
+	lampLumens := i.lampLumens()
+	if lampLumens <= 0 {
+		return i.ComputeUpwardLightRatio()
+	}
+
+	hAngles, vAngles, planes := i.typeCDistribution()
+	if len(hAngles) == 0 {
+		return 0
+	}
+
+	return zonalFluxBetween(hAngles, vAngles, planes, 90, 180) * i.candelaScale() / lampLumens
+}
+
+// IsDarkSkyCompliant reports whether the luminaire emits no light at or above the horizontal plane. Type A and B
+// photometry is resampled with ToTypeC.
+func (i *IES) IsDarkSkyCompliant() bool {
+	_, vAngles, planes := i.typeCDistribution()
+	return !emitsAboveHorizontal(vAngles, planes)
+}
+
+// IsAbsolutePhotometry reports whether the candela values are absolute luminaire values. LM-63 marks this by
+// setting the lumens per lamp to -1, some exporters use a negative number of lamps instead. Both are accepted and
+// kept unchanged on export.
+func (i *IES) IsAbsolutePhotometry() bool {
+	return i.LumensPerLamp < 0 || i.NumberLamps < 0
+}
+
+// lampLumens returns the rated lumens of all lamps, or 0 for absolute photometry.
+func (i *IES) lampLumens() float64 {
+	if i.IsAbsolutePhotometry() {
+		return 0
+	}
+
+	return i.LumensPerLamp * float64(i.NumberLamps)
+}
+
+// Efficacy returns the luminous efficacy (lm/W) calculated from the lamp lumens and the input watts.
+// For absolute photometry the luminaire flux is obtained by integrating the candela distribution instead.
+func (i *IES) Efficacy() (float64, error) {
+	if i.InputWatts <= 0 {
+		return 0, errors.New("input watts not set")
+	}
+
+	if i.IsAbsolutePhotometry() {
+		return i.ComputeTotalFlux() / i.InputWatts, nil
+	}
+
+	return i.lampLumens() / i.InputWatts, nil
+}
+
+// GetSpacingCriterion returns the spacing criterion (maximum spacing to mounting height ratio) for luminaires
+// aligned along the 0-180 plane, the 90-270 plane and the diagonal.
+func (i *IES) GetSpacingCriterion() SpacingCriterion {
+	hAngles, planes := i.expandedDistribution()
+	if len(hAngles) == 0 {
+		return SpacingCriterion{}
+	}
+
+	return computeSpacingCriterion(hAngles, i.VerticalAngles, planes)
+}
+
+// AverageLuminanceAt returns the average luminance (cd/m²) of the luminous opening seen from the given direction
+// (horizontal and vertical angle in degrees).
+func (i *IES) AverageLuminanceAt(horizontal, vertical float64) float64 {
+	area := i.luminousArea().projectedArea(horizontal, vertical)
+	if area <= 0 {
+		return 0
+	}
+
+	return i.IntensityFunc()(horizontal, vertical) / area
+}
+
+// ComputeGlareLuminances returns the maximum average luminance (cd/m²) of all horizontal angles at the vertical angles
+// 65, 70, 75, 80 and 85 degrees, which EN 12464-1 limits for luminaires illuminating screen work areas.
+func (i *IES) ComputeGlareLuminances() [5]float64 {
+	return computeGlareLuminances(i.IntensityFunc(), i.luminousArea())
+}
+
+// IlluminanceGrid calculates the horizontal illuminance on a ground grid for the given installation.
+func (i *IES) IlluminanceGrid(opts IlluminanceGridOptions) (IlluminanceGrid, error) {
+	return computeIlluminanceGrid(i.IntensityFunc(), opts)
+}
+
+// ThresholdIncrement calculates the threshold increment TI (%) for the given road lighting installation.
+func (i *IES) ThresholdIncrement(opts ThresholdIncrementOptions) (float64, error) {
+	return computeThresholdIncrement(i.IntensityFunc(), opts)
+}
+
+// ComputeUGR calculates the unified glare rating for the given room. The luminous opening dimensions are required.
+func (i *IES) ComputeUGR(opts UGROptions) (float64, error) {
+	return computeUGR(i.IntensityFunc(), i.luminousArea(), i.ComputeTotalFlux(), opts)
+}
+
+// GetIntensityClass returns the strictest luminous intensity class (G*1 to G*6) of EN 13201-2 met by the
+// luminaire, or an empty string if no class is met. The candela values are related to the rated lamp lumens, or to
+// the luminaire flux for absolute photometry.
+func (i *IES) GetIntensityClass() string {
+	flux := i.lampLumens()
+	if flux <= 0 {
+		flux = i.ComputeTotalFlux()
+	}
+	if flux <= 0 {
+		return ""
+	}
+
+	planes := make([][]float64, len(i.CandelaValues))
+	for h, plane := range i.CandelaValues {
+		planes[h] = make([]float64, len(plane))
+		for v := range plane {
+			planes[h][v] = plane[v] * i.candelaScale() * 1000 / flux
+		}
+	}
+
+	return computeIntensityClass(i.VerticalAngles, planes)
+}
+
+// ComputeCutOffAngles returns the cut-off angles of the principal horizontal planes of type C photometry, the vertical
+// angles beyond which the luminous intensity stays below the given threshold (cd/klm). The candela values are related
+// to the rated lamp lumens, or to the luminaire flux for absolute photometry. A threshold of zero uses
+// DefaultCutOffThreshold.
+func (i *IES) ComputeCutOffAngles(threshold float64) (CutOffAngles, error) {
+	if i.PhotometricType == 2 || i.PhotometricType == 3 {
+		return CutOffAngles{}, errors.New("cut-off angles require type C photometry")
+	}
+	flux := i.lampLumens()
+	if flux <= 0 {
+		flux = i.ComputeTotalFlux()
+	}
+	if flux <= 0 {
+		return CutOffAngles{}, errors.New("luminous flux unknown")
+	}
+
+	hAngles, planes := i.expandedDistribution()
+	return computeCutOffAngles(hAngles, i.VerticalAngles, planes, i.candelaScale()*1000/flux, threshold)
+}
+
+// ComputeShapeMetrics returns the metrics and the classification of the shape of the candela distribution of type C
+// photometry.
+func (i *IES) ComputeShapeMetrics() (ShapeMetrics, error) {
+	if i.PhotometricType == 2 || i.PhotometricType == 3 {
+		return ShapeMetrics{}, errors.New("shape metrics require type C photometry")
+	}
+
+	hAngles, planes := i.expandedDistribution()
+	return computeShapeMetrics(hAngles, i.VerticalAngles, planes)
+}
+
+// IntensityFunc returns a function yielding the absolute candela value in a given direction (C-plane and gamma angle),
+// type A and B photometry is resampled with ToTypeC.
+func (i *IES) IntensityFunc() IntensityFunc {
+	hAngles, vAngles, planes := i.typeCDistribution()
+	multiplier := i.candelaScale()
+	return func(horizontal, vertical float64) float64 {
+		return interpolateIntensity(hAngles, vAngles, planes, horizontal, vertical) * multiplier
+	}
+}
+
+// luminousArea returns the geometry of the luminous opening in meters.
+// Negative dimensions denote circular (width and length) or spherical (all dimensions) luminous openings.
+func (i *IES) luminousArea() luminousArea {
+	width := math.Abs(iesUnitsToMillimeters(i.LuminaireWidth, i.UnitsType) / 1000)
+	length := math.Abs(iesUnitsToMillimeters(i.LuminaireLength, i.UnitsType) / 1000)
+	height := math.Abs(iesUnitsToMillimeters(i.LuminaireHeight, i.UnitsType) / 1000)
+
+	area := luminousArea{
+		length:     length,
+		width:      width,
+		circular:   i.LuminaireWidth < 0 && i.LuminaireLength < 0,
+		heightC0:   height,
+		heightC90:  height,
+		heightC180: height,
+		heightC270: height,
+	}
+	area.spherical = area.circular && i.LuminaireHeight < 0
+
+	return area
+}
+
+// Validate the IESNA LM-63 Data structure. Strict validation additionally runs the StrictDefault checks, e.g. of the
+// keywords and value ranges defined by the format version. All issues found are returned, use Valid or Err to check
+// for errors.
+func (i *IES) Validate(strict bool) ValidationIssues {
+	return i.ValidateChecks(strictChecks(strict))
+}
+
+// ValidateChecks validates the IESNA LM-63 Data structure like Validate, running only the given strict checks.
+func (i *IES) ValidateChecks(checks StrictChecks) ValidationIssues {
+	var issues ValidationIssues
+	if checks.has(CheckKeywordLegality) {
+		i.validateKeywords(&issues)
+	}
+	if checks.has(CheckAngleMonotonicity) {
+		validateAngleOrder(&issues, "VerticalAngles", i.VerticalAngles)
+		validateAngleOrder(&issues, "HorizontalAngles", i.HorizontalAngles)
+	}
+	if checks.has(CheckValueRanges) {
+		i.validateValueRanges(&issues)
+	}
+	if checks.has(CheckFluxConsistency) {
+		_, fluxIssues := i.CheckFluxConsistency(DefaultFluxTolerance)
+		issues = append(issues, fluxIssues...)
+	}
+
+	if checks.has(CheckRequiredKeywords) && !i.ContainsRequiredKeywords() {
+		issues.add(ValidationMissingKeyword, SeverityError, "Keywords", "required keywords of format %s not present", i.Format)
 	}
 
 	if i.NumberVerticalAngles != len(i.VerticalAngles) {
-		return false, "VerticalAngles length mismatch"
+		issues.add(ValidationLengthMismatch, SeverityError, "VerticalAngles",
+			"%d angles, expected %d", len(i.VerticalAngles), i.NumberVerticalAngles)
 	}
 
 	if i.NumberHorizontalAngles != len(i.HorizontalAngles) {
-		return false, "HorizontalAngles length mismatch"
+		issues.add(ValidationLengthMismatch, SeverityError, "HorizontalAngles",
+			"%d angles, expected %d", len(i.HorizontalAngles), i.NumberHorizontalAngles)
 	}
 
 	if i.NumberHorizontalAngles != len(i.CandelaValues) {
-		return false, "CandelaValues horizontal length mismatch"
+		issues.add(ValidationLengthMismatch, SeverityError, "CandelaValues",
+			"%d horizontal planes, expected %d", len(i.CandelaValues), i.NumberHorizontalAngles)
 	}
 
-	for _, c := range i.CandelaValues {
+	for h, c := range i.CandelaValues {
 		if i.NumberVerticalAngles != len(c) {
-			return false, "CandelaValues vertical length mismatch"
+			issues.add(ValidationLengthMismatch, SeverityError, fmt.Sprintf("CandelaValues[%d]", h),
+				"%d values, expected %d", len(c), i.NumberVerticalAngles)
 		}
 	}
 
-	return true, ""
+	return issues
+}
+
+// validateKeywords checks the keywords allowed by the format version and their syntax.
+func (i *IES) validateKeywords(issues *ValidationIssues) {
+	if i.Format == IESFormatUnknown || i.Format == "" {
+		issues.add(ValidationUnknownFormat, SeverityInfo, "Format", "unknown format, keywords cannot be checked")
+	}
+	for _, keyword := range i.sortedKeywords() {
+		if len(keyword) > 18 {
+			issues.add(ValidationFieldTooLong, SeverityError, "Keywords", "keyword %s exceeds 18 characters", keyword)
+		} else if !i.isKeywordAllowed(keyword) {
+			issues.add(ValidationKeywordNotAllowed, SeverityError, "Keywords",
+				"keyword %s not allowed in format %s", keyword, i.Format)
+		}
+		if !isASCII(i.Keywords[keyword]) {
+			issues.add(ValidationNonASCII, SeverityWarning, "Keywords",
+				"keyword %s contains non-ASCII characters, use ExportOptions.NonASCII to replace them", keyword)
+		}
+	}
+	if value, ok := i.Keywords[nearFieldKeyword]; ok {
+		validateNearField(issues, "Keywords", value)
+	}
+}
+
+// validateValueRanges checks the TILT data and the value ranges defined by the format version.
+func (i *IES) validateValueRanges(issues *ValidationIssues) {
+	switch i.Tilt {
+	case IESTiltNone, IESTiltFile:
+	case IESTiltInclude:
+		if i.TiltLampToLuminaireGeometry < 1 || i.TiltLampToLuminaireGeometry > 3 {
+			issues.add(ValidationOutOfRange, SeverityError, "TiltLampToLuminaireGeometry",
+				"%d out of range (1 - 3)", i.TiltLampToLuminaireGeometry)
+		}
+		if i.TiltAnglesAndFactors != len(i.TiltAngles) || i.TiltAnglesAndFactors != len(i.TiltMultiplierFactors) {
+			issues.add(ValidationLengthMismatch, SeverityError, "TiltAngles",
+				"%d angles and %d factors, expected %d", len(i.TiltAngles), len(i.TiltMultiplierFactors),
+				i.TiltAnglesAndFactors)
+		}
+		for n := 1; n < len(i.TiltAngles); n++ {
+			if i.TiltAngles[n] <= i.TiltAngles[n-1] {
+				issues.add(ValidationInvalidTilt, SeverityError, "TiltAngles", "angles must be strictly increasing")
+				break
+			}
+		}
+	default:
+		issues.add(ValidationInvalidTilt, SeverityError, "Tilt", "invalid TILT value %s", i.Tilt)
+	}
+
+	if i.PhotometricType < 1 || i.PhotometricType > 3 {
+		issues.add(ValidationOutOfRange, SeverityError, "PhotometricType", "%d out of range (1 - 3)", i.PhotometricType)
+	}
+	if i.UnitsType != IESUnitsFeet && i.UnitsType != IESUnitsMeters {
+		issues.add(ValidationOutOfRange, SeverityError, "UnitsType", "%d out of range (1 - 2)", i.UnitsType)
+	}
+	if i.NumberLamps == 0 {
+		issues.add(ValidationOutOfRange, SeverityError, "NumberLamps",
+			"must be positive, or negative for absolute photometry")
+	}
+	if i.LumensPerLamp <= 0 && i.LumensPerLamp != -1 && i.NumberLamps >= 0 {
+		issues.add(ValidationOutOfRange, SeverityError, "LumensPerLamp",
+			"must be positive or -1 for absolute photometry")
+	}
+	if i.CandelaMultiplier <= 0 {
+		issues.add(ValidationOutOfRange, SeverityError, "CandelaMultiplier", "must be positive")
+	}
+	if i.NumberVerticalAngles < 1 || i.NumberHorizontalAngles < 1 {
+		issues.add(ValidationMissingPhotometry, SeverityError, "", "missing vertical or horizontal angles")
+	}
+	if i.PhotometricType == 1 {
+		i.validateAngleRanges(issues)
+	}
+}
+
+// validateAngleRanges checks that the angles of type C photometry cover one of the ranges defined by LM-63:
+// horizontal angles 0 (rotationally symmetric), 0-90, 0-180, 90-270 or 0-360 degrees and vertical angles
+// 0-90, 0-180 or 90-180 degrees.
+func (i *IES) validateAngleRanges(issues *ValidationIssues) {
+	if len(i.HorizontalAngles) > 0 {
+		first := i.HorizontalAngles[0]
+		last := i.HorizontalAngles[len(i.HorizontalAngles)-1]
+		switch {
+		case len(i.HorizontalAngles) == 1 && first == 0:
+		case first == 0 && (last == 90 || last == 180 || last == 360):
+		case first == 90 && last == 270:
+		default:
+			issues.add(ValidationAngleRange, SeverityWarning, "HorizontalAngles",
+				"range %g - %g is not allowed (0, 0 - 90, 0 - 180, 90 - 270 or 0 - 360)", first, last)
+		}
+	}
+	if len(i.VerticalAngles) > 0 {
+		first := i.VerticalAngles[0]
+		last := i.VerticalAngles[len(i.VerticalAngles)-1]
+		if (first != 0 && first != 90) || (last != 90 && last != 180) || first >= last {
+			issues.add(ValidationAngleRange, SeverityWarning, "VerticalAngles",
+				"range %g - %g is not allowed (0 - 90, 0 - 180 or 90 - 180)", first, last)
+		}
+	}
+}
+
+// leadingKeywords are exported first, in the order recommended by LM-63-2002.
+var leadingKeywords = [...]string{"TEST", "TESTLAB", "ISSUEDATE", "MANUFAC"}
+
+// sortedKeywords returns the keywords in a stable order: the leading keywords TEST, TESTLAB, ISSUEDATE and MANUFAC
+// first, then all other keywords alphabetically and the user defined keywords (starting with _) last.
+func (i *IES) sortedKeywords() []string {
+	rank := func(keyword string) int {
+		for r, leading := range leadingKeywords {
+			if keyword == leading {
+				return r
+			}
+		}
+		if strings.HasPrefix(keyword, "_") {
+			return len(leadingKeywords) + 1
+		}
+		return len(leadingKeywords)
+	}
+
+	keywords := make([]string, 0, len(i.Keywords))
+	for keyword := range i.Keywords {
+		keywords = append(keywords, keyword)
+	}
+	sort.Slice(keywords, func(a, b int) bool {
+		rankA, rankB := rank(keywords[a]), rank(keywords[b])
+		if rankA != rankB {
+			return rankA < rankB
+		}
+		return keywords[a] < keywords[b]
+	})
+
+	return keywords
 }
 
 func (i *IES) parseFormatVersion(line string) error {
@@ -779,6 +1556,11 @@ func (i *IES) checkKeywordBlock(keyword string) bool {
 	return true
 }
 
+// parseOptions returns the options of the current parser run.
+func (i *IES) parseOptions() ParseOptions {
+	return ParseOptions{Checks: i.checks, Logger: i.logger}
+}
+
 func (i *IES) fetchValidLineFromFile(scanner *bufio.Scanner) (string, error) {
 	lineLength := i.maxDataLineLength()
 
@@ -786,17 +1568,36 @@ func (i *IES) fetchValidLineFromFile(scanner *bufio.Scanner) (string, error) {
 		if err := scanner.Err(); err != nil {
 			return "", err
 		} else {
-			return "", errors.New("unexpected EOF")
+			return "", errUnexpectedEOF
 		}
 	}
 
-	if len(scanner.Text()) > lineLength && i.strictParsing {
+	if len(scanner.Text()) > lineLength && i.checks.has(CheckLineLength) {
 		return "", errors.New("line exceeds maximum allowed length: " + scanner.Text())
+	} else if lineLength > 0 && len(scanner.Text()) > lineLength {
+		i.parseOptions().logger().Warnf("line exceeds maximum allowed length: %d > %d, %s", len(scanner.Text()),
+			lineLength, scanner.Text())
 	}
 
 	return scanner.Text(), nil
 }
 
+// millimetersToIESUnits converts a dimension in millimeters (as used by EULUMDAT) to the given IES units type.
+func millimetersToIESUnits(value float64, unitsType int) float64 {
+	if unitsType == IESUnitsFeet {
+		return value / 1000 / feetToMeters
+	}
+	return value / 1000
+}
+
+// iesUnitsToMillimeters converts a dimension given in the IES units type to millimeters (as used by EULUMDAT).
+func iesUnitsToMillimeters(value float64, unitsType int) float64 {
+	if unitsType == IESUnitsFeet {
+		return value * feetToMeters * 1000
+	}
+	return value * 1000
+}
+
 func getIntFromLine(line string) (int, error) {
 	cleanLine := strings.TrimSpace(line)
 	// also replace spaces and underscores
@@ -826,22 +1627,24 @@ func convertStringSliceToFloat(input []string) ([]float64, error) {
 }
 
 func getWordListFromInput(scanner *bufio.Scanner, size int, lastScan bool) ([]string, error) {
-	list := make([]string, size)
-	processed := 0
-	for processed < size {
-		wordScanner := bufio.NewScanner(strings.NewReader(strings.TrimSpace(scanner.Text())))
-		wordScanner.Split(bufio.ScanWords)
-		for wordScanner.Scan() {
-			list[processed] = strings.TrimSpace(wordScanner.Text())
-			processed++
+	if size < 0 {
+		return nil, fmt.Errorf("invalid number of values %d", size)
+	}
+
+	list := make([]string, 0, initialCapacity(size))
+	for len(list) < size {
+		words := strings.Fields(scanner.Text())
+		if len(list)+len(words) > size {
+			return nil, fmt.Errorf("expected %d values, found more", size)
 		}
+		list = append(list, words...)
 
-		if processed < size || !lastScan {
+		if len(list) < size || !lastScan {
 			if !scanner.Scan() {
 				if err := scanner.Err(); err != nil {
 					return nil, err
 				} else {
-					return nil, errors.New("unexpected EOF")
+					return list, errUnexpectedEOF
 				}
 			}
 		}
@@ -850,16 +1653,17 @@ func getWordListFromInput(scanner *bufio.Scanner, size int, lastScan bool) ([]st
 	return list, nil
 }
 
-func convertFloatSliceToStringSlice(lineLength int, input []float64) []string {
+func convertFloatSliceToStringSlice(lineLength int, opts ExportOptions, input []float64) []string {
 	var lines []string
 
 	currentLine := ""
 	sep := ""
 	for _, flt := range input {
-		fltStr := strconv.FormatFloat(flt, 'f', 2, 64)
-		if len(currentLine)+len(fltStr)+1 > lineLength {
+		fltStr := opts.formatFloat(flt, 2)
+		if currentLine != "" && len(currentLine)+len(fltStr)+1 > lineLength {
 			lines = append(lines, currentLine)
 			currentLine = ""
+			sep = ""
 		}
 		currentLine += sep + fltStr
 		sep = " "
@@ -869,16 +1673,20 @@ func convertFloatSliceToStringSlice(lineLength int, input []float64) []string {
 	return lines
 }
 
-func convertValuesToStringSlice(lineLength int, input ...interface{}) []string {
+func convertValuesToStringSlice(lineLength int, opts ExportOptions, input ...interface{}) []string {
 	var lines []string
 
 	currentLine := ""
 	sep := ""
 	for _, val := range input {
 		valStr := fmt.Sprint(val)
-		if len(currentLine)+len(valStr)+1 > lineLength {
+		if flt, ok := val.(float64); ok {
+			valStr = opts.formatFloat(flt, -1)
+		}
+		if currentLine != "" && len(currentLine)+len(valStr)+1 > lineLength {
 			lines = append(lines, currentLine)
 			currentLine = ""
+			sep = ""
 		}
 		currentLine += sep + valStr
 		sep = " "
@@ -0,0 +1,403 @@
+package eulumies
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIES_Clone(t *testing.T) {
+	ies, err := NewIES("test/sample.ies", false)
+	assert.NoError(t, err)
+	assert.NoError(t, ies.SetTilt(TiltData{LampToLuminaireGeometry: 1, Angles: []float64{0, 90}, Factors: []float64{1, 0.9}}))
+
+	clone := ies.Clone()
+	assert.Equal(t, ies, clone)
+
+	clone.Keywords["LAMP"] = "changed"
+	clone.TiltAngles[1] = 45
+	clone.TiltMultiplierFactors[1] = 0.5
+	clone.VerticalAngles[0] = -1
+	clone.HorizontalAngles[0] = -1
+	clone.CandelaValues[0][0] = -1
+	assert.Equal(t, "LED", ies.Keywords["LAMP"])
+	assert.Equal(t, 90.0, ies.TiltAngles[1])
+	assert.Equal(t, 0.9, ies.TiltMultiplierFactors[1])
+	assert.Equal(t, 0.0, ies.VerticalAngles[0])
+	assert.Equal(t, 0.0, ies.HorizontalAngles[0])
+	assert.NotEqual(t, -1.0, ies.CandelaValues[0][0])
+}
+
+func TestNewIESFromReader_MalformedCounts(t *testing.T) {
+	header := "IESNA:LM-63-2002\n[TEST] 1\n[TESTLAB] lab\n[ISSUEDATE] 2020\n[MANUFAC] company\nTILT=NONE\n"
+
+	_, err := NewIESFromReader(strings.NewReader(header+"1 1000 1 -3 1 1 2 0 0 0\n1 1 10\n"), false)
+	assert.EqualError(t, err, "number of vertical angles -3 out of range (0 - 100000)")
+
+	_, err = NewIESFromReader(strings.NewReader(header+"1 1000 1 3 1 1 2 0 0 0 7\n1 1 10\n"), false)
+	assert.EqualError(t, err, "expected 10 values, found more")
+
+	_, err = NewIESFromReader(strings.NewReader(header+"1 1000 1 99999 99999 1 2 0 0 0\n1 1 10\n0 90\n"), false)
+	assert.EqualError(t, err, "unexpected EOF")
+
+	tilt := strings.Replace(header, "TILT=NONE", "TILT=INCLUDE\n1\n-2", 1)
+	_, err = NewIESFromReader(strings.NewReader(tilt+"0 90\n1 1\n"), false)
+	assert.Error(t, err)
+}
+
+func TestIES_ConvertUnits(t *testing.T) {
+	ies := IES{UnitsType: IESUnitsMeters, LuminaireWidth: 0.3048, LuminaireLength: 0.6096, LuminaireHeight: 0}
+
+	assert.NoError(t, ies.ConvertUnits(IESUnitsFeet))
+	assert.Equal(t, IESUnitsFeet, ies.UnitsType)
+	assert.InDelta(t, 1.0, ies.LuminaireWidth, 1e-9)
+	assert.InDelta(t, 2.0, ies.LuminaireLength, 1e-9)
+	assert.Equal(t, 0.0, ies.LuminaireHeight)
+
+	assert.NoError(t, ies.ConvertUnits(IESUnitsMeters))
+	assert.Equal(t, IESUnitsMeters, ies.UnitsType)
+	assert.InDelta(t, 0.3048, ies.LuminaireWidth, 1e-9)
+
+	assert.Error(t, ies.ConvertUnits(3))
+}
+
+func Test_millimetersToIESUnits(t *testing.T) {
+	assert.Equal(t, 1.5, millimetersToIESUnits(1500, IESUnitsMeters))
+	assert.InDelta(t, 1.0, millimetersToIESUnits(304.8, IESUnitsFeet), 1e-9)
+	assert.InDelta(t, 304.8, iesUnitsToMillimeters(1, IESUnitsFeet), 1e-9)
+	assert.Equal(t, 1500.0, iesUnitsToMillimeters(1.5, IESUnitsMeters))
+}
+
+func TestIES_ComputeTotalFlux(t *testing.T) {
+	ies := IES{
+		CandelaMultiplier:      1,
+		NumberVerticalAngles:   181,
+		NumberHorizontalAngles: 1,
+		HorizontalAngles:       []float64{0},
+		VerticalAngles:         make([]float64, 181),
+		CandelaValues:          [][]float64{make([]float64, 181)},
+	}
+	for v := range ies.VerticalAngles {
+		ies.VerticalAngles[v] = float64(v)
+		ies.CandelaValues[0][v] = 1
+	}
+	assert.InDelta(t, 4*math.Pi, ies.ComputeTotalFlux(), 1e-9)
+
+	ies.HorizontalAngles = []float64{0, 90}
+	ies.NumberHorizontalAngles = 2
+	ies.CandelaValues = append(ies.CandelaValues, ies.CandelaValues[0])
+	assert.InDelta(t, 4*math.Pi, ies.ComputeTotalFlux(), 1e-9)
+
+	sample, err := NewIES("test/sample.ies", false)
+	assert.NoError(t, err)
+	assert.InDelta(t, 1016*0.884, sample.ComputeTotalFlux(), 1)
+}
+
+func TestIES_Efficacy(t *testing.T) {
+	sample, err := NewIES("test/sample.ies", false)
+	assert.NoError(t, err)
+	efficacy, err := sample.Efficacy()
+	assert.NoError(t, err)
+	assert.InDelta(t, 1016/9.6, efficacy, 1e-9)
+
+	absolute, err := NewIES("test/ADL110.XTM5M.9540.61 - S1.ies", false)
+	assert.NoError(t, err)
+	efficacy, err = absolute.Efficacy()
+	assert.NoError(t, err)
+	assert.InDelta(t, absolute.ComputeTotalFlux()/11.5, efficacy, 1e-9)
+
+	absolute.InputWatts = 0
+	_, err = absolute.Efficacy()
+	assert.Error(t, err)
+}
+
+func TestIES_NegativeNumberLamps(t *testing.T) {
+	ies, err := NewIES("test/sample.ies", false)
+	assert.NoError(t, err)
+	assert.False(t, ies.IsAbsolutePhotometry())
+	ies.NumberLamps = -1
+
+	assert.True(t, ies.IsAbsolutePhotometry())
+	efficacy, err := ies.Efficacy()
+	assert.NoError(t, err)
+	assert.InDelta(t, ies.ComputeTotalFlux()/ies.InputWatts, efficacy, 1e-9)
+	assert.Equal(t, ies.ComputeUpwardLightRatio(), ies.ComputeUpwardLightOutputRatio())
+	for _, issue := range ies.Validate(true) {
+		assert.NotEqual(t, "NumberLamps", issue.Field)
+	}
+
+	var buffer bytes.Buffer
+	assert.NoError(t, ies.ExportTo(&buffer, ExportOptions{}))
+	exported, err := NewIESFromReader(&buffer, false)
+	assert.NoError(t, err)
+	assert.Equal(t, -1, exported.NumberLamps)
+
+	eulumdat, err := ConvertIESToEulumdat(ies, ConversionOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{-1}, eulumdat.NumberLamps)
+	assert.InDelta(t, ies.ComputeTotalFlux(), eulumdat.TotalLuminousFluxLamps[0], 0.1)
+
+	ies.NumberLamps = 0
+	assert.False(t, ies.Validate(true).Valid())
+}
+
+func TestIES_GetMaximumLuminousIntensity(t *testing.T) {
+	ies := IES{
+		CandelaMultiplier: 2,
+		HorizontalAngles:  []float64{0, 90, 180},
+		VerticalAngles:    []float64{0, 45, 90},
+		CandelaValues:     [][]float64{{100, 80, 10}, {100, 120, 5}, {100, 60, 0}},
+	}
+
+	assert.Equal(t, 200.0, ies.GetMaximumLuminousIntensity(0))
+	assert.Equal(t, 240.0, ies.GetMaximumLuminousIntensity(1))
+	assert.Equal(t, -1.0, ies.GetMaximumLuminousIntensity(3))
+	assert.Equal(t, 240.0, ies.GetOverallMaximumLuminousIntensity())
+	assert.Equal(t, 2, ies.GetHorizontalPlaneIndex(180))
+	assert.Equal(t, -1, ies.GetHorizontalPlaneIndex(45))
+
+	intensity, horizontal, vertical := ies.GetPeakIntensity()
+	assert.Equal(t, []float64{240, 90, 45}, []float64{intensity, horizontal, vertical})
+}
+
+func TestIES_MaxIntensityDirection(t *testing.T) {
+	ies := IES{
+		PhotometricType:  1,
+		HorizontalAngles: []float64{0, 90, 180},
+		VerticalAngles:   []float64{0, 45, 90},
+		CandelaValues:    [][]float64{{100, 80, 10}, {100, 120, 5}, {100, 60, 0}},
+	}
+	direction, c, gamma := ies.MaxIntensityDirection()
+	assert.Equal(t, 90.0, c)
+	assert.Equal(t, 45.0, gamma)
+	assertDirection(t, CGammaToDirection(90, 45), direction)
+
+	// type B, the horizontal angles 0 - 20 are mirrored to -20 - 0
+	ies.PhotometricType = 2
+	ies.HorizontalAngles = []float64{0, 10, 20}
+	ies.VerticalAngles = []float64{-10, 0, 10}
+	ies.CandelaValues = [][]float64{{10, 50, 20}, {20, 60, 30}, {30, 40, 90}}
+	direction, c, gamma = ies.MaxIntensityDirection()
+	assertDirection(t, BBetaToDirection(-20, 10), direction)
+	expectedC, expectedGamma := BBetaToCGamma(-20, 10)
+	assert.InDelta(t, expectedC, c, 1e-9)
+	assert.InDelta(t, expectedGamma, gamma, 1e-9)
+
+	ies.PhotometricType = 3
+	direction, _, _ = ies.MaxIntensityDirection()
+	assertDirection(t, AAlphaToDirection(-20, 10), direction)
+
+	direction, _, _ = (&IES{}).MaxIntensityDirection()
+	assert.Equal(t, Direction{}, direction)
+}
+
+func TestIES_ComputeZonalLumens(t *testing.T) {
+	ies, err := NewIES("test/sample.ies", false)
+	assert.NoError(t, err)
+	total := ies.ComputeTotalFlux()
+	assert.InDelta(t, total, ies.ComputeZonalLumens(0, 90)+ies.ComputeZonalLumens(90, 180), 1e-9)
+	assert.InDelta(t, 1-ies.ComputeUpwardLightRatio(), ies.ComputeDownwardFluxFraction(), 1e-9)
+
+	ies.BallastFactor = 0.5
+	assert.InDelta(t, total/2, ies.ComputeTotalFlux(), 1e-9)
+
+	// isotropic 100 cd source as type B photometry: 4 pi 100 lm over the full sphere, 2 pi 100 lm in front
+	typeB := IES{
+		PhotometricType:   2,
+		CandelaMultiplier: 1,
+		HorizontalAngles:  []float64{-90, -45, 0, 45, 90},
+		VerticalAngles:    []float64{-90, -45, 0, 45, 90},
+	}
+	for range typeB.HorizontalAngles {
+		typeB.CandelaValues = append(typeB.CandelaValues, []float64{100, 100, 100, 100, 100})
+	}
+	assert.InDelta(t, 2*math.Pi*100, typeB.ComputeTotalFlux(), 1e-9)
+	assert.InDelta(t, math.Pi*100, typeB.ComputeZonalLumens(0, 90), 1e-9)
+	assert.Equal(t, -1.0, typeB.ComputeDownwardFluxFraction())
+
+	typeB.HorizontalAngles = []float64{0, 45, 90}
+	typeB.CandelaValues = typeB.CandelaValues[:3]
+	assert.InDelta(t, 2*math.Pi*100, typeB.ComputeTotalFlux(), 1e-9)
+}
+
+func TestIES_GetFwhm(t *testing.T) {
+	eulumdat := loadSample2(t)
+	ies, err := ConvertEulumdatToIES(&eulumdat, ConversionOptions{})
+	assert.NoError(t, err)
+
+	planeIndex := ies.GetHorizontalPlaneIndex(90)
+	assert.InDelta(t, BeamAngle(eulumdat, 90), ies.GetFwhm(planeIndex), 1e-6)
+	assert.True(t, ies.GetFwtm(planeIndex) > ies.GetFwhm(planeIndex))
+	assert.Equal(t, -1.0, ies.GetFwhm(-1))
+
+	// type B: the crossings at 50 cd lie between the samples at -20/-10 and 10/20 degrees
+	typeB := IES{
+		PhotometricType:   2,
+		CandelaMultiplier: 1,
+		HorizontalAngles:  []float64{0},
+		VerticalAngles:    []float64{-20, -10, 0, 10, 20},
+		CandelaValues:     [][]float64{{0, 80, 100, 80, 0}},
+	}
+	assert.InDelta(t, 2*(10+10*30.0/80), typeB.GetFwhm(0), 1e-9)
+}
+
+func TestIES_GetBeamDivergence(t *testing.T) {
+	// type C with the peak at 20 degrees in the C0 plane
+	ies := IES{
+		PhotometricType:   1,
+		CandelaMultiplier: 1,
+		HorizontalAngles:  []float64{0, 90, 180, 270, 360},
+		VerticalAngles:    []float64{0, 10, 20, 30, 40},
+		CandelaValues: [][]float64{
+			{60, 80, 100, 40, 0},
+			{60, 40, 20, 0, 0},
+			{60, 40, 20, 0, 0},
+			{60, 40, 20, 0, 0},
+			{60, 80, 100, 40, 0},
+		},
+	}
+	divergence, err := ies.GetBeamDivergence(0, 0.5)
+	assert.NoError(t, err)
+	assert.Equal(t, 20.0, divergence.Peak)
+	assert.InDelta(t, -5, divergence.Lower, 1e-9)
+	assert.InDelta(t, 20+50.0/6, divergence.Upper, 1e-9)
+
+	typeB := IES{
+		PhotometricType:   2,
+		CandelaMultiplier: 1,
+		HorizontalAngles:  []float64{0},
+		VerticalAngles:    []float64{-20, -10, 0, 10, 20},
+		CandelaValues:     [][]float64{{0, 80, 100, 80, 0}},
+	}
+	divergence, err = typeB.GetBeamDivergence(0, 0.5)
+	assert.NoError(t, err)
+	assert.InDelta(t, typeB.GetFwhm(0), divergence.Width, 1e-9)
+	_, err = typeB.GetBeamDivergence(45, 0.5)
+	assert.Error(t, err)
+}
+
+func TestIES_ComputeUpwardLightRatio(t *testing.T) {
+	ies := IES{
+		CandelaMultiplier: 1,
+		LumensPerLamp:     -1,
+		NumberLamps:       1,
+		HorizontalAngles:  []float64{0},
+		VerticalAngles:    []float64{0, 45, 90, 135, 180},
+		CandelaValues:     [][]float64{{100, 100, 100, 100, 100}},
+	}
+	assert.InDelta(t, 0.5, ies.ComputeUpwardLightRatio(), 1e-9)
+	assert.InDelta(t, 0.5, ies.ComputeUpwardLightOutputRatio(), 1e-9)
+	assert.False(t, ies.IsDarkSkyCompliant())
+
+	ies.CandelaValues = [][]float64{{100, 100, 0, 0, 0}}
+	assert.Equal(t, 0.0, ies.ComputeUpwardLightRatio())
+	assert.True(t, ies.IsDarkSkyCompliant())
+}
+
+func TestIES_sortedKeywords(t *testing.T) {
+	ies := &IES{Keywords: map[string]string{
+		"_INTERNAL": "1",
+		"OTHER":     "x",
+		"MANUFAC":   "m",
+		"LUMCAT":    "c",
+		"TEST":      "t",
+		"_A":        "a",
+		"ISSUEDATE": "d",
+		"TESTLAB":   "l",
+	}}
+
+	expected := []string{"TEST", "TESTLAB", "ISSUEDATE", "MANUFAC", "LUMCAT", "OTHER", "_A", "_INTERNAL"}
+	for n := 0; n < 10; n++ {
+		assert.Equal(t, expected, ies.sortedKeywords())
+	}
+}
+
+func TestIES_Validate_Strict(t *testing.T) {
+	for _, path := range []string{"test/sample.ies", "test/ADL110.XTM5M.9540.61 - S1.ies"} {
+		ies, err := NewIES(path, false)
+		assert.NoError(t, err)
+		assert.NoError(t, ies.Validate(true).Err(), path)
+	}
+
+	ies, err := NewIES("test/sample.ies", false)
+	assert.NoError(t, err)
+	ies.Keywords["ISSUEDATE"] = "not allowed in LM-63-1995"
+	ies.PhotometricType = 4
+	ies.UnitsType = 3
+	ies.Tilt = IESTiltInclude
+	ies.TiltLampToLuminaireGeometry = 1
+	ies.TiltAnglesAndFactors = 2
+	ies.TiltAngles = []float64{0}
+	ies.TiltMultiplierFactors = []float64{1}
+
+	issues := ies.Validate(true)
+	assert.Len(t, issues, 4)
+	assert.Equal(t, ValidationKeywordNotAllowed, issues[0].Code)
+	assert.Contains(t, issues[0].Message, "ISSUEDATE")
+	assert.Equal(t, "TiltAngles", issues[1].Field)
+	assert.Equal(t, "PhotometricType", issues[2].Field)
+	assert.Equal(t, "UnitsType", issues[3].Field)
+	assert.True(t, ies.Validate(false).Valid())
+
+	ies.NumberVerticalAngles++
+	issues = ies.Validate(false)
+	assert.Len(t, issues, 1+len(ies.CandelaValues))
+	assert.Equal(t, "error: VerticalAngles: 181 angles, expected 182", issues[0].String())
+}
+
+func TestIES_ValidateChecks(t *testing.T) {
+	ies, err := NewIES("test/sample.ies", false)
+	assert.NoError(t, err)
+	ies.Format = IESFormatLM_63_2002
+	ies.Keywords = map[string]string{"TEST": "1", "TESTLAB": "Lab", "MANUFAC": "Company", "_EXCEEDS_18_CHARACTERS": "x"}
+	ies.PhotometricType = 4
+	ies.VerticalAngles[2] = ies.VerticalAngles[1]
+
+	issues := ies.ValidateChecks(CheckKeywordLegality)
+	assert.Len(t, issues, 1)
+	assert.Equal(t, ValidationFieldTooLong, issues[0].Code)
+
+	issues = ies.ValidateChecks(CheckRequiredKeywords | CheckAngleMonotonicity)
+	assert.Len(t, issues, 2)
+	assert.Equal(t, ValidationAngleOrder, issues[0].Code)
+	assert.Equal(t, "VerticalAngles", issues[0].Field)
+	assert.Equal(t, ValidationMissingKeyword, issues[1].Code)
+
+	assert.Len(t, ies.ValidateChecks(CheckValueRanges), 1)
+	assert.Len(t, ies.ValidateChecks(StrictAll), 4)
+	assert.Equal(t, ies.ValidateChecks(StrictDefault), ies.Validate(true))
+	assert.Empty(t, ies.ValidateChecks(0))
+}
+
+func TestIES_Validate_AngleRanges(t *testing.T) {
+	ies := &IES{
+		Format:            IESFormatLM_63_1995,
+		PhotometricType:   1,
+		UnitsType:         IESUnitsMeters,
+		NumberLamps:       1,
+		LumensPerLamp:     1000,
+		CandelaMultiplier: 1,
+		Tilt:              IESTiltNone,
+		HorizontalAngles:  []float64{0, 45},
+		VerticalAngles:    []float64{10, 180},
+		CandelaValues:     [][]float64{{1, 2}, {3, 4}},
+	}
+	ies.NumberHorizontalAngles = len(ies.HorizontalAngles)
+	ies.NumberVerticalAngles = len(ies.VerticalAngles)
+
+	issues := ies.Validate(true).Warnings()
+	assert.Len(t, issues, 2)
+	assert.Equal(t, ValidationAngleRange, issues[0].Code)
+	assert.Equal(t, "HorizontalAngles", issues[0].Field)
+	assert.Equal(t, "warning: VerticalAngles: range 10 - 180 is not allowed (0 - 90, 0 - 180 or 90 - 180)",
+		issues[1].String())
+
+	ies.VerticalAngles[0] = 90
+	for _, angles := range [][]float64{{0, 90}, {0, 180}, {90, 270}, {0, 360}} {
+		ies.HorizontalAngles = angles
+		assert.Empty(t, ies.Validate(true).Warnings(), angles)
+	}
+}
@@ -0,0 +1,338 @@
+package eulumies
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// nearFieldKeyword holds the near field photometry values, there is no EULUMDAT field for it. Conversions record it
+// in the extension block of the EULUMDAT file.
+const nearFieldKeyword = "NEARFIELD"
+
+// keywordNameRegex matches the keyword names accepted by SetKeyword, user defined keywords start with an underscore.
+var keywordNameRegex = regexp.MustCompile(`^_?[A-Z0-9][A-Z0-9_]*$`)
+
+// KeywordOverflow defines how SetKeyword handles values that do not fit on one keyword line of the format.
+type KeywordOverflow int
+
+const (
+	KeywordOverflowError    KeywordOverflow = iota // Reject the value.
+	KeywordOverflowTruncate                        // Cut the value at the end of the first line.
+	KeywordOverflowSplit                           // Wrap the value at word boundaries, it is exported as MORE lines.
+)
+
+// iesDateLayouts are the date formats accepted for ISSUEDATE, TESTDATE and DATE. The first layout is used when
+// setting a date.
+var iesDateLayouts = [...]string{
+	"2006-01-02",
+	"02-Jan-2006",
+	"2-Jan-2006",
+	"02 Jan 2006",
+	"2 Jan 2006",
+	"Jan 2, 2006",
+	"January 2, 2006",
+	"01/02/2006",
+	"1/2/2006",
+	"02.01.2006",
+	"2.1.2006",
+}
+
+// IssueDate parses the ISSUEDATE keyword, or DATE for files older than LM-63-2002.
+func (i *IES) IssueDate() (time.Time, error) {
+	keyword := "ISSUEDATE"
+	if _, ok := i.Keywords[keyword]; !ok && i.Format != IESFormatLM_63_2002 {
+		keyword = "DATE"
+	}
+
+	return i.dateKeyword(keyword)
+}
+
+// SetIssueDate sets the ISSUEDATE keyword, or DATE for files older than LM-63-2002.
+func (i *IES) SetIssueDate(date time.Time) {
+	if i.Format == IESFormatLM_63_1991 || i.Format == IESFormatLM_63_1995 {
+		i.setKeyword("DATE", date.Format(iesDateLayouts[0]))
+	} else {
+		i.setKeyword("ISSUEDATE", date.Format(iesDateLayouts[0]))
+	}
+}
+
+// TestDate parses the TESTDATE keyword.
+func (i *IES) TestDate() (time.Time, error) {
+	return i.dateKeyword("TESTDATE")
+}
+
+// SetTestDate sets the TESTDATE keyword.
+func (i *IES) SetTestDate(date time.Time) {
+	i.setKeyword("TESTDATE", date.Format(iesDateLayouts[0]))
+}
+
+// LampPosition parses the LAMPPOSITION keyword, the horizontal and vertical angle of the lamp position (degrees).
+func (i *IES) LampPosition() (horizontal, vertical float64, err error) {
+	values, err := i.numericKeyword("LAMPPOSITION", 2)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return values[0], values[1], nil
+}
+
+// SetLampPosition sets the LAMPPOSITION keyword.
+func (i *IES) SetLampPosition(horizontal, vertical float64) {
+	i.setNumericKeyword("LAMPPOSITION", horizontal, vertical)
+}
+
+// NearField parses the NEARFIELD keyword, the three near field photometry values D1, D2 and D3.
+func (i *IES) NearField() (d1, d2, d3 float64, err error) {
+	values, err := i.numericKeyword(nearFieldKeyword, 3)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	return values[0], values[1], values[2], nil
+}
+
+// SetNearField sets the NEARFIELD keyword.
+func (i *IES) SetNearField(d1, d2, d3 float64) {
+	i.setNumericKeyword(nearFieldKeyword, d1, d2, d3)
+}
+
+// FlashArea parses the FLASHAREA keyword, the luminous area used for flashed area luminance (m²).
+func (i *IES) FlashArea() (float64, error) {
+	values, err := i.numericKeyword("FLASHAREA", 1)
+	if err != nil {
+		return 0, err
+	}
+
+	return values[0], nil
+}
+
+// SetFlashArea sets the FLASHAREA keyword.
+func (i *IES) SetFlashArea(area float64) {
+	i.setNumericKeyword("FLASHAREA", area)
+}
+
+func (i *IES) dateKeyword(keyword string) (time.Time, error) {
+	value, ok := i.Keywords[keyword]
+	if !ok {
+		return time.Time{}, fmt.Errorf("keyword %s not set", keyword)
+	}
+
+	value = strings.TrimSpace(value)
+	for _, layout := range iesDateLayouts {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date %q in keyword %s", value, keyword)
+}
+
+// numericKeyword parses count numbers separated by whitespace or commas.
+func (i *IES) numericKeyword(keyword string, count int) ([]float64, error) {
+	value, ok := i.Keywords[keyword]
+	if !ok {
+		return nil, fmt.Errorf("keyword %s not set", keyword)
+	}
+
+	return parseKeywordNumbers(keyword, value, count)
+}
+
+func parseKeywordNumbers(keyword, value string, count int) ([]float64, error) {
+	fields := strings.FieldsFunc(value, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t'
+	})
+	if len(fields) != count {
+		return nil, fmt.Errorf("keyword %s requires %d values, got %q", keyword, count, value)
+	}
+
+	values := make([]float64, count)
+	for n, field := range fields {
+		number, err := strconv.ParseFloat(field, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q in keyword %s", field, keyword)
+		}
+		values[n] = number
+	}
+
+	return values, nil
+}
+
+// validateNearField adds an issue if the NEARFIELD value does not consist of the three values D1, D2 and D3.
+func validateNearField(issues *ValidationIssues, field, value string) {
+	if _, err := parseKeywordNumbers(nearFieldKeyword, value, 3); err != nil {
+		issues.add(ValidationInvalidKeyword, SeverityError, field, "%v", err)
+	}
+}
+
+func (i *IES) setNumericKeyword(keyword string, values ...float64) {
+	fields := make([]string, len(values))
+	for n, value := range values {
+		fields[n] = strconv.FormatFloat(value, 'f', -1, 64)
+	}
+	i.setKeyword(keyword, strings.Join(fields, " "))
+}
+
+func (i *IES) setKeyword(keyword, value string) {
+	if i.Keywords == nil {
+		i.Keywords = make(map[string]string)
+	}
+	i.Keywords[keyword] = value
+}
+
+// SetKeyword sets the keyword after checking it against the format. The keyword name is converted to upper case,
+// control characters are removed from the value and values that do not fit on one line are handled according to the
+// overflow policy. The returned issues describe all changes, if they contain errors the keyword is not set.
+func (i *IES) SetKeyword(keyword, value string, overflow KeywordOverflow) ValidationIssues {
+	var issues ValidationIssues
+	field := "Keywords[" + keyword + "]"
+
+	name := strings.ToUpper(strings.TrimSpace(strings.Trim(strings.TrimSpace(keyword), "[]")))
+	if name != keyword {
+		issues.add(ValidationInvalidKeyword, SeverityInfo, field, "keyword name changed to %s", name)
+		field = "Keywords[" + name + "]"
+	}
+	switch {
+	case !keywordNameRegex.MatchString(name):
+		issues.add(ValidationInvalidKeyword, SeverityError, field, "invalid keyword name %q", keyword)
+	case len(name) > 18:
+		issues.add(ValidationFieldTooLong, SeverityError, field, "keyword %s exceeds 18 characters", name)
+	case !i.isKeywordAllowed(name) || name == "MORE" || i.Format == IESFormatLM_63_1986:
+		issues.add(ValidationKeywordNotAllowed, SeverityError, field, "keyword %s not allowed in format %s", name,
+			i.Format)
+	}
+	if !issues.Valid() {
+		return issues
+	}
+
+	lines, removed := sanitizeKeywordLines(value)
+	if removed {
+		issues.add(ValidationInvalidKeyword, SeverityWarning, field, "control characters removed")
+	}
+	if !isASCII(value) {
+		issues.add(ValidationNonASCII, SeverityWarning, field, "value contains non-ASCII characters")
+	}
+
+	first, more := i.keywordLineLengths(name)
+	fits := first <= 0 || (len(lines) == 1 && len(lines[0]) <= first)
+	switch {
+	case fits:
+	case overflow == KeywordOverflowTruncate:
+		line := strings.Join(lines, " ")
+		if len(line) > first {
+			line = strings.TrimSpace(line[:runeBoundary(line, first)])
+		}
+		lines = []string{line}
+		issues.add(ValidationFieldTooLong, SeverityWarning, field, "value truncated to %d characters", first)
+	case overflow == KeywordOverflowSplit:
+		lines = wrapKeywordLines(lines, first, more)
+		issues.add(ValidationFieldTooLong, SeverityInfo, field, "value split into %d lines", len(lines))
+	default:
+		issues.add(ValidationFieldTooLong, SeverityError, field, "value exceeds the line length of %d characters",
+			first)
+		return issues
+	}
+
+	i.setKeyword(name, strings.Join(lines, "\n"))
+
+	return issues
+}
+
+// keywordLineLengths returns the maximum length of the value on the keyword line and on continuation lines. Both are
+// 0 if the format defines no limit.
+func (i *IES) keywordLineLengths(keyword string) (first, more int) {
+	lineLength := i.maxKeywordLineLength()
+	if lineLength == 0 {
+		return 0, 0
+	}
+	if i.Format == IESFormatLM_63_2002 {
+		more = lineLength - 7 // [MORE] and space
+	} else {
+		more = lineLength - 1 // space in front
+	}
+
+	return lineLength - len(keyword) - 3, more // -3: [ ] and space
+}
+
+// sanitizeKeywordLines splits the value into trimmed lines and removes control characters. Reports whether any
+// character was removed, tabs are replaced by spaces.
+func sanitizeKeywordLines(value string) ([]string, bool) {
+	removed := false
+	lines := strings.Split(strings.ReplaceAll(value, "\r\n", "\n"), "\n")
+	for n, line := range lines {
+		lines[n] = strings.TrimSpace(strings.Map(func(r rune) rune {
+			switch {
+			case r == '\t':
+				return ' '
+			case unicode.IsControl(r):
+				removed = true
+				return -1
+			}
+			return r
+		}, line))
+	}
+
+	return lines, removed
+}
+
+// wrapKeywordLines wraps the lines at word boundaries, the first line holds up to first characters, the others up
+// to more characters. Words longer than a line are split.
+func wrapKeywordLines(lines []string, first, more int) []string {
+	var wrapped []string
+	width := func() int {
+		if len(wrapped) == 0 {
+			return first
+		}
+		return more
+	}
+
+	for _, line := range lines {
+		current := ""
+		for _, word := range strings.Fields(line) {
+			for current == "" && len(word) > width() {
+				cut := runeBoundary(word, width())
+				wrapped = append(wrapped, word[:cut])
+				word = word[cut:]
+			}
+			switch {
+			case current == "":
+				current = word
+			case len(current)+1+len(word) <= width():
+				current += " " + word
+			default:
+				wrapped = append(wrapped, current)
+				for len(word) > width() {
+					cut := runeBoundary(word, width())
+					wrapped = append(wrapped, word[:cut])
+					word = word[cut:]
+				}
+				current = word
+			}
+		}
+		wrapped = append(wrapped, current)
+	}
+
+	return wrapped
+}
+
+// runeBoundary returns the largest index not exceeding n at which the text can be cut without splitting a character.
+// At least one character is kept.
+func runeBoundary(text string, n int) int {
+	if n >= len(text) {
+		return len(text)
+	}
+	cut := n
+	for cut > 0 && !utf8.RuneStart(text[cut]) {
+		cut--
+	}
+	if cut == 0 {
+		_, size := utf8.DecodeRuneInString(text)
+		return size
+	}
+
+	return cut
+}
@@ -0,0 +1,103 @@
+package eulumies
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIES_IssueDate(t *testing.T) {
+	ies := IES{Format: IESFormatLM_63_2002, Keywords: map[string]string{"ISSUEDATE": "15-Mar-2021"}}
+	date, err := ies.IssueDate()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2021, 3, 15, 0, 0, 0, 0, time.UTC), date)
+
+	ies.SetIssueDate(time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, "2022-01-02", ies.Keywords["ISSUEDATE"])
+
+	ies.Keywords["ISSUEDATE"] = "unknown"
+	_, err = ies.IssueDate()
+	assert.Error(t, err)
+
+	old := IES{Format: IESFormatLM_63_1995, Keywords: map[string]string{"DATE": "03/15/2021"}}
+	date, err = old.IssueDate()
+	assert.NoError(t, err)
+	assert.Equal(t, 15, date.Day())
+	old.SetIssueDate(date)
+	assert.Equal(t, "2021-03-15", old.Keywords["DATE"])
+	assert.NotContains(t, old.Keywords, "ISSUEDATE")
+
+	_, err = (&IES{}).TestDate()
+	assert.Error(t, err)
+}
+
+func TestIES_NumericKeywords(t *testing.T) {
+	ies := IES{Keywords: map[string]string{"LAMPPOSITION": "0, 90", "NEARFIELD": "1 2.5 3", "FLASHAREA": "x"}}
+
+	horizontal, vertical, err := ies.LampPosition()
+	assert.NoError(t, err)
+	assert.Equal(t, 0.0, horizontal)
+	assert.Equal(t, 90.0, vertical)
+
+	d1, d2, d3, err := ies.NearField()
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{1, 2.5, 3}, []float64{d1, d2, d3})
+
+	_, err = ies.FlashArea()
+	assert.Error(t, err)
+	ies.SetFlashArea(0.25)
+	area, err := ies.FlashArea()
+	assert.NoError(t, err)
+	assert.Equal(t, 0.25, area)
+
+	ies.SetLampPosition(45, 180)
+	assert.Equal(t, "45 180", ies.Keywords["LAMPPOSITION"])
+	ies.Keywords["NEARFIELD"] = "1 2"
+	_, _, _, err = ies.NearField()
+	assert.Error(t, err)
+}
+
+func TestIES_SetKeyword(t *testing.T) {
+	ies := IES{Format: IESFormatLM_63_1995}
+
+	issues := ies.SetKeyword("[lumcat]", "ABC\t123\x00", KeywordOverflowError)
+	assert.True(t, issues.Valid())
+	assert.Equal(t, "ABC 123", ies.Keywords["LUMCAT"])
+	assert.Len(t, issues, 2)
+
+	assert.False(t, ies.SetKeyword("ISSUEDATE", "2021-01-01", KeywordOverflowError).Valid()) // LM-63-2002 only
+	assert.False(t, ies.SetKeyword("MORE", "text", KeywordOverflowError).Valid())
+	assert.False(t, ies.SetKeyword("LUM CAT", "text", KeywordOverflowError).Valid())
+	assert.False(t, ies.SetKeyword("_A_VERY_LONG_KEYWORD", "text", KeywordOverflowError).Valid())
+	assert.NotContains(t, ies.Keywords, "ISSUEDATE")
+
+	// 80 characters per line: [LUMINAIRE] leaves 68 characters for the value
+	long := strings.Repeat("word ", 30)
+	issues = ies.SetKeyword("LUMINAIRE", long, KeywordOverflowError)
+	assert.Equal(t, ValidationFieldTooLong, issues.Errors()[0].Code)
+	assert.NotContains(t, ies.Keywords, "LUMINAIRE")
+
+	assert.True(t, ies.SetKeyword("LUMINAIRE", long, KeywordOverflowTruncate).Valid())
+	assert.Equal(t, strings.TrimSpace(long[:68]), ies.Keywords["LUMINAIRE"])
+
+	assert.True(t, ies.SetKeyword("LUMINAIRE", long+"\n"+strings.Repeat("x", 100), KeywordOverflowSplit).Valid())
+	lines := strings.Split(ies.Keywords["LUMINAIRE"], "\n")
+	assert.Len(t, lines, 5)
+	assert.True(t, len(lines[0]) <= 68)
+	for _, line := range lines[1:] {
+		assert.True(t, len(line) <= 79)
+	}
+	assert.Equal(t, strings.Repeat("x", 79), lines[3])
+	assert.Equal(t, strings.Repeat("word", 30), strings.ReplaceAll(strings.Join(lines[:3], " "), " ", ""))
+
+	assert.False(t, (&IES{Format: IESFormatLM_63_1986}).SetKeyword("TEST", "x", KeywordOverflowError).Valid())
+	assert.True(t, (&IES{}).SetKeyword("ANY", long, KeywordOverflowError).Valid())
+}
+
+func Test_runeBoundary(t *testing.T) {
+	assert.Equal(t, 2, runeBoundary("abü", 3))
+	assert.Equal(t, 4, runeBoundary("abü", 4))
+	assert.Equal(t, 2, runeBoundary("üb", 1))
+}
@@ -0,0 +1,134 @@
+package eulumies
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"math"
+	"strconv"
+)
+
+// IntensityFunc returns the absolute luminous intensity (cd) in the given direction (C-plane and gamma angle in degrees).
+type IntensityFunc func(c, gamma float64) float64
+
+// IlluminanceGridOptions describes the luminaire installation and the ground grid for an illuminance calculation.
+// All lengths are given in meters, the luminaire is located above the origin of the grid coordinate system.
+type IlluminanceGridOptions struct {
+	MountingHeight float64 // height of the luminaire above the ground
+	Tilt           float64 // rotation (in degrees) around the C0-C180 axis, positive values raise the C90 side
+	Rotation       float64 // rotation (in degrees) around the vertical axis, positive values turn C0 towards +y
+	MinX           float64
+	MaxX           float64
+	MinY           float64
+	MaxY           float64
+	PointsX        int // number of grid points along the x axis, at least 2
+	PointsY        int // number of grid points along the y axis, at least 2
+}
+
+// IlluminanceGrid contains the horizontal illuminance (lx) on a rectangular ground grid.
+type IlluminanceGrid struct {
+	X       []float64   // x coordinates of the grid columns
+	Y       []float64   // y coordinates of the grid rows
+	Values  [][]float64 // illuminance values, Values[row][column]
+	Min     float64
+	Max     float64
+	Average float64
+}
+
+// computeIlluminanceGrid evaluates the horizontal illuminance on the ground grid for the given intensity function.
+func computeIlluminanceGrid(intensity IntensityFunc, opts IlluminanceGridOptions) (IlluminanceGrid, error) {
+	if opts.MountingHeight <= 0 {
+		return IlluminanceGrid{}, errors.New("mounting height must be positive")
+	}
+	if opts.PointsX < 2 || opts.PointsY < 2 {
+		return IlluminanceGrid{}, errors.New("grid needs at least two points per axis")
+	}
+	if opts.MaxX <= opts.MinX || opts.MaxY <= opts.MinY {
+		return IlluminanceGrid{}, errors.New("invalid grid extents")
+	}
+
+	grid := IlluminanceGrid{
+		X:      make([]float64, opts.PointsX),
+		Y:      make([]float64, opts.PointsY),
+		Values: make([][]float64, opts.PointsY),
+		Min:    math.MaxFloat64,
+	}
+	for x := range grid.X {
+		grid.X[x] = opts.MinX + (opts.MaxX-opts.MinX)*float64(x)/float64(opts.PointsX-1)
+	}
+
+	sum := 0.0
+	for y := range grid.Y {
+		grid.Y[y] = opts.MinY + (opts.MaxY-opts.MinY)*float64(y)/float64(opts.PointsY-1)
+		grid.Values[y] = make([]float64, opts.PointsX)
+		for x := range grid.X {
+			value := illuminanceAt(intensity, opts, grid.X[x], grid.Y[y])
+			grid.Values[y][x] = value
+			grid.Min = math.Min(grid.Min, value)
+			grid.Max = math.Max(grid.Max, value)
+			sum += value
+		}
+	}
+	grid.Average = sum / float64(opts.PointsX*opts.PointsY)
+
+	return grid, nil
+}
+
+// illuminanceAt returns the horizontal illuminance (lx) at the given ground point.
+func illuminanceAt(intensity IntensityFunc, opts IlluminanceGridOptions, x, y float64) float64 {
+	c, gamma, distance := installedDirection(opts.MountingHeight, opts.Tilt, opts.Rotation, x, y, 0)
+	return intensity(c, gamma) * opts.MountingHeight / (distance * distance * distance)
+}
+
+// installedDirection returns the direction (C-plane and gamma angle in the luminaire coordinate system) and the
+// distance from a luminaire mounted at the given height, tilt and rotation to the point (x, y, z).
+func installedDirection(height, tilt, rotation, x, y, z float64) (float64, float64, float64) {
+	dx, dy, dz := x, y, z-height
+
+	// undo the rotation around the vertical axis
+	sinR, cosR := math.Sincos(degToRad(rotation))
+	rx := dx*cosR + dy*sinR
+	ry := -dx*sinR + dy*cosR
+
+	// undo the tilt around the C0-C180 axis
+	sinT, cosT := math.Sincos(degToRad(tilt))
+	lx := rx
+	ly := ry*cosT + dz*sinT
+	lz := -ry*sinT + dz*cosT
+
+	distance := math.Sqrt(lx*lx + ly*ly + lz*lz)
+	if distance == 0 {
+		return 0, 0, 0
+	}
+	c, gamma := Direction{lx, ly, lz}.CGamma()
+
+	return c, gamma, distance
+}
+
+// WriteCSV writes the grid as CSV. The first row contains the x coordinates, the first column the y coordinates.
+func (g IlluminanceGrid) WriteCSV(out io.Writer) error {
+	writer := csv.NewWriter(out)
+
+	header := make([]string, len(g.X)+1)
+	header[0] = "y/x"
+	for x := range g.X {
+		header[x+1] = strconv.FormatFloat(g.X[x], 'f', -1, 64)
+	}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for y := range g.Y {
+		record := make([]string, len(g.X)+1)
+		record[0] = strconv.FormatFloat(g.Y[y], 'f', -1, 64)
+		for x := range g.X {
+			record[x+1] = strconv.FormatFloat(g.Values[y][x], 'f', 2, 64)
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
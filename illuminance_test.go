@@ -0,0 +1,45 @@
+package eulumies
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_computeIlluminanceGrid(t *testing.T) {
+	uniform := func(c, gamma float64) float64 { return 1000 }
+	opts := IlluminanceGridOptions{MountingHeight: 2, MinX: -2, MaxX: 2, MinY: -2, MaxY: 2, PointsX: 5, PointsY: 5}
+
+	grid, err := computeIlluminanceGrid(uniform, opts)
+	assert.NoError(t, err)
+	assert.InDelta(t, 250.0, grid.Values[2][2], 1e-9) // directly below: I / h²
+	assert.Equal(t, grid.Max, grid.Values[2][2])
+	assert.Equal(t, grid.Values[0][0], grid.Min)
+	assert.Equal(t, grid.Values[0][1], grid.Values[1][0])
+
+	buffer := &bytes.Buffer{}
+	assert.NoError(t, grid.WriteCSV(buffer))
+	assert.True(t, strings.HasPrefix(buffer.String(), "y/x,-2,-1,0,1,2\n"))
+
+	opts.PointsX = 1
+	_, err = computeIlluminanceGrid(uniform, opts)
+	assert.Error(t, err)
+}
+
+func Test_installedDirection(t *testing.T) {
+	c, gamma, distance := installedDirection(1, 0, 0, 1, 0, 0)
+	assert.InDelta(t, 0.0, c, 1e-9)
+	assert.InDelta(t, 45.0, gamma, 1e-9)
+	assert.InDelta(t, 1.4142135, distance, 1e-6)
+
+	// rotating the luminaire by 90 degrees turns C0 towards +y
+	c, _, _ = installedDirection(1, 0, 90, 0, 1, 0)
+	assert.InDelta(t, 0.0, c, 1e-9)
+
+	// tilting by 45 degrees raises the C90 side, the point below is seen at gamma 45 in C270
+	c, gamma, _ = installedDirection(1, 45, 0, 0, 0, 0)
+	assert.InDelta(t, 270.0, c, 1e-9)
+	assert.InDelta(t, 45.0, gamma, 1e-9)
+}
@@ -0,0 +1,151 @@
+package eulumies
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// PhotometryInfoFormatEulumdat is the format of PhotometryInfo read from EULUMDAT data, IES data uses the IESFormat.
+const PhotometryInfoFormatEulumdat = "EULUMDAT"
+
+// PhotometryInfo contains the catalog data of a photometric file. It is read from the header only, the luminous
+// intensities are not parsed, which keeps building search indexes over large libraries cheap.
+type PhotometryInfo struct {
+	Format           string // EULUMDAT or the IES format version
+	Name             string
+	Manufacturer     string
+	CatalogNumber    string
+	LuminousFlux     float64        // lm, rated lamp flux or the luminaire flux of absolute EULUMDAT photometry
+	Power            float64        // W, including ballast
+	ColorTemperature string         // EULUMDAT only, IES defines no keyword for it
+	ColorRendering   string         // EULUMDAT only
+	Length           float64        // mm
+	Width            float64        // mm, 0 for circular luminaires
+	Height           float64        // mm
+	Absolute         bool           // absolute photometry, the flux of absolute IES photometry is unknown (0)
+	Date             time.Time      // measurement or issue date found by ParseDate, zero if there is none
+	DateConfidence   DateConfidence // reliability of Date
+}
+
+// ReadPhotometryInfo reads the header of the given .ldt or .ies file, optionally gzip compressed (.gz).
+func ReadPhotometryInfo(path string) (PhotometryInfo, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return PhotometryInfo{}, err
+	}
+	defer file.Close()
+
+	switch strings.ToLower(filepath.Ext(strings.TrimSuffix(strings.ToLower(path), ".gz"))) {
+	case ".ldt":
+		return ReadEulumdatInfo(file)
+	case ".ies":
+		return ReadIESInfo(file)
+	default:
+		return PhotometryInfo{}, fmt.Errorf("unknown format of %s, expected .ldt or .ies", path)
+	}
+}
+
+// PhotometryIndexEntry is the result of indexing a single file with IndexPhotometryInfo.
+type PhotometryIndexEntry struct {
+	Path string
+	Info PhotometryInfo
+	Err  error // the file could not be read, Info is empty
+}
+
+// IndexPhotometryInfo reads the headers of all given files, e.g. to build a catalog of a photometry library. Files
+// that cannot be read are reported in their entry and do not stop the indexing. The optional progress function is
+// called after each file.
+func IndexPhotometryInfo(paths []string, progress ProgressFunc) []PhotometryIndexEntry {
+	entries := make([]PhotometryIndexEntry, len(paths))
+	for i, path := range paths {
+		info, err := ReadPhotometryInfo(path)
+		entries[i] = PhotometryIndexEntry{Path: path, Info: info, Err: err}
+		if progress != nil {
+			progress(Progress{Done: i + 1, Total: len(paths), File: path})
+		}
+	}
+
+	return entries
+}
+
+// ReadEulumdatInfo reads the fields 1 to 26f of the EULUMDAT data. The first standard set of lamps is used.
+func ReadEulumdatInfo(in io.Reader) (PhotometryInfo, error) {
+	in, err := decompressReader(in)
+	if err != nil {
+		return PhotometryInfo{}, err
+	}
+
+	var eulumdat Eulumdat
+	if err = eulumdat.parseHeader(bufio.NewScanner(in), ParseOptions{}); err != nil {
+		return PhotometryInfo{}, err
+	}
+
+	return eulumdat.Info(), nil
+}
+
+// ReadIESInfo reads the keywords and the lines 10 and 11 of the IES data.
+func ReadIESInfo(in io.Reader) (PhotometryInfo, error) {
+	in, err := decompressReader(in)
+	if err != nil {
+		return PhotometryInfo{}, err
+	}
+
+	ies := IES{Format: IESFormatUnknown}
+	if err = ies.parseHeader(bufio.NewScanner(in)); err != nil {
+		return PhotometryInfo{}, err
+	}
+
+	return ies.Info(), nil
+}
+
+// Info returns the catalog data of the EULUMDAT data. The first standard set of lamps is used.
+func (e Eulumdat) Info() PhotometryInfo {
+	info := PhotometryInfo{
+		Format:        PhotometryInfoFormatEulumdat,
+		Name:          e.LuminaireName,
+		Manufacturer:  e.CompanyIdentification,
+		CatalogNumber: e.LuminaireNumber,
+		Length:        e.LengthDiameter,
+		Width:         e.WidthLuminaire,
+		Height:        e.HeightLuminaire,
+		Absolute:      e.IsAbsolutePhotometry(),
+	}
+	info.Date, info.DateConfidence = e.GuessDate()
+	if e.NumberStandardSetLamps > 0 && len(e.TotalLuminousFluxLamps) > 0 && len(e.BallastWatts) > 0 &&
+		len(e.ColorTemperature) > 0 && len(e.ColorRenderingIndexCRI) > 0 {
+		info.LuminousFlux = math.Abs(e.TotalLuminousFluxLamps[0])
+		info.Power = e.BallastWatts[0]
+		info.ColorTemperature = e.ColorTemperature[0]
+		info.ColorRendering = e.ColorRenderingIndexCRI[0]
+	}
+
+	return info
+}
+
+// Info returns the catalog data of the IES data.
+func (i *IES) Info() PhotometryInfo {
+	info := PhotometryInfo{
+		Format:        string(i.Format),
+		Name:          i.Keywords["LUMINAIRE"],
+		Manufacturer:  i.Keywords["MANUFAC"],
+		CatalogNumber: i.Keywords["LUMCAT"],
+		LuminousFlux:  i.lampLumens(),
+		Power:         i.InputWatts,
+		Length:        math.Abs(iesUnitsToMillimeters(i.LuminaireLength, i.UnitsType)),
+		Width:         math.Abs(iesUnitsToMillimeters(i.LuminaireWidth, i.UnitsType)),
+		Height:        math.Abs(iesUnitsToMillimeters(i.LuminaireHeight, i.UnitsType)),
+		Absolute:      i.IsAbsolutePhotometry(),
+	}
+	info.Date, info.DateConfidence = i.GuessIssueDate()
+	if i.LuminaireWidth < 0 && i.LuminaireLength < 0 {
+		info.Width = 0 // circular luminous opening
+	}
+
+	return info
+}
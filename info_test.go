@@ -0,0 +1,95 @@
+package eulumies
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/h44z/eulumies/samples"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadPhotometryInfo(t *testing.T) {
+	info, err := ReadPhotometryInfo("test/sample2.ldt")
+	assert.NoError(t, err)
+	assert.Equal(t, PhotometryInfoFormatEulumdat, info.Format)
+	assert.Equal(t, 520.0, info.LuminousFlux)
+	assert.Equal(t, 3.19, info.Power)
+	assert.Equal(t, "4000K", info.ColorTemperature)
+	assert.Equal(t, "80", info.ColorRendering)
+	assert.False(t, info.Absolute)
+
+	eulumdat := loadSample2(t)
+	assert.Equal(t, eulumdat.LuminaireName, info.Name)
+	assert.Equal(t, eulumdat.LuminaireNumber, info.CatalogNumber)
+	assert.Equal(t, eulumdat.LengthDiameter, info.Length)
+
+	info, err = ReadPhotometryInfo("test/sample.ies")
+	assert.NoError(t, err)
+	assert.Equal(t, string(IESFormatLM_63_1995), info.Format)
+	assert.Equal(t, "A SUPER LAMP", info.Name)
+	assert.Equal(t, "Sample Company", info.Manufacturer)
+	assert.Equal(t, "889-1551-H27-K18-L00", info.CatalogNumber)
+	assert.Equal(t, 9.6, info.Power)
+
+	info, err = ReadPhotometryInfo("test/ADL110.XTM5M.9540.61 - S1.ies")
+	assert.NoError(t, err)
+	assert.True(t, info.Absolute)
+	assert.Equal(t, 0.0, info.LuminousFlux)
+
+	_, err = ReadPhotometryInfo("test/luminaire.yaml")
+	assert.Error(t, err)
+}
+
+func TestReadPhotometryInfo_Samples(t *testing.T) {
+	symmetries := map[int]bool{}
+	formats := map[string]bool{}
+	for _, sample := range samples.All() {
+		if strings.HasSuffix(sample.Name, ".ldt") {
+			eulumdat, err := NewEulumdat(sample.Open(), true)
+			assert.NoError(t, err, sample.Name)
+			assert.NoError(t, eulumdat.Validate(true).Err(), sample.Name)
+			assert.InDelta(t, eulumdat.LightOutputRatioLuminaire, eulumdat.ComputeLightOutputRatio(), 0.5, sample.Name)
+			symmetries[eulumdat.SymmetryIndicator] = true
+
+			info, err := ReadEulumdatInfo(sample.Open())
+			assert.NoError(t, err, sample.Name)
+			assert.Equal(t, eulumdat.LuminaireName, info.Name, sample.Name)
+			continue
+		}
+		if strings.HasPrefix(sample.Name, "lm63_1986_") {
+			continue // the parser does not support LM-63-1986, the file lacks the format line
+		}
+
+		ies, err := NewIESFromReader(sample.Open(), true)
+		assert.NoError(t, err, sample.Name)
+		assert.NoError(t, ies.Validate(true).Err(), sample.Name)
+		info, err := ReadIESInfo(sample.Open())
+		assert.NoError(t, err, sample.Name)
+		formats[info.Format] = true
+	}
+
+	assert.Len(t, symmetries, 5)
+	assert.Len(t, formats, 3)
+}
+
+func TestIndexPhotometryInfo(t *testing.T) {
+	paths := []string{"test/sample2.ldt", "test/luminaire.yaml", "test/sample.ies"}
+	var progress []Progress
+	entries := IndexPhotometryInfo(paths, func(p Progress) {
+		progress = append(progress, p)
+	})
+
+	assert.Len(t, entries, 3)
+	assert.NoError(t, entries[0].Err)
+	assert.Equal(t, 520.0, entries[0].Info.LuminousFlux)
+	assert.Error(t, entries[1].Err)
+	assert.NoError(t, entries[2].Err)
+	assert.Equal(t, "A SUPER LAMP", entries[2].Info.Name)
+	assert.Equal(t, []Progress{
+		{Done: 1, Total: 3, File: "test/sample2.ldt"},
+		{Done: 2, Total: 3, File: "test/luminaire.yaml"},
+		{Done: 3, Total: 3, File: "test/sample.ies"},
+	}, progress)
+
+	assert.Len(t, IndexPhotometryInfo(paths[:1], nil), 1)
+}
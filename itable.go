@@ -0,0 +1,221 @@
+package eulumies
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ImportCIEITable reads a photometric text file in the CIE 102 i-table layout and maps it onto the EULUMDAT structure.
+//
+// The file consists of the following lines, blank lines are ignored:
+//
+//	line 1:  identification of the luminaire
+//	line 2:  symmetry indicator (0 - 4, as in EULUMDAT), number of lamps, total lamp flux (lm), luminaire watts
+//	line 3:  length, width and height of the luminaire (mm), width 0 for circular luminaires
+//	line 4:  column header: a label followed by the C-angles of the table columns
+//	line 5+: one row per gamma angle: the gamma angle followed by the luminous intensities (cd/klm)
+//
+// Values may be separated by spaces, tabs or semicolons, a decimal comma is accepted. The columns must contain the
+// C-planes stored by EULUMDAT for the given symmetry, e.g. C0 to C90 for symmetry indicator 4. For symmetry indicator 3
+// the columns C90 to C270 are accepted as well.
+func ImportCIEITable(in io.Reader) (*Eulumdat, error) {
+	in, err := decompressReader(in)
+	if err != nil {
+		return nil, err
+	}
+
+	var lines []string
+	scanner := bufio.NewScanner(in)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(lines) < 5 {
+		return nil, errors.New("i-table is incomplete, expected header lines and at least one intensity row")
+	}
+
+	lamps, err := parseITableValues(lines[1], 4)
+	if err != nil {
+		return nil, fmt.Errorf("lamp line: %w", err)
+	}
+	dimensions, err := parseITableValues(lines[2], 3)
+	if err != nil {
+		return nil, fmt.Errorf("dimension line: %w", err)
+	}
+	header := splitITableLine(lines[3])
+	if len(header) < 2 {
+		return nil, errors.New("column header contains no C-angles")
+	}
+	cAngles, err := parseITableValues(strings.Join(header[1:], " "), len(header)-1)
+	if err != nil {
+		return nil, fmt.Errorf("column header: %w", err)
+	}
+
+	symmetry := int(lamps[0])
+	if float64(symmetry) != lamps[0] || symmetry < 0 || symmetry > 4 {
+		return nil, fmt.Errorf("symmetry indicator %g out of range (0 - 4)", lamps[0])
+	}
+	if lamps[2] <= 0 {
+		return nil, errors.New("total lamp flux must be positive")
+	}
+
+	eulumdat := &Eulumdat{
+		CompanyIdentification:     lines[0],
+		LuminaireName:             lines[0],
+		TypeIndicator:             3,
+		SymmetryIndicator:         symmetry,
+		LengthDiameter:            dimensions[0],
+		WidthLuminaire:            dimensions[1],
+		HeightLuminaire:           dimensions[2],
+		IntensityConversionFactor: 1,
+		NumberStandardSetLamps:    1,
+		NumberLamps:               []int{int(math.Max(1, lamps[1]))},
+		TypeLamps:                 []string{""},
+		TotalLuminousFluxLamps:    []float64{lamps[2]},
+		ColorTemperature:          []string{""},
+		ColorRenderingIndexCRI:    []string{""},
+		BallastWatts:              []float64{lamps[3]},
+	}
+	if symmetry == 1 {
+		eulumdat.TypeIndicator = 1
+	}
+	eulumdat.LengthDiameterLuminousArea = eulumdat.LengthDiameter
+	eulumdat.WidthLuminousArea = eulumdat.WidthLuminaire
+	eulumdat.HeightLuminousAreaC0 = eulumdat.HeightLuminaire
+	eulumdat.HeightLuminousAreaC90 = eulumdat.HeightLuminaire
+	eulumdat.HeightLuminousAreaC180 = eulumdat.HeightLuminaire
+	eulumdat.HeightLuminousAreaC270 = eulumdat.HeightLuminaire
+
+	if eulumdat.AnglesC, err = iTableCAngles(cAngles, symmetry); err != nil {
+		return nil, err
+	}
+	eulumdat.NumberMcCPlanes = len(eulumdat.AnglesC)
+	eulumdat.DistanceDcCPlanes = angleDistance(eulumdat.AnglesC)
+
+	columns := make([][]float64, len(cAngles))
+	for _, line := range lines[4:] {
+		row, err := parseITableValues(line, len(cAngles)+1)
+		if err != nil {
+			return nil, fmt.Errorf("intensity row %d: %w", len(eulumdat.AnglesG)+1, err)
+		}
+		if n := len(eulumdat.AnglesG); n > 0 && row[0] <= eulumdat.AnglesG[n-1] {
+			return nil, fmt.Errorf("gamma angle %g is not ascending", row[0])
+		}
+		eulumdat.AnglesG = append(eulumdat.AnglesG, row[0])
+		for c := range columns {
+			columns[c] = append(columns[c], row[c+1])
+		}
+	}
+	eulumdat.NumberNgIntensitiesCPlane = len(eulumdat.AnglesG)
+	eulumdat.DistanceDgCPlane = angleDistance(eulumdat.AnglesG)
+
+	// order the columns as stored by EULUMDAT, for symmetry 3 from C270 across C0 to C90
+	eulumdat.calcMc1andMc2()
+	for c := eulumdat.mc1 - 1; c < eulumdat.mc2; c++ {
+		angle := eulumdat.AnglesC[c%eulumdat.NumberMcCPlanes]
+		column := iTableColumn(cAngles, angle)
+		if column < 0 && symmetry == 3 {
+			column = iTableColumn(cAngles, math.Mod(540-angle, 360)) // mirrored at the C90-C270 plane
+		}
+		if column < 0 {
+			return nil, fmt.Errorf("column for C-plane %g is missing", angle)
+		}
+		eulumdat.LuminousIntensityDistributionRaw = append(eulumdat.LuminousIntensityDistributionRaw,
+			columns[column]...)
+	}
+	if err = eulumdat.CalcLuminousIntensityDistributionFromRaw(); err != nil {
+		return nil, err
+	}
+
+	eulumdat.LightOutputRatioLuminaire = eulumdat.ComputeLightOutputRatio()
+	eulumdat.DownwardFluxFractionPhiu = 100 * (1 - eulumdat.ComputeUpwardLightRatio())
+	eulumdat.FillDirectRatios()
+
+	return eulumdat, nil
+}
+
+// iTableCAngles returns all C-angles of the distribution described by the i-table columns. The columns must contain
+// exactly the equidistant C-planes stored for the symmetry indicator.
+func iTableCAngles(columns []float64, symmetry int) ([]float64, error) {
+	if symmetry == 1 {
+		if len(columns) != 1 {
+			return nil, fmt.Errorf("expected 1 column for symmetry indicator 1, found %d", len(columns))
+		}
+		return []float64{0}, nil
+	}
+	if len(columns) < 2 {
+		return nil, fmt.Errorf("expected at least 2 columns for symmetry indicator %d", symmetry)
+	}
+
+	step := 360.0
+	for i := range columns {
+		next := columns[(i+1)%len(columns)]
+		distance := math.Mod(next-columns[i]+360, 360)
+		if distance > 0 {
+			step = math.Min(step, distance)
+		}
+	}
+	count := int(math.Round(360 / step))
+	if count < 2 || math.Abs(float64(count)*step-360) > 1e-6 {
+		return nil, fmt.Errorf("C-plane distance %g does not divide the full circle", step)
+	}
+
+	expected := map[int]int{0: count, 2: count/2 + 1, 3: count/2 + 1, 4: count/4 + 1}[symmetry]
+	if ((symmetry == 2 || symmetry == 3) && count%2 != 0) || (symmetry == 4 && count%4 != 0) ||
+		len(columns) != expected {
+		return nil, fmt.Errorf("columns do not match the C-planes stored for symmetry indicator %d", symmetry)
+	}
+
+	angles := make([]float64, count)
+	for i := range angles {
+		angles[i] = float64(i) * step
+	}
+
+	return angles, nil
+}
+
+// iTableColumn returns the index of the column holding the given C-angle, or -1 if there is none.
+func iTableColumn(columns []float64, angle float64) int {
+	for i := range columns {
+		if math.Abs(math.Mod(columns[i]+360, 360)-angle) < 1e-6 {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// splitITableLine splits an i-table line into its fields.
+func splitITableLine(line string) []string {
+	return strings.FieldsFunc(line, func(r rune) bool {
+		return r == ' ' || r == '\t' || r == ';'
+	})
+}
+
+// parseITableValues parses a line holding the given number of numeric values.
+func parseITableValues(line string, count int) ([]float64, error) {
+	fields := splitITableLine(line)
+	if len(fields) != count {
+		return nil, fmt.Errorf("expected %d values, found %d", count, len(fields))
+	}
+
+	values := make([]float64, count)
+	for i, field := range fields {
+		value, err := strconv.ParseFloat(strings.ReplaceAll(field, ",", "."), 64)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+
+	return values, nil
+}
@@ -0,0 +1,73 @@
+package eulumies
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportCIEITable(t *testing.T) {
+	eulumdat := loadSample2(t)
+
+	// write the stored planes of the symmetric sample (I_sym = 4) as i-table
+	var table strings.Builder
+	table.WriteString("Sample luminaire\n")
+	fmt.Fprintf(&table, "4 1 %g %g\n", eulumdat.TotalLuminousFluxLamps[0], eulumdat.BallastWatts[0])
+	fmt.Fprintf(&table, "%g;%g;%g\n", eulumdat.LengthDiameter, eulumdat.WidthLuminaire, eulumdat.HeightLuminaire)
+	table.WriteString("G/C")
+	for c := range eulumdat.LuminousIntensityDistribution {
+		fmt.Fprintf(&table, "\t%g", eulumdat.AnglesC[c])
+	}
+	table.WriteString("\n")
+	for g, gamma := range eulumdat.AnglesG {
+		table.WriteString(strings.ReplaceAll(fmt.Sprintf("%g", gamma), ".", ","))
+		for c := range eulumdat.LuminousIntensityDistribution {
+			value := fmt.Sprintf("%g", eulumdat.LuminousIntensityDistribution[c][g])
+			fmt.Fprintf(&table, "\t%s", strings.ReplaceAll(value, ".", ","))
+		}
+		table.WriteString("\n\n")
+	}
+
+	imported, err := ImportCIEITable(strings.NewReader(table.String()))
+	assert.NoError(t, err)
+	assert.Equal(t, "Sample luminaire", imported.LuminaireName)
+	assert.Equal(t, 4, imported.SymmetryIndicator)
+	assert.Equal(t, eulumdat.NumberMcCPlanes, imported.NumberMcCPlanes)
+	assert.Equal(t, eulumdat.AnglesG, imported.AnglesG)
+	assert.Equal(t, eulumdat.LuminousIntensityDistribution, imported.LuminousIntensityDistribution)
+	assert.Equal(t, eulumdat.WidthLuminaire, imported.WidthLuminaire)
+	assert.InDelta(t, eulumdat.ComputeTotalFlux(), imported.ComputeTotalFlux(), 1e-9)
+	assert.NoError(t, imported.Validate(false).Err())
+}
+
+func TestImportCIEITable_Symmetry3(t *testing.T) {
+	table := "Wall washer\n3 2 2000 25\n1000 100 50\nG/C 90 180 270\n0 100 100 100\n90 300 50 300\n180 0 0 0\n"
+
+	imported, err := ImportCIEITable(strings.NewReader(table))
+	assert.NoError(t, err)
+	assert.Equal(t, 4, imported.NumberMcCPlanes)
+	assert.Equal(t, []float64{0, 90, 180, 270}, imported.AnglesC)
+	// EULUMDAT stores C270, C0 and C90, C0 is the mirror image of C180
+	assert.Equal(t, [][]float64{{100, 300, 0}, {100, 50, 0}, {100, 300, 0}}, imported.LuminousIntensityDistribution)
+	assert.Equal(t, []int{2}, imported.NumberLamps)
+}
+
+func TestImportCIEITable_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"incomplete":      "Luminaire\n1 1 1000 10\n0 0 0\nG/C 0\n",
+		"symmetry":        "Luminaire\n5 1 1000 10\n0 0 0\nG/C 0\n0 100\n",
+		"flux":            "Luminaire\n1 1 0 10\n0 0 0\nG/C 0\n0 100\n",
+		"columns":         "Luminaire\n1 1 1000 10\n0 0 0\nG/C 0 90\n0 100 100\n",
+		"missing planes":  "Luminaire\n4 1 1000 10\n0 0 0\nG/C 0 45\n0 100 100\n",
+		"row length":      "Luminaire\n1 1 1000 10\n0 0 0\nG/C 0\n0 100 100\n",
+		"gamma order":     "Luminaire\n1 1 1000 10\n0 0 0\nG/C 0\n10 100\n0 100\n",
+		"invalid number":  "Luminaire\n1 1 1000 10\n0 0 0\nG/C 0\n0 abc\n",
+		"missing columns": "Luminaire\n4 1 1000 10\n0 0 0\nG/C 0 30 60 180\n0 1 1 1 1\n",
+	}
+	for name, table := range tests {
+		_, err := ImportCIEITable(strings.NewReader(table))
+		assert.Error(t, err, name)
+	}
+}
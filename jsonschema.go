@@ -0,0 +1,308 @@
+package eulumies
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// JSONSchema is the JSON Schema (draft-07) of the JSON representation of Eulumdat and IES, as produced by
+// encoding/json. A document is either an Eulumdat or an IES object, services can publish the schema as contract for
+// photometric payloads and check incoming data with ValidateJSON.
+const JSONSchema = `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "$id": "https://github.com/h44z/eulumies/photometry.schema.json",
+  "title": "Photometry",
+  "description": "JSON representation of the EULUMDAT and IES data structures of github.com/h44z/eulumies.",
+  "oneOf": [
+    {"$ref": "#/definitions/eulumdat"},
+    {"$ref": "#/definitions/ies"}
+  ],
+  "definitions": {
+    "count": {"type": "integer", "minimum": 0, "maximum": 100000},
+    "numbers": {"type": ["array", "null"], "items": {"type": "number"}},
+    "integers": {"type": ["array", "null"], "items": {"type": "integer"}},
+    "strings": {"type": ["array", "null"], "items": {"type": "string"}},
+    "keywords": {"type": ["object", "null"], "additionalProperties": {"type": "string"}},
+    "eulumdat": {
+      "title": "EULUMDAT",
+      "type": "object",
+      "required": ["TypeIndicator", "SymmetryIndicator", "NumberMcCPlanes", "NumberNgIntensitiesCPlane", "AnglesC",
+        "AnglesG", "LuminousIntensityDistributionRaw"],
+      "additionalProperties": false,
+      "properties": {
+        "CompanyIdentification": {"type": "string"},
+        "TypeIndicator": {"type": "integer", "minimum": 0, "maximum": 3},
+        "SymmetryIndicator": {"type": "integer", "minimum": 0, "maximum": 4},
+        "NumberMcCPlanes": {"$ref": "#/definitions/count"},
+        "DistanceDcCPlanes": {"type": "number", "minimum": 0},
+        "NumberNgIntensitiesCPlane": {"$ref": "#/definitions/count"},
+        "DistanceDgCPlane": {"type": "number", "minimum": 0},
+        "MeasurementReportNumber": {"type": "string"},
+        "LuminaireName": {"type": "string"},
+        "LuminaireNumber": {"type": "string"},
+        "FileName": {"type": "string"},
+        "DateUser": {"type": "string"},
+        "LengthDiameter": {"type": "number"},
+        "WidthLuminaire": {"type": "number"},
+        "HeightLuminaire": {"type": "number"},
+        "LengthDiameterLuminousArea": {"type": "number"},
+        "WidthLuminousArea": {"type": "number"},
+        "HeightLuminousAreaC0": {"type": "number"},
+        "HeightLuminousAreaC90": {"type": "number"},
+        "HeightLuminousAreaC180": {"type": "number"},
+        "HeightLuminousAreaC270": {"type": "number"},
+        "DownwardFluxFractionPhiu": {"type": "number"},
+        "LightOutputRatioLuminaire": {"type": "number"},
+        "IntensityConversionFactor": {"type": "number"},
+        "MeasurementTiltLuminaire": {"type": "number"},
+        "NumberStandardSetLamps": {"$ref": "#/definitions/count"},
+        "NumberLamps": {"$ref": "#/definitions/integers"},
+        "TypeLamps": {"$ref": "#/definitions/strings"},
+        "TotalLuminousFluxLamps": {"$ref": "#/definitions/numbers"},
+        "ColorTemperature": {"$ref": "#/definitions/strings"},
+        "ColorRenderingIndexCRI": {"$ref": "#/definitions/strings"},
+        "BallastWatts": {"$ref": "#/definitions/numbers"},
+        "DirectRatios": {"type": "array", "items": {"type": "number"}, "minItems": 10, "maxItems": 10},
+        "AnglesC": {"$ref": "#/definitions/numbers"},
+        "AnglesG": {"$ref": "#/definitions/numbers"},
+        "LuminousIntensityDistributionRaw": {"$ref": "#/definitions/numbers"},
+        "LuminousIntensityDistribution": {"type": ["array", "null"], "items": {"$ref": "#/definitions/numbers"}},
+        "Extensions": {"$ref": "#/definitions/keywords"}
+      }
+    },
+    "ies": {
+      "title": "IESNA LM-63",
+      "type": "object",
+      "required": ["Format", "PhotometricType", "VerticalAngles", "HorizontalAngles", "CandelaValues"],
+      "additionalProperties": false,
+      "properties": {
+        "Format": {"enum": ["UNKNOWN", "LM-63-1986", "LM-63-1991", "LM-63-1995", "LM-63-2002"]},
+        "Keywords": {"$ref": "#/definitions/keywords"},
+        "Tilt": {"enum": ["", "NONE", "INCLUDE", "FILE"]},
+        "TiltLampToLuminaireGeometry": {"type": "integer", "minimum": 0, "maximum": 3},
+        "TiltAnglesAndFactors": {"$ref": "#/definitions/count"},
+        "TiltAngles": {"$ref": "#/definitions/numbers"},
+        "TiltMultiplierFactors": {"$ref": "#/definitions/numbers"},
+        "NumberLamps": {"type": "integer"},
+        "LumensPerLamp": {"type": "number"},
+        "CandelaMultiplier": {"type": "number"},
+        "NumberVerticalAngles": {"$ref": "#/definitions/count"},
+        "NumberHorizontalAngles": {"$ref": "#/definitions/count"},
+        "PhotometricType": {"type": "integer", "minimum": 1, "maximum": 3},
+        "UnitsType": {"type": "integer", "minimum": 0, "maximum": 2},
+        "LuminaireWidth": {"type": "number"},
+        "LuminaireLength": {"type": "number"},
+        "LuminaireHeight": {"type": "number"},
+        "BallastFactor": {"type": "number"},
+        "FutureUse": {"type": "number"},
+        "InputWatts": {"type": "number"},
+        "VerticalAngles": {"$ref": "#/definitions/numbers"},
+        "HorizontalAngles": {"$ref": "#/definitions/numbers"},
+        "CandelaValues": {"type": ["array", "null"], "items": {"$ref": "#/definitions/numbers"}}
+      }
+    }
+  }
+}`
+
+// jsonSchema is the parsed JSONSchema.
+var jsonSchema = mustParseJSONSchema(JSONSchema)
+
+func mustParseJSONSchema(schema string) map[string]interface{} {
+	var parsed map[string]interface{}
+	if err := json.Unmarshal([]byte(schema), &parsed); err != nil {
+		panic("invalid json schema: " + err.Error())
+	}
+
+	return parsed
+}
+
+// ValidateJSON validates the JSON document against JSONSchema. The Field of each issue is the JSON pointer of the
+// offending value. Only the schema keywords used by JSONSchema are evaluated.
+func ValidateJSON(data []byte) ValidationIssues {
+	var issues ValidationIssues
+
+	var document interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if err := decoder.Decode(&document); err != nil {
+		issues.add(ValidationInvalidJSON, SeverityError, "", "%v", err)
+		return issues
+	}
+	if decoder.More() {
+		issues.add(ValidationInvalidJSON, SeverityError, "", "unexpected data after the JSON document")
+		return issues
+	}
+
+	validateJSONValue(&issues, jsonSchema, document, "")
+
+	return issues
+}
+
+// validateJSONValue validates the value against the schema and adds all violations to the issues.
+func validateJSONValue(issues *ValidationIssues, schema map[string]interface{}, value interface{}, pointer string) {
+	if ref, ok := schema["$ref"].(string); ok {
+		schema = resolveJSONSchemaRef(ref)
+	}
+
+	if branches, ok := schema["oneOf"].([]interface{}); ok {
+		validateJSONOneOf(issues, branches, value, pointer)
+	}
+	if allowed, ok := schema["enum"].([]interface{}); ok {
+		found := false
+		for _, candidate := range allowed {
+			found = found || candidate == value
+		}
+		if !found {
+			issues.add(ValidationSchemaViolation, SeverityError, pointer, "value %v is not one of %v", value, allowed)
+		}
+	}
+	if types, ok := schema["type"]; ok && !matchesJSONType(types, value) {
+		issues.add(ValidationSchemaViolation, SeverityError, pointer, "expected %v, found %s", types,
+			jsonTypeName(value))
+		return
+	}
+
+	switch typed := value.(type) {
+	case float64:
+		if minimum, ok := schema["minimum"].(float64); ok && typed < minimum {
+			issues.add(ValidationSchemaViolation, SeverityError, pointer, "%v is less than %v", typed, minimum)
+		}
+		if maximum, ok := schema["maximum"].(float64); ok && typed > maximum {
+			issues.add(ValidationSchemaViolation, SeverityError, pointer, "%v is greater than %v", typed, maximum)
+		}
+	case []interface{}:
+		if minItems, ok := schema["minItems"].(float64); ok && float64(len(typed)) < minItems {
+			issues.add(ValidationSchemaViolation, SeverityError, pointer, "expected at least %v items, found %d",
+				minItems, len(typed))
+		}
+		if maxItems, ok := schema["maxItems"].(float64); ok && float64(len(typed)) > maxItems {
+			issues.add(ValidationSchemaViolation, SeverityError, pointer, "expected at most %v items, found %d",
+				maxItems, len(typed))
+		}
+		if items, ok := schema["items"].(map[string]interface{}); ok {
+			for i, item := range typed {
+				validateJSONValue(issues, items, item, fmt.Sprintf("%s/%d", pointer, i))
+			}
+		}
+	case map[string]interface{}:
+		validateJSONObject(issues, schema, typed, pointer)
+	}
+}
+
+// validateJSONObject validates the required, properties and additionalProperties keywords.
+func validateJSONObject(issues *ValidationIssues, schema, object map[string]interface{}, pointer string) {
+	if required, ok := schema["required"].([]interface{}); ok {
+		for _, name := range required {
+			if _, ok := object[name.(string)]; !ok {
+				issues.add(ValidationSchemaViolation, SeverityError, pointer+"/"+escapeJSONPointer(name.(string)),
+					"required property is missing")
+			}
+		}
+	}
+
+	properties, _ := schema["properties"].(map[string]interface{})
+	names := make([]string, 0, len(object))
+	for name := range object {
+		names = append(names, name)
+	}
+	sort.Strings(names) // report the issues in a stable order
+	for _, name := range names {
+		path := pointer + "/" + escapeJSONPointer(name)
+		if property, ok := properties[name].(map[string]interface{}); ok {
+			validateJSONValue(issues, property, object[name], path)
+			continue
+		}
+		switch additional := schema["additionalProperties"].(type) {
+		case bool:
+			if !additional {
+				issues.add(ValidationSchemaViolation, SeverityError, path, "unknown property")
+			}
+		case map[string]interface{}:
+			validateJSONValue(issues, additional, object[name], path)
+		}
+	}
+}
+
+// validateJSONOneOf checks that the value matches exactly one of the schemas. If it matches none, the issues of the
+// closest schema are reported.
+func validateJSONOneOf(issues *ValidationIssues, branches []interface{}, value interface{}, pointer string) {
+	var closest ValidationIssues
+	matches := 0
+	for i, branch := range branches {
+		var branchIssues ValidationIssues
+		validateJSONValue(&branchIssues, branch.(map[string]interface{}), value, pointer)
+		if len(branchIssues) == 0 {
+			matches++
+		} else if i == 0 || len(branchIssues) < len(closest) {
+			closest = branchIssues
+		}
+	}
+
+	switch {
+	case matches == 0:
+		*issues = append(*issues, closest...)
+	case matches > 1:
+		issues.add(ValidationSchemaViolation, SeverityError, pointer, "value matches %d schemas, expected exactly one",
+			matches)
+	}
+}
+
+// resolveJSONSchemaRef resolves a reference to the definitions of JSONSchema.
+func resolveJSONSchemaRef(ref string) map[string]interface{} {
+	const prefix = "#/definitions/"
+	definitions := jsonSchema["definitions"].(map[string]interface{})
+	if definition, ok := definitions[strings.TrimPrefix(ref, prefix)].(map[string]interface{}); ok &&
+		strings.HasPrefix(ref, prefix) {
+		return definition
+	}
+
+	panic("unresolvable json schema reference " + ref)
+}
+
+// matchesJSONType reports whether the value has one of the given JSON Schema types.
+func matchesJSONType(types interface{}, value interface{}) bool {
+	list, ok := types.([]interface{})
+	if !ok {
+		list = []interface{}{types}
+	}
+
+	actual := jsonTypeName(value)
+	for _, expected := range list {
+		if expected == actual || (expected == "number" && actual == "integer") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// jsonTypeName returns the JSON Schema type of the decoded value, numbers without fraction are integers.
+func jsonTypeName(value interface{}) string {
+	switch typed := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		if typed == math.Trunc(typed) && !math.IsInf(typed, 0) {
+			return "integer"
+		}
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+
+	return "unknown"
+}
+
+// escapeJSONPointer escapes a property name for the use in a JSON pointer (RFC 6901).
+func escapeJSONPointer(name string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(name, "~", "~0"), "/", "~1")
+}
@@ -0,0 +1,74 @@
+package eulumies
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONSchema_CoversStructures(t *testing.T) {
+	definitions := jsonSchema["definitions"].(map[string]interface{})
+	for name, value := range map[string]interface{}{"eulumdat": Eulumdat{}, "ies": IES{}} {
+		properties := definitions[name].(map[string]interface{})["properties"].(map[string]interface{})
+		structType := reflect.TypeOf(value)
+		exported := 0
+		for i := 0; i < structType.NumField(); i++ {
+			if field := structType.Field(i); field.PkgPath == "" && field.Tag.Get("json") != "-" {
+				exported++
+				assert.Contains(t, properties, field.Name, name)
+			}
+		}
+		assert.Len(t, properties, exported, name)
+	}
+}
+
+func TestValidateJSON(t *testing.T) {
+	eulumdat := loadSample2(t)
+	data, err := json.Marshal(eulumdat)
+	assert.NoError(t, err)
+	assert.Empty(t, ValidateJSON(data))
+
+	ies, err := NewIES("test/sample.ies", false)
+	assert.NoError(t, err)
+	data, err = json.Marshal(ies)
+	assert.NoError(t, err)
+	assert.Empty(t, ValidateJSON(data))
+
+	data, err = json.Marshal(Eulumdat{})
+	assert.NoError(t, err)
+	assert.Empty(t, ValidateJSON(data))
+}
+
+func TestValidateJSON_Invalid(t *testing.T) {
+	issues := ValidateJSON([]byte(`{"Format": "LM-63-2002"`))
+	assert.Len(t, issues, 1)
+	assert.Equal(t, ValidationInvalidJSON, issues[0].Code)
+
+	issues = ValidateJSON([]byte(`{} {}`))
+	assert.Len(t, issues, 1)
+	assert.Equal(t, ValidationInvalidJSON, issues[0].Code)
+
+	issues = ValidateJSON([]byte(`[]`))
+	assert.Error(t, issues.Err())
+
+	issues = ValidateJSON([]byte(`{"Format": "LM-63-2002", "PhotometricType": 1, "VerticalAngles": [0, 90],
+		"HorizontalAngles": [0], "CandelaValues": [[100, "50"]]}`))
+	assert.Len(t, issues, 1)
+	assert.Equal(t, ValidationSchemaViolation, issues[0].Code)
+	assert.Equal(t, "/CandelaValues/0/1", issues[0].Field)
+
+	issues = ValidateJSON([]byte(`{"TypeIndicator": 1, "SymmetryIndicator": 5, "NumberMcCPlanes": 1,
+		"NumberNgIntensitiesCPlane": 1.5, "AnglesC": [0], "AnglesG": [0], "LuminousIntensityDistributionRaw": [1],
+		"Unknown": true}`))
+	assert.Len(t, issues, 3)
+	assert.Equal(t, "/NumberNgIntensitiesCPlane", issues[0].Field)
+	assert.Equal(t, "/SymmetryIndicator", issues[1].Field)
+	assert.Equal(t, "/Unknown", issues[2].Field)
+
+	issues = ValidateJSON([]byte(`{"Format": "LM-63-2002", "PhotometricType": 1, "VerticalAngles": [0]}`))
+	assert.Len(t, issues, 2)
+	assert.Equal(t, "/HorizontalAngles", issues[0].Field)
+	assert.Equal(t, "/CandelaValues", issues[1].Field)
+}
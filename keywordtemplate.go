@@ -0,0 +1,109 @@
+package eulumies
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// KeywordTemplate fills IES keywords from Go text/template templates over the photometry metadata, e.g. to stamp
+// MANUFAC, LUMCAT, SEARCH and a generated OTHER line on all files of a batch conversion. The templates are executed
+// with KeywordTemplateData, for example
+//
+//	[LUMCAT] ACME-{{.CatalogNumber}}
+//	[OTHER]  {{.Name}}, {{printf "%.0f" .LuminousFlux}} lm, {{.Power}} W, source {{.File}}
+//
+// Besides the functions of text/template the functions upper, lower and trim are available, {{.Keyword "LUMCAT"}}
+// returns the value of a keyword before templating. A KeywordTemplate is safe for concurrent use.
+type KeywordTemplate struct {
+	keywords  []string
+	templates map[string]*template.Template
+}
+
+// KeywordTemplateData is the data the keyword templates are executed with.
+type KeywordTemplateData struct {
+	PhotometryInfo                   // metadata of the photometry, e.g. {{.Name}} or {{.ColorTemperature}}
+	Keywords       map[string]string // keywords of the IES data before templating
+	File           string            // name of the source file, set by the caller
+	Index          int               // position of the file within the batch, set by the caller
+}
+
+// NewKeywordTemplate parses the templates of the given keywords. The keyword names are converted to upper case.
+func NewKeywordTemplate(templates map[string]string) (*KeywordTemplate, error) {
+	t := &KeywordTemplate{templates: make(map[string]*template.Template, len(templates))}
+	for keyword, text := range templates {
+		name := strings.ToUpper(strings.Trim(strings.TrimSpace(keyword), "[]"))
+		if !keywordNameRegex.MatchString(name) {
+			return nil, fmt.Errorf("invalid keyword name %q", keyword)
+		}
+		if _, ok := t.templates[name]; ok {
+			return nil, fmt.Errorf("duplicate template for keyword %s", name)
+		}
+
+		parsed, err := template.New(name).Funcs(keywordTemplateFuncs).Parse(text)
+		if err != nil {
+			return nil, err
+		}
+		t.templates[name] = parsed
+		t.keywords = append(t.keywords, name)
+	}
+	sort.Strings(t.keywords)
+
+	return t, nil
+}
+
+// keywordTemplateFuncs are the functions available in keyword templates besides the text/template builtins.
+var keywordTemplateFuncs = template.FuncMap{
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"trim":  strings.TrimSpace,
+}
+
+// Keyword returns the value of the keyword before templating, or an empty string if it is missing.
+func (d KeywordTemplateData) Keyword(name string) string {
+	return d.Keywords[strings.ToUpper(name)]
+}
+
+// Execute returns the value of every templated keyword for the given data. Leading and trailing white space is
+// removed from the values.
+func (t *KeywordTemplate) Execute(data KeywordTemplateData) (map[string]string, error) {
+	keywords := make(map[string]string, len(t.keywords))
+	for _, keyword := range t.keywords {
+		var value strings.Builder
+		if err := t.templates[keyword].Execute(&value, data); err != nil {
+			return nil, fmt.Errorf("keyword %s: %w", keyword, err)
+		}
+		keywords[keyword] = strings.TrimSpace(value.String())
+	}
+
+	return keywords, nil
+}
+
+// Apply executes the templates and sets the keywords of the IES data with SetKeyword using the given overflow policy.
+// The metadata and keywords of the data default to the ones of the IES data if they are not set. A template producing
+// an empty value removes the keyword. The issues of SetKeyword are returned, keywords with errors are not set.
+func (t *KeywordTemplate) Apply(ies *IES, data KeywordTemplateData, overflow KeywordOverflow) (ValidationIssues, error) {
+	if data.PhotometryInfo == (PhotometryInfo{}) {
+		data.PhotometryInfo = ies.Info()
+	}
+	if data.Keywords == nil {
+		data.Keywords = ies.Keywords
+	}
+
+	keywords, err := t.Execute(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var issues ValidationIssues
+	for _, keyword := range t.keywords {
+		if keywords[keyword] == "" {
+			delete(ies.Keywords, keyword)
+			continue
+		}
+		issues = append(issues, ies.SetKeyword(keyword, keywords[keyword], overflow)...)
+	}
+
+	return issues, nil
+}
@@ -0,0 +1,62 @@
+package eulumies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewKeywordTemplate(t *testing.T) {
+	_, err := NewKeywordTemplate(map[string]string{"LUM CAT": "x"})
+	assert.Error(t, err)
+
+	_, err = NewKeywordTemplate(map[string]string{"lumcat": "x", "[LUMCAT]": "y"})
+	assert.Error(t, err)
+
+	_, err = NewKeywordTemplate(map[string]string{"LUMCAT": "{{.Name"})
+	assert.Error(t, err)
+}
+
+func TestKeywordTemplate_Execute(t *testing.T) {
+	templates, err := NewKeywordTemplate(map[string]string{
+		"lumcat": "ACME-{{.CatalogNumber}}",
+		"OTHER":  " {{upper .Name}}, {{printf \"%.0f\" .LuminousFlux}} lm, {{.Keyword \"lumcat\"}}, {{.File}} #{{.Index}} ",
+	})
+	assert.NoError(t, err)
+
+	keywords, err := templates.Execute(KeywordTemplateData{
+		PhotometryInfo: PhotometryInfo{Name: "Lamp", CatalogNumber: "123", LuminousFlux: 520.4},
+		Keywords:       map[string]string{"LUMCAT": "old"},
+		File:           "lamp.ldt",
+		Index:          3,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"LUMCAT": "ACME-123",
+		"OTHER":  "LAMP, 520 lm, old, lamp.ldt #3",
+	}, keywords)
+
+	templates, err = NewKeywordTemplate(map[string]string{"OTHER": "{{.Missing}}"})
+	assert.NoError(t, err)
+	_, err = templates.Execute(KeywordTemplateData{})
+	assert.Error(t, err)
+}
+
+func TestKeywordTemplate_Apply(t *testing.T) {
+	ies, err := NewIES("test/sample.ies", false)
+	assert.NoError(t, err)
+
+	templates, err := NewKeywordTemplate(map[string]string{
+		"LUMCAT":  "X-{{.CatalogNumber}}",
+		"MANUFAC": "",
+		"OTHER":   "{{.Name}} from {{.File}}",
+	})
+	assert.NoError(t, err)
+
+	issues, err := templates.Apply(ies, KeywordTemplateData{File: "sample.ies"}, KeywordOverflowSplit)
+	assert.NoError(t, err)
+	assert.True(t, issues.Valid())
+	assert.Equal(t, "X-889-1551-H27-K18-L00", ies.Keywords["LUMCAT"])
+	assert.Equal(t, "A SUPER LAMP from sample.ies", ies.Keywords["OTHER"])
+	assert.NotContains(t, ies.Keywords, "MANUFAC")
+}
@@ -0,0 +1,290 @@
+package eulumies
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// KlemsBasisName is the name of the Klems full angle basis in LBNL WINDOW BSDF files.
+const KlemsBasisName = "LBNL/Klems Full"
+
+// klemsRings are the theta bounds (degrees) and the number of phi divisions of the rings of the Klems full basis.
+var klemsRings = []struct {
+	thetaMin, thetaMax float64
+	phis               int
+}{
+	{0, 5, 1}, {5, 15, 8}, {15, 25, 16}, {25, 35, 20}, {35, 45, 24}, {45, 55, 24}, {55, 65, 24}, {65, 75, 16},
+	{75, 90, 12},
+}
+
+// klemsSamples is the number of samples per patch and dimension used to average the intensity over a patch.
+const klemsSamples = 8
+
+// KlemsPatch is a patch of the Klems full hemisphere basis. Angles are given in degrees, theta is measured from the
+// normal of the hemisphere and phi counterclockwise from the x axis.
+type KlemsPatch struct {
+	Theta, Phi         float64 // center of the patch
+	ThetaMin, ThetaMax float64
+	PhiMin, PhiMax     float64 // PhiMin is negative for the patches centered at phi 0
+	SolidAngle         float64 // sr
+	ProjectedSolid     float64 // projected solid angle (sr), the cosine weighted solid angle used by BSDF matrices
+}
+
+// KlemsBasis returns the 145 patches of the Klems full basis in the order used by BSDF matrices: rings from the
+// normal outwards, patches of a ring counterclockwise from phi 0.
+func KlemsBasis() []KlemsPatch {
+	var patches []KlemsPatch
+	for ring, r := range klemsRings {
+		width := 360 / float64(r.phis)
+		theta := (r.thetaMin + r.thetaMax) / 2
+		if ring == 0 {
+			theta = 0
+		}
+		cosMin, cosMax := math.Cos(degToRad(r.thetaMin)), math.Cos(degToRad(r.thetaMax))
+		for n := 0; n < r.phis; n++ {
+			phi := float64(n) * width
+			patches = append(patches, KlemsPatch{
+				Theta:          theta,
+				Phi:            phi,
+				ThetaMin:       r.thetaMin,
+				ThetaMax:       r.thetaMax,
+				PhiMin:         phi - width/2,
+				PhiMax:         phi + width/2,
+				SolidAngle:     degToRad(width) * (cosMin - cosMax),
+				ProjectedSolid: degToRad(width) / 2 * (cosMin*cosMin - cosMax*cosMax),
+			})
+		}
+	}
+
+	return patches
+}
+
+// KlemsDistribution is a luminous intensity distribution resampled onto the Klems full basis of both hemispheres,
+// e.g. to feed luminaire photometry into daylight and facade simulations working with BSDF matrices. The normal of the
+// lower hemisphere points to nadir (theta is the gamma angle), the normal of the upper hemisphere to zenith (theta is
+// 180 degrees minus gamma). Phi is the C-plane angle in both hemispheres.
+type KlemsDistribution struct {
+	Name         string
+	Manufacturer string
+	Patches      []KlemsPatch // see KlemsBasis
+	Downward     []float64    // mean luminous intensity (cd) of each patch of the lower hemisphere
+	Upward       []float64    // mean luminous intensity (cd) of each patch of the upper hemisphere
+}
+
+// ResampleKlems resamples the luminous intensity distribution of either format onto the Klems full basis. The
+// intensity of each patch is the mean over the patch weighted by solid angle, so the flux of the distribution is
+// preserved. IES type A and B photometry is not supported.
+func ResampleKlems(data PhotometricData) KlemsDistribution {
+	photometry := data.normalizedPhotometry()
+	distribution := KlemsDistribution{
+		Name:         photometry.luminaire,
+		Manufacturer: photometry.manufacturer,
+		Patches:      KlemsBasis(),
+	}
+	distribution.Downward = make([]float64, len(distribution.Patches))
+	distribution.Upward = make([]float64, len(distribution.Patches))
+	if len(photometry.cAngles) == 0 || len(photometry.gAngles) == 0 {
+		return distribution
+	}
+
+	intensity := func(c, gamma float64) float64 {
+		return interpolateIntensity(photometry.cAngles, photometry.gAngles, photometry.planes, normalizeAngle(c), gamma)
+	}
+	for n, patch := range distribution.Patches {
+		distribution.Downward[n] = patch.meanIntensity(func(theta, phi float64) float64 {
+			return intensity(phi, theta)
+		})
+		distribution.Upward[n] = patch.meanIntensity(func(theta, phi float64) float64 {
+			return intensity(phi, 180-theta)
+		})
+	}
+
+	return distribution
+}
+
+// meanIntensity returns the solid angle weighted mean of the intensity over the patch, sampled at the centers of a
+// regular grid in theta and phi.
+func (p KlemsPatch) meanIntensity(intensity func(theta, phi float64) float64) float64 {
+	dTheta := (p.ThetaMax - p.ThetaMin) / klemsSamples
+	dPhi := (p.PhiMax - p.PhiMin) / klemsSamples
+	sum, weights := 0.0, 0.0
+	for t := 0; t < klemsSamples; t++ {
+		theta := p.ThetaMin + (float64(t)+0.5)*dTheta
+		weight := math.Sin(degToRad(theta))
+		for f := 0; f < klemsSamples; f++ {
+			sum += intensity(theta, p.PhiMin+(float64(f)+0.5)*dPhi) * weight
+			weights += weight
+		}
+	}
+	if weights == 0 {
+		return 0
+	}
+
+	return sum / weights
+}
+
+// Flux returns the luminous flux (lm) of both hemispheres.
+func (d KlemsDistribution) Flux() float64 {
+	flux := 0.0
+	for n, patch := range d.Patches {
+		flux += (d.Downward[n] + d.Upward[n]) * patch.SolidAngle
+	}
+
+	return flux
+}
+
+// BSDF returns the BSDF values (1/sr) of the patches of one hemisphere for a fictitious element that redistributes
+// all incident light like the luminaire: the share of the total flux emitted into a patch divided by its projected
+// solid angle. The values do not depend on the incident direction and conserve energy, the hemispherical integral
+// is the share of the flux emitted into the hemisphere.
+func (d KlemsDistribution) BSDF(downward bool) []float64 {
+	intensities := d.Upward
+	if downward {
+		intensities = d.Downward
+	}
+	values := make([]float64, len(d.Patches))
+	flux := d.Flux()
+	if flux <= 0 {
+		return values
+	}
+	for n, patch := range d.Patches {
+		values[n] = intensities[n] * patch.SolidAngle / flux / patch.ProjectedSolid
+	}
+
+	return values
+}
+
+// bsdfDocument is the LBNL WINDOW BSDF XML format read by Radiance, WINDOW and other daylight tools.
+type bsdfDocument struct {
+	XMLName           xml.Name             `xml:"WindowElement"`
+	Namespace         string               `xml:"xmlns,attr"`
+	Version           string               `xml:"version,attr"`
+	WindowElementType string               `xml:"WindowElementType"`
+	FileType          string               `xml:"FileType"`
+	Name              string               `xml:"Optical>Layer>Material>Name"`
+	Manufacturer      string               `xml:"Optical>Layer>Material>Manufacturer"`
+	DeviceType        string               `xml:"Optical>Layer>Material>DeviceType"`
+	IncidentStructure string               `xml:"Optical>Layer>DataDefinition>IncidentDataStructure"`
+	BasisName         string               `xml:"Optical>Layer>DataDefinition>AngleBasis>AngleBasisName"`
+	BasisBlocks       []bsdfBasisBlock     `xml:"Optical>Layer>DataDefinition>AngleBasis>AngleBasisBlock"`
+	WavelengthData    []bsdfWavelengthData `xml:"Optical>Layer>WavelengthData"`
+}
+
+// bsdfBasisBlock is a ring of the angle basis.
+type bsdfBasisBlock struct {
+	Theta      float64 `xml:"Theta"`
+	Phis       int     `xml:"nPhis"`
+	LowerTheta float64 `xml:"ThetaBounds>LowerTheta"`
+	UpperTheta float64 `xml:"ThetaBounds>UpperTheta"`
+}
+
+// bsdfWavelengthData is a BSDF matrix of the visible range.
+type bsdfWavelengthData struct {
+	LayerNumber      string              `xml:"LayerNumber"`
+	Wavelength       bsdfWavelength      `xml:"Wavelength"`
+	SourceSpectrum   string              `xml:"SourceSpectrum"`
+	DetectorSpectrum string              `xml:"DetectorSpectrum"`
+	Block            bsdfWavelengthBlock `xml:"WavelengthDataBlock"`
+}
+
+// bsdfWavelength is the wavelength range of a BSDF matrix.
+type bsdfWavelength struct {
+	Unit  string `xml:"unit,attr"`
+	Value string `xml:",chardata"`
+}
+
+// bsdfWavelengthBlock holds the values of a BSDF matrix.
+type bsdfWavelengthBlock struct {
+	Direction      string   `xml:"WavelengthDataDirection"`
+	ColumnBasis    string   `xml:"ColumnAngleBasis"`
+	RowBasis       string   `xml:"RowAngleBasis"`
+	ScatteringType string   `xml:"ScatteringDataType"`
+	ScatteringData bsdfData `xml:"ScatteringData"`
+}
+
+// bsdfData is the text of a matrix, written unescaped to keep its line breaks.
+type bsdfData struct {
+	Values string `xml:",innerxml"`
+}
+
+// WriteBSDF writes the distribution as LBNL WINDOW BSDF XML file with Klems full basis matrices, see BSDF. Light
+// incident on the front side (from above) is emitted downwards as "Transmission Front" and upwards as "Reflection
+// Front". The matrices list one row per outgoing patch with the value repeated for every incident patch (column).
+func (d KlemsDistribution) WriteBSDF(out io.Writer) error {
+	if d.Flux() <= 0 {
+		return errors.New("distribution emits no luminous flux")
+	}
+
+	document := bsdfDocument{
+		Namespace:         "http://windows.lbl.gov",
+		Version:           "1.1",
+		WindowElementType: "System",
+		FileType:          "BSDF",
+		Name:              d.Name,
+		Manufacturer:      d.Manufacturer,
+		DeviceType:        "Other",
+		IncidentStructure: "Columns",
+		BasisName:         KlemsBasisName,
+	}
+	for ring, r := range klemsRings {
+		theta := (r.thetaMin + r.thetaMax) / 2
+		if ring == 0 {
+			theta = 0
+		}
+		document.BasisBlocks = append(document.BasisBlocks,
+			bsdfBasisBlock{Theta: theta, Phis: r.phis, LowerTheta: r.thetaMin, UpperTheta: r.thetaMax})
+	}
+	for _, matrix := range []struct {
+		direction, scattering string
+		downward              bool
+	}{{"Transmission Front", "BTDF", true}, {"Reflection Front", "BRDF", false}} {
+		document.WavelengthData = append(document.WavelengthData, bsdfWavelengthData{
+			LayerNumber:      "System",
+			Wavelength:       bsdfWavelength{Unit: "Integral", Value: "Visible"},
+			SourceSpectrum:   "CIE Illuminant D65 1nm.ssp",
+			DetectorSpectrum: "ASTM E308 1931 Y.dsp",
+			Block: bsdfWavelengthBlock{
+				Direction:      matrix.direction,
+				ColumnBasis:    KlemsBasisName,
+				RowBasis:       KlemsBasisName,
+				ScatteringType: matrix.scattering,
+				ScatteringData: bsdfData{bsdfMatrix(d.BSDF(matrix.downward))},
+			},
+		})
+	}
+
+	data, err := xml.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return err
+	}
+	if _, err = io.WriteString(out, xml.Header); err != nil {
+		return err
+	}
+	if _, err = out.Write(append(data, '\n')); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bsdfMatrix returns the matrix text with one line per outgoing patch, repeating its value for all incident patches.
+func bsdfMatrix(values []float64) string {
+	var builder strings.Builder
+	builder.WriteString("\n")
+	for _, value := range values {
+		text := strconv.FormatFloat(value, 'g', 6, 64)
+		for n := range values {
+			if n > 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(text)
+		}
+		builder.WriteString("\n")
+	}
+
+	return builder.String()
+}
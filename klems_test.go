@@ -0,0 +1,62 @@
+package eulumies
+
+import (
+	"bytes"
+	"encoding/xml"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKlemsBasis(t *testing.T) {
+	patches := KlemsBasis()
+	require.Len(t, patches, 145)
+	assert.Equal(t, 0.0, patches[0].Theta)
+	assert.Equal(t, 10.0, patches[1].Theta)
+	assert.Equal(t, 82.5, patches[144].Theta)
+	assert.Equal(t, 330.0, patches[144].Phi)
+
+	solid, projected := 0.0, 0.0
+	for _, patch := range patches {
+		solid += patch.SolidAngle
+		projected += patch.ProjectedSolid
+	}
+	assert.InDelta(t, 2*math.Pi, solid, 1e-9)
+	assert.InDelta(t, math.Pi, projected, 1e-9)
+}
+
+func TestResampleKlems(t *testing.T) {
+	eulumdat := loadSample2(t)
+
+	distribution := ResampleKlems(eulumdat)
+	assert.Equal(t, eulumdat.LuminaireName, distribution.Name)
+	assert.InDelta(t, eulumdat.ComputeTotalFlux(), distribution.Flux(), 0.02*eulumdat.ComputeTotalFlux())
+	assert.InDelta(t, eulumdat.IntensityFunc()(0, 0), distribution.Downward[0], 0.05*distribution.Downward[0])
+
+	// the hemispherical integrals are the shares of the flux emitted downwards and upwards
+	downward, upward := distribution.BSDF(true), distribution.BSDF(false)
+	share := 0.0
+	for n, patch := range distribution.Patches {
+		share += (downward[n] + upward[n]) * patch.ProjectedSolid
+	}
+	assert.InDelta(t, 1, share, 1e-9)
+
+	var out bytes.Buffer
+	require.NoError(t, distribution.WriteBSDF(&out))
+	assert.True(t, strings.HasPrefix(out.String(), xml.Header))
+	assert.Contains(t, out.String(), "<AngleBasisName>LBNL/Klems Full</AngleBasisName>")
+	assert.Contains(t, out.String(), "<WavelengthDataDirection>Transmission Front</WavelengthDataDirection>")
+
+	var document bsdfDocument
+	require.NoError(t, xml.Unmarshal(out.Bytes(), &document))
+	require.Len(t, document.BasisBlocks, 9)
+	require.Len(t, document.WavelengthData, 2)
+	rows := strings.Split(strings.TrimSpace(document.WavelengthData[0].Block.ScatteringData.Values), "\n")
+	require.Len(t, rows, 145)
+	assert.Len(t, strings.Split(rows[0], ","), 145)
+
+	assert.Error(t, ResampleKlems(Eulumdat{}).WriteBSDF(&out))
+}
@@ -0,0 +1,96 @@
+package eulumies
+
+import (
+	"fmt"
+	"log"
+	"sync"
+)
+
+// Logger receives the warnings of the parsers, for example about fields exceeding their maximum length in non-strict
+// mode. Implementations can route them into a structured logging framework or collect them per file.
+type Logger interface {
+	Warnf(format string, args ...interface{})
+}
+
+// LoggerFunc adapts a function to the Logger interface.
+type LoggerFunc func(format string, args ...interface{})
+
+// Warnf calls the function.
+func (f LoggerFunc) Warnf(format string, args ...interface{}) {
+	f(format, args...)
+}
+
+// WarningCollector is a Logger collecting all warnings, e.g. to report them per parsed file.
+type WarningCollector struct {
+	Warnings []string
+}
+
+// Warnf appends the formatted warning.
+func (c *WarningCollector) Warnf(format string, args ...interface{}) {
+	c.Warnings = append(c.Warnings, fmt.Sprintf(format, args...))
+}
+
+// standardLogger writes the warnings to the standard logger of the log package.
+var standardLogger = LoggerFunc(func(format string, args ...interface{}) {
+	log.Printf("[W] "+format, args...)
+})
+
+// discardLogger drops all warnings.
+var discardLogger = LoggerFunc(func(string, ...interface{}) {})
+
+var (
+	defaultLoggerMutex sync.RWMutex
+	defaultLogger      Logger = standardLogger
+)
+
+// SetLogger sets the logger used by parsers without a logger in their ParseOptions. By default warnings are written
+// with the log package, nil discards them.
+func SetLogger(logger Logger) {
+	if logger == nil {
+		logger = discardLogger
+	}
+
+	defaultLoggerMutex.Lock()
+	defer defaultLoggerMutex.Unlock()
+	defaultLogger = logger
+}
+
+// ParseOptions controls the parsing of EULUMDAT and IES data.
+type ParseOptions struct {
+	// Strict rejects data violating the format specification instead of warning about it, it enables StrictDefault.
+	Strict bool
+	// Checks selects individual strict checks, e.g. CheckLineLength rejects overlong lines. The parsers only check
+	// the line lengths, use ValidateChecks for the other checks.
+	Checks StrictChecks
+	// Logger receives the warnings of this parser run, the logger set with SetLogger is used if it is nil.
+	Logger Logger
+	// AllowTruncated returns the partially parsed data of files ending within the angles or luminous intensities
+	// instead of an error. The Truncated flag of the result is set and ValuesRead holds the number of intensities read.
+	AllowTruncated bool
+}
+
+// strictChecks returns the strict checks enabled by the options.
+func (o ParseOptions) strictChecks() StrictChecks {
+	if o.Strict {
+		return o.Checks | StrictDefault
+	}
+
+	return o.Checks
+}
+
+// truncated reports whether err is the end of the data that the options allow.
+func (o ParseOptions) truncated(err error) bool {
+	return o.AllowTruncated && err == errUnexpectedEOF
+}
+
+// logger returns the logger of the options, or the default logger.
+func (o ParseOptions) logger() Logger {
+	if o.Logger != nil {
+		return o.Logger
+	}
+
+	defaultLoggerMutex.RLock()
+	defer defaultLoggerMutex.RUnlock()
+
+	return defaultLogger
+}
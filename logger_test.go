@@ -0,0 +1,168 @@
+package eulumies
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// longCompanyLDT returns the sample EULUMDAT data with a company identification exceeding 78 characters.
+func longCompanyLDT(t *testing.T) []byte {
+	data, err := ioutil.ReadFile("test/sample2.ldt")
+	assert.NoError(t, err)
+	lines := strings.SplitN(string(data), "\n", 2)
+
+	return []byte(strings.Repeat("C", 100) + "\r\n" + lines[1])
+}
+
+func TestNewEulumdatWithOptions_Logger(t *testing.T) {
+	data := longCompanyLDT(t)
+
+	var collector WarningCollector
+	_, err := NewEulumdatWithOptions(bytes.NewReader(data), ParseOptions{Logger: &collector})
+	assert.NoError(t, err)
+	assert.Len(t, collector.Warnings, 1)
+	assert.Contains(t, collector.Warnings[0], "100 > 78")
+
+	_, err = NewEulumdatWithOptions(bytes.NewReader(data), ParseOptions{Strict: true, Logger: &collector})
+	assert.Error(t, err)
+	assert.Len(t, collector.Warnings, 1)
+}
+
+func TestParseOptions_Checks(t *testing.T) {
+	data := longCompanyLDT(t)
+
+	var collector WarningCollector
+	eulumdat, err := NewEulumdatWithOptions(bytes.NewReader(data),
+		ParseOptions{Checks: CheckKeywordLegality | CheckRequiredKeywords, Logger: &collector})
+	assert.NoError(t, err)
+	assert.Len(t, collector.Warnings, 1)
+	assert.Empty(t, eulumdat.ValidateChecks(CheckKeywordLegality|CheckRequiredKeywords))
+	assert.Equal(t, ValidationFieldTooLong, eulumdat.ValidateChecks(CheckLineLength)[0].Code)
+
+	_, err = NewEulumdatWithOptions(bytes.NewReader(data), ParseOptions{Checks: CheckLineLength, Logger: &collector})
+	assert.Error(t, err)
+
+	eulumdat.AnglesG[2] = eulumdat.AnglesG[1]
+	eulumdat.LightOutputRatioLuminaire = 84.35
+	assert.NotContains(t, eulumdat.Validate(true), ValidationAngleOrder)
+	issues := eulumdat.ValidateChecks(CheckAngleMonotonicity | CheckFieldWidths)
+	assert.Len(t, issues, 2)
+	assert.Equal(t, ValidationFieldTooWide, issues[0].Code)
+	assert.Equal(t, "LightOutputRatioLuminaire", issues[0].Field)
+	assert.Equal(t, SeverityWarning, issues[0].Severity)
+	assert.Equal(t, ValidationAngleOrder, issues[1].Code)
+	assert.Equal(t, "AnglesG", issues[1].Field)
+}
+
+func TestSetLogger(t *testing.T) {
+	defer SetLogger(standardLogger)
+
+	var warnings []string
+	SetLogger(LoggerFunc(func(format string, args ...interface{}) {
+		warnings = append(warnings, format)
+	}))
+	_, err := NewEulumdat(bytes.NewReader(longCompanyLDT(t)), false)
+	assert.NoError(t, err)
+	assert.Len(t, warnings, 1)
+
+	// the logger of the options takes precedence
+	var collector WarningCollector
+	_, err = NewEulumdatWithOptions(bytes.NewReader(longCompanyLDT(t)), ParseOptions{Logger: &collector})
+	assert.NoError(t, err)
+	assert.Len(t, warnings, 1)
+	assert.Len(t, collector.Warnings, 1)
+
+	SetLogger(nil)
+	_, err = NewEulumdat(bytes.NewReader(longCompanyLDT(t)), false)
+	assert.NoError(t, err)
+	assert.Len(t, warnings, 1)
+}
+
+func TestNewIESFromReaderWithOptions_Logger(t *testing.T) {
+	data, err := ioutil.ReadFile("test/sample.ies")
+	assert.NoError(t, err)
+	lines := strings.Split(string(data), "\n")
+	for n, line := range lines {
+		if strings.HasPrefix(line, "TILT=") {
+			lines[n+1] += strings.Repeat(" ", 100) // line 10 exceeds 80 characters
+			break
+		}
+	}
+
+	var collector WarningCollector
+	ies, err := NewIESFromReaderWithOptions(strings.NewReader(strings.Join(lines, "\n")),
+		ParseOptions{Logger: &collector})
+	assert.NoError(t, err)
+	assert.NotNil(t, ies)
+	assert.Len(t, collector.Warnings, 1)
+	assert.Contains(t, collector.Warnings[0], "line exceeds maximum allowed length")
+}
+
+// truncatedData returns the given test file cut after the given number of lines.
+func truncatedData(t *testing.T, name string, lines int) []byte {
+	data, err := ioutil.ReadFile("test/" + name)
+	assert.NoError(t, err)
+
+	return []byte(strings.Join(strings.SplitAfter(string(data), "\n")[:lines], ""))
+}
+
+func TestParseOptions_AllowTruncatedEulumdat(t *testing.T) {
+	data := truncatedData(t, "sample2.ldt", 3000)
+	_, err := NewEulumdat(bytes.NewReader(data), false)
+	assert.Error(t, err)
+
+	eulumdat, err := NewEulumdatWithOptions(bytes.NewReader(data), ParseOptions{AllowTruncated: true})
+	assert.NoError(t, err)
+	assert.True(t, eulumdat.Truncated)
+	assert.Equal(t, 3000-42-eulumdat.NumberMcCPlanes-eulumdat.NumberNgIntensitiesCPlane, eulumdat.ValuesRead)
+	assert.Len(t, eulumdat.LuminousIntensityDistributionRaw, eulumdat.ValuesRead)
+	assert.Nil(t, eulumdat.LuminousIntensityDistribution)
+	assert.NotEmpty(t, eulumdat.LuminaireName)
+	assert.False(t, eulumdat.Validate(false).Valid())
+
+	eulumdat, err = NewEulumdatWithOptions(bytes.NewReader(truncatedData(t, "sample2.ldt", 35)),
+		ParseOptions{AllowTruncated: true})
+	assert.NoError(t, err)
+	assert.True(t, eulumdat.Truncated)
+	assert.Equal(t, 0, eulumdat.ValuesRead)
+	assert.Equal(t, 0.0, eulumdat.DirectRatios[3])
+	assert.Nil(t, eulumdat.AnglesC)
+
+	_, err = NewEulumdatWithOptions(bytes.NewReader(truncatedData(t, "sample2.ldt", 20)),
+		ParseOptions{AllowTruncated: true})
+	assert.Error(t, err, "the header is not a distribution")
+
+	file, err := ioutil.ReadFile("test/sample2.ldt")
+	assert.NoError(t, err)
+	eulumdat, err = NewEulumdatWithOptions(bytes.NewReader(file), ParseOptions{AllowTruncated: true})
+	assert.NoError(t, err)
+	assert.False(t, eulumdat.Truncated)
+}
+
+func TestParseOptions_AllowTruncatedIES(t *testing.T) {
+	data := truncatedData(t, "sample.ies", 26)
+	_, err := NewIESFromReader(bytes.NewReader(data), false)
+	assert.Error(t, err)
+
+	ies, err := NewIESFromReaderWithOptions(bytes.NewReader(data), ParseOptions{AllowTruncated: true})
+	assert.NoError(t, err)
+	assert.True(t, ies.Truncated)
+	assert.Less(t, ies.ValuesRead, ies.NumberVerticalAngles*ies.NumberHorizontalAngles)
+	assert.Len(t, ies.VerticalAngles, ies.NumberVerticalAngles)
+	assert.Len(t, ies.HorizontalAngles, ies.NumberHorizontalAngles)
+	values := 0
+	for _, plane := range ies.CandelaValues {
+		values += len(plane)
+	}
+	assert.Equal(t, ies.ValuesRead, values)
+	assert.Equal(t, "A SUPER LAMP", ies.Keywords["LUMINAIRE"])
+	assert.Error(t, ies.Validate(false).Err())
+
+	ies, err = NewIESFromReader(bytes.NewReader(truncatedData(t, "sample.ies", 28)), false)
+	assert.NoError(t, err)
+	assert.False(t, ies.Truncated)
+}
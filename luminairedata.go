@@ -0,0 +1,214 @@
+package eulumies
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// LuminaireData describes the LED modules and the driver of a luminaire. It is used to calculate the standard sets of
+// lamps (fields 26a-f) for every driver current, see CalculateEulumdatAssemblies.
+type LuminaireData struct {
+	Name             string            `json:"name" yaml:"name"`
+	PossibleCurrents []int             `json:"possibleCurrents" yaml:"possibleCurrents"` // driver output currents (mA)
+	DriverEfficiency float64           `json:"driverEfficiency" yaml:"driverEfficiency"` // 0 - 1, 0 means no driver losses
+	Modules          []LuminaireModule `json:"modules" yaml:"modules"`
+}
+
+// LuminaireModule describes one type of LED module of the luminaire.
+type LuminaireModule struct {
+	Name             string                 `json:"name" yaml:"name"`
+	Count            int                    `json:"count" yaml:"count"`                       // number of modules of this type
+	ColorTemperature int                    `json:"colorTemperature" yaml:"colorTemperature"` // correlated color temperature (K)
+	Cri              float64                `json:"cri" yaml:"cri"`                           // color rendering index Ra
+	Characteristics  []ModuleCharacteristic `json:"characteristics" yaml:"characteristics"`
+}
+
+// ModuleCharacteristic contains the output of a single module at the given current.
+type ModuleCharacteristic struct {
+	Current      int     `json:"current" yaml:"current"`           // mA
+	LuminousFlux float64 `json:"luminousFlux" yaml:"luminousFlux"` // lm
+	Power        float64 `json:"power" yaml:"power"`               // W
+}
+
+// LoadLuminaireData reads the luminaire data from the given JSON (.json) or YAML (.yaml, .yml) file.
+func LoadLuminaireData(path string) (LuminaireData, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return LuminaireData{}, err
+	}
+	defer file.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return NewLuminaireDataJSON(file)
+	case ".yaml", ".yml":
+		return NewLuminaireDataYAML(file)
+	default:
+		return LuminaireData{}, fmt.Errorf("unknown format of %s, expected .json, .yaml or .yml", path)
+	}
+}
+
+// NewLuminaireDataJSON parses and validates JSON luminaire data.
+func NewLuminaireDataJSON(in io.Reader) (LuminaireData, error) {
+	var data LuminaireData
+	decoder := json.NewDecoder(in)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&data); err != nil {
+		return LuminaireData{}, err
+	}
+
+	return data, data.Validate()
+}
+
+// NewLuminaireDataYAML parses and validates YAML luminaire data.
+func NewLuminaireDataYAML(in io.Reader) (LuminaireData, error) {
+	var data LuminaireData
+	decoder := yaml.NewDecoder(in)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&data); err != nil {
+		return LuminaireData{}, err
+	}
+
+	return data, data.Validate()
+}
+
+// Validate checks that every module provides a characteristic for each possible current.
+func (l LuminaireData) Validate() error {
+	if len(l.PossibleCurrents) == 0 {
+		return fmt.Errorf("luminaire %s has no possible currents", l.Name)
+	}
+	if l.DriverEfficiency < 0 || l.DriverEfficiency > 1 {
+		return fmt.Errorf("driver efficiency %g out of range (0 - 1)", l.DriverEfficiency)
+	}
+	if len(l.Modules) == 0 {
+		return fmt.Errorf("luminaire %s has no modules", l.Name)
+	}
+	for _, module := range l.Modules {
+		if module.Count <= 0 {
+			return fmt.Errorf("module %s: count must be positive", module.Name)
+		}
+		for _, current := range l.PossibleCurrents {
+			characteristic, ok := module.characteristic(current)
+			if !ok {
+				return fmt.Errorf("module %s: no characteristic for %d mA", module.Name, current)
+			}
+			if characteristic.LuminousFlux < 0 || characteristic.Power < 0 {
+				return fmt.Errorf("module %s: negative flux or power at %d mA", module.Name, current)
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetUniqueColorTemperatures returns the sorted color temperatures of the modules operated at the given current.
+func (l LuminaireData) GetUniqueColorTemperatures(current int) []int {
+	var temperatures []int
+	for _, module := range l.Modules {
+		if _, ok := module.characteristic(current); !ok || module.ColorTemperature <= 0 {
+			continue
+		}
+		index := sort.SearchInts(temperatures, module.ColorTemperature)
+		if index < len(temperatures) && temperatures[index] == module.ColorTemperature {
+			continue
+		}
+		temperatures = append(temperatures, 0)
+		copy(temperatures[index+1:], temperatures[index:])
+		temperatures[index] = module.ColorTemperature
+	}
+
+	return temperatures
+}
+
+// GetMinimalCri returns the lowest color rendering index of the modules operated at the given current.
+func (l LuminaireData) GetMinimalCri(current int) float64 {
+	minimum := 0.0
+	for _, module := range l.Modules {
+		if _, ok := module.characteristic(current); !ok {
+			continue
+		}
+		if minimum == 0 || module.Cri < minimum {
+			minimum = module.Cri
+		}
+	}
+
+	return minimum
+}
+
+// GetTotalLuminousFlux returns the sum of the module fluxes (lm) at the given current.
+func (l LuminaireData) GetTotalLuminousFlux(current int) float64 {
+	flux := 0.0
+	for _, module := range l.Modules {
+		if characteristic, ok := module.characteristic(current); ok {
+			flux += float64(module.Count) * characteristic.LuminousFlux
+		}
+	}
+
+	return flux
+}
+
+// GetTotalPower returns the sum of the module powers (W) at the given current, without driver losses.
+func (l LuminaireData) GetTotalPower(current int) float64 {
+	power := 0.0
+	for _, module := range l.Modules {
+		if characteristic, ok := module.characteristic(current); ok {
+			power += float64(module.Count) * characteristic.Power
+		}
+	}
+
+	return power
+}
+
+// GetRealTotalPower returns the power consumption (W) of the luminaire at the given current, including driver losses.
+func (l LuminaireData) GetRealTotalPower(current int) float64 {
+	if l.DriverEfficiency <= 0 {
+		return l.GetTotalPower(current)
+	}
+
+	return l.GetTotalPower(current) / l.DriverEfficiency
+}
+
+// GetNumberOfLamps returns the number of modules per luminous point, at least one.
+func (l LuminaireData) GetNumberOfLamps(luminousPoints float64) int {
+	count := 0
+	for _, module := range l.Modules {
+		count += module.Count
+	}
+	if luminousPoints > 0 {
+		count = int(math.Round(float64(count) / luminousPoints))
+	}
+	if count < 1 {
+		return 1
+	}
+
+	return count
+}
+
+func (m LuminaireModule) characteristic(current int) (ModuleCharacteristic, bool) {
+	for _, characteristic := range m.Characteristics {
+		if characteristic.Current == current {
+			return characteristic, true
+		}
+	}
+
+	return ModuleCharacteristic{}, false
+}
+
+// mapColorTempsToString formats the color temperatures for field 26d, for example "3000K/4000K".
+func mapColorTempsToString(temperatures []int) string {
+	parts := make([]string, len(temperatures))
+	for i, temperature := range temperatures {
+		parts[i] = strconv.Itoa(temperature) + "K"
+	}
+
+	return strings.Join(parts, "/")
+}
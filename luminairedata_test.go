@@ -0,0 +1,45 @@
+package eulumies
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadLuminaireData(t *testing.T) {
+	data, err := LoadLuminaireData("test/luminaire.yaml")
+	assert.NoError(t, err)
+	assert.Equal(t, []int{350, 500, 700}, data.PossibleCurrents)
+	assert.Equal(t, []int{3000, 4000}, data.GetUniqueColorTemperatures(500))
+	assert.Equal(t, 82.0, data.GetMinimalCri(500))
+	assert.Equal(t, 5600.0, data.GetTotalLuminousFlux(500))
+	assert.InDelta(t, 55.2, data.GetTotalPower(500), 1e-9)
+	assert.InDelta(t, 61.33, data.GetRealTotalPower(500), 0.01)
+	assert.Equal(t, 2, data.GetNumberOfLamps(2))
+
+	assemblies, err := CalculateEulumdatAssemblies(data, 1)
+	assert.NoError(t, err)
+	assert.Len(t, assemblies, 3)
+	assert.Equal(t, 700.0, assemblies[0].Current)
+	assert.Equal(t, "3000K/4000K", assemblies[0].ColorTemperature)
+	assert.Equal(t, "82", assemblies[0].ColorRenderingIndex)
+
+	_, err = LoadLuminaireData("test/sample.ldt")
+	assert.Error(t, err)
+}
+
+func TestNewLuminaireDataJSON(t *testing.T) {
+	data, err := NewLuminaireDataJSON(strings.NewReader(`{"name": "Spot", "possibleCurrents": [500],
+		"modules": [{"name": "COB", "count": 1, "colorTemperature": 2700, "cri": 95,
+		"characteristics": [{"current": 500, "luminousFlux": 900, "power": 8}]}]}`))
+	assert.NoError(t, err)
+	assert.Equal(t, 8.0, data.GetRealTotalPower(500))
+
+	_, err = NewLuminaireDataJSON(strings.NewReader(`{"name": "Spot", "possibleCurrents": [350, 500],
+		"modules": [{"name": "COB", "count": 1, "characteristics": [{"current": 500}]}]}`))
+	assert.EqualError(t, err, "module COB: no characteristic for 350 mA")
+
+	_, err = NewLuminaireDataJSON(strings.NewReader(`{"name": "Spot", "current": 500}`))
+	assert.Error(t, err)
+}
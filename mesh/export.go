@@ -0,0 +1,61 @@
+package mesh
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+)
+
+// WriteOBJ writes the mesh in the Wavefront OBJ format.
+func (m Mesh) WriteOBJ(out io.Writer) error {
+	w := bufio.NewWriter(out)
+	fmt.Fprintln(w, "# photometric solid, exported by eulumies")
+	fmt.Fprintln(w, "o photometric_solid")
+	for _, v := range m.Vertices {
+		fmt.Fprintf(w, "v %s %s %s\n", objNumber(v[0]), objNumber(v[1]), objNumber(v[2]))
+	}
+	for _, f := range m.Faces {
+		fmt.Fprintf(w, "f %d %d %d\n", f[0]+1, f[1]+1, f[2]+1) // OBJ indices start at 1
+	}
+
+	return w.Flush()
+}
+
+func objNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', 6, 64)
+}
+
+// WriteSTL writes the mesh in the binary STL format.
+func (m Mesh) WriteSTL(out io.Writer) error {
+	w := bufio.NewWriter(out)
+
+	var header [80]byte
+	copy(header[:], "photometric solid, exported by eulumies")
+	if _, err := w.Write(header[:]); err != nil {
+		return err
+	}
+	if err := binary.Write(w, binary.LittleEndian, uint32(len(m.Faces))); err != nil {
+		return err
+	}
+
+	var triangle [50]byte // normal, three vertices (12 float32 values) and the attribute byte count
+	for _, f := range m.Faces {
+		values := make([]float64, 0, 12)
+		normal := m.faceNormal(f)
+		values = append(values, normal[:]...)
+		for _, index := range f {
+			values = append(values, m.Vertices[index][:]...)
+		}
+		for i, value := range values {
+			binary.LittleEndian.PutUint32(triangle[4*i:], math.Float32bits(float32(value)))
+		}
+		if _, err := w.Write(triangle[:]); err != nil {
+			return err
+		}
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,284 @@
+package mesh
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+)
+
+// glTF constants, see the glTF 2.0 specification.
+const (
+	gltfFloat        = 5126
+	gltfUnsignedInt  = 5125
+	gltfArrayBuffer  = 34962
+	gltfElementArray = 34963
+	gltfLinear       = 9729
+	gltfClampToEdge  = 33071
+
+	glbMagic     = 0x46546C67 // "glTF"
+	glbChunkJSON = 0x4E4F534A // "JSON"
+	glbChunkBIN  = 0x004E4942 // "BIN\0"
+
+	rampSize = 256
+)
+
+type gltfDocument struct {
+	Asset       gltfAsset        `json:"asset"`
+	Scene       int              `json:"scene"`
+	Scenes      []gltfScene      `json:"scenes"`
+	Nodes       []gltfNode       `json:"nodes"`
+	Meshes      []gltfMesh       `json:"meshes"`
+	Materials   []gltfMaterial   `json:"materials"`
+	Textures    []gltfTexture    `json:"textures"`
+	Samplers    []gltfSampler    `json:"samplers"`
+	Images      []gltfImage      `json:"images"`
+	Accessors   []gltfAccessor   `json:"accessors"`
+	BufferViews []gltfBufferView `json:"bufferViews"`
+	Buffers     []gltfBuffer     `json:"buffers"`
+}
+
+type gltfAsset struct {
+	Version   string `json:"version"`
+	Generator string `json:"generator"`
+}
+
+type gltfScene struct {
+	Nodes []int `json:"nodes"`
+}
+
+type gltfNode struct {
+	Name string `json:"name"`
+	Mesh int    `json:"mesh"`
+}
+
+type gltfMesh struct {
+	Primitives []gltfPrimitive `json:"primitives"`
+}
+
+type gltfPrimitive struct {
+	Attributes map[string]int `json:"attributes"`
+	Indices    int            `json:"indices"`
+	Material   int            `json:"material"`
+}
+
+type gltfMaterial struct {
+	PbrMetallicRoughness gltfPbr `json:"pbrMetallicRoughness"`
+	DoubleSided          bool    `json:"doubleSided"`
+}
+
+type gltfPbr struct {
+	BaseColorTexture gltfTextureInfo `json:"baseColorTexture"`
+	MetallicFactor   float64         `json:"metallicFactor"`
+	RoughnessFactor  float64         `json:"roughnessFactor"`
+}
+
+type gltfTextureInfo struct {
+	Index int `json:"index"`
+}
+
+type gltfTexture struct {
+	Sampler int `json:"sampler"`
+	Source  int `json:"source"`
+}
+
+type gltfSampler struct {
+	MagFilter int `json:"magFilter"`
+	MinFilter int `json:"minFilter"`
+	WrapS     int `json:"wrapS"`
+	WrapT     int `json:"wrapT"`
+}
+
+type gltfImage struct {
+	BufferView int    `json:"bufferView"`
+	MimeType   string `json:"mimeType"`
+}
+
+type gltfAccessor struct {
+	BufferView    int       `json:"bufferView"`
+	ComponentType int       `json:"componentType"`
+	Count         int       `json:"count"`
+	Type          string    `json:"type"`
+	Min           []float64 `json:"min,omitempty"`
+	Max           []float64 `json:"max,omitempty"`
+}
+
+type gltfBufferView struct {
+	Buffer     int `json:"buffer"`
+	ByteOffset int `json:"byteOffset"`
+	ByteLength int `json:"byteLength"`
+	Target     int `json:"target,omitempty"`
+}
+
+type gltfBuffer struct {
+	ByteLength int    `json:"byteLength"`
+	URI        string `json:"uri,omitempty"`
+}
+
+// WriteGLTF writes the mesh as glTF 2.0 document with the binary data embedded as data URI. The surface is textured
+// with a color ramp from blue (no intensity) to red (peak intensity).
+func (m Mesh) WriteGLTF(out io.Writer) error {
+	document, data, err := m.gltf()
+	if err != nil {
+		return err
+	}
+	document.Buffers[0].URI = "data:application/octet-stream;base64," + base64.StdEncoding.EncodeToString(data)
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(document)
+}
+
+// WriteGLB writes the mesh as binary glTF 2.0 (GLB) file, see WriteGLTF.
+func (m Mesh) WriteGLB(out io.Writer) error {
+	document, data, err := m.gltf()
+	if err != nil {
+		return err
+	}
+	content, err := json.Marshal(document)
+	if err != nil {
+		return err
+	}
+	content = pad(content, ' ')
+	data = pad(data, 0)
+
+	header := []uint32{
+		glbMagic, 2, uint32(12 + 8 + len(content) + 8 + len(data)),
+		uint32(len(content)), glbChunkJSON,
+	}
+	if err := binary.Write(out, binary.LittleEndian, header); err != nil {
+		return err
+	}
+	if _, err := out.Write(content); err != nil {
+		return err
+	}
+	if err := binary.Write(out, binary.LittleEndian, []uint32{uint32(len(data)), glbChunkBIN}); err != nil {
+		return err
+	}
+	_, err = out.Write(data)
+	return err
+}
+
+// gltf builds the glTF document and the binary buffer. The mesh is rotated into the Y-up coordinate system of glTF,
+// so that nadir points along -y.
+func (m Mesh) gltf() (gltfDocument, []byte, error) {
+	peak := 0.0
+	for _, value := range m.Intensities {
+		peak = math.Max(peak, value)
+	}
+
+	normals := m.vertexNormals()
+	positions := make([]float32, 0, 3*len(m.Vertices))
+	normalData := make([]float32, 0, 3*len(m.Vertices))
+	texCoords := make([]float32, 0, 2*len(m.Vertices))
+	min := []float64{math.Inf(1), math.Inf(1), math.Inf(1)}
+	max := []float64{math.Inf(-1), math.Inf(-1), math.Inf(-1)}
+	for i, v := range m.Vertices {
+		position := [3]float64{v[0], v[2], -v[1]}
+		for axis, value := range position {
+			positions = append(positions, float32(value))
+			min[axis] = math.Min(min[axis], float64(float32(value)))
+			max[axis] = math.Max(max[axis], float64(float32(value)))
+		}
+		normalData = append(normalData, float32(normals[i][0]), float32(normals[i][2]), float32(-normals[i][1]))
+
+		relative := 0.0
+		if peak > 0 {
+			relative = math.Max(0, math.Min(1, m.Intensities[i]/peak))
+		}
+		// sample the texel centers of the ramp
+		texCoords = append(texCoords, float32((0.5+relative*(rampSize-1))/rampSize), 0.5)
+	}
+	indices := make([]uint32, 0, 3*len(m.Faces))
+	for _, f := range m.Faces {
+		indices = append(indices, uint32(f[0]), uint32(f[1]), uint32(f[2]))
+	}
+
+	var ramp bytes.Buffer
+	if err := png.Encode(&ramp, colorRamp()); err != nil {
+		return gltfDocument{}, nil, err
+	}
+
+	var data bytes.Buffer
+	var views []gltfBufferView
+	appendView := func(values interface{}, target int) {
+		for data.Len()%4 != 0 {
+			data.WriteByte(0)
+		}
+		offset := data.Len()
+		_ = binary.Write(&data, binary.LittleEndian, values)
+		views = append(views, gltfBufferView{ByteOffset: offset, ByteLength: data.Len() - offset, Target: target})
+	}
+	appendView(positions, gltfArrayBuffer)
+	appendView(normalData, gltfArrayBuffer)
+	appendView(texCoords, gltfArrayBuffer)
+	appendView(indices, gltfElementArray)
+	appendView(ramp.Bytes(), 0)
+
+	document := gltfDocument{
+		Asset:  gltfAsset{Version: "2.0", Generator: "eulumies"},
+		Scenes: []gltfScene{{Nodes: []int{0}}},
+		Nodes:  []gltfNode{{Name: "photometric_solid", Mesh: 0}},
+		Meshes: []gltfMesh{{Primitives: []gltfPrimitive{{
+			Attributes: map[string]int{"POSITION": 0, "NORMAL": 1, "TEXCOORD_0": 2},
+			Indices:    3,
+		}}}},
+		Materials: []gltfMaterial{{
+			PbrMetallicRoughness: gltfPbr{MetallicFactor: 0, RoughnessFactor: 1},
+			DoubleSided:          true,
+		}},
+		Textures: []gltfTexture{{}},
+		Samplers: []gltfSampler{{MagFilter: gltfLinear, MinFilter: gltfLinear, WrapS: gltfClampToEdge, WrapT: gltfClampToEdge}},
+		Images:   []gltfImage{{BufferView: 4, MimeType: "image/png"}},
+		Accessors: []gltfAccessor{
+			{BufferView: 0, ComponentType: gltfFloat, Count: len(m.Vertices), Type: "VEC3", Min: min, Max: max},
+			{BufferView: 1, ComponentType: gltfFloat, Count: len(m.Vertices), Type: "VEC3"},
+			{BufferView: 2, ComponentType: gltfFloat, Count: len(m.Vertices), Type: "VEC2"},
+			{BufferView: 3, ComponentType: gltfUnsignedInt, Count: len(indices), Type: "SCALAR"},
+		},
+		BufferViews: views,
+		Buffers:     []gltfBuffer{{ByteLength: data.Len()}},
+	}
+
+	return document, data.Bytes(), nil
+}
+
+// colorRamp returns the texture mapping the relative intensity (u coordinate) to a color from blue over cyan, green
+// and yellow to red.
+func colorRamp() image.Image {
+	stops := []color.RGBA{
+		{R: 0, G: 0, B: 255, A: 255},
+		{R: 0, G: 255, B: 255, A: 255},
+		{R: 0, G: 255, B: 0, A: 255},
+		{R: 255, G: 255, B: 0, A: 255},
+		{R: 255, G: 0, B: 0, A: 255},
+	}
+
+	ramp := image.NewRGBA(image.Rect(0, 0, rampSize, 1))
+	for x := 0; x < rampSize; x++ {
+		position := float64(x) / (rampSize - 1) * float64(len(stops)-1)
+		index := int(math.Min(position, float64(len(stops)-2)))
+		t := position - float64(index)
+		mix := func(a, b uint8) uint8 {
+			return uint8(math.Round(float64(a)*(1-t) + float64(b)*t))
+		}
+		from, to := stops[index], stops[index+1]
+		ramp.SetRGBA(x, 0, color.RGBA{R: mix(from.R, to.R), G: mix(from.G, to.G), B: mix(from.B, to.B), A: 255})
+	}
+
+	return ramp
+}
+
+// pad appends the filler byte until the length is a multiple of four, as required for GLB chunks.
+func pad(data []byte, filler byte) []byte {
+	for len(data)%4 != 0 {
+		data = append(data, filler)
+	}
+
+	return data
+}
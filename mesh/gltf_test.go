@@ -0,0 +1,81 @@
+package mesh
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"image/color"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMesh_WriteGLTF(t *testing.T) {
+	m := NewMesh(uniformPhotometry(100), Options{CStep: 90, GammaStep: 90})
+
+	var out bytes.Buffer
+	assert.NoError(t, m.WriteGLTF(&out))
+	var document gltfDocument
+	assert.NoError(t, json.Unmarshal(out.Bytes(), &document))
+	assert.Equal(t, "2.0", document.Asset.Version)
+	assert.Equal(t, 6, document.Accessors[0].Count)
+	assert.Equal(t, 24, document.Accessors[3].Count)
+	assert.Equal(t, []float64{-1, -1, -1}, document.Accessors[0].Min)
+	assert.Equal(t, []float64{1, 1, 1}, document.Accessors[0].Max)
+
+	uri := document.Buffers[0].URI
+	assert.True(t, strings.HasPrefix(uri, "data:application/octet-stream;base64,"))
+	data, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(uri, "data:application/octet-stream;base64,"))
+	assert.NoError(t, err)
+	assert.Len(t, data, document.Buffers[0].ByteLength)
+	for _, view := range document.BufferViews {
+		assert.Equal(t, 0, view.ByteOffset%4)
+		assert.LessOrEqual(t, view.ByteOffset+view.ByteLength, len(data))
+	}
+
+	// nadir is rotated to -y
+	nadirY := math.Float32frombits(binary.LittleEndian.Uint32(data[4:]))
+	assert.Equal(t, float32(-1), nadirY)
+	image := data[document.BufferViews[4].ByteOffset:]
+	assert.Equal(t, "\x89PNG", string(image[:4]))
+}
+
+func TestMesh_WriteGLB(t *testing.T) {
+	m := NewMesh(uniformPhotometry(100), Options{CStep: 30, GammaStep: 30})
+
+	var out bytes.Buffer
+	assert.NoError(t, m.WriteGLB(&out))
+	data := out.Bytes()
+	assert.Equal(t, "glTF", string(data[:4]))
+	assert.Equal(t, uint32(2), binary.LittleEndian.Uint32(data[4:]))
+	assert.Equal(t, uint32(len(data)), binary.LittleEndian.Uint32(data[8:]))
+	assert.Equal(t, 0, len(data)%4)
+
+	jsonLength := binary.LittleEndian.Uint32(data[12:])
+	assert.Equal(t, "JSON", string(data[16:20]))
+	var document gltfDocument
+	assert.NoError(t, json.Unmarshal(data[20:20+jsonLength], &document))
+	assert.Empty(t, document.Buffers[0].URI)
+	binLength := binary.LittleEndian.Uint32(data[20+jsonLength:])
+	assert.Equal(t, "BIN\x00", string(data[24+jsonLength:28+jsonLength]))
+	assert.GreaterOrEqual(t, int(binLength), document.Buffers[0].ByteLength)
+}
+
+func Test_colorRamp(t *testing.T) {
+	ramp := colorRamp()
+	assert.Equal(t, rampSize, ramp.Bounds().Dx())
+	assert.Equal(t, color.RGBA{B: 255, A: 255}, ramp.At(0, 0))
+	assert.Equal(t, color.RGBA{R: 255, A: 255}, ramp.At(rampSize-1, 0))
+}
+
+func TestMesh_vertexNormals(t *testing.T) {
+	m := NewMesh(uniformPhotometry(1), Options{})
+	for i, n := range m.vertexNormals() {
+		assert.InDelta(t, 1, math.Sqrt(n[0]*n[0]+n[1]*n[1]+n[2]*n[2]), 1e-9)
+		// normals of a sphere point away from the center
+		assert.Greater(t, n[0]*m.Vertices[i][0]+n[1]*m.Vertices[i][1]+n[2]*m.Vertices[i][2], 0.9)
+	}
+}
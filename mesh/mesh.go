@@ -0,0 +1,153 @@
+// Package mesh exports the photometric solid of EULUMDAT and IES data as 3D mesh. The distance of every vertex from
+// the origin is proportional to the luminous intensity in its direction.
+package mesh
+
+import (
+	"math"
+
+	"github.com/h44z/eulumies"
+)
+
+// Photometry is implemented by eulumies.Eulumdat and *eulumies.IES.
+type Photometry interface {
+	IntensityFunc() eulumies.IntensityFunc
+}
+
+// Options controls the tessellation of the photometric solid.
+type Options struct {
+	CStep     float64 // distance of the C-planes in degrees, defaults to 5
+	GammaStep float64 // distance of the gamma angles in degrees, defaults to 5
+	Scale     float64 // radius of the peak intensity, defaults to 1
+}
+
+func (o Options) withDefaults() Options {
+	if o.CStep <= 0 || o.CStep > 90 {
+		o.CStep = 5
+	}
+	if o.GammaStep <= 0 || o.GammaStep > 90 {
+		o.GammaStep = 5
+	}
+	if o.Scale <= 0 {
+		o.Scale = 1
+	}
+
+	return o
+}
+
+// Mesh is a closed triangle mesh of the photometric solid. The coordinate system matches the luminaire:
+// C0 points along +x, C90 along +y and nadir (gamma 0) along -z.
+type Mesh struct {
+	Vertices    [][3]float64
+	Intensities []float64 // luminous intensity (cd) of each vertex
+	Faces       [][3]int  // vertex indices, counterclockwise when seen from outside
+}
+
+// NewMesh samples the luminous intensity distribution on a regular C/gamma grid and triangulates it.
+func NewMesh(photometry Photometry, opts Options) Mesh {
+	opts = opts.withDefaults()
+	intensity := photometry.IntensityFunc()
+
+	planes := int(math.Round(360 / opts.CStep))
+	rings := int(math.Round(180 / opts.GammaStep))
+	cStep := 360 / float64(planes)
+	gammaStep := 180 / float64(rings)
+
+	var m Mesh
+	add := func(c, gamma float64) {
+		direction := eulumies.CGammaToDirection(c, gamma)
+		value := intensity(c, gamma)
+		m.Vertices = append(m.Vertices, [3]float64{value * direction[0], value * direction[1], value * direction[2]})
+		m.Intensities = append(m.Intensities, value)
+	}
+
+	// vertex 0 is nadir, followed by the rings from nadir upwards and zenith as last vertex
+	add(0, 0)
+	for ring := 1; ring < rings; ring++ {
+		for plane := 0; plane < planes; plane++ {
+			add(float64(plane)*cStep, float64(ring)*gammaStep)
+		}
+	}
+	add(0, 180)
+
+	vertex := func(ring, plane int) int {
+		return 1 + (ring-1)*planes + plane%planes
+	}
+	zenith := len(m.Vertices) - 1
+	for plane := 0; plane < planes; plane++ {
+		m.Faces = append(m.Faces, [3]int{0, vertex(1, plane+1), vertex(1, plane)})
+		for ring := 1; ring+1 < rings; ring++ {
+			a, b := vertex(ring, plane), vertex(ring, plane+1)
+			c, d := vertex(ring+1, plane+1), vertex(ring+1, plane)
+			m.Faces = append(m.Faces, [3]int{a, b, d}, [3]int{b, c, d})
+		}
+		m.Faces = append(m.Faces, [3]int{vertex(rings-1, plane), vertex(rings-1, plane+1), zenith})
+	}
+
+	m.normalize(opts.Scale)
+	return m
+}
+
+// normalize scales the vertices so that the peak intensity has the given distance from the origin.
+func (m *Mesh) normalize(scale float64) {
+	peak := 0.0
+	for _, value := range m.Intensities {
+		peak = math.Max(peak, value)
+	}
+	if peak <= 0 {
+		return
+	}
+
+	for i := range m.Vertices {
+		for axis := range m.Vertices[i] {
+			m.Vertices[i][axis] *= scale / peak
+		}
+	}
+}
+
+// faceNormal returns the unit normal of the face, or the zero vector for degenerate faces.
+func (m Mesh) faceNormal(face [3]int) [3]float64 {
+	return normalize(m.faceCross(face))
+}
+
+// faceCross returns the cross product of two face edges, its length is twice the face area.
+func (m Mesh) faceCross(face [3]int) [3]float64 {
+	a, b, c := m.Vertices[face[0]], m.Vertices[face[1]], m.Vertices[face[2]]
+	u := [3]float64{b[0] - a[0], b[1] - a[1], b[2] - a[2]}
+	v := [3]float64{c[0] - a[0], c[1] - a[1], c[2] - a[2]}
+
+	return [3]float64{u[1]*v[2] - u[2]*v[1], u[2]*v[0] - u[0]*v[2], u[0]*v[1] - u[1]*v[0]}
+}
+
+// normalize returns the vector scaled to unit length, or the zero vector.
+func normalize(v [3]float64) [3]float64 {
+	length := math.Sqrt(v[0]*v[0] + v[1]*v[1] + v[2]*v[2])
+	if length == 0 {
+		return [3]float64{}
+	}
+
+	return [3]float64{v[0] / length, v[1] / length, v[2] / length}
+}
+
+// vertexNormals returns the unit vertex normals, averaged from the normals of the adjacent faces weighted by area.
+// Vertices surrounded by degenerate faces only (directions without intensity) get an upward normal.
+func (m Mesh) vertexNormals() [][3]float64 {
+	sums := make([][3]float64, len(m.Vertices))
+	for _, f := range m.Faces {
+		n := m.faceCross(f)
+		for _, index := range f {
+			for axis := range n {
+				sums[index][axis] += n[axis]
+			}
+		}
+	}
+
+	normals := make([][3]float64, len(m.Vertices))
+	for i := range sums {
+		normals[i] = normalize(sums[i])
+		if normals[i] == ([3]float64{}) {
+			normals[i] = [3]float64{0, 0, 1}
+		}
+	}
+
+	return normals
+}
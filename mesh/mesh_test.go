@@ -0,0 +1,91 @@
+package mesh
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"math"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/h44z/eulumies"
+	"github.com/stretchr/testify/assert"
+)
+
+type uniformPhotometry float64
+
+func (p uniformPhotometry) IntensityFunc() eulumies.IntensityFunc {
+	return func(c, gamma float64) float64 {
+		return float64(p)
+	}
+}
+
+// volume returns the signed volume enclosed by the mesh, positive for outward facing triangles.
+func volume(m Mesh) float64 {
+	sum := 0.0
+	for _, f := range m.Faces {
+		a, b, c := m.Vertices[f[0]], m.Vertices[f[1]], m.Vertices[f[2]]
+		sum += a[0]*(b[1]*c[2]-b[2]*c[1]) - a[1]*(b[0]*c[2]-b[2]*c[0]) + a[2]*(b[0]*c[1]-b[1]*c[0])
+	}
+
+	return sum / 6
+}
+
+func TestNewMesh(t *testing.T) {
+	m := NewMesh(uniformPhotometry(500), Options{CStep: 10, GammaStep: 10, Scale: 2})
+	assert.Len(t, m.Vertices, 2+17*36)
+	assert.Len(t, m.Faces, 2*36+2*16*36)
+	assert.Equal(t, [3]float64{0, 0, -2}, m.Vertices[0])
+	assert.Equal(t, 500.0, m.Intensities[0])
+	for _, v := range m.Vertices {
+		assert.InDelta(t, 2, math.Sqrt(v[0]*v[0]+v[1]*v[1]+v[2]*v[2]), 1e-9)
+	}
+	// sphere with radius 2, slightly smaller due to the tessellation
+	assert.InDelta(t, 4.0/3*math.Pi*8, volume(m), 0.5)
+
+	file, err := os.Open("../test/sample2.ldt")
+	assert.NoError(t, err)
+	defer file.Close()
+	eulumdat, err := eulumies.NewEulumdat(file, false)
+	assert.NoError(t, err)
+	m = NewMesh(eulumdat, Options{})
+	assert.Len(t, m.Vertices, 2+35*72)
+	assert.Greater(t, volume(m), 0.0)
+	assert.InDelta(t, 0, m.Vertices[len(m.Vertices)-1][2], 1e-9) // no upward light
+}
+
+func TestMesh_WriteOBJ(t *testing.T) {
+	m := NewMesh(uniformPhotometry(1), Options{CStep: 90, GammaStep: 90})
+
+	var out bytes.Buffer
+	assert.NoError(t, m.WriteOBJ(&out))
+	obj := out.String()
+	vertices, faces := 0, 0
+	scanner := bufio.NewScanner(strings.NewReader(obj))
+	for scanner.Scan() {
+		switch {
+		case strings.HasPrefix(scanner.Text(), "v "):
+			vertices++
+		case strings.HasPrefix(scanner.Text(), "f "):
+			faces++
+		}
+	}
+	assert.Equal(t, 6, vertices) // octahedron
+	assert.Equal(t, 8, faces)
+	assert.Contains(t, obj, "v 0.000000 0.000000 -1.000000")
+}
+
+func TestMesh_WriteSTL(t *testing.T) {
+	m := NewMesh(uniformPhotometry(1), Options{CStep: 90, GammaStep: 90})
+
+	var out bytes.Buffer
+	assert.NoError(t, m.WriteSTL(&out))
+	data := out.Bytes()
+	assert.Len(t, data, 84+8*50)
+	assert.Equal(t, uint32(8), binary.LittleEndian.Uint32(data[80:]))
+
+	// the normal of the first face points downwards and outwards
+	normalZ := math.Float32frombits(binary.LittleEndian.Uint32(data[84+8:]))
+	assert.Less(t, normalZ, float32(0))
+}
@@ -0,0 +1,151 @@
+// Package objectstore loads photometric files directly from S3-compatible object storage (AWS S3, Google Cloud
+// Storage, MinIO, ...). The object is parsed while it is downloaded, it is never buffered completely.
+//
+// The module does not depend on a cloud SDK: authenticated access is done by passing the client of the application
+// wrapped as Getter, public buckets can be read with HTTPGetter and single objects with a presigned URL via LoadURL.
+package objectstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/h44z/eulumies"
+)
+
+// Getter returns the content of an object, for example by calling GetObject of the AWS SDK and returning the body.
+type Getter interface {
+	GetObject(ctx context.Context, bucket, key string) (io.ReadCloser, error)
+}
+
+// GetterFunc adapts a function to the Getter interface.
+type GetterFunc func(ctx context.Context, bucket, key string) (io.ReadCloser, error)
+
+// GetObject calls f(ctx, bucket, key).
+func (f GetterFunc) GetObject(ctx context.Context, bucket, key string) (io.ReadCloser, error) {
+	return f(ctx, bucket, key)
+}
+
+// HTTPGetter reads objects of public buckets with unauthenticated path-style requests, for example from
+// https://s3.eu-central-1.amazonaws.com or https://storage.googleapis.com.
+type HTTPGetter struct {
+	Endpoint string
+	Client   *http.Client // http.DefaultClient if nil
+}
+
+// GetObject requests <Endpoint>/<bucket>/<key>.
+func (g HTTPGetter) GetObject(ctx context.Context, bucket, key string) (io.ReadCloser, error) {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	objectURL := strings.TrimRight(g.Endpoint, "/") + "/" + url.PathEscape(bucket) + "/" + strings.Join(segments, "/")
+
+	return get(ctx, g.Client, objectURL)
+}
+
+// Photometry is a loaded photometric file, exactly one of Eulumdat and IES is set.
+type Photometry struct {
+	Key      string
+	Eulumdat *eulumies.Eulumdat
+	IES      *eulumies.IES
+}
+
+// Load reads and parses the object. The format is selected by the extension of the key (.ldt or .ies, optionally
+// followed by .gz), gzip compressed content is decompressed automatically.
+func Load(ctx context.Context, getter Getter, bucket, key string, strict bool) (Photometry, error) {
+	format, err := formatOf(key)
+	if err != nil {
+		return Photometry{}, err
+	}
+
+	body, err := getter.GetObject(ctx, bucket, key)
+	if err != nil {
+		return Photometry{}, fmt.Errorf("failed to get %s/%s: %v", bucket, key, err)
+	}
+	defer body.Close()
+
+	return parse(body, key, format, strict)
+}
+
+// LoadURL reads and parses the object of a presigned (or public) URL, the format is selected by the extension of
+// the URL path. If client is nil http.DefaultClient is used.
+func LoadURL(ctx context.Context, client *http.Client, objectURL string, strict bool) (Photometry, error) {
+	parsed, err := url.Parse(objectURL)
+	if err != nil {
+		return Photometry{}, err
+	}
+	key := strings.TrimPrefix(parsed.Path, "/")
+	format, err := formatOf(key)
+	if err != nil {
+		return Photometry{}, err
+	}
+
+	body, err := get(ctx, client, objectURL)
+	if err != nil {
+		return Photometry{}, fmt.Errorf("failed to get %s: %v", key, err)
+	}
+	defer body.Close()
+
+	return parse(body, key, format, strict)
+}
+
+func get(ctx context.Context, client *http.Client, objectURL string) (io.ReadCloser, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	request, err := http.NewRequest(http.MethodGet, objectURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := client.Do(request.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode != http.StatusOK {
+		response.Body.Close()
+		return nil, errors.New(response.Status)
+	}
+
+	return response.Body, nil
+}
+
+func parse(in io.Reader, key, format string, strict bool) (Photometry, error) {
+	photometry := Photometry{Key: key}
+	if format == "ies" {
+		ies, err := eulumies.NewIESFromReader(in, strict)
+		if err != nil {
+			return Photometry{}, fmt.Errorf("failed to parse %s: %v", key, err)
+		}
+		photometry.IES = ies
+
+		return photometry, nil
+	}
+
+	eulumdat, err := eulumies.NewEulumdat(in, strict)
+	if err != nil {
+		return Photometry{}, fmt.Errorf("failed to parse %s: %v", key, err)
+	}
+	photometry.Eulumdat = &eulumdat
+
+	return photometry, nil
+}
+
+// formatOf returns ldt or ies depending on the extension of the key.
+func formatOf(key string) (string, error) {
+	name, _ := url.PathUnescape(strings.TrimSuffix(strings.ToLower(key), ".gz"))
+	switch path.Ext(name) {
+	case ".ldt":
+		return "ldt", nil
+	case ".ies":
+		return "ies", nil
+	default:
+		return "", fmt.Errorf("unknown format of %s, expected .ldt or .ies", key)
+	}
+}
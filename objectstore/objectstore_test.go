@@ -0,0 +1,60 @@
+package objectstore
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	getter := GetterFunc(func(ctx context.Context, bucket, key string) (io.ReadCloser, error) {
+		assert.Equal(t, "photometry", bucket)
+		return os.Open("../test/" + key)
+	})
+
+	photometry, err := Load(context.Background(), getter, "photometry", "sample2.ldt", false)
+	require.NoError(t, err)
+	assert.Equal(t, "sample2.ldt", photometry.Key)
+	require.NotNil(t, photometry.Eulumdat)
+	assert.Nil(t, photometry.IES)
+	assert.Equal(t, 4, photometry.Eulumdat.SymmetryIndicator)
+
+	_, err = Load(context.Background(), getter, "photometry", "sample.txt", false)
+	assert.Error(t, err)
+
+	_, err = Load(context.Background(), getter, "photometry", "missing.ies", false)
+	assert.Error(t, err)
+}
+
+func TestHTTPGetter(t *testing.T) {
+	server := httptest.NewServer(http.StripPrefix("/photometry/", http.FileServer(http.Dir("../test"))))
+	defer server.Close()
+
+	getter := HTTPGetter{Endpoint: server.URL + "/"}
+	photometry, err := Load(context.Background(), getter, "photometry", "ADL110.XTM5M.9540.61 - S1.ies", false)
+	require.NoError(t, err)
+	require.NotNil(t, photometry.IES)
+	assert.NotEmpty(t, photometry.IES.VerticalAngles)
+
+	_, err = Load(context.Background(), getter, "photometry", "missing.ldt", false)
+	assert.Error(t, err)
+}
+
+func TestLoadURL(t *testing.T) {
+	server := httptest.NewServer(http.FileServer(http.Dir("../test")))
+	defer server.Close()
+
+	photometry, err := LoadURL(context.Background(), nil, server.URL+"/sample.ies?X-Amz-Signature=abc", false)
+	require.NoError(t, err)
+	assert.Equal(t, "sample.ies", photometry.Key)
+	require.NotNil(t, photometry.IES)
+
+	_, err = LoadURL(context.Background(), nil, server.URL+"/sample", false)
+	assert.Error(t, err)
+}
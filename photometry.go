@@ -0,0 +1,765 @@
+package eulumies
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+// SpacingCriterion holds the spacing to mounting height ratios along the principal planes and the diagonal.
+type SpacingCriterion struct {
+	C0C180   float64 // luminaires aligned along the C0-C180 plane
+	C90C270  float64 // luminaires aligned along the C90-C270 plane
+	Diagonal float64 // luminaires aligned along the C45-C225 plane
+}
+
+// degToRad converts the given angle from degrees to radians.
+func degToRad(angle float64) float64 {
+	return angle * math.Pi / 180
+}
+
+// normalizeAngle maps the given angle (in degrees) to the range [0, 360).
+func normalizeAngle(angle float64) float64 {
+	angle = math.Mod(angle, 360)
+	if angle < 0 {
+		angle += 360
+	}
+	return angle
+}
+
+// radToDeg converts the given angle from radians to degrees.
+func radToDeg(angle float64) float64 {
+	return angle * 180 / math.Pi
+}
+
+// mirrorPlanes adds the planes produced by the given mirror function to the distribution.
+// The returned C-plane angles are normalized to [0, 360), sorted and free of duplicates.
+func mirrorPlanes(angles []float64, planes [][]float64, mirror func(float64) float64) ([]float64, [][]float64) {
+	mirroredAngles := make([]float64, 0, 2*len(angles))
+	mirroredPlanes := make([][]float64, 0, 2*len(planes))
+	mirroredAngles = append(mirroredAngles, angles...)
+	mirroredPlanes = append(mirroredPlanes, planes...)
+	for i := range angles {
+		mirroredAngles = append(mirroredAngles, mirror(angles[i]))
+		mirroredPlanes = append(mirroredPlanes, planes[i])
+	}
+
+	return normalizePlanes(mirroredAngles, mirroredPlanes)
+}
+
+// normalizePlanes normalizes all C-plane angles to [0, 360), sorts the planes by angle and removes duplicate planes.
+func normalizePlanes(angles []float64, planes [][]float64) ([]float64, [][]float64) {
+	indices := make([]int, len(angles))
+	normalized := make([]float64, len(angles))
+	for i := range angles {
+		indices[i] = i
+		normalized[i] = normalizeAngle(angles[i])
+	}
+	sort.SliceStable(indices, func(a, b int) bool {
+		return normalized[indices[a]] < normalized[indices[b]]
+	})
+
+	resultAngles := make([]float64, 0, len(angles))
+	resultPlanes := make([][]float64, 0, len(planes))
+	for _, idx := range indices {
+		if len(resultAngles) > 0 && math.Abs(resultAngles[len(resultAngles)-1]-normalized[idx]) < 1e-9 {
+			continue // duplicate plane, the first occurrence wins
+		}
+		resultAngles = append(resultAngles, normalized[idx])
+		resultPlanes = append(resultPlanes, planes[idx])
+	}
+
+	return resultAngles, resultPlanes
+}
+
+// sectorWidths returns the azimuthal width (in radians) of the sector represented by each C-plane.
+// The angles must be sorted and normalized to [0, 360). A single plane represents the full circle.
+func sectorWidths(angles []float64) []float64 {
+	widths := make([]float64, len(angles))
+	if len(angles) == 1 {
+		widths[0] = 2 * math.Pi
+		return widths
+	}
+
+	for i := range angles {
+		var prev, next float64
+		if i == 0 {
+			prev = angles[len(angles)-1] - 360
+		} else {
+			prev = angles[i-1]
+		}
+		if i == len(angles)-1 {
+			next = angles[0] + 360
+		} else {
+			next = angles[i+1]
+		}
+		widths[i] = degToRad((next - prev) / 2)
+	}
+
+	return widths
+}
+
+// zoneBounds returns the lower and upper gamma bound (in radians) of the zone represented by the given gamma angle.
+func zoneBounds(angles []float64, index int) (float64, float64) {
+	lower := angles[index]
+	upper := angles[index]
+	if index > 0 {
+		lower = (angles[index-1] + angles[index]) / 2
+	}
+	if index < len(angles)-1 {
+		upper = (angles[index] + angles[index+1]) / 2
+	}
+
+	return degToRad(lower), degToRad(upper)
+}
+
+// zonalFluxTypeAB integrates type A and B photometry between the given vertical angles (in degrees). The vertical
+// angles are measured from the horizontal plane (-90 to 90) within planes rotating about the horizontal polar axis,
+// so the solid angle of a cell is cos(V) dV dH. Laterally symmetric data (horizontal angles 0 to 90) only covers one
+// half of the distribution, its flux is doubled.
+func zonalFluxTypeAB(hAngles, vAngles []float64, planes [][]float64, from, to float64) float64 {
+	if len(hAngles) == 0 || len(planes) != len(hAngles) {
+		return 0
+	}
+
+	flux := 0.0
+	for h := range hAngles {
+		left, right := zoneBounds(hAngles, h)
+		if len(hAngles) == 1 {
+			left, right = -math.Pi/2, math.Pi/2
+		}
+		for v := range vAngles {
+			if v >= len(planes[h]) {
+				break
+			}
+			lower, upper := zoneBounds(vAngles, v)
+			lower = math.Max(lower, degToRad(from))
+			upper = math.Min(upper, degToRad(to))
+			if upper <= lower {
+				continue
+			}
+			flux += planes[h][v] * (right - left) * (math.Sin(upper) - math.Sin(lower))
+		}
+	}
+	if len(hAngles) > 1 && hAngles[0] == 0 {
+		flux *= 2
+	}
+
+	return flux
+}
+
+// zonalFlux integrates the intensity distribution over the sphere using the zonal method.
+// The C-plane angles must be normalized and sorted, gamma angles are measured from nadir.
+// planes[c][g] holds the intensity for C-plane c and gamma angle g.
+func zonalFlux(cAngles, gAngles []float64, planes [][]float64) float64 {
+	return zonalFluxBetween(cAngles, gAngles, planes, 0, 180)
+}
+
+// zonalFluxBetween integrates the intensity distribution over the zone between the given gamma angles (in degrees).
+func zonalFluxBetween(cAngles, gAngles []float64, planes [][]float64, gammaFrom, gammaTo float64) float64 {
+	widths := sectorWidths(cAngles)
+	from := degToRad(gammaFrom)
+	to := degToRad(gammaTo)
+	flux := 0.0
+	for g := range gAngles {
+		lower, upper := zoneBounds(gAngles, g)
+		lower = math.Max(lower, from)
+		upper = math.Min(upper, to)
+		if upper <= lower {
+			continue
+		}
+		zoneFactor := math.Cos(lower) - math.Cos(upper)
+		for c := range cAngles {
+			flux += planes[c][g] * widths[c] * zoneFactor
+		}
+	}
+
+	return flux
+}
+
+// planeFlux integrates the sector represented by the C-plane with the given index over all gamma zones.
+func planeFlux(cAngles, gAngles []float64, planes [][]float64, plane int) float64 {
+	width := sectorWidths(cAngles)[plane]
+	flux := 0.0
+	for g := range gAngles {
+		lower, upper := zoneBounds(gAngles, g)
+		lower = math.Max(lower, 0)
+		upper = math.Min(upper, math.Pi)
+		if upper > lower {
+			flux += planes[plane][g] * width * (math.Cos(lower) - math.Cos(upper))
+		}
+	}
+
+	return flux
+}
+
+// interpolateIntensity returns the intensity in the given direction (in degrees) using bilinear interpolation
+// between the surrounding C-planes and gamma angles. Directions outside of the measured gamma range yield zero.
+func interpolateIntensity(cAngles, gAngles []float64, planes [][]float64, c, g float64) float64 {
+	if len(cAngles) == 0 || len(gAngles) == 0 {
+		return 0
+	}
+
+	lowerPlane, upperPlane, planeWeight := surroundingPlanes(cAngles, c)
+
+	return interpolateLinear(planes[lowerPlane], gAngles, g)*(1-planeWeight) +
+		interpolateLinear(planes[upperPlane], gAngles, g)*planeWeight
+}
+
+// interpolatePlane returns the intensities of the C-plane at the given angle, interpolated linearly between the
+// surrounding C-planes.
+func interpolatePlane(cAngles []float64, planes [][]float64, c float64) []float64 {
+	if len(cAngles) == 0 {
+		return nil
+	}
+
+	lowerPlane, upperPlane, planeWeight := surroundingPlanes(cAngles, c)
+	plane := make([]float64, len(planes[lowerPlane]))
+	for g := range plane {
+		plane[g] = planes[lowerPlane][g]*(1-planeWeight) + planes[upperPlane][g]*planeWeight
+	}
+
+	return plane
+}
+
+// surroundingPlanes returns the indices of the C-planes enclosing the given angle and the weight of the upper plane.
+// The C-angles must be sorted and lie within 0 to 360 degrees, the last plane wraps around to the first one.
+func surroundingPlanes(cAngles []float64, c float64) (lowerPlane, upperPlane int, planeWeight float64) {
+	if len(cAngles) < 2 {
+		return 0, 0, 0
+	}
+
+	c = normalizeAngle(c)
+	lowerPlane = len(cAngles) - 1
+	for i := range cAngles {
+		if cAngles[i] <= c {
+			lowerPlane = i
+		}
+	}
+	upperPlane = (lowerPlane + 1) % len(cAngles)
+	lowerAngle := cAngles[lowerPlane]
+	upperAngle := cAngles[upperPlane]
+	if c < lowerAngle {
+		lowerAngle -= 360 // c lies between the last plane and 360 degrees
+	}
+	if upperAngle <= lowerAngle {
+		upperAngle += 360
+	}
+
+	return lowerPlane, upperPlane, (c - lowerAngle) / (upperAngle - lowerAngle)
+}
+
+// interpolateLinear returns the linearly interpolated value at the given angle.
+// Angles outside of the given angle range yield zero.
+func interpolateLinear(values, angles []float64, angle float64) float64 {
+	if len(angles) == 0 || angle < angles[0] || angle > angles[len(angles)-1] {
+		return 0
+	}
+
+	for i := 1; i < len(angles); i++ {
+		if angle <= angles[i] {
+			span := angles[i] - angles[i-1]
+			if span == 0 {
+				return values[i]
+			}
+			weight := (angle - angles[i-1]) / span
+			return values[i-1]*(1-weight) + values[i]*weight
+		}
+	}
+
+	return values[len(values)-1]
+}
+
+// directRatios returns the direct ratios (share of the downward flux falling directly onto the working plane) for the
+// room indices k = a*b / (h*(a+b)) using the zonal method: the flux of each gamma zone of the lower hemisphere is
+// weighted with the share of the zone flux reaching the working plane, see directRatioZoneFactors.
+func directRatios(cAngles, gAngles []float64, planes [][]float64, roomIndices []float64) []float64 {
+	ratios := make([]float64, len(roomIndices))
+	zoneFluxes := make([]float64, directRatioZones)
+	widths := sectorWidths(cAngles)
+	downwardFlux := 0.0
+	for g := range gAngles {
+		lower, upper := zoneBounds(gAngles, g)
+		planeSum := 0.0
+		for c := range cAngles {
+			planeSum += planes[c][g] * widths[c]
+		}
+		for zone := range zoneFluxes {
+			from := math.Max(lower, degToRad(float64(zone)*90/directRatioZones))
+			to := math.Min(upper, degToRad(float64(zone+1)*90/directRatioZones))
+			if to > from {
+				flux := planeSum * (math.Cos(from) - math.Cos(to))
+				zoneFluxes[zone] += flux
+				downwardFlux += flux
+			}
+		}
+	}
+	if downwardFlux <= 0 {
+		return ratios
+	}
+
+	for n, roomIndex := range roomIndices {
+		if roomIndex <= 0 {
+			continue
+		}
+		for zone, factor := range directRatioZoneFactors(roomIndex) {
+			ratios[n] += zoneFluxes[zone] * factor
+		}
+		ratios[n] /= downwardFlux
+	}
+
+	return ratios
+}
+
+// directRatioZones is the number of gamma zones of the lower hemisphere used by the zonal direct ratio method.
+const directRatioZones = 90
+
+// zoneFactorCache holds the zone factors of the room indices, they do not depend on the photometry.
+var zoneFactorCache = struct {
+	sync.Mutex
+	factors map[float64][]float64
+}{factors: make(map[float64][]float64)}
+
+// directRatioZoneFactors returns for each gamma zone the share of the flux emitted into the zone that falls directly
+// onto the working plane of a square room with the given room index. The room is lit by a regular array of 8 x 8
+// luminaires at a height of 1 above the working plane, the flux of a zone is assumed to be distributed evenly over
+// the C-planes.
+func directRatioZoneFactors(roomIndex float64) []float64 {
+	zoneFactorCache.Lock()
+	defer zoneFactorCache.Unlock()
+	if factors, ok := zoneFactorCache.factors[roomIndex]; ok {
+		return factors
+	}
+
+	const luminaireGrid = 8
+	const planeGrid = 48
+
+	height := 1.0
+	roomSize := 2 * roomIndex * height // square room: k = a / (2h)
+	luminaireSpacing := roomSize / luminaireGrid
+	cellSize := roomSize / planeGrid
+	cellArea := cellSize * cellSize
+
+	// flux reaching the working plane per luminaire for a unit intensity in each zone, the luminaires of one quadrant
+	// represent the mirrored luminaires of the other quadrants
+	factors := make([]float64, directRatioZones)
+	for lx := 0; lx < luminaireGrid/2; lx++ {
+		for ly := 0; ly < luminaireGrid/2; ly++ {
+			posX := (float64(lx) + 0.5) * luminaireSpacing
+			posY := (float64(ly) + 0.5) * luminaireSpacing
+			for px := 0; px < planeGrid; px++ {
+				for py := 0; py < planeGrid; py++ {
+					distance := math.Hypot((float64(px)+0.5)*cellSize-posX, (float64(py)+0.5)*cellSize-posY)
+					gamma := math.Atan2(distance, height)
+					cos := math.Cos(gamma)
+					zone := int(radToDeg(gamma) * directRatioZones / 90)
+					factors[zone] += 4 * cos * cos * cos / (height * height) * cellArea
+				}
+			}
+		}
+	}
+	for zone := range factors {
+		solidAngle := 2 * math.Pi * (math.Cos(degToRad(float64(zone)*90/directRatioZones)) -
+			math.Cos(degToRad(float64(zone+1)*90/directRatioZones)))
+		factors[zone] /= luminaireGrid * luminaireGrid * solidAngle
+	}
+	zoneFactorCache.factors[roomIndex] = factors
+
+	return factors
+}
+
+// planeProfile returns the intensity profile through the given C-plane and its opposite plane (C+180).
+// The angles of the opposite plane are negated, so the profile covers gamma angles from -180 to 180 degrees.
+func planeProfile(cAngles, gAngles []float64, planes [][]float64, c float64) ([]float64, []float64) {
+	angles := make([]float64, 0, 2*len(gAngles))
+	values := make([]float64, 0, 2*len(gAngles))
+	for g := len(gAngles) - 1; g >= 0; g-- {
+		if gAngles[g] == 0 {
+			continue // nadir is part of both planes, it is added once below
+		}
+		angles = append(angles, -gAngles[g])
+		values = append(values, interpolateIntensity(cAngles, gAngles, planes, c+180, gAngles[g]))
+	}
+	for g := range gAngles {
+		angles = append(angles, gAngles[g])
+		values = append(values, interpolateIntensity(cAngles, gAngles, planes, c, gAngles[g]))
+	}
+
+	return angles, values
+}
+
+// BeamDivergence describes the beam of a plane profile at a fraction of its peak intensity. The angles are measured
+// from nadir within the plane, negative angles lie in the opposite plane (C+180).
+type BeamDivergence struct {
+	Peak  float64 // angle of the peak intensity
+	Lower float64 // crossing angle before the peak
+	Upper float64 // crossing angle after the peak
+	Width float64 // full width between both crossings
+}
+
+// beamWidth returns the full width (in degrees) of the profile at the given fraction of its maximum.
+// Returns -1 if the profile is empty.
+func beamWidth(angles, values []float64, fraction float64) float64 {
+	if len(values) == 0 {
+		return -1
+	}
+
+	return beamDivergence(angles, values, fraction).Width
+}
+
+// beamDivergence returns the beam of the profile at the given fraction of its maximum. Starting at the peak, the
+// profile is followed in both directions until the intensity drops below the threshold, the exact crossing angles
+// are interpolated linearly. If the intensity does not drop below the threshold, the end of the profile is used.
+func beamDivergence(angles, values []float64, fraction float64) BeamDivergence {
+	peak := 0
+	for i := range values {
+		if values[i] > values[peak] {
+			peak = i
+		}
+	}
+	threshold := values[peak] * fraction
+
+	lower := angles[0]
+	for i := peak; i > 0; i-- {
+		if values[i-1] < threshold {
+			lower = interpolateCrossing(angles[i-1], angles[i], values[i-1], values[i], threshold)
+			break
+		}
+	}
+	upper := angles[len(angles)-1]
+	for i := peak; i < len(values)-1; i++ {
+		if values[i+1] < threshold {
+			upper = interpolateCrossing(angles[i+1], angles[i], values[i+1], values[i], threshold)
+			break
+		}
+	}
+
+	return BeamDivergence{Peak: angles[peak], Lower: lower, Upper: upper, Width: upper - lower}
+}
+
+// interpolateCrossing returns the angle between angleA and angleB at which the linearly interpolated value
+// equals the threshold.
+func interpolateCrossing(angleA, angleB, valueA, valueB, threshold float64) float64 {
+	if valueB == valueA {
+		return angleA
+	}
+
+	return angleA + (threshold-valueA)/(valueB-valueA)*(angleB-angleA)
+}
+
+// spacingCriterion returns the maximum spacing to mounting height ratio for two luminaires aligned along the given
+// C-plane, at which the illuminance midway between the luminaires still reaches the illuminance directly below
+// a luminaire. The search is limited to ratios up to maxSpacingRatio.
+func spacingCriterion(cAngles, gAngles []float64, planes [][]float64, c float64) float64 {
+	const maxSpacingRatio = 4.0
+	const step = 0.01
+
+	// illuminance on the working plane at the given horizontal distance (in mounting heights) for the given plane
+	illuminance := func(distance, plane float64) float64 {
+		gamma := math.Atan(distance)
+		return interpolateIntensity(cAngles, gAngles, planes, plane, radToDeg(gamma)) * math.Pow(math.Cos(gamma), 3)
+	}
+	difference := func(ratio float64) float64 {
+		midpoint := illuminance(ratio/2, c) + illuminance(ratio/2, c+180)
+		below := illuminance(0, c) + (illuminance(ratio, c)+illuminance(ratio, c+180))/2
+		return midpoint - below
+	}
+
+	for ratio := step; ratio <= maxSpacingRatio; ratio += step {
+		if difference(ratio) >= 0 {
+			continue
+		}
+
+		// refine the crossing by bisection
+		lower, upper := ratio-step, ratio
+		for i := 0; i < 20; i++ {
+			middle := (lower + upper) / 2
+			if difference(middle) >= 0 {
+				lower = middle
+			} else {
+				upper = middle
+			}
+		}
+		return math.Round(lower*100) / 100
+	}
+
+	return maxSpacingRatio
+}
+
+// computeSpacingCriterion returns the spacing criterion along both principal planes and the diagonal.
+func computeSpacingCriterion(cAngles, gAngles []float64, planes [][]float64) SpacingCriterion {
+	return SpacingCriterion{
+		C0C180:   spacingCriterion(cAngles, gAngles, planes, 0),
+		C90C270:  spacingCriterion(cAngles, gAngles, planes, 90),
+		Diagonal: spacingCriterion(cAngles, gAngles, planes, 45),
+	}
+}
+
+// luminousArea describes the geometry of the luminous area of a luminaire, all dimensions are given in meters.
+type luminousArea struct {
+	length     float64 // length along the C0-C180 plane or the diameter if circular
+	width      float64 // width along the C90-C270 plane, ignored if circular
+	circular   bool
+	spherical  bool
+	heightC0   float64
+	heightC90  float64
+	heightC180 float64
+	heightC270 float64
+}
+
+// projectedArea returns the area (in m²) of the luminous area projected onto a plane perpendicular to the given
+// viewing direction (C-plane and gamma angle in degrees).
+func (a luminousArea) projectedArea(c, gamma float64) float64 {
+	if a.spherical {
+		return math.Pi * a.length * a.length / 4
+	}
+
+	cosC := math.Cos(degToRad(c))
+	sinC := math.Sin(degToRad(c))
+	cosGamma := math.Cos(degToRad(gamma))
+	sinGamma := math.Abs(math.Sin(degToRad(gamma)))
+
+	heightC := a.heightC0
+	if cosC < 0 {
+		heightC = a.heightC180
+	}
+	heightC90 := a.heightC90
+	if sinC < 0 {
+		heightC90 = a.heightC270
+	}
+
+	area := 0.0
+	if a.circular {
+		if cosGamma > 0 {
+			area += math.Pi * a.length * a.length / 4 * cosGamma
+		}
+		// interpolate the height of the cylindrical side between the principal planes
+		height := math.Abs(cosC)*heightC + math.Abs(sinC)*heightC90
+		area += a.length * height * sinGamma
+	} else {
+		if cosGamma > 0 {
+			area += a.length * a.width * cosGamma
+		}
+		area += a.width * heightC * sinGamma * math.Abs(cosC)
+		area += a.length * heightC90 * sinGamma * math.Abs(sinC)
+	}
+
+	return area
+}
+
+// beamCentroid returns the direction (C-plane and gamma angle in degrees) of the flux weighted centroid of the
+// intensity distribution. The gamma angle equals the tilt of the beam axis from nadir.
+func beamCentroid(cAngles, gAngles []float64, planes [][]float64) (float64, float64) {
+	widths := sectorWidths(cAngles)
+	var x, y, z float64
+	for g := range gAngles {
+		lower, upper := zoneBounds(gAngles, g)
+		zoneFactor := math.Cos(lower) - math.Cos(upper)
+		sinGamma := math.Sin(degToRad(gAngles[g]))
+		cosGamma := math.Cos(degToRad(gAngles[g]))
+		for c := range cAngles {
+			flux := planes[c][g] * widths[c] * zoneFactor
+			if len(cAngles) == 1 {
+				// rotationally symmetric, all horizontal components cancel out
+				z -= flux * cosGamma
+				continue
+			}
+			x += flux * sinGamma * math.Cos(degToRad(cAngles[c]))
+			y += flux * sinGamma * math.Sin(degToRad(cAngles[c]))
+			z -= flux * cosGamma
+		}
+	}
+
+	horizontal := math.Hypot(x, y)
+	if horizontal < 1e-9*math.Abs(z) {
+		x, y, horizontal = 0, 0, 0
+	}
+	gamma := radToDeg(math.Atan2(horizontal, -z))
+	c := 0.0
+	if horizontal > 0 {
+		c = normalizeAngle(radToDeg(math.Atan2(y, x)))
+	}
+
+	return c, gamma
+}
+
+// peakPosition returns the plane and gamma angle of the maximum of the planes, or false if there are no values. If the
+// maximum occurs multiple times, the occurrence with the lowest plane angle (then the lowest gamma angle) wins.
+func peakPosition(planeAngles, gAngles []float64, planes [][]float64) (float64, float64, bool) {
+	var plane, gamma float64
+	peak := math.Inf(-1)
+	for p := range planes {
+		for g, value := range planes[p] {
+			if g >= len(gAngles) || p >= len(planeAngles) {
+				continue
+			}
+			better := value > peak || (value == peak && (planeAngles[p] < plane ||
+				(planeAngles[p] == plane && gAngles[g] < gamma)))
+			if better {
+				peak, plane, gamma = value, planeAngles[p], gAngles[g]
+			}
+		}
+	}
+
+	return plane, gamma, !math.IsInf(peak, -1)
+}
+
+// emitsAboveHorizontal reports whether any intensity at or above the horizontal (gamma >= 90) is greater than zero.
+func emitsAboveHorizontal(gAngles []float64, planes [][]float64) bool {
+	for _, plane := range planes {
+		for g, value := range plane {
+			if g < len(gAngles) && gAngles[g] >= 90 && value > 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// distributionFingerprint returns a SHA-256 hash (hex encoded) of the expanded distribution. The intensities are
+// normalized to 1000 lm of luminaire flux and rounded, so the same measurement yields the same fingerprint
+// independent of the file format, the lamp data and the number formatting.
+func distributionFingerprint(cAngles, gAngles []float64, planes [][]float64) string {
+	scale := 1.0
+	if flux := zonalFlux(cAngles, gAngles, planes); flux > 0 {
+		scale = 1000 / flux
+	}
+
+	hash := sha256.New()
+	write := func(prefix string, values []float64, decimals int) {
+		buffer := []byte(prefix)
+		for _, value := range values {
+			buffer = append(buffer, ' ')
+			buffer = strconv.AppendFloat(buffer, value, 'f', decimals, 64)
+		}
+		hash.Write(append(buffer, '\n'))
+	}
+	write("C", cAngles, 3)
+	write("G", gAngles, 3)
+	normalized := make([]float64, len(gAngles))
+	for _, plane := range planes {
+		for g := range normalized {
+			normalized[g] = 0
+			if g < len(plane) {
+				normalized[g] = math.Round(plane[g]*scale*10) / 10
+			}
+		}
+		write("I", normalized, 1)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// PhotometricData is implemented by Eulumdat and *IES. It allows format independent processing like comparisons.
+type PhotometricData interface {
+	normalizedPhotometry() normalizedPhotometry
+}
+
+// BeamAngle returns the beam angle (full width at half maximum, in degrees) of the plane pair c and c + 180 of
+// either format. Unlike GetFwhm it works for all symmetries and follows the profile from the actual peak, which is
+// not at nadir for asymmetric optics. Returns -1 if the data contains no intensities. IES type A and B photometry
+// is not supported, use IES.GetFwhm instead.
+func BeamAngle(data PhotometricData, c float64) float64 {
+	photometry := data.normalizedPhotometry()
+	if len(photometry.cAngles) == 0 || len(photometry.gAngles) == 0 {
+		return -1
+	}
+	angles, values := planeProfile(photometry.cAngles, photometry.gAngles, photometry.planes, c)
+
+	return beamWidth(angles, values, 0.5)
+}
+
+// normalizedPhotometry is the format independent representation of photometric data. The intensities are
+// absolute candela values with the symmetry expanded, all lengths are given in millimeters.
+type normalizedPhotometry struct {
+	manufacturer  string
+	luminaire     string
+	catalogNumber string
+	lamp          string
+	testReport    string
+	date          string
+	lampFlux      float64 // rated lamp lumens, the luminaire flux for absolute photometry
+	inputWatts    float64
+	length        float64
+	width         float64
+	height        float64
+
+	cAngles []float64
+	gAngles []float64
+	planes  [][]float64
+}
+
+func (e Eulumdat) normalizedPhotometry() normalizedPhotometry {
+	cAngles, planes := e.expandedDistribution()
+	factor := e.intensityScale(0)
+	photometry := normalizedPhotometry{
+		manufacturer:  e.CompanyIdentification,
+		luminaire:     e.LuminaireName,
+		catalogNumber: e.LuminaireNumber,
+		testReport:    e.MeasurementReportNumber,
+		date:          e.DateUser,
+		lampFlux:      e.lampFlux(),
+		length:        e.LengthDiameter,
+		width:         e.WidthLuminaire,
+		height:        e.HeightLuminaire,
+		cAngles:       cAngles,
+		gAngles:       e.AnglesG,
+		planes:        scalePlanes(planes, factor),
+	}
+	if len(e.TypeLamps) > 0 {
+		photometry.lamp = e.TypeLamps[0]
+	}
+	if len(e.BallastWatts) > 0 {
+		photometry.inputWatts = e.BallastWatts[0]
+	}
+
+	return photometry
+}
+
+func (i *IES) normalizedPhotometry() normalizedPhotometry {
+	hAngles, vAngles, planes := i.typeCDistribution()
+	photometry := normalizedPhotometry{
+		manufacturer:  i.Keywords["MANUFAC"],
+		luminaire:     i.Keywords["LUMINAIRE"],
+		catalogNumber: i.Keywords["LUMCAT"],
+		lamp:          i.Keywords["LAMP"],
+		testReport:    i.Keywords["TEST"],
+		date:          i.Keywords["ISSUEDATE"],
+		lampFlux:      i.lampLumens(),
+		inputWatts:    i.InputWatts,
+		length:        math.Abs(iesUnitsToMillimeters(i.LuminaireLength, i.UnitsType)),
+		width:         math.Abs(iesUnitsToMillimeters(i.LuminaireWidth, i.UnitsType)),
+		height:        math.Abs(iesUnitsToMillimeters(i.LuminaireHeight, i.UnitsType)),
+		cAngles:       hAngles,
+		gAngles:       vAngles,
+		planes:        scalePlanes(planes, i.candelaScale()),
+	}
+	if photometry.date == "" {
+		photometry.date = i.Keywords["DATE"]
+	}
+	if i.IsAbsolutePhotometry() {
+		photometry.lampFlux = i.ComputeTotalFlux()
+	}
+	if i.LuminaireWidth < 0 && i.LuminaireLength < 0 {
+		photometry.width = 0 // circular luminous opening, EULUMDAT uses a width of 0
+	}
+
+	return photometry
+}
+
+// scalePlanes returns a copy of the planes with all values multiplied by the factor.
+func scalePlanes(planes [][]float64, factor float64) [][]float64 {
+	scaled := make([][]float64, len(planes))
+	for p := range planes {
+		scaled[p] = make([]float64, len(planes[p]))
+		for v := range planes[p] {
+			scaled[p][v] = planes[p][v] * factor
+		}
+	}
+
+	return scaled
+}
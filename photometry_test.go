@@ -0,0 +1,103 @@
+package eulumies
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_beamWidth(t *testing.T) {
+	// triangular profile with its peak at 10 degrees
+	angles := []float64{-30, -20, -10, 0, 10, 20, 30, 40}
+	values := []float64{0, 0, 20, 60, 100, 60, 20, 0}
+
+	assert.InDelta(t, 25.0, beamWidth(angles, values, 0.5), 1e-9)
+	assert.InDelta(t, 50.0, beamWidth(angles, values, 0.1), 1e-9)
+	assert.Equal(t, -1.0, beamWidth(nil, nil, 0.5))
+
+	divergence := beamDivergence(angles, values, 0.5)
+	assert.Equal(t, 10.0, divergence.Peak)
+	assert.InDelta(t, -2.5, divergence.Lower, 1e-9)
+	assert.InDelta(t, 22.5, divergence.Upper, 1e-9)
+}
+
+func Test_computeSpacingCriterion(t *testing.T) {
+	// uniform intensity in the lower hemisphere
+	gAngles := make([]float64, 91)
+	plane := make([]float64, 91)
+	for g := range gAngles {
+		gAngles[g] = float64(g)
+		plane[g] = 100
+	}
+
+	sc := computeSpacingCriterion([]float64{0}, gAngles, [][]float64{plane})
+	assert.InDelta(t, 1.2, sc.C0C180, 0.01)
+	assert.Equal(t, sc.C0C180, sc.C90C270)
+	assert.Equal(t, sc.C0C180, sc.Diagonal)
+}
+
+func Test_luminousArea_projectedArea(t *testing.T) {
+	rectangular := luminousArea{length: 0.6, width: 0.2, heightC0: 0.1, heightC90: 0.1, heightC180: 0.1, heightC270: 0.1}
+	assert.InDelta(t, 0.12, rectangular.projectedArea(0, 0), 1e-9)
+	assert.InDelta(t, 0.12*0.5+0.02*math.Sin(math.Pi/3), rectangular.projectedArea(0, 60), 1e-9)
+	assert.InDelta(t, 0.06, rectangular.projectedArea(90, 90), 1e-9)
+
+	circular := luminousArea{length: 0.2, circular: true}
+	assert.InDelta(t, math.Pi*0.01, circular.projectedArea(45, 0), 1e-9)
+	assert.InDelta(t, 0, circular.projectedArea(45, 90), 1e-9)
+}
+
+func Test_beamCentroid(t *testing.T) {
+	gAngles := []float64{0, 10, 20, 30, 40, 50, 60, 70, 80, 90}
+	cAngles := []float64{0, 90, 180, 270}
+	planes := make([][]float64, len(cAngles))
+	for c := range cAngles {
+		planes[c] = make([]float64, len(gAngles))
+	}
+	planes[0][3] = 100 // single peak at C0, gamma 30
+
+	c, gamma := beamCentroid(cAngles, gAngles, planes)
+	assert.InDelta(t, 0.0, c, 1e-9)
+	assert.InDelta(t, 30.0, gamma, 1e-9)
+
+	c, gamma = beamCentroid([]float64{0}, gAngles, [][]float64{planes[0]})
+	assert.Equal(t, 0.0, c)
+	assert.InDelta(t, 0.0, gamma, 1e-9)
+}
+
+func TestFingerprint(t *testing.T) {
+	eulumdat := loadSample2(t)
+
+	fingerprint := eulumdat.Fingerprint()
+	assert.Len(t, fingerprint, 64)
+
+	renamed, _ := CopyEulumdat(eulumdat)
+	renamed.LuminaireName = "Other name"
+	renamed.TotalLuminousFluxLamps[0] *= 2
+	assert.Equal(t, fingerprint, renamed.Fingerprint())
+
+	// the same distribution in another format and symmetry
+	ies, err := ConvertEulumdatToIES(&eulumdat, ConversionOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, fingerprint, ies.Fingerprint())
+	ies, err = ConvertEulumdatToIES(&eulumdat, ConversionOptions{PreserveSymmetry: true})
+	assert.NoError(t, err)
+	assert.Equal(t, fingerprint, ies.Fingerprint())
+
+	renamed.LuminousIntensityDistribution[0][0] += 10
+	assert.NotEqual(t, fingerprint, renamed.Fingerprint())
+}
+
+func TestBeamAngle(t *testing.T) {
+	eulumdat := loadSample2(t)
+
+	planeIndex := eulumdat.GetCPlaneIndex(0)
+	assert.InDelta(t, eulumdat.GetFwhm(planeIndex), BeamAngle(eulumdat, 0), 1e-9)
+
+	ies, err := ConvertEulumdatToIES(&eulumdat, ConversionOptions{})
+	assert.NoError(t, err)
+	assert.InDelta(t, BeamAngle(eulumdat, 90), BeamAngle(ies, 90), 1e-6)
+
+	assert.Equal(t, -1.0, BeamAngle(&IES{}, 0))
+}
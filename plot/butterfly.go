@@ -0,0 +1,92 @@
+package plot
+
+import (
+	"image"
+	"io"
+	"strconv"
+)
+
+// ButterflyOptions controls the rendering of butterfly diagrams.
+type ButterflyOptions struct {
+	Width  int // width of the diagram in pixels, defaults to 400
+	Height int // height of the diagram in pixels, defaults to 420
+	// Rings is the approximate number of intensity rings, defaults to 4.
+	Rings int
+	// Rotation selects the compared planes: C(Rotation) - C(Rotation+180) and C(Rotation+90) - C(Rotation+270).
+	// Defaults to 0, the principal planes C0 - C180 and C90 - C270.
+	Rotation      float64
+	Normalization Normalization
+}
+
+func (o ButterflyOptions) withDefaults() ButterflyOptions {
+	polar := PolarOptions{Width: o.Width, Height: o.Height, Rings: o.Rings}.withDefaults()
+	o.Width, o.Height, o.Rings = polar.Width, polar.Height, polar.Rings
+
+	return o
+}
+
+const butterflyFillAlpha = 0.2
+
+// ButterflySVG renders the butterfly diagram comparing two perpendicular planes as SVG document. Both plane profiles
+// are drawn as filled wings on a common polar grid, the symmetry of the data is expanded so that the full planes
+// are shown for every symmetry type.
+func ButterflySVG(out io.Writer, photometry Photometry, opts ButterflyOptions) error {
+	return butterflyFigure(photometry, opts.withDefaults()).writeSVG(out)
+}
+
+// ButterflyImage renders the butterfly diagram (see ButterflySVG) into a raster image.
+func ButterflyImage(photometry Photometry, opts ButterflyOptions) image.Image {
+	return butterflyFigure(photometry, opts.withDefaults()).rasterize()
+}
+
+// ButterflyPNG renders the butterfly diagram (see ButterflySVG) as PNG image.
+func ButterflyPNG(out io.Writer, photometry Photometry, opts ButterflyOptions) error {
+	return butterflyFigure(photometry, opts.withDefaults()).writePNG(out)
+}
+
+// butterflyFigure builds the butterfly diagram, the first plane pair is drawn solid, the second one dashed.
+func butterflyFigure(photometry Photometry, opts ButterflyOptions) *figure {
+	intensity := photometry.IntensityFunc()
+	factor, unit := normalizationFactor(photometry, opts.Normalization)
+
+	planes := [2]float64{opts.Rotation, opts.Rotation + 90}
+	var right, left [2][]float64
+	for p, c := range planes {
+		right[p], left[p] = sampleProfile(intensity, factor, c)
+	}
+
+	diagram := &figure{width: opts.Width, height: opts.Height}
+	grid := newPolarGrid(diagram, maxValue(right[0], left[0], right[1], left[1]), opts.Rings, unit)
+
+	styles := [2]style{
+		{color: colorPrimary, width: 1.5},
+		{color: colorSecondary, width: 1.5, dashed: true},
+	}
+	for p := range planes {
+		diagram.polygon(styles[p].color, butterflyFillAlpha, grid.profile(right[p], left[p])...)
+	}
+	for p := len(planes) - 1; p >= 0; p-- {
+		diagram.polyline(styles[p], grid.profile(right[p], left[p])...)
+	}
+
+	legendY := float64(opts.Height) - polarLegendHeight/2
+	for p, c := range planes {
+		x := 10 + float64(p)*grid.center.x
+		diagram.polyline(styles[p], point{x: x, y: legendY}, point{x: x + 20, y: legendY})
+		diagram.text(point{x: x + 24, y: legendY + fontSize/3}, anchorStart, planeName(c)+" - "+planeName(c+180))
+	}
+
+	return diagram
+}
+
+// planeName returns the label of the C-plane, normalized to 0 - 360 degrees.
+func planeName(c float64) string {
+	for c >= 360 {
+		c -= 360
+	}
+	for c < 0 {
+		c += 360
+	}
+
+	return "C" + strconv.FormatFloat(c, 'f', -1, 64)
+}
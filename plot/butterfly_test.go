@@ -0,0 +1,56 @@
+package plot
+
+import (
+	"bytes"
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestButterflySVG(t *testing.T) {
+	eulumdat := loadSample2(t)
+
+	var out bytes.Buffer
+	assert.NoError(t, ButterflySVG(&out, eulumdat, ButterflyOptions{}))
+	svg := out.String()
+	assert.Equal(t, 2, strings.Count(svg, "<polygon"))
+	assert.Contains(t, svg, `fill-opacity="0.2"`)
+	assert.Contains(t, svg, ">C0 - C180<")
+	assert.Contains(t, svg, ">C90 - C270<")
+
+	out.Reset()
+	assert.NoError(t, ButterflySVG(&out, eulumdat, ButterflyOptions{Rotation: 45, Normalization: NormalizationFlux}))
+	assert.Contains(t, out.String(), ">C45 - C225<")
+	assert.Contains(t, out.String(), ">C135 - C315<")
+	assert.Contains(t, out.String(), ">cd/klm<")
+}
+
+func TestButterflyImage(t *testing.T) {
+	img := ButterflyImage(loadSample2(t), ButterflyOptions{Width: 200, Height: 220})
+	assert.Equal(t, 200, img.Bounds().Dx())
+
+	// the luminaire emits downwards, the filled wings cover the area below the center
+	below := color.RGBAModel.Convert(img.At(100, 120)).(color.RGBA)
+	above := color.RGBAModel.Convert(img.At(100, 60)).(color.RGBA)
+	assert.NotEqual(t, colorBackground, below)
+	assert.Equal(t, colorBackground, above)
+}
+
+func Test_planeName(t *testing.T) {
+	assert.Equal(t, "C0", planeName(360))
+	assert.Equal(t, "C270", planeName(-90))
+	assert.Equal(t, "C22.5", planeName(22.5))
+}
+
+func TestFigure_rasterizePolygon(t *testing.T) {
+	diagram := &figure{width: 10, height: 10}
+	diagram.polygon(colorPrimary, 1, point{x: 2, y: 2}, point{x: 8, y: 2}, point{x: 8, y: 8}, point{x: 2, y: 8})
+
+	img := diagram.rasterize()
+	assert.Equal(t, colorPrimary, img.RGBAAt(2, 2))
+	assert.Equal(t, colorPrimary, img.RGBAAt(7, 7))
+	assert.Equal(t, colorBackground, img.RGBAAt(8, 8))
+	assert.Equal(t, colorBackground, img.RGBAAt(1, 5))
+}
@@ -0,0 +1,162 @@
+package plot
+
+import (
+	"image"
+	"io"
+	"math"
+	"strconv"
+)
+
+// Normalization selects the unit of the plotted intensities.
+type Normalization int
+
+const (
+	NormalizationNone Normalization = iota // absolute candela values
+	NormalizationFlux                      // cd/klm, relative to 1000 lm of luminaire flux
+	NormalizationPeak                      // percent of the peak intensity
+)
+
+// CartesianOptions controls the rendering of cartesian intensity plots.
+type CartesianOptions struct {
+	Width         int       // width of the plot in pixels, defaults to 500
+	Height        int       // height of the plot in pixels, defaults to 320
+	Planes        []float64 // C-planes to plot, defaults to C0 and C90
+	MaxGamma      float64   // upper end of the gamma axis in degrees, defaults to 180
+	Normalization Normalization
+}
+
+func (o CartesianOptions) withDefaults() CartesianOptions {
+	if o.Width <= 0 {
+		o.Width = 500
+	}
+	if o.Height <= 0 {
+		o.Height = 320
+	}
+	if len(o.Planes) == 0 {
+		o.Planes = []float64{0, 90}
+	}
+	if o.MaxGamma <= 0 || o.MaxGamma > 180 {
+		o.MaxGamma = 180
+	}
+
+	return o
+}
+
+const (
+	cartesianMarginLeft   = 50.0
+	cartesianMarginRight  = 20.0
+	cartesianMarginTop    = 20.0
+	cartesianMarginBottom = 45.0
+)
+
+// planeStyles are used for the plotted C-planes in turn, dashed lines are used once all colors are taken.
+var planeStyles = []style{
+	{color: colorPrimary, width: 1.5},
+	{color: colorSecondary, width: 1.5},
+	{color: colorTertiary, width: 1.5},
+	{color: colorQuaternary, width: 1.5},
+}
+
+// CartesianSVG renders the intensity over the gamma angle (x-axis) with one line per selected C-plane as SVG document.
+func CartesianSVG(out io.Writer, photometry Photometry, opts CartesianOptions) error {
+	return cartesianFigure(photometry, opts.withDefaults()).writeSVG(out)
+}
+
+// CartesianImage renders the cartesian intensity plot (see CartesianSVG) into a raster image.
+func CartesianImage(photometry Photometry, opts CartesianOptions) image.Image {
+	return cartesianFigure(photometry, opts.withDefaults()).rasterize()
+}
+
+// CartesianPNG renders the cartesian intensity plot (see CartesianSVG) as PNG image.
+func CartesianPNG(out io.Writer, photometry Photometry, opts CartesianOptions) error {
+	return cartesianFigure(photometry, opts.withDefaults()).writePNG(out)
+}
+
+// cartesianFigure builds the cartesian plot with automatically scaled intensity axis.
+func cartesianFigure(photometry Photometry, opts CartesianOptions) *figure {
+	intensity := photometry.IntensityFunc()
+	factor, unit := normalizationFactor(photometry, opts.Normalization)
+
+	curves := make([][]float64, len(opts.Planes))
+	for p, c := range opts.Planes {
+		for gamma := 0.0; gamma <= opts.MaxGamma+1e-9; gamma += polarGammaStep {
+			curves[p] = append(curves[p], intensity(c, gamma)*factor)
+		}
+	}
+	max := maxValue(curves...)
+	step := niceStep(max, 5)
+	ticks := int(math.Ceil(max/step - 1e-9))
+	if ticks < 1 {
+		ticks = 1
+	}
+	scaleMax := float64(ticks) * step
+
+	diagram := &figure{width: opts.Width, height: opts.Height}
+	left, top := cartesianMarginLeft, cartesianMarginTop
+	right := math.Max(left+1, float64(opts.Width)-cartesianMarginRight)
+	bottom := math.Max(top+1, float64(opts.Height)-cartesianMarginBottom)
+	position := func(gamma, value float64) point {
+		return point{x: left + gamma/opts.MaxGamma*(right-left), y: bottom - value/scaleMax*(bottom-top)}
+	}
+
+	grid := style{color: colorGrid, width: 0.5}
+	gammaStep := 30.0
+	if opts.MaxGamma <= 90 {
+		gammaStep = 15
+	}
+	for gamma := 0.0; gamma <= opts.MaxGamma+1e-9; gamma += gammaStep {
+		diagram.polyline(grid, position(gamma, 0), position(gamma, scaleMax))
+		label := position(gamma, 0)
+		diagram.text(point{x: label.x, y: label.y + fontSize + 4}, anchorMiddle, strconv.Itoa(int(gamma))+"°")
+	}
+	for tick := 0; tick <= ticks; tick++ {
+		value := float64(tick) * step
+		diagram.polyline(grid, position(0, value), position(opts.MaxGamma, value))
+		label := position(0, value)
+		diagram.text(point{x: label.x - 4, y: label.y + fontSize/3}, anchorEnd, formatLabel(value, step))
+	}
+	diagram.text(point{x: 4, y: fontSize + 4}, anchorStart, unit)
+
+	legendY := float64(opts.Height) - 10
+	for p, c := range opts.Planes {
+		s := planeStyles[p%len(planeStyles)]
+		s.dashed = p >= len(planeStyles)
+		points := make([]point, len(curves[p]))
+		for i, value := range curves[p] {
+			points[i] = position(float64(i)*polarGammaStep, value)
+		}
+		diagram.polyline(s, points...)
+
+		legendX := left + float64(p)*70
+		diagram.polyline(s, point{x: legendX, y: legendY}, point{x: legendX + 20, y: legendY})
+		diagram.text(point{x: legendX + 24, y: legendY + fontSize/3}, anchorStart, "C"+strconv.FormatFloat(c, 'f', -1, 64))
+	}
+
+	return diagram
+}
+
+// normalizationFactor returns the factor converting absolute candela values to the given normalization together
+// with the unit label.
+func normalizationFactor(photometry Photometry, normalization Normalization) (float64, string) {
+	switch normalization {
+	case NormalizationFlux:
+		if flux := photometry.ComputeTotalFlux(); flux > 0 {
+			return 1000 / flux, "cd/klm"
+		}
+		return 1, "cd/klm"
+	case NormalizationPeak:
+		intensity := photometry.IntensityFunc()
+		peak := 0.0
+		for c := 0.0; c < 360; c += 5 {
+			for gamma := 0.0; gamma <= 180; gamma++ {
+				peak = math.Max(peak, intensity(c, gamma))
+			}
+		}
+		if peak > 0 {
+			return 100 / peak, "%"
+		}
+		return 1, "%"
+	}
+
+	return 1, "cd"
+}
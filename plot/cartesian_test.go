@@ -0,0 +1,59 @@
+package plot
+
+import (
+	"bytes"
+	"image/png"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/h44z/eulumies"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCartesianSVG(t *testing.T) {
+	eulumdat := loadSample2(t)
+
+	var out bytes.Buffer
+	assert.NoError(t, CartesianSVG(&out, eulumdat, CartesianOptions{Planes: []float64{0, 45, 90, 135, 180}}))
+	svg := out.String()
+	assert.True(t, strings.HasPrefix(svg, `<svg xmlns="http://www.w3.org/2000/svg" width="500" height="320"`))
+	assert.Contains(t, svg, ">C135<")
+	assert.Contains(t, svg, ">180°<")
+	assert.Equal(t, 2, strings.Count(svg, "stroke-dasharray")) // fifth plane line and legend
+
+	out.Reset()
+	assert.NoError(t, CartesianPNG(&out, eulumdat, CartesianOptions{Width: 200, Height: 150, MaxGamma: 90}))
+	img, err := png.Decode(&out)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, img.Bounds().Dx())
+}
+
+func TestCartesianFigure_Normalization(t *testing.T) {
+	ies, err := eulumies.NewIES("../test/sample.ies", false)
+	assert.NoError(t, err)
+
+	diagram := cartesianFigure(ies, CartesianOptions{Normalization: NormalizationPeak}.withDefaults())
+	labels := map[string]bool{}
+	minY := math.Inf(1)
+	for _, s := range diagram.shapes {
+		if s.kind == shapeText {
+			labels[s.text] = true
+		}
+		if s.kind == shapePolyline && s.style.color == colorPrimary && len(s.points) > 2 {
+			for _, p := range s.points {
+				minY = math.Min(minY, p.y)
+			}
+		}
+	}
+	assert.True(t, labels["%"])
+	assert.True(t, labels["100"])
+	assert.InDelta(t, cartesianMarginTop, minY, 0.5) // the peak reaches the top of the 100 % axis
+
+	factor, unit := normalizationFactor(ies, NormalizationFlux)
+	assert.Equal(t, "cd/klm", unit)
+	assert.InDelta(t, 1000/ies.ComputeTotalFlux(), factor, 1e-12)
+	factor, unit = normalizationFactor(ies, NormalizationNone)
+	assert.Equal(t, "cd", unit)
+	assert.Equal(t, 1.0, factor)
+}
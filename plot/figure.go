@@ -0,0 +1,176 @@
+package plot
+
+import (
+	"fmt"
+	"image/color"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// point is a position on the figure in pixels, the origin is the top left corner.
+type point struct {
+	x, y float64
+}
+
+// style describes how the outline of a shape is drawn.
+type style struct {
+	color  color.RGBA
+	width  float64
+	dashed bool
+}
+
+// textAnchor describes the horizontal alignment of a label relative to its position.
+type textAnchor int
+
+const (
+	anchorStart textAnchor = iota
+	anchorMiddle
+	anchorEnd
+)
+
+type shapeKind int
+
+const (
+	shapePolyline shapeKind = iota
+	shapeCircle
+	shapeText
+	shapePolygon
+)
+
+// shape is a single drawing primitive of a figure.
+type shape struct {
+	kind   shapeKind
+	style  style
+	points []point // polyline points, the first point is the circle center or the text position
+	radius float64
+	text   string
+	anchor textAnchor
+	fill   color.RGBA // fill color of polygons
+	alpha  float64    // fill opacity of polygons
+}
+
+// figure is a backend independent list of drawing primitives, rendered to SVG or raster images.
+type figure struct {
+	width  int
+	height int
+	shapes []shape
+}
+
+var (
+	colorBackground = color.RGBA{R: 255, G: 255, B: 255, A: 255}
+	colorGrid       = color.RGBA{R: 190, G: 190, B: 190, A: 255}
+	colorText       = color.RGBA{R: 60, G: 60, B: 60, A: 255}
+	colorPrimary    = color.RGBA{R: 31, G: 78, B: 156, A: 255}
+	colorSecondary  = color.RGBA{R: 192, G: 57, B: 43, A: 255}
+	colorTertiary   = color.RGBA{R: 39, G: 174, B: 96, A: 255}
+	colorQuaternary = color.RGBA{R: 230, G: 126, B: 34, A: 255}
+)
+
+const fontSize = 10.0
+
+func (f *figure) polyline(s style, points ...point) {
+	f.shapes = append(f.shapes, shape{kind: shapePolyline, style: s, points: points})
+}
+
+func (f *figure) circle(s style, center point, radius float64) {
+	f.shapes = append(f.shapes, shape{kind: shapeCircle, style: s, points: []point{center}, radius: radius})
+}
+
+func (f *figure) polygon(fill color.RGBA, alpha float64, points ...point) {
+	f.shapes = append(f.shapes, shape{kind: shapePolygon, points: points, fill: fill, alpha: alpha})
+}
+
+func (f *figure) text(position point, anchor textAnchor, text string) {
+	f.shapes = append(f.shapes, shape{kind: shapeText, style: style{color: colorText}, points: []point{position},
+		text: text, anchor: anchor})
+}
+
+// writeSVG renders the figure as a standalone SVG document.
+func (f *figure) writeSVG(out io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`+"\n",
+		f.width, f.height, f.width, f.height)
+	fmt.Fprintf(&b, `<rect width="100%%" height="100%%" fill="%s"/>`+"\n", svgColor(colorBackground))
+
+	for _, s := range f.shapes {
+		switch s.kind {
+		case shapePolyline:
+			coordinates := make([]string, len(s.points))
+			for i, p := range s.points {
+				coordinates[i] = svgNumber(p.x) + "," + svgNumber(p.y)
+			}
+			fmt.Fprintf(&b, `<polyline points="%s" fill="none"%s/>`+"\n", strings.Join(coordinates, " "), svgStroke(s.style))
+		case shapeCircle:
+			fmt.Fprintf(&b, `<circle cx="%s" cy="%s" r="%s" fill="none"%s/>`+"\n",
+				svgNumber(s.points[0].x), svgNumber(s.points[0].y), svgNumber(s.radius), svgStroke(s.style))
+		case shapePolygon:
+			coordinates := make([]string, len(s.points))
+			for i, p := range s.points {
+				coordinates[i] = svgNumber(p.x) + "," + svgNumber(p.y)
+			}
+			fmt.Fprintf(&b, `<polygon points="%s" fill="%s" fill-opacity="%s" stroke="none"/>`+"\n",
+				strings.Join(coordinates, " "), svgColor(s.fill), svgNumber(s.alpha))
+		case shapeText:
+			anchor := [...]string{"start", "middle", "end"}[s.anchor]
+			fmt.Fprintf(&b, `<text x="%s" y="%s" text-anchor="%s" font-family="sans-serif" font-size="%s" fill="%s">%s</text>`+"\n",
+				svgNumber(s.points[0].x), svgNumber(s.points[0].y), anchor, svgNumber(fontSize), svgColor(s.style.color),
+				svgEscape(s.text))
+		}
+	}
+	b.WriteString("</svg>\n")
+
+	_, err := io.WriteString(out, b.String())
+	return err
+}
+
+func svgStroke(s style) string {
+	stroke := fmt.Sprintf(` stroke="%s" stroke-width="%s"`, svgColor(s.color), svgNumber(s.width))
+	if s.dashed {
+		stroke += fmt.Sprintf(` stroke-dasharray="%s,%s"`, svgNumber(4*s.width), svgNumber(3*s.width))
+	}
+
+	return stroke
+}
+
+func svgColor(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+func svgNumber(value float64) string {
+	return strconv.FormatFloat(math.Round(value*100)/100, 'f', -1, 64)
+}
+
+var svgEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;")
+
+func svgEscape(text string) string {
+	return svgEscaper.Replace(text)
+}
+
+// niceStep returns a step size of 1, 2 or 5 times a power of ten, so that about count steps cover the given range.
+func niceStep(max float64, count int) float64 {
+	if max <= 0 || count < 1 {
+		return 1
+	}
+
+	raw := max / float64(count)
+	magnitude := math.Pow(10, math.Floor(math.Log10(raw)))
+	for _, factor := range []float64{1, 2, 5} {
+		if factor*magnitude >= raw {
+			return factor * magnitude
+		}
+	}
+
+	return 10 * magnitude
+}
+
+// formatLabel formats an axis value with as many decimals as the step size requires.
+func formatLabel(value, step float64) string {
+	decimals := 0
+	if step > 0 && step < 1 {
+		decimals = int(math.Ceil(-math.Log10(step) - 1e-9))
+	}
+
+	return strconv.FormatFloat(value, 'f', decimals, 64)
+}
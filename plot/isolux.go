@@ -0,0 +1,317 @@
+package plot
+
+import (
+	"image"
+	"image/color"
+	"io"
+	"math"
+
+	"github.com/h44z/eulumies"
+)
+
+// IsoluxOptions controls the rendering of isolux diagrams.
+type IsoluxOptions struct {
+	Width  int // width of the diagram in pixels, defaults to 400
+	Height int // height of the diagram in pixels, defaults to 420
+	// Grid describes the installation and the evaluated ground area. The mounting height is required, the area
+	// defaults to three mounting heights around the luminaire and the resolution to 81 points per axis.
+	Grid eulumies.IlluminanceGridOptions
+	// Levels are the illuminance values (lx) of the contour lines. By default levels of 1, 2 and 5 times a power
+	// of ten between 1 % of the maximum and the maximum illuminance are used.
+	Levels []float64
+}
+
+func (o IsoluxOptions) withDefaults() IsoluxOptions {
+	if o.Width <= 0 {
+		o.Width = 400
+	}
+	if o.Height <= 0 {
+		o.Height = 420
+	}
+	if o.Grid.MinX == 0 && o.Grid.MaxX == 0 && o.Grid.MinY == 0 && o.Grid.MaxY == 0 {
+		extent := 3 * o.Grid.MountingHeight
+		o.Grid.MinX, o.Grid.MaxX, o.Grid.MinY, o.Grid.MaxY = -extent, extent, -extent, extent
+	}
+	if o.Grid.PointsX == 0 {
+		o.Grid.PointsX = 81
+	}
+	if o.Grid.PointsY == 0 {
+		o.Grid.PointsY = 81
+	}
+
+	return o
+}
+
+const (
+	isoluxMarginLeft   = 40.0
+	isoluxMarginRight  = 15.0
+	isoluxMarginTop    = 20.0
+	isoluxMarginBottom = 45.0
+)
+
+// IsoluxSVG renders the isolux contour lines of the horizontal ground illuminance as SVG document. The axes are
+// scaled in meters, the luminaire is located at the origin.
+func IsoluxSVG(out io.Writer, photometry Photometry, opts IsoluxOptions) error {
+	diagram, err := isoluxFigure(photometry, opts.withDefaults())
+	if err != nil {
+		return err
+	}
+
+	return diagram.writeSVG(out)
+}
+
+// IsoluxImage renders the isolux diagram (see IsoluxSVG) into a raster image.
+func IsoluxImage(photometry Photometry, opts IsoluxOptions) (image.Image, error) {
+	diagram, err := isoluxFigure(photometry, opts.withDefaults())
+	if err != nil {
+		return nil, err
+	}
+
+	return diagram.rasterize(), nil
+}
+
+// IsoluxPNG renders the isolux diagram (see IsoluxSVG) as PNG image.
+func IsoluxPNG(out io.Writer, photometry Photometry, opts IsoluxOptions) error {
+	diagram, err := isoluxFigure(photometry, opts.withDefaults())
+	if err != nil {
+		return err
+	}
+
+	return diagram.writePNG(out)
+}
+
+// isoluxFigure builds the isolux diagram with equally scaled axes.
+func isoluxFigure(photometry Photometry, opts IsoluxOptions) (*figure, error) {
+	grid, err := photometry.IlluminanceGrid(opts.Grid)
+	if err != nil {
+		return nil, err
+	}
+	levels := opts.Levels
+	if len(levels) == 0 {
+		levels = isoluxLevels(grid.Max)
+	}
+
+	diagram := &figure{width: opts.Width, height: opts.Height}
+	areaWidth := math.Max(1, float64(opts.Width)-isoluxMarginLeft-isoluxMarginRight)
+	areaHeight := math.Max(1, float64(opts.Height)-isoluxMarginTop-isoluxMarginBottom)
+	spanX := opts.Grid.MaxX - opts.Grid.MinX
+	spanY := opts.Grid.MaxY - opts.Grid.MinY
+	scale := math.Min(areaWidth/spanX, areaHeight/spanY)
+	left := isoluxMarginLeft + (areaWidth-spanX*scale)/2
+	bottom := isoluxMarginTop + areaHeight - (areaHeight-spanY*scale)/2
+	position := func(x, y float64) point {
+		return point{x: left + (x-opts.Grid.MinX)*scale, y: bottom - (y-opts.Grid.MinY)*scale}
+	}
+
+	lines := style{color: colorGrid, width: 0.5}
+	step := niceStep(math.Max(spanX, spanY), 6)
+	for x := math.Ceil(opts.Grid.MinX/step) * step; x <= opts.Grid.MaxX+1e-9; x += step {
+		diagram.polyline(lines, position(x, opts.Grid.MinY), position(x, opts.Grid.MaxY))
+		label := position(x, opts.Grid.MinY)
+		diagram.text(point{x: label.x, y: label.y + fontSize + 4}, anchorMiddle, formatLabel(x, step))
+	}
+	for y := math.Ceil(opts.Grid.MinY/step) * step; y <= opts.Grid.MaxY+1e-9; y += step {
+		diagram.polyline(lines, position(opts.Grid.MinX, y), position(opts.Grid.MaxX, y))
+		label := position(opts.Grid.MinX, y)
+		diagram.text(point{x: label.x - 4, y: label.y + fontSize/3}, anchorEnd, formatLabel(y, step))
+	}
+	diagram.polyline(style{color: colorText, width: 0.5}, position(opts.Grid.MinX, opts.Grid.MinY),
+		position(opts.Grid.MaxX, opts.Grid.MinY), position(opts.Grid.MaxX, opts.Grid.MaxY),
+		position(opts.Grid.MinX, opts.Grid.MaxY), position(opts.Grid.MinX, opts.Grid.MinY))
+	diagram.text(point{x: 4, y: fontSize + 4}, anchorStart, "m")
+
+	legendY := float64(opts.Height) - 10
+	legendX := isoluxMarginLeft
+	diagram.text(point{x: legendX, y: legendY + fontSize/3}, anchorStart, "lx")
+	legendX += 20
+	for l, level := range levels {
+		s := style{color: levelColor(l, len(levels)), width: 1.5}
+		for _, contour := range contourLines(grid, level) {
+			points := make([]point, len(contour))
+			for i, p := range contour {
+				points[i] = position(p.x, p.y)
+			}
+			diagram.polyline(s, points...)
+		}
+
+		label := formatLabel(level, level)
+		diagram.polyline(s, point{x: legendX, y: legendY}, point{x: legendX + 12, y: legendY})
+		diagram.text(point{x: legendX + 15, y: legendY + fontSize/3}, anchorStart, label)
+		legendX += 22 + float64(textWidth(label))
+	}
+
+	if 0 >= opts.Grid.MinX && 0 <= opts.Grid.MaxX && 0 >= opts.Grid.MinY && 0 <= opts.Grid.MaxY {
+		diagram.circle(style{color: colorText, width: 1}, position(0, 0), 3)
+	}
+
+	return diagram, nil
+}
+
+// isoluxLevels returns the levels 1, 2 and 5 times a power of ten between 1 % of the maximum and the maximum.
+func isoluxLevels(max float64) []float64 {
+	if max <= 0 {
+		return nil
+	}
+
+	var levels []float64
+	magnitude := math.Pow(10, math.Floor(math.Log10(max/100)))
+	for len(levels) < 16 {
+		for _, factor := range []float64{1, 2, 5} {
+			level := factor * magnitude
+			if level >= max/100 && level < max {
+				levels = append(levels, level)
+			}
+		}
+		magnitude *= 10
+		if magnitude >= max {
+			break
+		}
+	}
+
+	return levels
+}
+
+// levelColor returns the color of the contour level, ranging from blue (lowest) to red (highest level).
+func levelColor(level, count int) color.RGBA {
+	t := 0.0
+	if count > 1 {
+		t = float64(level) / float64(count-1)
+	}
+	mix := func(a, b uint8) uint8 {
+		return uint8(math.Round(float64(a)*(1-t) + float64(b)*t))
+	}
+
+	return color.RGBA{R: mix(colorPrimary.R, colorSecondary.R), G: mix(colorPrimary.G, colorSecondary.G),
+		B: mix(colorPrimary.B, colorSecondary.B), A: 255}
+}
+
+// contourLines returns the contour lines of the grid at the given level (marching squares). The points are given
+// in grid coordinates, connected segments are joined to polylines.
+func contourLines(grid eulumies.IlluminanceGrid, level float64) [][]point {
+	var segments [][2]point
+	for row := 0; row+1 < len(grid.Y); row++ {
+		for column := 0; column+1 < len(grid.X); column++ {
+			// corners counterclockwise: a (column, row), b (column+1, row), c (column+1, row+1), d (column, row+1)
+			corners := [4]point{
+				{x: grid.X[column], y: grid.Y[row]},
+				{x: grid.X[column+1], y: grid.Y[row]},
+				{x: grid.X[column+1], y: grid.Y[row+1]},
+				{x: grid.X[column], y: grid.Y[row+1]},
+			}
+			values := [4]float64{
+				grid.Values[row][column], grid.Values[row][column+1],
+				grid.Values[row+1][column+1], grid.Values[row+1][column],
+			}
+
+			cell := 0
+			for i, value := range values {
+				if value >= level {
+					cell |= 1 << uint(i)
+				}
+			}
+			// edge returns the crossing point on the edge from corner i to the next corner
+			edge := func(i int) point {
+				j := (i + 1) % 4
+				t := 0.5
+				if values[j] != values[i] {
+					t = (level - values[i]) / (values[j] - values[i])
+				}
+				return interpolatePoint(corners[i], corners[j], t)
+			}
+			centerInside := (values[0]+values[1]+values[2]+values[3])/4 >= level
+
+			for _, pair := range contourEdges(cell, centerInside) {
+				segments = append(segments, [2]point{edge(pair[0]), edge(pair[1])})
+			}
+		}
+	}
+
+	return joinSegments(segments)
+}
+
+// contourEdges returns the pairs of cell edges (0: a-b, 1: b-c, 2: c-d, 3: d-a) crossed by the contour for the
+// given corner configuration. Saddle cells are resolved using the average of the corner values.
+func contourEdges(cell int, centerInside bool) [][2]int {
+	switch cell {
+	case 1, 14:
+		return [][2]int{{3, 0}}
+	case 2, 13:
+		return [][2]int{{0, 1}}
+	case 3, 12:
+		return [][2]int{{3, 1}}
+	case 4, 11:
+		return [][2]int{{1, 2}}
+	case 6, 9:
+		return [][2]int{{0, 2}}
+	case 7, 8:
+		return [][2]int{{2, 3}}
+	case 5:
+		if centerInside {
+			return [][2]int{{0, 1}, {2, 3}}
+		}
+		return [][2]int{{3, 0}, {1, 2}}
+	case 10:
+		if centerInside {
+			return [][2]int{{3, 0}, {1, 2}}
+		}
+		return [][2]int{{0, 1}, {2, 3}}
+	}
+
+	return nil
+}
+
+// joinSegments connects segments sharing end points to polylines.
+func joinSegments(segments [][2]point) [][]point {
+	type key struct{ x, y int64 }
+	keyOf := func(p point) key {
+		return key{x: int64(math.Round(p.x * 1e6)), y: int64(math.Round(p.y * 1e6))}
+	}
+
+	// corners lying exactly on the level yield segments of zero length
+	nonDegenerate := segments[:0]
+	for _, segment := range segments {
+		if keyOf(segment[0]) != keyOf(segment[1]) {
+			nonDegenerate = append(nonDegenerate, segment)
+		}
+	}
+	segments = nonDegenerate
+
+	byEndpoint := make(map[key][]int)
+	for i, segment := range segments {
+		byEndpoint[keyOf(segment[0])] = append(byEndpoint[keyOf(segment[0])], i)
+		byEndpoint[keyOf(segment[1])] = append(byEndpoint[keyOf(segment[1])], i)
+	}
+	used := make([]bool, len(segments))
+	// next returns an unused segment touching the point, oriented to start at the point
+	next := func(p point) ([2]point, bool) {
+		for _, i := range byEndpoint[keyOf(p)] {
+			if used[i] {
+				continue
+			}
+			used[i] = true
+			if keyOf(segments[i][0]) == keyOf(p) {
+				return segments[i], true
+			}
+			return [2]point{segments[i][1], segments[i][0]}, true
+		}
+		return [2]point{}, false
+	}
+
+	var lines [][]point
+	for i := range segments {
+		if used[i] {
+			continue
+		}
+		used[i] = true
+		line := []point{segments[i][0], segments[i][1]}
+		for segment, ok := next(line[len(line)-1]); ok; segment, ok = next(line[len(line)-1]) {
+			line = append(line, segment[1])
+		}
+		for segment, ok := next(line[0]); ok; segment, ok = next(line[0]) {
+			line = append([]point{segment[1]}, line...)
+		}
+		lines = append(lines, line)
+	}
+
+	return lines
+}
@@ -0,0 +1,63 @@
+package plot
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/h44z/eulumies"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsoluxSVG(t *testing.T) {
+	eulumdat := loadSample2(t)
+
+	var out bytes.Buffer
+	opts := IsoluxOptions{Grid: eulumies.IlluminanceGridOptions{MountingHeight: 3}, Levels: []float64{1, 5, 10}}
+	assert.NoError(t, IsoluxSVG(&out, eulumdat, opts))
+	svg := out.String()
+	assert.Contains(t, svg, ">lx<")
+	assert.Contains(t, svg, ">-5<")                                // axis in meters, three mounting heights around the luminaire
+	assert.Equal(t, 3+3, strings.Count(svg, `stroke-width="1.5"`)) // one closed contour and legend entry per level
+
+	_, err := IsoluxImage(eulumdat, IsoluxOptions{})
+	assert.Error(t, err) // mounting height missing
+	out.Reset()
+	assert.NoError(t, IsoluxPNG(&out, eulumdat, IsoluxOptions{Grid: eulumies.IlluminanceGridOptions{MountingHeight: 2}}))
+	assert.True(t, out.Len() > 0)
+}
+
+func Test_contourLines(t *testing.T) {
+	// radial field, the contour at level 1 is a circle with radius 1
+	grid := eulumies.IlluminanceGrid{}
+	for i := 0; i <= 40; i++ {
+		grid.X = append(grid.X, -2+float64(i)*0.1)
+		grid.Y = append(grid.Y, -2+float64(i)*0.1)
+	}
+	for _, y := range grid.Y {
+		row := make([]float64, len(grid.X))
+		for i, x := range grid.X {
+			row[i] = 2 - math.Hypot(x, y)
+		}
+		grid.Values = append(grid.Values, row)
+	}
+
+	lines := contourLines(grid, 1)
+	assert.Len(t, lines, 1)
+	assert.Greater(t, len(lines[0]), 20)
+	assert.Equal(t, lines[0][0], lines[0][len(lines[0])-1]) // closed
+	for _, p := range lines[0] {
+		assert.InDelta(t, 1, math.Hypot(p.x, p.y), 0.01)
+	}
+	assert.Empty(t, contourLines(grid, 5))
+}
+
+func Test_isoluxLevels(t *testing.T) {
+	assert.Equal(t, []float64{2, 5, 10, 20, 50, 100}, isoluxLevels(150))
+	assert.Nil(t, isoluxLevels(0))
+	for _, level := range isoluxLevels(0.37) {
+		assert.True(t, level >= 0.0037 && level < 0.37, level)
+	}
+	assert.True(t, strings.HasPrefix(formatLabel(0.05, 0.05), "0.05"))
+}
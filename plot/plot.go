@@ -0,0 +1,13 @@
+// Package plot renders photometric diagrams of EULUMDAT and IES data without an external plotting stack.
+package plot
+
+import (
+	"github.com/h44z/eulumies"
+)
+
+// Photometry is implemented by eulumies.Eulumdat and *eulumies.IES.
+type Photometry interface {
+	IntensityFunc() eulumies.IntensityFunc
+	ComputeTotalFlux() float64
+	IlluminanceGrid(opts eulumies.IlluminanceGridOptions) (eulumies.IlluminanceGrid, error)
+}
@@ -0,0 +1,178 @@
+package plot
+
+import (
+	"image"
+	"io"
+	"math"
+	"strconv"
+
+	"github.com/h44z/eulumies"
+)
+
+// PolarOptions controls the rendering of polar intensity diagrams.
+type PolarOptions struct {
+	Width  int // width of the diagram in pixels, defaults to 400
+	Height int // height of the diagram in pixels, defaults to 420
+	// Rings is the approximate number of intensity rings, defaults to 4. The ring distance is rounded to 1, 2 or 5
+	// times a power of ten.
+	Rings int
+	// Relative plots the intensities in cd/klm (per 1000 lm of luminaire flux) instead of absolute candela values.
+	Relative bool
+}
+
+func (o PolarOptions) withDefaults() PolarOptions {
+	if o.Width <= 0 {
+		o.Width = 400
+	}
+	if o.Height <= 0 {
+		o.Height = 420
+	}
+	if o.Rings <= 0 {
+		o.Rings = 4
+	}
+
+	return o
+}
+
+const (
+	polarGammaStep    = 1.0  // sampling distance of the curves in degrees
+	polarMargin       = 24.0 // space for the angle labels around the diagram
+	polarLegendHeight = 20.0
+)
+
+// PolarSVG renders the classic polar intensity diagram as SVG document: the C0-C180 plane is drawn as solid line,
+// the C90-C270 plane as dashed line. Nadir points downwards, C0 and C90 are drawn on the right hand side.
+func PolarSVG(out io.Writer, photometry Photometry, opts PolarOptions) error {
+	diagram := polarFigure(photometry, opts.withDefaults())
+	return diagram.writeSVG(out)
+}
+
+// PolarImage renders the polar intensity diagram (see PolarSVG) into a raster image.
+func PolarImage(photometry Photometry, opts PolarOptions) image.Image {
+	return polarFigure(photometry, opts.withDefaults()).rasterize()
+}
+
+// PolarPNG renders the polar intensity diagram (see PolarSVG) as PNG image.
+func PolarPNG(out io.Writer, photometry Photometry, opts PolarOptions) error {
+	return polarFigure(photometry, opts.withDefaults()).writePNG(out)
+}
+
+// polarFigure builds the polar diagram with automatically scaled intensity rings.
+func polarFigure(photometry Photometry, opts PolarOptions) *figure {
+	intensity := photometry.IntensityFunc()
+	normalization := NormalizationNone
+	if opts.Relative {
+		normalization = NormalizationFlux
+	}
+	factor, unit := normalizationFactor(photometry, normalization)
+
+	right0, left0 := sampleProfile(intensity, factor, 0)
+	right90, left90 := sampleProfile(intensity, factor, 90)
+
+	diagram := &figure{width: opts.Width, height: opts.Height}
+	grid := newPolarGrid(diagram, maxValue(right0, left0, right90, left90), opts.Rings, unit)
+
+	primary := style{color: colorPrimary, width: 1.5}
+	secondary := style{color: colorSecondary, width: 1.5, dashed: true}
+	diagram.polyline(secondary, grid.profile(right90, left90)...)
+	diagram.polyline(primary, grid.profile(right0, left0)...)
+
+	legendY := float64(opts.Height) - polarLegendHeight/2
+	diagram.polyline(primary, point{x: 10, y: legendY}, point{x: 30, y: legendY})
+	diagram.text(point{x: 34, y: legendY + fontSize/3}, anchorStart, "C0 - C180")
+	diagram.polyline(secondary, point{x: grid.center.x, y: legendY}, point{x: grid.center.x + 20, y: legendY})
+	diagram.text(point{x: grid.center.x + 24, y: legendY + fontSize/3}, anchorStart, "C90 - C270")
+
+	return diagram
+}
+
+// sampleProfile returns the intensities of the half planes c and c + 180 for gamma 0 to 180.
+func sampleProfile(intensity eulumies.IntensityFunc, factor, c float64) (right, left []float64) {
+	for gamma := 0.0; gamma <= 180; gamma += polarGammaStep {
+		right = append(right, intensity(c, gamma)*factor)
+		left = append(left, intensity(c+180, gamma)*factor)
+	}
+
+	return right, left
+}
+
+// polarGrid holds the geometry of a polar diagram, nadir points downwards.
+type polarGrid struct {
+	center   point
+	radius   float64
+	scaleMax float64
+}
+
+// newPolarGrid draws the intensity rings, the angle lines and their labels for the given maximum intensity.
+func newPolarGrid(diagram *figure, max float64, rings int, unit string) polarGrid {
+	step := niceStep(max, rings)
+	rings = int(math.Ceil(max/step - 1e-9))
+	if rings < 1 {
+		rings = 1
+	}
+
+	grid := polarGrid{
+		center:   point{x: float64(diagram.width) / 2, y: (float64(diagram.height) - polarLegendHeight) / 2},
+		radius:   math.Max(1, math.Min(float64(diagram.width), float64(diagram.height)-polarLegendHeight)/2-polarMargin),
+		scaleMax: float64(rings) * step,
+	}
+
+	lines := style{color: colorGrid, width: 0.5}
+	for ring := 1; ring <= rings; ring++ {
+		diagram.circle(lines, grid.center, grid.radius*float64(ring)/float64(rings))
+	}
+	for gamma := 0.0; gamma < 180; gamma += 30 {
+		diagram.polyline(lines, grid.position(grid.scaleMax, gamma, true), grid.position(grid.scaleMax, gamma, false))
+	}
+	for gamma := 0.0; gamma <= 180; gamma += 30 {
+		label := grid.position(grid.scaleMax*(1+12/grid.radius), gamma, true)
+		diagram.text(point{x: label.x, y: label.y + fontSize/3}, anchorMiddle, strconv.Itoa(int(gamma))+"°")
+		if gamma > 0 && gamma < 180 {
+			label = grid.position(grid.scaleMax*(1+12/grid.radius), gamma, false)
+			diagram.text(point{x: label.x, y: label.y + fontSize/3}, anchorMiddle, strconv.Itoa(int(gamma))+"°")
+		}
+	}
+	for ring := 1; ring <= rings; ring++ {
+		label := grid.position(float64(ring)*step, 90, true)
+		diagram.text(point{x: label.x - 2, y: label.y - 3}, anchorEnd, formatLabel(float64(ring)*step, step))
+	}
+	diagram.text(point{x: 4, y: fontSize + 4}, anchorStart, unit)
+
+	return grid
+}
+
+// position returns the figure position of the given intensity at gamma, on the right or the left hand side.
+func (g polarGrid) position(value, gamma float64, rightSide bool) point {
+	r := value / g.scaleMax * g.radius
+	sin, cos := math.Sincos(gamma * math.Pi / 180)
+	if !rightSide {
+		sin = -sin
+	}
+
+	return point{x: g.center.x + r*sin, y: g.center.y + r*cos}
+}
+
+// profile returns the closed curve of a plane profile, starting at the top of the left half (gamma 180).
+func (g polarGrid) profile(right, left []float64) []point {
+	points := make([]point, 0, len(right)+len(left))
+	for i := len(left) - 1; i >= 0; i-- {
+		points = append(points, g.position(left[i], float64(i)*polarGammaStep, false))
+	}
+	for i := range right {
+		points = append(points, g.position(right[i], float64(i)*polarGammaStep, true))
+	}
+
+	return points
+}
+
+// maxValue returns the maximum of all given values.
+func maxValue(values ...[]float64) float64 {
+	max := 0.0
+	for _, list := range values {
+		for _, value := range list {
+			max = math.Max(max, value)
+		}
+	}
+
+	return max
+}
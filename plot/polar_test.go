@@ -0,0 +1,66 @@
+package plot
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/h44z/eulumies"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// loadSample2 returns the parsed test/sample2.ldt.
+func loadSample2(t *testing.T) eulumies.Eulumdat {
+	file, err := os.Open("../test/sample2.ldt")
+	require.NoError(t, err)
+	defer file.Close()
+	eulumdat, err := eulumies.NewEulumdat(file, false)
+	require.NoError(t, err)
+
+	return eulumdat
+}
+
+func TestPolarSVG(t *testing.T) {
+	eulumdat := loadSample2(t)
+
+	var out bytes.Buffer
+	assert.NoError(t, PolarSVG(&out, eulumdat, PolarOptions{}))
+	svg := out.String()
+	assert.True(t, strings.HasPrefix(svg, `<svg xmlns="http://www.w3.org/2000/svg" width="400" height="420"`))
+	assert.True(t, strings.HasSuffix(svg, "</svg>\n"))
+	assert.Contains(t, svg, "C0 - C180")
+	assert.Contains(t, svg, ">cd<")
+	assert.Equal(t, 2, strings.Count(svg, "stroke-dasharray"))
+
+	ies, err := eulumies.NewIES("../test/sample.ies", false)
+	assert.NoError(t, err)
+	out.Reset()
+	assert.NoError(t, PolarSVG(&out, ies, PolarOptions{Width: 200, Height: 200, Relative: true}))
+	assert.Contains(t, out.String(), ">cd/klm<")
+}
+
+func TestPolarFigure_Scaling(t *testing.T) {
+	diagram := polarFigure(loadSample2(t), PolarOptions{Rings: 4}.withDefaults())
+	circles := 0
+	for _, s := range diagram.shapes {
+		if s.kind == shapeCircle {
+			circles++
+		}
+	}
+	assert.GreaterOrEqual(t, circles, 3)
+	assert.LessOrEqual(t, circles, 6)
+}
+
+func Test_niceStep(t *testing.T) {
+	assert.Equal(t, 100.0, niceStep(400, 4))
+	assert.Equal(t, 200.0, niceStep(650, 4))
+	assert.Equal(t, 500.0, niceStep(1800, 4))
+	assert.Equal(t, 1000.0, niceStep(3900, 4))
+	assert.InDelta(t, 0.02, niceStep(0.07, 4), 1e-12)
+	assert.Equal(t, 1.0, niceStep(0, 4))
+
+	assert.Equal(t, "0.02", formatLabel(0.02, 0.02))
+	assert.Equal(t, "300", formatLabel(300, 100))
+}
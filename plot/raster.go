@@ -0,0 +1,194 @@
+package plot
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+	"sort"
+)
+
+// rasterizer draws the figure primitives with anti-aliased lines into an RGBA image.
+type rasterizer struct {
+	img      *image.RGBA
+	coverage []float64 // coverage of the current shape per pixel, combined by maximum to avoid overdraw at joints
+	bounds   image.Rectangle
+}
+
+// rasterize renders the figure into a new image.
+func (f *figure) rasterize() *image.RGBA {
+	r := &rasterizer{
+		img:      image.NewRGBA(image.Rect(0, 0, f.width, f.height)),
+		coverage: make([]float64, f.width*f.height),
+	}
+	for i := 0; i < len(r.img.Pix); i += 4 {
+		r.img.Pix[i], r.img.Pix[i+1], r.img.Pix[i+2], r.img.Pix[i+3] =
+			colorBackground.R, colorBackground.G, colorBackground.B, colorBackground.A
+	}
+
+	for _, s := range f.shapes {
+		switch s.kind {
+		case shapePolyline:
+			r.polyline(s.points, s.style)
+		case shapeCircle:
+			segments := int(math.Max(32, s.radius))
+			points := make([]point, segments+1)
+			for i := range points {
+				sin, cos := math.Sincos(2 * math.Pi * float64(i) / float64(segments))
+				points[i] = point{x: s.points[0].x + s.radius*cos, y: s.points[0].y + s.radius*sin}
+			}
+			r.polyline(points, s.style)
+		case shapePolygon:
+			r.polygon(s.points, s.fill, s.alpha)
+		case shapeText:
+			r.text(s.points[0], s.anchor, s.text, s.style.color)
+		}
+	}
+
+	return r.img
+}
+
+// writePNG renders the figure as PNG image.
+func (f *figure) writePNG(out io.Writer) error {
+	return png.Encode(out, f.rasterize())
+}
+
+// polyline draws the connected line segments, dashed lines are split into dashes of 4 and gaps of 3 line widths.
+func (r *rasterizer) polyline(points []point, s style) {
+	if !s.dashed {
+		for i := 1; i < len(points); i++ {
+			r.segment(points[i-1], points[i], s.width)
+		}
+		r.blend(s.color)
+		return
+	}
+
+	dash, gap := 4*s.width, 3*s.width
+	position := 0.0 // position within the dash pattern
+	for i := 1; i < len(points); i++ {
+		from, to := points[i-1], points[i]
+		length := math.Hypot(to.x-from.x, to.y-from.y)
+		for done := 0.0; done < length; {
+			step := math.Min(length-done, dash+gap-position)
+			if position < dash {
+				drawn := math.Min(step, dash-position)
+				r.segment(interpolatePoint(from, to, done/length), interpolatePoint(from, to, (done+drawn)/length), s.width)
+			}
+			done += step
+			position = math.Mod(position+step, dash+gap)
+		}
+	}
+	r.blend(s.color)
+}
+
+// polygon fills all pixels whose center lies within the polygon (even-odd rule).
+func (r *rasterizer) polygon(points []point, c color.RGBA, alpha float64) {
+	bounds := r.img.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		py := float64(y) + 0.5
+		var crossings []float64
+		for i := range points {
+			a, b := points[i], points[(i+1)%len(points)]
+			if (a.y <= py) != (b.y <= py) {
+				crossings = append(crossings, a.x+(py-a.y)/(b.y-a.y)*(b.x-a.x))
+			}
+		}
+		sort.Float64s(crossings)
+		for i := 0; i+1 < len(crossings); i += 2 {
+			from := int(math.Max(float64(bounds.Min.X), math.Ceil(crossings[i]-0.5)))
+			to := int(math.Min(float64(bounds.Max.X-1), math.Floor(crossings[i+1]-0.5)))
+			for x := from; x <= to; x++ {
+				r.paint(x, y, c, alpha)
+			}
+		}
+	}
+}
+
+// segment adds the coverage of a line segment with the given width.
+func (r *rasterizer) segment(from, to point, width float64) {
+	halfWidth := width / 2
+	minX := int(math.Floor(math.Min(from.x, to.x) - halfWidth - 1))
+	maxX := int(math.Ceil(math.Max(from.x, to.x) + halfWidth + 1))
+	minY := int(math.Floor(math.Min(from.y, to.y) - halfWidth - 1))
+	maxY := int(math.Ceil(math.Max(from.y, to.y) + halfWidth + 1))
+	area := image.Rect(minX, minY, maxX+1, maxY+1).Intersect(r.img.Bounds())
+	r.bounds = r.bounds.Union(area)
+
+	dx, dy := to.x-from.x, to.y-from.y
+	lengthSquared := dx*dx + dy*dy
+	for y := area.Min.Y; y < area.Max.Y; y++ {
+		for x := area.Min.X; x < area.Max.X; x++ {
+			// distance of the pixel center to the segment
+			px, py := float64(x)+0.5, float64(y)+0.5
+			t := 0.0
+			if lengthSquared > 0 {
+				t = math.Max(0, math.Min(1, ((px-from.x)*dx+(py-from.y)*dy)/lengthSquared))
+			}
+			distance := math.Hypot(px-from.x-t*dx, py-from.y-t*dy)
+			coverage := math.Max(0, math.Min(1, halfWidth+0.5-distance))
+			index := y*r.img.Bounds().Dx() + x
+			r.coverage[index] = math.Max(r.coverage[index], coverage)
+		}
+	}
+}
+
+// blend paints the accumulated coverage with the given color and resets it.
+func (r *rasterizer) blend(c color.RGBA) {
+	width := r.img.Bounds().Dx()
+	for y := r.bounds.Min.Y; y < r.bounds.Max.Y; y++ {
+		for x := r.bounds.Min.X; x < r.bounds.Max.X; x++ {
+			index := y*width + x
+			if alpha := r.coverage[index]; alpha > 0 {
+				r.paint(x, y, c, alpha)
+				r.coverage[index] = 0
+			}
+		}
+	}
+	r.bounds = image.Rectangle{}
+}
+
+// paint blends the color into the pixel with the given opacity.
+func (r *rasterizer) paint(x, y int, c color.RGBA, alpha float64) {
+	if !(image.Point{X: x, Y: y}).In(r.img.Bounds()) {
+		return
+	}
+
+	offset := r.img.PixOffset(x, y)
+	pixel := r.img.Pix[offset : offset+3]
+	for i, channel := range []uint8{c.R, c.G, c.B} {
+		pixel[i] = uint8(math.Round(float64(pixel[i])*(1-alpha) + float64(channel)*alpha))
+	}
+}
+
+// text draws the text with the bitmap font, the position is the baseline like in SVG.
+func (r *rasterizer) text(position point, anchor textAnchor, text string, c color.RGBA) {
+	x := int(math.Round(position.x))
+	switch anchor {
+	case anchorMiddle:
+		x -= textWidth(text) / 2
+	case anchorEnd:
+		x -= textWidth(text)
+	}
+	top := int(math.Round(position.y)) - glyphHeight
+
+	for _, character := range text {
+		glyph, ok := glyphs[character]
+		if !ok {
+			glyph = glyphs['?']
+		}
+		for row := 0; row < glyphHeight; row++ {
+			for column := 0; column < glyphWidth; column++ {
+				if glyph[row]&(1<<uint(glyphWidth-1-column)) != 0 {
+					r.paint(x+column, top+row, c, 1)
+				}
+			}
+		}
+		x += glyphAdvance
+	}
+}
+
+// interpolatePoint returns the point at the fraction t on the way from a to b.
+func interpolatePoint(a, b point, t float64) point {
+	return point{x: a.x + (b.x-a.x)*t, y: a.y + (b.y-a.y)*t}
+}
@@ -0,0 +1,61 @@
+package plot
+
+import (
+	"bytes"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolarPNG(t *testing.T) {
+	eulumdat := loadSample2(t)
+
+	var out bytes.Buffer
+	assert.NoError(t, PolarPNG(&out, eulumdat, PolarOptions{Width: 300, Height: 320}))
+	img, err := png.Decode(&out)
+	assert.NoError(t, err)
+	assert.Equal(t, 300, img.Bounds().Dx())
+	assert.Equal(t, 320, img.Bounds().Dy())
+
+	primary := 0
+	secondary := 0
+	for y := 0; y < img.Bounds().Dy(); y++ {
+		for x := 0; x < img.Bounds().Dx(); x++ {
+			switch color.RGBAModel.Convert(img.At(x, y)) {
+			case colorPrimary:
+				primary++
+			case colorSecondary:
+				secondary++
+			}
+		}
+	}
+	assert.Greater(t, primary, 100)
+	assert.Greater(t, secondary, 50)
+}
+
+func TestFigure_rasterize(t *testing.T) {
+	diagram := &figure{width: 20, height: 20}
+	diagram.polyline(style{color: colorPrimary, width: 2}, point{x: 0, y: 10}, point{x: 20, y: 10})
+	diagram.text(point{x: 0, y: 8}, anchorStart, "1")
+
+	img := diagram.rasterize()
+	assert.Equal(t, colorPrimary, img.RGBAAt(5, 9))
+	assert.Equal(t, colorPrimary, img.RGBAAt(5, 10))
+	assert.Equal(t, colorBackground, img.RGBAAt(5, 14))
+	assert.Equal(t, colorText, img.RGBAAt(2, 1)) // top of the glyph "1"
+	assert.Equal(t, colorBackground, img.RGBAAt(0, 1))
+
+	dashed := &figure{width: 40, height: 5}
+	dashed.polyline(style{color: colorPrimary, width: 1, dashed: true}, point{x: 0, y: 2.5}, point{x: 40, y: 2.5})
+	img = dashed.rasterize()
+	assert.Equal(t, colorPrimary, img.RGBAAt(1, 2))
+	assert.Equal(t, colorBackground, img.RGBAAt(5, 2))
+	assert.Equal(t, colorPrimary, img.RGBAAt(8, 2))
+}
+
+func Test_textWidth(t *testing.T) {
+	assert.Equal(t, 0, textWidth(""))
+	assert.Equal(t, 3*glyphAdvance, textWidth("90°"))
+}
@@ -0,0 +1,190 @@
+package plot
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strings"
+)
+
+// TextOptions controls the rendering of text polar diagrams.
+type TextOptions struct {
+	Width    int  // width of the diagram in characters, defaults to 61. The height is half the width.
+	Rings    int  // approximate number of intensity rings, defaults to 3
+	Relative bool // plot the intensities in cd/klm instead of absolute candela values
+}
+
+func (o TextOptions) withDefaults() TextOptions {
+	if o.Width <= 0 {
+		o.Width = 61
+	}
+	if o.Width < 11 {
+		o.Width = 11
+	}
+	if o.Rings <= 0 {
+		o.Rings = 3
+	}
+
+	return o
+}
+
+const (
+	textCharC0    = '*' // C0 - C180 plane
+	textCharC90   = 'o' // C90 - C270 plane
+	textCharRing  = '.'
+	textGammaStep = 0.25 // sampling distance of the curves in degrees
+)
+
+// PolarText prints an approximate polar intensity diagram (see PolarSVG) for terminals, followed by the maximum
+// intensity, the beam angles (50 % of the peak) of both principal planes and the luminaire flux. Terminal characters
+// are assumed to be twice as high as wide.
+func PolarText(out io.Writer, photometry Photometry, opts TextOptions) error {
+	opts = opts.withDefaults()
+	intensity := photometry.IntensityFunc()
+	normalization := NormalizationNone
+	if opts.Relative {
+		normalization = NormalizationFlux
+	}
+	factor, unit := normalizationFactor(photometry, normalization)
+
+	var right0, left0, right90, left90 []float64
+	for gamma := 0.0; gamma <= 180; gamma += textGammaStep {
+		right0 = append(right0, intensity(0, gamma)*factor)
+		left0 = append(left0, intensity(180, gamma)*factor)
+		right90 = append(right90, intensity(90, gamma)*factor)
+		left90 = append(left90, intensity(270, gamma)*factor)
+	}
+	max := maxValue(right0, left0, right90, left90)
+
+	radius := (opts.Width - 1) / 2
+	canvas := newTextCanvas(2*radius+1, radius+1)
+	step := niceStep(max, opts.Rings)
+	scaleMax := math.Max(step, math.Ceil(max/step-1e-9)*step)
+
+	for ring := step; ring <= scaleMax+1e-9; ring += step {
+		for angle := 0.0; angle < 360; angle += 2 {
+			canvas.plot(ring/scaleMax, angle, textCharRing)
+		}
+	}
+	canvas.line(0, canvas.height/2, canvas.width-1, canvas.height/2, '-')
+	canvas.line(canvas.width/2, 0, canvas.width/2, canvas.height-1, '|')
+	canvas.curve(right90, left90, scaleMax, textCharC90)
+	canvas.curve(right0, left0, scaleMax, textCharC0)
+
+	canvas.writeTo(out)
+	fmt.Fprintf(out, "%c C0 - C180   %c C90 - C270   %c %s %s\n",
+		textCharC0, textCharC90, textCharRing, formatLabel(step, step), unit)
+	fmt.Fprintf(out, "Maximum intensity: %.1f %s\n", max, unit)
+	fmt.Fprintf(out, "Beam angle:        %.1f° (C0 - C180), %.1f° (C90 - C270)\n",
+		beamAngle(right0, left0), beamAngle(right90, left90))
+	fmt.Fprintf(out, "Luminaire flux:    %.0f lm\n", photometry.ComputeTotalFlux())
+
+	return nil
+}
+
+// beamAngle returns the angle between the directions at which the intensity of a plane profile drops below 50 % of
+// its peak, profiles are sampled every textGammaStep degrees.
+func beamAngle(right, left []float64) float64 {
+	// the profile runs from gamma 180 of the left half plane over nadir to gamma 180 of the right half plane
+	profile := make([]float64, 0, len(right)+len(left)-1)
+	for i := len(left) - 1; i > 0; i-- {
+		profile = append(profile, left[i])
+	}
+	profile = append(profile, right...)
+
+	peak := 0
+	for i, value := range profile {
+		if value > profile[peak] {
+			peak = i
+		}
+	}
+	threshold := profile[peak] / 2
+	if threshold <= 0 {
+		return 0
+	}
+
+	lower, upper := 0.0, float64(len(profile)-1)
+	for i := peak; i > 0; i-- {
+		if profile[i-1] < threshold {
+			lower = float64(i) - (profile[i]-threshold)/(profile[i]-profile[i-1])
+			break
+		}
+	}
+	for i := peak; i < len(profile)-1; i++ {
+		if profile[i+1] < threshold {
+			upper = float64(i) + (profile[i]-threshold)/(profile[i]-profile[i+1])
+			break
+		}
+	}
+
+	return (upper - lower) * textGammaStep
+}
+
+// textCanvas is a character grid, the origin is the top left corner.
+type textCanvas struct {
+	width, height int
+	cells         [][]rune
+}
+
+func newTextCanvas(width, height int) *textCanvas {
+	cells := make([][]rune, height)
+	for i := range cells {
+		cells[i] = []rune(strings.Repeat(" ", width))
+	}
+
+	return &textCanvas{width: width, height: height, cells: cells}
+}
+
+func (c *textCanvas) set(x, y int, char rune) {
+	if x >= 0 && x < c.width && y >= 0 && y < c.height {
+		c.cells[y][x] = char
+	}
+}
+
+// position returns the cell of the relative radius r (1 = outer ring) at gamma, nadir points downwards.
+func (c *textCanvas) position(r, gamma float64) (int, int) {
+	sin, cos := math.Sincos(gamma * math.Pi / 180)
+	x := float64(c.width/2) + r*sin*float64(c.width/2)
+	y := float64(c.height/2) + r*cos*float64(c.height/2)
+
+	return int(math.Round(x)), int(math.Round(y))
+}
+
+func (c *textCanvas) plot(r, gamma float64, char rune) {
+	x, y := c.position(r, gamma)
+	c.set(x, y, char)
+}
+
+// line draws a straight line between two cells.
+func (c *textCanvas) line(x0, y0, x1, y1 int, char rune) {
+	steps := int(math.Max(math.Abs(float64(x1-x0)), math.Abs(float64(y1-y0))))
+	if steps == 0 {
+		c.set(x0, y0, char)
+		return
+	}
+	for i := 0; i <= steps; i++ {
+		t := float64(i) / float64(steps)
+		c.set(int(math.Round(float64(x0)+t*float64(x1-x0))), int(math.Round(float64(y0)+t*float64(y1-y0))), char)
+	}
+}
+
+// curve draws the closed profile of a plane pair, the left half plane uses negative gamma angles.
+func (c *textCanvas) curve(right, left []float64, scaleMax float64, char rune) {
+	x0, y0 := c.position(left[len(left)-1]/scaleMax, -180)
+	for i := len(left) - 2; i >= 0; i-- {
+		x1, y1 := c.position(left[i]/scaleMax, -float64(i)*textGammaStep)
+		c.line(x0, y0, x1, y1, char)
+		x0, y0 = x1, y1
+	}
+	for i := range right {
+		x1, y1 := c.position(right[i]/scaleMax, float64(i)*textGammaStep)
+		c.line(x0, y0, x1, y1, char)
+		x0, y0 = x1, y1
+	}
+}
+
+func (c *textCanvas) writeTo(out io.Writer) {
+	for _, row := range c.cells {
+		fmt.Fprintln(out, strings.TrimRight(string(row), " "))
+	}
+}
@@ -0,0 +1,42 @@
+package plot
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolarText(t *testing.T) {
+	eulumdat := loadSample2(t)
+
+	var out bytes.Buffer
+	assert.NoError(t, PolarText(&out, eulumdat, TextOptions{Width: 41}))
+	lines := strings.Split(out.String(), "\n")
+	assert.Len(t, lines, 21+4+1)
+	for _, line := range lines[:21] {
+		assert.LessOrEqual(t, len([]rune(line)), 41)
+	}
+	assert.Contains(t, lines[10], "*") // horizontal axis through the luminaire
+	assert.Equal(t, "* C0 - C180   o C90 - C270   . 50 cd", lines[21])
+	assert.Contains(t, out.String(), "Luminaire flux:    285 lm")
+
+	out.Reset()
+	assert.NoError(t, PolarText(&out, eulumdat, TextOptions{Width: 41, Relative: true}))
+	assert.Contains(t, out.String(), "cd/klm")
+}
+
+func Test_beamAngle(t *testing.T) {
+	// 100 % up to 30°, 0 % beyond: the 50 % threshold lies halfway between the samples at 30° and 30.25°
+	var right, left []float64
+	for gamma := 0.0; gamma <= 180; gamma += textGammaStep {
+		value := 0.0
+		if gamma <= 30 {
+			value = 1
+		}
+		right = append(right, value)
+		left = append(left, value)
+	}
+	assert.InDelta(t, 60.25, beamAngle(right, left), 1e-9)
+}
@@ -0,0 +1,12 @@
+package eulumies
+
+// Progress is the state of an operation processing multiple files, e.g. to display a progress bar.
+type Progress struct {
+	Done  int    // number of files processed, including File
+	Total int    // number of files of the operation
+	File  string // file processed last
+}
+
+// ProgressFunc receives the progress of long running operations after each processed file. It is not called
+// concurrently.
+type ProgressFunc func(Progress)
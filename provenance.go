@@ -0,0 +1,162 @@
+package eulumies
+
+import (
+	"fmt"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// provenanceKeyword is the user defined IES keyword that records the provenance of converted files.
+const provenanceKeyword = "_CONVERTED"
+
+// provenanceExtension is the EULUMDAT extension that records the provenance of converted files.
+const provenanceExtension = "CONVERTED"
+
+// modulePath is the import path of this library, used to look up its version in the build information.
+const modulePath = "github.com/h44z/eulumies"
+
+// Provenance describes the origin of a converted file. It is recorded as "key=value" pairs separated by semicolons,
+// for example
+//
+//	source=EULUMDAT; file=sample2.ldt; library=eulumies v1.4.0; options=format:LM-63-2002, type:1, ...; time=...
+type Provenance struct {
+	SourceFormat string    // EULUMDAT or the IES format of the source file
+	SourceFile   string    // name of the source file
+	Library      string    // name and version of the converting library
+	Options      string    // conversion options as "name:value" pairs separated by commas
+	Time         time.Time // time of the conversion
+}
+
+// newProvenance returns the provenance of a conversion of the given source with the options.
+func newProvenance(sourceFormat, sourceFile string, opts ConversionOptions) Provenance {
+	timestamp := opts.ProvenanceTime
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+
+	return Provenance{
+		SourceFormat: sourceFormat,
+		SourceFile:   sourceFile,
+		Library:      "eulumies " + libraryVersion(),
+		Options:      opts.provenanceString(),
+		Time:         timestamp.UTC().Truncate(time.Second),
+	}
+}
+
+// String returns the recorded form of the provenance. Semicolons and line breaks are removed from the values.
+func (p Provenance) String() string {
+	clean := strings.NewReplacer(";", ",", "\r", " ", "\n", " ")
+	fields := []string{
+		"source=" + clean.Replace(p.SourceFormat),
+		"file=" + clean.Replace(p.SourceFile),
+		"library=" + clean.Replace(p.Library),
+		"options=" + clean.Replace(p.Options),
+	}
+	if !p.Time.IsZero() {
+		fields = append(fields, "time="+p.Time.Format(time.RFC3339))
+	}
+
+	return strings.Join(fields, "; ")
+}
+
+// ParseProvenance parses the recorded form of a provenance, e.g. the value of the _CONVERTED keyword of an IES file
+// or the CONVERTED extension of an EULUMDAT file. Values wrapped to several keyword lines are joined. Unknown keys
+// are ignored.
+func ParseProvenance(value string) (Provenance, error) {
+	var provenance Provenance
+	value = strings.NewReplacer("\r\n", " ", "\n", " ").Replace(value)
+	for _, field := range strings.Split(value, ";") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		separator := strings.Index(field, "=")
+		if separator < 0 {
+			return Provenance{}, fmt.Errorf("invalid provenance field %q", field)
+		}
+
+		key, fieldValue := field[:separator], strings.TrimSpace(field[separator+1:])
+		switch key {
+		case "source":
+			provenance.SourceFormat = fieldValue
+		case "file":
+			provenance.SourceFile = fieldValue
+		case "library":
+			provenance.Library = fieldValue
+		case "options":
+			provenance.Options = fieldValue
+		case "time":
+			timestamp, err := time.Parse(time.RFC3339, fieldValue)
+			if err != nil {
+				return Provenance{}, fmt.Errorf("invalid provenance time: %w", err)
+			}
+			provenance.Time = timestamp
+		}
+	}
+
+	return provenance, nil
+}
+
+// Provenance returns the provenance recorded by a conversion to IES. Reports false if the data contains none.
+func (i *IES) Provenance() (Provenance, bool) {
+	value, ok := i.Keywords[provenanceKeyword]
+	if !ok {
+		return Provenance{}, false
+	}
+	provenance, err := ParseProvenance(value)
+
+	return provenance, err == nil
+}
+
+// Provenance returns the provenance recorded by a conversion to EULUMDAT. Reports false if the data contains none.
+func (e Eulumdat) Provenance() (Provenance, bool) {
+	value, ok := e.Extensions[provenanceExtension]
+	if !ok {
+		return Provenance{}, false
+	}
+	provenance, err := ParseProvenance(value)
+
+	return provenance, err == nil
+}
+
+// provenanceString returns the options that influence the converted data as comma separated "name:value" pairs.
+func (o ConversionOptions) provenanceString() string {
+	multiplier := "unity"
+	if o.CandelaMultiplier == CandelaMultiplierNormalized {
+		multiplier = "normalized"
+	}
+
+	return strings.Join([]string{
+		"format:" + string(o.Format),
+		"type:" + strconv.Itoa(o.PhotometricType),
+		"units:" + strconv.Itoa(o.UnitsType),
+		"lampset:" + strconv.Itoa(o.LampSet),
+		"symmetry:" + strconv.FormatBool(o.PreserveSymmetry),
+		"multiplier:" + multiplier,
+	}, ", ")
+}
+
+// libraryVersion returns the module version of this library from the build information of the binary, "(devel)"
+// if it is built from a working copy or the version cannot be determined.
+func libraryVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "(devel)"
+	}
+	modules := append([]*debug.Module{&info.Main}, info.Deps...)
+	for _, module := range modules {
+		if module.Path != modulePath {
+			continue
+		}
+		if module.Replace != nil {
+			module = module.Replace
+		}
+		if module.Version != "" {
+			return module.Version
+		}
+	}
+
+	return "(devel)"
+}
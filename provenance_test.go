@@ -0,0 +1,79 @@
+package eulumies
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseProvenance(t *testing.T) {
+	timestamp := time.Date(2026, 10, 16, 8, 30, 0, 0, time.UTC)
+	provenance := Provenance{
+		SourceFormat: "LM-63-2002",
+		SourceFile:   "lamp; v2.ies",
+		Library:      "eulumies v1.0.0",
+		Options:      "format:LM-63-2002, type:1",
+		Time:         timestamp,
+	}
+	value := provenance.String()
+	assert.Equal(t, "source=LM-63-2002; file=lamp, v2.ies; library=eulumies v1.0.0; "+
+		"options=format:LM-63-2002, type:1; time=2026-10-16T08:30:00Z", value)
+
+	parsed, err := ParseProvenance(strings.Replace(value, " ", "\n", 3))
+	assert.NoError(t, err)
+	provenance.SourceFile = "lamp, v2.ies"
+	assert.Equal(t, provenance, parsed)
+
+	_, err = ParseProvenance("source")
+	assert.Error(t, err)
+	_, err = ParseProvenance("time=yesterday")
+	assert.Error(t, err)
+}
+
+func TestConvertEulumdatToIES_Provenance(t *testing.T) {
+	eulumdat := loadSample2(t)
+
+	timestamp := time.Date(2026, 10, 16, 8, 30, 0, 0, time.FixedZone("CEST", 2*3600))
+	ies, err := ConvertEulumdatToIES(&eulumdat, ConversionOptions{Format: IESFormatLM_63_1995, Provenance: true,
+		SourceFile: "sample2.ldt", ProvenanceTime: timestamp})
+	assert.NoError(t, err)
+
+	// the record is wrapped to the short keyword lines of LM-63-1995 and restored when parsing the export
+	var buffer bytes.Buffer
+	assert.NoError(t, ies.ExportTo(&buffer, ExportOptions{}))
+	assert.Contains(t, buffer.String(), "[_CONVERTED] source=EULUMDAT;")
+	exported, err := NewIESFromReader(&buffer, false)
+	assert.NoError(t, err)
+
+	provenance, ok := exported.Provenance()
+	assert.True(t, ok)
+	assert.Equal(t, "EULUMDAT", provenance.SourceFormat)
+	assert.Equal(t, "sample2.ldt", provenance.SourceFile)
+	assert.True(t, strings.HasPrefix(provenance.Library, "eulumies "))
+	assert.Equal(t, "format:LM-63-1995, type:1, units:2, lampset:0, symmetry:false, multiplier:unity",
+		provenance.Options)
+	assert.Equal(t, time.Date(2026, 10, 16, 6, 30, 0, 0, time.UTC), provenance.Time)
+
+	ies, err = ConvertEulumdatToIES(&eulumdat, ConversionOptions{})
+	assert.NoError(t, err)
+	_, ok = ies.Provenance()
+	assert.False(t, ok)
+}
+
+func TestConvertIESToEulumdat_Provenance(t *testing.T) {
+	ies, err := NewIES("test/sample.ies", false)
+	assert.NoError(t, err)
+
+	eulumdat, err := ConvertIESToEulumdat(ies, ConversionOptions{Provenance: true, SourceFile: "sample.ies"})
+	assert.NoError(t, err)
+	assert.NoError(t, eulumdat.Validate(false).Err())
+
+	provenance, ok := eulumdat.Provenance()
+	assert.True(t, ok)
+	assert.Equal(t, "LM-63-1995", provenance.SourceFormat)
+	assert.Equal(t, "sample.ies", provenance.SourceFile)
+	assert.WithinDuration(t, time.Now(), provenance.Time, time.Minute)
+}
@@ -0,0 +1,178 @@
+package eulumies
+
+import (
+	"fmt"
+	"math"
+)
+
+// Weights of the quality categories in percent of the total score.
+const (
+	qualityWeightValidation  = 35
+	qualityWeightResolution  = 20
+	qualityWeightMetadata    = 20
+	qualityWeightConsistency = 25
+)
+
+// QualityScore rates photometric data from 0 (unusable) to 100 (complete, valid and consistent), e.g. to reject or
+// quarantine poor files automatically during catalog ingestion. The score is the weighted mean of the categories,
+// the breakdown explains every deduction.
+type QualityScore struct {
+	Score      int // 0 - 100
+	Categories []QualityCategory
+}
+
+// QualityCategory is the score of one aspect of the data.
+type QualityCategory struct {
+	Name   string   // validation, resolution, metadata or consistency
+	Score  int      // 0 - 100
+	Weight int      // share of the total score in percent
+	Notes  []string // reasons of the deductions
+}
+
+// QualityScore rates the data, see QualityScore. The categories are:
+//
+//	validation:  strict validation including the angle order, -25 per error and -5 per warning
+//	resolution:  largest gamma step (5 degrees or finer) and C-plane step (15 degrees or finer)
+//	metadata:    share of the filled catalog fields (names, lamp, report, date, flux, power, dimensions)
+//	consistency: declared against integrated flux, downward flux fraction and direct ratios
+func (e Eulumdat) QualityScore() QualityScore {
+	consistency := QualityCategory{Name: "consistency", Score: 100, Weight: qualityWeightConsistency}
+	if len(e.LuminousIntensityDistribution) > 0 {
+		result, issues := e.CheckFluxConsistency(DefaultFluxTolerance)
+		consistency.deductFlux(result, issues)
+
+		downward := 100 * (1 - e.ComputeUpwardLightRatio())
+		if math.Abs(downward-e.DownwardFluxFractionPhiu) > 2 {
+			consistency.deduct(20, "downward flux fraction %.1f%% differs from the integrated %.1f%%",
+				e.DownwardFluxFractionPhiu, downward)
+		}
+		if e.DirectRatios != [10]float64{} {
+			computed := e.ComputeDirectRatios()
+			for n := range computed {
+				if math.Abs(computed[n]-e.DirectRatios[n]) > 0.05 {
+					consistency.deduct(20, "direct ratio for k = %g is %.3f, the integrated ratio is %.3f",
+						DirectRatioRoomIndices[n], e.DirectRatios[n], computed[n])
+					break
+				}
+			}
+		}
+	} else {
+		consistency.deduct(100, "no luminous intensity distribution")
+	}
+
+	return newQualityScore(e.ValidateChecks(StrictDefault|CheckAngleMonotonicity), e.normalizedPhotometry(),
+		DateOrderDayMonth, consistency)
+}
+
+// QualityScore rates the data, see QualityScore. The categories are:
+//
+//	validation:  strict validation including the angle order, -25 per error and -5 per warning
+//	resolution:  largest vertical step (5 degrees or finer) and horizontal step (15 degrees or finer)
+//	metadata:    share of the filled catalog keywords and values (names, lamp, report, date, flux, power, dimensions)
+//	consistency: rated lamp lumens against the integrated flux
+func (i *IES) QualityScore() QualityScore {
+	consistency := QualityCategory{Name: "consistency", Score: 100, Weight: qualityWeightConsistency}
+	if len(i.CandelaValues) > 0 {
+		result, issues := i.CheckFluxConsistency(DefaultFluxTolerance)
+		consistency.deductFlux(result, issues)
+	} else {
+		consistency.deduct(100, "no candela values")
+	}
+
+	return newQualityScore(i.ValidateChecks(StrictDefault|CheckAngleMonotonicity), i.normalizedPhotometry(),
+		DateOrderMonthDay, consistency)
+}
+
+// newQualityScore returns the score of the validation issues, the normalized photometry and the format specific
+// consistency category.
+func newQualityScore(issues ValidationIssues, photometry normalizedPhotometry, order DateOrder,
+	consistency QualityCategory) QualityScore {
+	validation := QualityCategory{Name: "validation", Score: 100, Weight: qualityWeightValidation}
+	for _, issue := range issues {
+		switch issue.Severity {
+		case SeverityError:
+			validation.deduct(25, "%s", issue)
+		case SeverityWarning:
+			validation.deduct(5, "%s", issue)
+		}
+	}
+
+	resolution := QualityCategory{Name: "resolution", Score: 100, Weight: qualityWeightResolution}
+	if len(photometry.planes) == 0 || len(photometry.gAngles) < 2 {
+		resolution.deduct(100, "no luminous intensity distribution")
+	} else {
+		resolution.deductStep("gamma", largestStep(photometry.gAngles), 5)
+		if len(photometry.cAngles) > 1 {
+			resolution.deductStep("C-plane", largestStep(photometry.cAngles), 15)
+		}
+	}
+
+	metadata := QualityCategory{Name: "metadata", Score: 100, Weight: qualityWeightMetadata}
+	_, dateConfidence := ParseDate(photometry.date, order)
+	fields := []struct {
+		name    string
+		present bool
+	}{
+		{"manufacturer", photometry.manufacturer != ""},
+		{"luminaire name", photometry.luminaire != ""},
+		{"catalog number", photometry.catalogNumber != ""},
+		{"lamp", photometry.lamp != ""},
+		{"test report", photometry.testReport != ""},
+		{"date", dateConfidence >= DateConfidenceMedium},
+		{"luminous flux", photometry.lampFlux > 0},
+		{"power", photometry.inputWatts > 0},
+		{"dimensions", photometry.length > 0 || photometry.width > 0},
+	}
+	for _, field := range fields {
+		if !field.present {
+			metadata.deduct(100/len(fields)+1, "%s missing", field.name)
+		}
+	}
+
+	score := QualityScore{Categories: []QualityCategory{validation, resolution, metadata, consistency}}
+	total := 0
+	for _, category := range score.Categories {
+		total += category.Score * category.Weight
+	}
+	score.Score = int(math.Round(float64(total) / 100))
+
+	return score
+}
+
+// deduct lowers the score of the category by the given points, at least 0 remain, and records the reason.
+func (c *QualityCategory) deduct(points int, format string, args ...interface{}) {
+	c.Score -= points
+	if c.Score < 0 {
+		c.Score = 0
+	}
+	c.Notes = append(c.Notes, fmt.Sprintf(format, args...))
+}
+
+// deductFlux deducts 2 points per percent of deviation between declared and integrated flux beyond the tolerance.
+func (c *QualityCategory) deductFlux(result FluxConsistency, issues ValidationIssues) {
+	for _, issue := range issues {
+		points := int(math.Round(200 * (math.Abs(result.Deviation) - DefaultFluxTolerance)))
+		c.deduct(int(math.Max(10, float64(points))), "%s", issue.Message)
+	}
+}
+
+// deductStep deducts 30 points for angle steps up to twice the recommended step and 60 points for coarser ones.
+func (c *QualityCategory) deductStep(name string, step, recommended float64) {
+	switch {
+	case step <= recommended+1e-9:
+	case step <= 2*recommended+1e-9:
+		c.deduct(30, "coarse %s step of %g degrees, %g or finer recommended", name, step, recommended)
+	default:
+		c.deduct(60, "very coarse %s step of %g degrees, %g or finer recommended", name, step, recommended)
+	}
+}
+
+// largestStep returns the largest difference between neighboring angles.
+func largestStep(angles []float64) float64 {
+	step := 0.0
+	for n := 1; n < len(angles); n++ {
+		step = math.Max(step, angles[n]-angles[n-1])
+	}
+
+	return step
+}
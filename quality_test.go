@@ -0,0 +1,83 @@
+package eulumies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func qualityCategory(t *testing.T, score QualityScore, name string) QualityCategory {
+	for _, category := range score.Categories {
+		if category.Name == name {
+			return category
+		}
+	}
+	t.Fatalf("category %s missing", name)
+	return QualityCategory{}
+}
+
+func TestEulumdat_QualityScore(t *testing.T) {
+	eulumdat := loadSample2(t)
+
+	score := eulumdat.QualityScore()
+	require.Len(t, score.Categories, 4)
+	weights := 0
+	for _, category := range score.Categories {
+		weights += category.Weight
+	}
+	assert.Equal(t, 100, weights)
+	assert.Equal(t, 98, score.Score)
+	assert.Equal(t, 100, qualityCategory(t, score, "validation").Score)
+	assert.Equal(t, 100, qualityCategory(t, score, "resolution").Score)
+	assert.Equal(t, []string{"test report missing"}, qualityCategory(t, score, "metadata").Notes)
+	assert.Equal(t, 100, qualityCategory(t, score, "consistency").Score)
+
+	// broken copy: declared flux far off, no metadata, coarse gamma angles
+	poor := eulumdat
+	poor.LightOutputRatioLuminaire *= 2
+	poor.CompanyIdentification, poor.LuminaireName, poor.LuminaireNumber, poor.DateUser = "", "", "", ""
+	gAngles := make([]float64, 0, len(poor.AnglesG))
+	var planes [][]float64
+	for _, plane := range poor.LuminousIntensityDistribution {
+		var values []float64
+		for n, value := range plane {
+			if n%10 == 0 {
+				values = append(values, value)
+			}
+		}
+		planes = append(planes, values)
+	}
+	for n, angle := range poor.AnglesG {
+		if n%10 == 0 {
+			gAngles = append(gAngles, angle)
+		}
+	}
+	poor.AnglesG, poor.LuminousIntensityDistribution, poor.NumberNgIntensitiesCPlane = gAngles, planes, len(gAngles)
+	poor.DistanceDgCPlane *= 10
+
+	poorScore := poor.QualityScore()
+	assert.Less(t, poorScore.Score, score.Score)
+	assert.Less(t, qualityCategory(t, poorScore, "resolution").Score, 100)
+	assert.Len(t, qualityCategory(t, poorScore, "metadata").Notes, 5)
+	consistency := qualityCategory(t, poorScore, "consistency")
+	assert.Less(t, consistency.Score, 50)
+	assert.Contains(t, consistency.Notes[0], "deviates by")
+
+	assert.Equal(t, 0, qualityCategory(t, Eulumdat{}.QualityScore(), "consistency").Score)
+}
+
+func TestIES_QualityScore(t *testing.T) {
+	ies, err := NewIES("test/sample.ies", false)
+	require.NoError(t, err)
+
+	score := ies.QualityScore()
+	assert.Equal(t, 98, score.Score)
+	assert.Equal(t, []string{"test report missing"}, qualityCategory(t, score, "metadata").Notes)
+
+	ies.LumensPerLamp = 100 // far below the integrated flux
+	consistency := qualityCategory(t, ies.QualityScore(), "consistency")
+	assert.Equal(t, 0, consistency.Score)
+	require.Len(t, consistency.Notes, 1)
+	assert.Contains(t, consistency.Notes[0], "candela multiplier")
+}
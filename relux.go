@@ -0,0 +1,120 @@
+package eulumies
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"sort"
+	"strings"
+)
+
+// maxReluxEntrySize is the upper bound of the uncompressed size of a photometric file within a Relux exchange file.
+const maxReluxEntrySize = 64 << 20
+
+// ReluxPhotometry is a photometric data set read from a Relux exchange file.
+type ReluxPhotometry struct {
+	Name     string // path of the photometric file within the exchange file
+	Eulumdat *Eulumdat
+}
+
+// NewReluxPhotometries reads all photometric data sets of a Relux exchange file. Exchange files are zip archives
+// bundling the luminaire data, the contained EULUMDAT, IES and EN 13032-4 XML files are read, IES and XML data is
+// converted to EULUMDAT using the given options. Other entries, like XML files with luminaire metadata, are ignored.
+// Relux's proprietary binary photometry is not supported. The data sets are sorted by name.
+func NewReluxPhotometries(in io.Reader, opts ConversionOptions) ([]ReluxPhotometry, error) {
+	data, err := ioutil.ReadAll(in)
+	if err != nil {
+		return nil, err
+	}
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("not a relux exchange file: %w", err)
+	}
+
+	var photometries []ReluxPhotometry
+	for _, entry := range archive.File {
+		if entry.FileInfo().IsDir() {
+			continue
+		}
+		eulumdat, err := readReluxEntry(entry, opts)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", entry.Name, err)
+		}
+		if eulumdat != nil {
+			photometries = append(photometries, ReluxPhotometry{Name: entry.Name, Eulumdat: eulumdat})
+		}
+	}
+	if len(photometries) == 0 {
+		return nil, errors.New("relux exchange file contains no photometric data")
+	}
+	sort.Slice(photometries, func(i, j int) bool {
+		return photometries[i].Name < photometries[j].Name
+	})
+
+	return photometries, nil
+}
+
+// readReluxEntry reads the photometric data of the archive entry. Returns nil if the entry holds no photometric data.
+func readReluxEntry(entry *zip.File, opts ConversionOptions) (*Eulumdat, error) {
+	extension := strings.ToLower(path.Ext(entry.Name))
+	if extension != ".ldt" && extension != ".ies" && extension != ".xml" {
+		return nil, nil
+	}
+	if entry.UncompressedSize64 > maxReluxEntrySize {
+		return nil, fmt.Errorf("file size %d exceeds the maximum of %d bytes", entry.UncompressedSize64,
+			maxReluxEntrySize)
+	}
+
+	file, err := entry.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	data, err := ioutil.ReadAll(io.LimitReader(file, maxReluxEntrySize))
+	if err != nil {
+		return nil, err
+	}
+
+	switch extension {
+	case ".ldt":
+		eulumdat, err := NewEulumdat(bytes.NewReader(data), false)
+		if err != nil {
+			return nil, err
+		}
+		return &eulumdat, nil
+	case ".ies":
+		ies, err := NewIESFromReader(bytes.NewReader(data), false)
+		if err != nil {
+			return nil, err
+		}
+		return ConvertIESToEulumdat(ies, opts)
+	default:
+		if !isXMLPhotometry(data) {
+			return nil, nil
+		}
+		photometry, err := NewXMLPhotometry(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		return ConvertXMLToEulumdat(photometry, opts)
+	}
+}
+
+// isXMLPhotometry reports whether the root element of the XML data is the one of XMLPhotometry.
+func isXMLPhotometry(data []byte) bool {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return false
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			return start.Name.Local == "LuminaireOpticalData"
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package eulumies
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// reluxArchive returns a zip archive holding the given files.
+func reluxArchive(t *testing.T, files map[string][]byte) []byte {
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for name, data := range files {
+		writer, err := archive.Create(name)
+		assert.NoError(t, err)
+		_, err = writer.Write(data)
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, archive.Close())
+
+	return buf.Bytes()
+}
+
+func TestNewReluxPhotometries(t *testing.T) {
+	ldt, err := ioutil.ReadFile("test/sample2.ldt")
+	assert.NoError(t, err)
+	ies, err := ioutil.ReadFile("test/sample.ies")
+	assert.NoError(t, err)
+	eulumdat, err := NewEulumdat(bytes.NewReader(ldt), false)
+	assert.NoError(t, err)
+	photometry, err := ConvertEulumdatToXML(&eulumdat, ConversionOptions{})
+	assert.NoError(t, err)
+	var xmlData bytes.Buffer
+	assert.NoError(t, photometry.Export(&xmlData))
+
+	data := reluxArchive(t, map[string][]byte{
+		"luminaire.xml":         []byte(`<?xml version="1.0"?><Luminaire><Name>Sample</Name></Luminaire>`),
+		"photometry/a.LDT":      ldt,
+		"photometry/b.ies":      ies,
+		"photometry/c.xml":      xmlData.Bytes(),
+		"images/luminaire.jpg":  {0xff, 0xd8},
+		"photometry/readme.txt": []byte("readme"),
+	})
+
+	photometries, err := NewReluxPhotometries(bytes.NewReader(data), ConversionOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, photometries, 3)
+	assert.Equal(t, "photometry/a.LDT", photometries[0].Name)
+	assert.Equal(t, eulumdat.LuminousIntensityDistribution, photometries[0].Eulumdat.LuminousIntensityDistribution)
+	assert.Equal(t, "photometry/b.ies", photometries[1].Name)
+	assert.NoError(t, photometries[1].Eulumdat.Validate(false).Err())
+	assert.Equal(t, "photometry/c.xml", photometries[2].Name)
+	assert.InDelta(t, eulumdat.ComputeTotalFlux(), photometries[2].Eulumdat.ComputeTotalFlux(),
+		1e-6*eulumdat.ComputeTotalFlux())
+}
+
+func TestNewReluxPhotometries_Invalid(t *testing.T) {
+	_, err := NewReluxPhotometries(strings.NewReader("no archive"), ConversionOptions{})
+	assert.Error(t, err)
+
+	data := reluxArchive(t, map[string][]byte{"luminaire.xml": []byte("<Luminaire/>")})
+	_, err = NewReluxPhotometries(bytes.NewReader(data), ConversionOptions{})
+	assert.Error(t, err)
+
+	data = reluxArchive(t, map[string][]byte{"broken.ldt": []byte("broken")})
+	_, err = NewReluxPhotometries(bytes.NewReader(data), ConversionOptions{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "broken.ldt")
+}
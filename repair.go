@@ -0,0 +1,275 @@
+package eulumies
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Repair fixes mechanical defects of the EULUMDAT data: overlong strings are truncated, the counts are recomputed
+// from the slice lengths, the raw luminous intensities and the planes are synchronized, angle lists are sorted and
+// negative luminous intensities are clamped to zero. Angular distances not matching the angles are corrected and
+// missing direct ratios are calculated. The repaired defects are returned.
+func (e *Eulumdat) Repair() ValidationIssues {
+	var repaired ValidationIssues
+
+	truncate := func(field string, value *string, maxLength int) {
+		if len(*value) > maxLength {
+			*value = (*value)[:maxLength]
+			repaired.add(ValidationFieldTooLong, SeverityError, field, "truncated to %d characters", maxLength)
+		}
+	}
+	truncate("CompanyIdentification", &e.CompanyIdentification, 78)
+	truncate("MeasurementReportNumber", &e.MeasurementReportNumber, 78)
+	truncate("LuminaireName", &e.LuminaireName, 78)
+	truncate("LuminaireNumber", &e.LuminaireNumber, 78)
+	truncate("FileName", &e.FileName, 8)
+	truncate("DateUser", &e.DateUser, 78)
+
+	// Lamp sets: pad all slices to the longest one
+	sets := len(e.NumberLamps)
+	for _, length := range []int{len(e.TypeLamps), len(e.TotalLuminousFluxLamps), len(e.ColorTemperature),
+		len(e.ColorRenderingIndexCRI), len(e.BallastWatts)} {
+		if length > sets {
+			sets = length
+		}
+	}
+	if sets != e.NumberStandardSetLamps || sets != len(e.NumberLamps) || sets != len(e.TypeLamps) ||
+		sets != len(e.TotalLuminousFluxLamps) || sets != len(e.ColorTemperature) ||
+		sets != len(e.ColorRenderingIndexCRI) || sets != len(e.BallastWatts) {
+		for len(e.NumberLamps) < sets {
+			e.NumberLamps = append(e.NumberLamps, 0)
+		}
+		for len(e.TypeLamps) < sets {
+			e.TypeLamps = append(e.TypeLamps, "")
+		}
+		for len(e.TotalLuminousFluxLamps) < sets {
+			e.TotalLuminousFluxLamps = append(e.TotalLuminousFluxLamps, 0)
+		}
+		for len(e.ColorTemperature) < sets {
+			e.ColorTemperature = append(e.ColorTemperature, "")
+		}
+		for len(e.ColorRenderingIndexCRI) < sets {
+			e.ColorRenderingIndexCRI = append(e.ColorRenderingIndexCRI, "")
+		}
+		for len(e.BallastWatts) < sets {
+			e.BallastWatts = append(e.BallastWatts, 0)
+		}
+		e.NumberStandardSetLamps = sets
+		repaired.add(ValidationLengthMismatch, SeverityError, "NumberStandardSetLamps", "set to %d", sets)
+	}
+	for i := 0; i < sets; i++ {
+		truncate(fmt.Sprintf("TypeLamps[%d]", i), &e.TypeLamps[i], 24)
+		truncate(fmt.Sprintf("ColorTemperature[%d]", i), &e.ColorTemperature[i], 16)
+		truncate(fmt.Sprintf("ColorRenderingIndexCRI[%d]", i), &e.ColorRenderingIndexCRI[i], 6)
+	}
+
+	if e.NumberMcCPlanes != len(e.AnglesC) {
+		e.NumberMcCPlanes = len(e.AnglesC)
+		repaired.add(ValidationLengthMismatch, SeverityError, "NumberMcCPlanes", "set to %d", e.NumberMcCPlanes)
+	}
+	if e.NumberNgIntensitiesCPlane != len(e.AnglesG) {
+		e.NumberNgIntensitiesCPlane = len(e.AnglesG)
+		repaired.add(ValidationLengthMismatch, SeverityError, "NumberNgIntensitiesCPlane",
+			"set to %d", e.NumberNgIntensitiesCPlane)
+	}
+
+	// Synchronize the raw luminous intensities and the planes, the consistent representation wins
+	e.calcMc1andMc2()
+	e.calcMc()
+	rawLength := (e.mc2 - e.mc1 + 1) * e.NumberNgIntensitiesCPlane
+	if !e.planesMatchDimensions() || len(e.LuminousIntensityDistributionRaw) != rawLength {
+		if len(e.LuminousIntensityDistributionRaw) == rawLength {
+			_ = e.CalcLuminousIntensityDistributionFromRaw()
+			repaired.add(ValidationLengthMismatch, SeverityError, "LuminousIntensityDistribution",
+				"regenerated from LuminousIntensityDistributionRaw")
+		} else if e.planesMatchDimensions() {
+			e.CalcLuminousIntensityDistributionRawFromPlanes()
+			repaired.add(ValidationLengthMismatch, SeverityError, "LuminousIntensityDistributionRaw",
+				"regenerated from LuminousIntensityDistribution")
+		}
+	}
+
+	// Sort the angles together with the luminous intensities
+	if !sort.Float64sAreSorted(e.AnglesG) && e.planesMatchDimensions() {
+		order := sortedOrder(e.AnglesG)
+		e.AnglesG = permuteFloats(e.AnglesG, order)
+		for c := range e.LuminousIntensityDistribution {
+			e.LuminousIntensityDistribution[c] = permuteFloats(e.LuminousIntensityDistribution[c], order)
+		}
+		e.CalcLuminousIntensityDistributionRawFromPlanes()
+		repaired.add(ValidationInconsistentValues, SeverityWarning, "AnglesG", "sorted in ascending order")
+	}
+	if !sort.Float64sAreSorted(e.AnglesC) {
+		if e.SymmetryIndicator == 0 && e.planesMatchDimensions() {
+			order := sortedOrder(e.AnglesC)
+			e.AnglesC = permuteFloats(e.AnglesC, order)
+			permuted := make([][]float64, len(order))
+			for i, index := range order {
+				permuted[i] = e.LuminousIntensityDistribution[index]
+			}
+			e.LuminousIntensityDistribution = permuted
+			e.CalcLuminousIntensityDistributionRawFromPlanes()
+			repaired.add(ValidationInconsistentValues, SeverityWarning, "AnglesC", "sorted in ascending order")
+		} else if e.SymmetryIndicator == 1 {
+			sort.Float64s(e.AnglesC) // all C-planes share the same intensities
+			repaired.add(ValidationInconsistentValues, SeverityWarning, "AnglesC", "sorted in ascending order")
+		}
+	}
+
+	// Declared angular distances must match the angles, non-equidistant angles use a distance of zero
+	if e.DistanceDcCPlanes > 0 && angleSpacingMismatch(e.AnglesC, e.DistanceDcCPlanes) >= 0 {
+		e.DistanceDcCPlanes = angleDistance(e.AnglesC)
+		repaired.add(ValidationSpacingMismatch, SeverityWarning, "DistanceDcCPlanes", "set to %g", e.DistanceDcCPlanes)
+	}
+	if e.DistanceDgCPlane > 0 && angleSpacingMismatch(e.AnglesG, e.DistanceDgCPlane) >= 0 {
+		e.DistanceDgCPlane = angleDistance(e.AnglesG)
+		repaired.add(ValidationSpacingMismatch, SeverityWarning, "DistanceDgCPlane", "set to %g", e.DistanceDgCPlane)
+	}
+
+	clamped := 0
+	for i, value := range e.LuminousIntensityDistributionRaw {
+		if value < 0 {
+			e.LuminousIntensityDistributionRaw[i] = 0
+			clamped++
+		}
+	}
+	for _, plane := range e.LuminousIntensityDistribution {
+		for g := range plane {
+			if plane[g] < 0 {
+				plane[g] = 0
+			}
+		}
+	}
+	if clamped > 0 {
+		repaired.add(ValidationOutOfRange, SeverityWarning, "LuminousIntensityDistributionRaw",
+			"clamped %d negative luminous intensities to zero", clamped)
+	}
+
+	if e.DirectRatios == [10]float64{} && e.planesMatchDimensions() && len(e.AnglesC) > 0 {
+		e.FillDirectRatios()
+		repaired.add(ValidationOutOfRange, SeverityWarning, "DirectRatios", "calculated from the luminous intensities")
+	}
+
+	return repaired
+}
+
+// planesMatchDimensions reports whether the split luminous intensity planes match the symmetry and angle counts.
+func (e *Eulumdat) planesMatchDimensions() bool {
+	e.calcMc()
+	if len(e.LuminousIntensityDistribution) != e.mc {
+		return false
+	}
+	for _, plane := range e.LuminousIntensityDistribution {
+		if len(plane) != e.NumberNgIntensitiesCPlane {
+			return false
+		}
+	}
+
+	return true
+}
+
+// CalcLuminousIntensityDistributionRawFromPlanes joins the luminous intensity planes to the raw values.
+func (e *Eulumdat) CalcLuminousIntensityDistributionRawFromPlanes() {
+	raw := make([]float64, 0, len(e.LuminousIntensityDistribution)*e.NumberNgIntensitiesCPlane)
+	for _, plane := range e.LuminousIntensityDistribution {
+		raw = append(raw, plane...)
+	}
+	e.LuminousIntensityDistributionRaw = raw
+}
+
+// Repair fixes mechanical defects of the IES data: the counts are recomputed from the slice lengths, the angle
+// lists are sorted together with the candela values, a full circle measurement lacking the 360 degree plane is closed
+// and negative candela values are clamped to zero. The repaired defects are returned.
+func (i *IES) Repair() ValidationIssues {
+	var repaired ValidationIssues
+
+	if i.NumberVerticalAngles != len(i.VerticalAngles) {
+		i.NumberVerticalAngles = len(i.VerticalAngles)
+		repaired.add(ValidationLengthMismatch, SeverityError, "NumberVerticalAngles", "set to %d", i.NumberVerticalAngles)
+	}
+	if i.NumberHorizontalAngles != len(i.HorizontalAngles) {
+		i.NumberHorizontalAngles = len(i.HorizontalAngles)
+		repaired.add(ValidationLengthMismatch, SeverityError, "NumberHorizontalAngles",
+			"set to %d", i.NumberHorizontalAngles)
+	}
+	if i.Tilt == IESTiltInclude && len(i.TiltAngles) == len(i.TiltMultiplierFactors) &&
+		i.TiltAnglesAndFactors != len(i.TiltAngles) {
+		i.TiltAnglesAndFactors = len(i.TiltAngles)
+		repaired.add(ValidationLengthMismatch, SeverityError, "TiltAnglesAndFactors", "set to %d", i.TiltAnglesAndFactors)
+	}
+
+	valuesMatch := len(i.CandelaValues) == len(i.HorizontalAngles)
+	for _, plane := range i.CandelaValues {
+		valuesMatch = valuesMatch && len(plane) == len(i.VerticalAngles)
+	}
+	if valuesMatch && !sort.Float64sAreSorted(i.VerticalAngles) {
+		order := sortedOrder(i.VerticalAngles)
+		i.VerticalAngles = permuteFloats(i.VerticalAngles, order)
+		for h := range i.CandelaValues {
+			i.CandelaValues[h] = permuteFloats(i.CandelaValues[h], order)
+		}
+		repaired.add(ValidationInconsistentValues, SeverityWarning, "VerticalAngles", "sorted in ascending order")
+	}
+	if valuesMatch && !sort.Float64sAreSorted(i.HorizontalAngles) {
+		order := sortedOrder(i.HorizontalAngles)
+		i.HorizontalAngles = permuteFloats(i.HorizontalAngles, order)
+		permuted := make([][]float64, len(order))
+		for n, index := range order {
+			permuted[n] = i.CandelaValues[index]
+		}
+		i.CandelaValues = permuted
+		repaired.add(ValidationInconsistentValues, SeverityWarning, "HorizontalAngles", "sorted in ascending order")
+	}
+
+	// A full circle measurement without the closing 360 degree plane gets the 0 degree plane appended
+	if valuesMatch && len(i.HorizontalAngles) > 2 && i.HorizontalAngles[0] == 0 {
+		last := i.HorizontalAngles[len(i.HorizontalAngles)-1]
+		distance := angleDistance(i.HorizontalAngles)
+		if last > 180 && last < 360 && distance > 0 && math.Abs(last+distance-360) <= angleGridTolerance {
+			i.HorizontalAngles = append(i.HorizontalAngles, 360)
+			i.CandelaValues = append(i.CandelaValues, append([]float64(nil), i.CandelaValues[0]...))
+			i.NumberHorizontalAngles = len(i.HorizontalAngles)
+			repaired.add(ValidationAngleRange, SeverityWarning, "HorizontalAngles", "closed with the 360 degree plane")
+		}
+	}
+
+	clamped := 0
+	for _, plane := range i.CandelaValues {
+		for v := range plane {
+			if plane[v] < 0 {
+				plane[v] = 0
+				clamped++
+			}
+		}
+	}
+	if clamped > 0 {
+		repaired.add(ValidationOutOfRange, SeverityWarning, "CandelaValues",
+			"clamped %d negative candela values to zero", clamped)
+	}
+
+	return repaired
+}
+
+// sortedOrder returns the indices of the values in ascending order of the values.
+func sortedOrder(values []float64) []int {
+	order := make([]int, len(values))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return values[order[a]] < values[order[b]]
+	})
+
+	return order
+}
+
+// permuteFloats returns a new slice containing the values in the given order.
+func permuteFloats(values []float64, order []int) []float64 {
+	permuted := make([]float64, len(order))
+	for i, index := range order {
+		permuted[i] = values[index]
+	}
+
+	return permuted
+}
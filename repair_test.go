@@ -0,0 +1,92 @@
+package eulumies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEulumdat_Repair(t *testing.T) {
+	original := loadSample2(t)
+
+	eulumdat, _ := CopyEulumdat(original)
+	assert.Empty(t, eulumdat.Repair())
+
+	eulumdat.FileName = "much too long.ldt"
+	eulumdat.NumberStandardSetLamps = 2
+	eulumdat.TypeLamps = append(eulumdat.TypeLamps, "LED")
+	eulumdat.NumberNgIntensitiesCPlane = 0
+	eulumdat.LuminousIntensityDistributionRaw = nil
+	eulumdat.LuminousIntensityDistribution[0][0] = -1
+	eulumdat.AnglesG[0], eulumdat.AnglesG[1] = eulumdat.AnglesG[1], eulumdat.AnglesG[0]
+	for c := range eulumdat.LuminousIntensityDistribution {
+		plane := eulumdat.LuminousIntensityDistribution[c]
+		plane[0], plane[1] = plane[1], plane[0]
+	}
+	assert.False(t, eulumdat.Validate(true).Valid())
+
+	repaired := eulumdat.Repair()
+	assert.Len(t, repaired, 6)
+	assert.Equal(t, "much too", eulumdat.FileName)
+	assert.Equal(t, 2, eulumdat.NumberStandardSetLamps)
+	assert.Len(t, eulumdat.BallastWatts, 2)
+	assert.Equal(t, original.AnglesG, eulumdat.AnglesG)
+	assert.Equal(t, 0.0, eulumdat.LuminousIntensityDistribution[0][0])
+	assert.Equal(t, original.LuminousIntensityDistribution[1], eulumdat.LuminousIntensityDistribution[1])
+	assert.Equal(t, 0.0, eulumdat.LuminousIntensityDistributionRaw[0])
+	assert.Empty(t, eulumdat.Validate(true))
+}
+
+func TestEulumdat_Repair_DirectRatios(t *testing.T) {
+	eulumdat := loadSample2(t)
+
+	eulumdat.DirectRatios = [10]float64{}
+	assert.Len(t, eulumdat.Validate(true).Warnings(), 1)
+
+	repaired := eulumdat.Repair()
+	assert.Len(t, repaired, 1)
+	assert.Equal(t, "DirectRatios", repaired[0].Field)
+	assert.InDelta(t, eulumdat.ComputeDirectRatios()[0], eulumdat.DirectRatios[0], 1e-3)
+	assert.NotZero(t, eulumdat.DirectRatios[9])
+	assert.Empty(t, eulumdat.Validate(true))
+}
+
+func TestIES_Repair(t *testing.T) {
+	ies, err := NewIES("test/sample.ies", false)
+	assert.NoError(t, err)
+	assert.Empty(t, ies.Repair())
+
+	ies.HorizontalAngles = []float64{90, 0}
+	ies.CandelaValues = [][]float64{{2, 1}, {4, -3}}
+	ies.VerticalAngles = []float64{90, 0}
+	ies.NumberVerticalAngles = 5
+
+	repaired := ies.Repair()
+	assert.Len(t, repaired, 5)
+	assert.Equal(t, []float64{0, 90}, ies.VerticalAngles)
+	assert.Equal(t, []float64{0, 90}, ies.HorizontalAngles)
+	assert.Equal(t, [][]float64{{0, 4}, {1, 2}}, ies.CandelaValues)
+	assert.Equal(t, 2, ies.NumberVerticalAngles)
+	assert.Equal(t, 2, ies.NumberHorizontalAngles)
+	assert.True(t, ies.Validate(false).Valid())
+}
+
+func TestIES_Repair_CloseHorizontalRange(t *testing.T) {
+	ies := &IES{
+		PhotometricType:  1,
+		HorizontalAngles: []float64{0, 90, 180, 270},
+		VerticalAngles:   []float64{0, 90},
+		CandelaValues:    [][]float64{{1, 2}, {3, 4}, {5, 6}, {7, 8}},
+	}
+	ies.NumberHorizontalAngles = len(ies.HorizontalAngles)
+	ies.NumberVerticalAngles = len(ies.VerticalAngles)
+	assert.Len(t, ies.Validate(true).Warnings(), 1)
+
+	repaired := ies.Repair()
+	assert.Len(t, repaired, 1)
+	assert.Equal(t, ValidationAngleRange, repaired[0].Code)
+	assert.Equal(t, []float64{0, 90, 180, 270, 360}, ies.HorizontalAngles)
+	assert.Equal(t, []float64{1, 2}, ies.CandelaValues[4])
+	assert.Equal(t, 5, ies.NumberHorizontalAngles)
+	assert.Empty(t, ies.Validate(true).Warnings())
+}
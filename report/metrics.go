@@ -0,0 +1,167 @@
+package report
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/h44z/eulumies"
+)
+
+const (
+	zonalPlaneStep = 5.0 // C-plane distance of the flux integration in degrees
+	zonalGammaStep = 0.5 // gamma band width of the flux integration in degrees
+	beamGammaStep  = 0.1 // sampling distance of the beam angle profiles in degrees
+)
+
+// Zone holds the luminous flux emitted between two gamma angles (measured from nadir).
+type Zone struct {
+	From, To float64
+	Flux     float64 // lm
+	Share    float64 // share of the luminaire flux (%)
+}
+
+// BeamAngles holds the beam (50 % of the peak) and field (10 % of the peak) angles of a plane pair.
+type BeamAngles struct {
+	Plane string
+	Beam  float64
+	Field float64
+}
+
+// summaryZones are the zones of the IES zonal lumen summary.
+var summaryZones = [][2]float64{
+	{0, 30}, {0, 40}, {0, 60}, {0, 90}, {90, 120}, {90, 130}, {90, 150}, {90, 180}, {0, 180},
+}
+
+// fluxBands integrates the intensity distribution into bands of zonalGammaStep degrees from nadir to zenith.
+func fluxBands(intensity eulumies.IntensityFunc) []float64 {
+	bands := make([]float64, int(180/zonalGammaStep))
+	planeWidth := zonalPlaneStep * math.Pi / 180
+	for i := range bands {
+		from := float64(i) * zonalGammaStep
+		to := from + zonalGammaStep
+		solidAngle := planeWidth * (math.Cos(from*math.Pi/180) - math.Cos(to*math.Pi/180))
+		for c := zonalPlaneStep / 2; c < 360; c += zonalPlaneStep {
+			bands[i] += intensity(c, from+zonalGammaStep/2) * solidAngle
+		}
+	}
+
+	return bands
+}
+
+// cumulativeFlux returns the flux emitted between nadir and gamma, partial bands are interpolated linearly.
+func cumulativeFlux(bands []float64, gamma float64) float64 {
+	flux := 0.0
+	for i, band := range bands {
+		from := float64(i) * zonalGammaStep
+		if from >= gamma {
+			break
+		}
+		flux += band * math.Min(1, (gamma-from)/zonalGammaStep)
+	}
+
+	return flux
+}
+
+// zones returns the zonal flux of the given zones.
+func zones(bands []float64, bounds [][2]float64) []Zone {
+	total := cumulativeFlux(bands, 180)
+	result := make([]Zone, len(bounds))
+	for i, bound := range bounds {
+		flux := cumulativeFlux(bands, bound[1]) - cumulativeFlux(bands, bound[0])
+		result[i] = Zone{From: bound[0], To: bound[1], Flux: flux}
+		if total > 0 {
+			result[i].Share = flux / total * 100
+		}
+	}
+
+	return result
+}
+
+// tenDegreeZones returns the bounds of the 10 degree zones from nadir to zenith.
+func tenDegreeZones() [][2]float64 {
+	bounds := make([][2]float64, 18)
+	for i := range bounds {
+		bounds[i] = [2]float64{float64(i) * 10, float64(i+1) * 10}
+	}
+
+	return bounds
+}
+
+// fluxCode returns the CIE flux code N1 N2 N3 N4 N5. N1 to N3 are the shares of the downward flux within 41.4, 60
+// and 75.5 degrees, N4 is the downward light output ratio and N5 the light output ratio (in %) of the luminaire.
+func fluxCode(bands []float64, lightOutputRatio float64) string {
+	downward := cumulativeFlux(bands, 90)
+	total := cumulativeFlux(bands, 180)
+	if downward <= 0 || total <= 0 {
+		return fmt.Sprintf("0 0 0 0 %.0f", lightOutputRatio)
+	}
+
+	return fmt.Sprintf("%.0f %.0f %.0f %.0f %.0f",
+		cumulativeFlux(bands, 41.4)/downward*100,
+		cumulativeFlux(bands, 60)/downward*100,
+		cumulativeFlux(bands, 75.5)/downward*100,
+		downward/total*100,
+		lightOutputRatio)
+}
+
+// beamAngles returns the beam and field angles of the plane pair c and c + 180.
+func beamAngles(intensity eulumies.IntensityFunc, c float64) BeamAngles {
+	var angles, values []float64
+	steps := int(math.Round(180 / beamGammaStep))
+	for i := -steps; i <= steps; i++ {
+		gamma := float64(i) * beamGammaStep
+		angles = append(angles, gamma)
+		if gamma < 0 {
+			values = append(values, intensity(c+180, -gamma))
+		} else {
+			values = append(values, intensity(c, gamma))
+		}
+	}
+
+	return BeamAngles{
+		Plane: fmt.Sprintf("C%.0f - C%.0f", c, c+180),
+		Beam:  profileWidth(angles, values, 0.5),
+		Field: profileWidth(angles, values, 0.1),
+	}
+}
+
+// profileWidth returns the angular width of the profile around its peak, in which the intensity stays above the
+// given fraction of the peak intensity.
+func profileWidth(angles, values []float64, fraction float64) float64 {
+	peak := 0
+	for i, value := range values {
+		if value > values[peak] {
+			peak = i
+		}
+	}
+	threshold := values[peak] * fraction
+	if threshold <= 0 {
+		return 0
+	}
+
+	lower := angles[0]
+	for i := peak; i > 0; i-- {
+		if values[i-1] < threshold {
+			lower = crossing(angles[i-1], angles[i], values[i-1], values[i], threshold)
+			break
+		}
+	}
+	upper := angles[len(angles)-1]
+	for i := peak; i < len(values)-1; i++ {
+		if values[i+1] < threshold {
+			upper = crossing(angles[i], angles[i+1], values[i], values[i+1], threshold)
+			break
+		}
+	}
+
+	return upper - lower
+}
+
+// crossing returns the angle between angleA and angleB at which the linearly interpolated value equals threshold.
+func crossing(angleA, angleB, valueA, valueB, threshold float64) float64 {
+	if valueA == valueB {
+		return angleA
+	}
+
+	return angleA + (threshold-valueA)/(valueB-valueA)*(angleB-angleA)
+}
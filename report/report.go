@@ -0,0 +1,292 @@
+// Package report generates self-contained HTML datasheets of EULUMDAT and IES files.
+package report
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/h44z/eulumies"
+	"github.com/h44z/eulumies/plot"
+)
+
+// Photometry is implemented by eulumies.Eulumdat and *eulumies.IES.
+type Photometry interface {
+	plot.Photometry
+	ComputeUGR(opts eulumies.UGROptions) (float64, error)
+}
+
+// Options controls the content of the datasheet.
+type Options struct {
+	Title string            // document title, defaults to the luminaire name
+	Polar plot.PolarOptions // options of the embedded polar diagram
+}
+
+// field is a single row of the metadata table.
+type field struct {
+	Name  string
+	Value string
+}
+
+// datasheet holds all values rendered by the report template.
+type datasheet struct {
+	Title     string
+	Format    string
+	Metadata  []field
+	Polar     template.HTML
+	TotalFlux float64
+	FluxCode  string
+	Summary   []Zone
+	Zones     []Zone
+	Beams     []BeamAngles
+	UGR       template.HTML
+	UGRError  string
+}
+
+// WriteEulumdat writes the HTML datasheet of the given EULUMDAT file.
+func WriteEulumdat(out io.Writer, eulumdat eulumies.Eulumdat, opts Options) error {
+	metadata := []field{
+		{"Company", eulumdat.CompanyIdentification},
+		{"Luminaire name", eulumdat.LuminaireName},
+		{"Luminaire number", eulumdat.LuminaireNumber},
+		{"Measurement report", eulumdat.MeasurementReportNumber},
+		{"Date / user", eulumdat.DateUser},
+		{"Dimensions (mm)", dimensions(eulumdat.LengthDiameter, eulumdat.WidthLuminaire, eulumdat.HeightLuminaire)},
+		{"Luminous area (mm)", dimensions(eulumdat.LengthDiameterLuminousArea, eulumdat.WidthLuminousArea, 0)},
+		{"Symmetry", strconv.Itoa(eulumdat.SymmetryIndicator)},
+		{"Light output ratio (%)", number(eulumdat.LightOutputRatioLuminaire)},
+		{"Downward flux fraction (%)", number(eulumdat.DownwardFluxFractionPhiu)},
+	}
+	for i := 0; i < eulumdat.NumberStandardSetLamps; i++ {
+		metadata = append(metadata, field{
+			Name:  fmt.Sprintf("Lamp set %d", i+1),
+			Value: lampSet(eulumdat, i),
+		})
+	}
+
+	title := opts.Title
+	if title == "" {
+		title = eulumdat.LuminaireName
+	}
+
+	return write(out, eulumdat, datasheet{
+		Title:    title,
+		Format:   "EULUMDAT",
+		Metadata: metadata,
+	}, eulumdat.ComputeLightOutputRatio(), opts)
+}
+
+// WriteIES writes the HTML datasheet of the given IES file.
+func WriteIES(out io.Writer, ies *eulumies.IES, opts Options) error {
+	var metadata []field
+	keywords := make([]string, 0, len(ies.Keywords))
+	for keyword := range ies.Keywords {
+		keywords = append(keywords, keyword)
+	}
+	sort.Strings(keywords)
+	for _, keyword := range keywords {
+		metadata = append(metadata, field{keyword, ies.Keywords[keyword]})
+	}
+
+	units := "ft"
+	if ies.UnitsType == 2 {
+		units = "m"
+	}
+	lumens := number(ies.LumensPerLamp)
+	if ies.IsAbsolutePhotometry() {
+		lumens = "absolute photometry"
+	}
+	metadata = append(metadata,
+		field{"Number of lamps", strconv.Itoa(ies.NumberLamps)},
+		field{"Lumens per lamp", lumens},
+		field{"Candela multiplier", number(ies.CandelaMultiplier)},
+		field{"Photometric type", strconv.Itoa(ies.PhotometricType)},
+		field{"Dimensions (" + units + ")", dimensions(ies.LuminaireLength, ies.LuminaireWidth, ies.LuminaireHeight)},
+		field{"Ballast factor", number(ies.BallastFactor)},
+		field{"Input watts", number(ies.InputWatts)},
+	)
+
+	title := opts.Title
+	if title == "" {
+		title = ies.Keywords["LUMINAIRE"]
+	}
+
+	lightOutputRatio := 100.0
+	if lampFlux := ies.LumensPerLamp * float64(ies.NumberLamps); !ies.IsAbsolutePhotometry() && lampFlux > 0 {
+		lightOutputRatio = ies.ComputeTotalFlux() / lampFlux * 100
+	}
+
+	return write(out, ies, datasheet{
+		Title:    title,
+		Format:   "IES LM-63",
+		Metadata: metadata,
+	}, lightOutputRatio, opts)
+}
+
+// WriteFile parses the given EULUMDAT (.ldt) or IES (.ies) file and writes its HTML datasheet.
+// Gzip compressed files are supported if the file name ends with .gz.
+func WriteFile(out io.Writer, path string, opts Options) error {
+	switch strings.ToLower(filepath.Ext(strings.TrimSuffix(path, ".gz"))) {
+	case ".ldt":
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		eulumdat, err := eulumies.NewEulumdat(file, false)
+		if err != nil {
+			return err
+		}
+
+		return WriteEulumdat(out, eulumdat, opts)
+	case ".ies":
+		ies, err := eulumies.NewIES(path, false)
+		if err != nil {
+			return err
+		}
+
+		return WriteIES(out, ies, opts)
+	default:
+		return errors.New("unsupported file extension: " + filepath.Ext(path))
+	}
+}
+
+// write calculates the photometric metrics and renders the datasheet.
+func write(out io.Writer, photometry Photometry, sheet datasheet, lightOutputRatio float64, opts Options) error {
+	var polar bytes.Buffer
+	if err := plot.PolarSVG(&polar, photometry, opts.Polar); err != nil {
+		return err
+	}
+	sheet.Polar = template.HTML(polar.String())
+
+	bands := fluxBands(photometry.IntensityFunc())
+	sheet.TotalFlux = photometry.ComputeTotalFlux()
+	sheet.FluxCode = fluxCode(bands, lightOutputRatio)
+	sheet.Summary = zones(bands, summaryZones)
+	sheet.Zones = zones(bands, tenDegreeZones())
+	sheet.Beams = []BeamAngles{
+		beamAngles(photometry.IntensityFunc(), 0),
+		beamAngles(photometry.IntensityFunc(), 90),
+	}
+
+	table, err := NewUGRTable(photometry)
+	if err == nil {
+		sheet.UGR, err = table.HTML()
+	}
+	if err != nil {
+		sheet.UGRError = err.Error()
+	}
+
+	return datasheetTemplate.Execute(out, sheet)
+}
+
+// lampSet returns a summary of the given standard set of lamps.
+func lampSet(eulumdat eulumies.Eulumdat, index int) string {
+	var parts []string
+	if index < len(eulumdat.NumberLamps) {
+		parts = append(parts, strconv.Itoa(eulumdat.NumberLamps[index])+" x")
+	}
+	if index < len(eulumdat.TypeLamps) {
+		parts = append(parts, eulumdat.TypeLamps[index])
+	}
+	if index < len(eulumdat.TotalLuminousFluxLamps) {
+		parts = append(parts, number(eulumdat.TotalLuminousFluxLamps[index])+" lm")
+	}
+	if index < len(eulumdat.ColorTemperature) {
+		parts = append(parts, eulumdat.ColorTemperature[index])
+	}
+	if index < len(eulumdat.ColorRenderingIndexCRI) {
+		parts = append(parts, "CRI "+eulumdat.ColorRenderingIndexCRI[index])
+	}
+	if index < len(eulumdat.BallastWatts) {
+		parts = append(parts, number(eulumdat.BallastWatts[index])+" W")
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// dimensions formats length x width x height, a zero height is omitted.
+func dimensions(length, width, height float64) string {
+	if height == 0 {
+		return number(length) + " x " + number(width)
+	}
+
+	return number(length) + " x " + number(width) + " x " + number(height)
+}
+
+func number(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+func fixed(precision int, value float64) string {
+	return strconv.FormatFloat(value, 'f', precision, 64)
+}
+
+var datasheetTemplate = template.Must(template.New("datasheet").Funcs(template.FuncMap{
+	"fixed": fixed,
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { font-family: sans-serif; font-size: 14px; color: #222; margin: 2em auto; max-width: 60em; }
+h1 { font-size: 1.6em; margin-bottom: 0.2em; }
+h2 { font-size: 1.2em; border-bottom: 1px solid #ccc; margin-top: 1.5em; }
+table { border-collapse: collapse; margin: 0.5em 0; }
+th, td { border: 1px solid #ccc; padding: 0.2em 0.6em; text-align: left; }
+td.number { text-align: right; }
+.format { color: #666; }
+.overview { display: flex; flex-wrap: wrap; gap: 2em; align-items: flex-start; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<div class="format">{{.Format}}</div>
+
+<div class="overview">
+<div>
+<h2>Metadata</h2>
+<table>
+{{range .Metadata}}<tr><th>{{.Name}}</th><td>{{.Value}}</td></tr>
+{{end}}</table>
+</div>
+<div>
+<h2>Polar diagram</h2>
+{{.Polar}}
+</div>
+</div>
+
+<h2>Photometric summary</h2>
+<table>
+<tr><th>Luminaire flux</th><td class="number">{{fixed 0 .TotalFlux}} lm</td></tr>
+<tr><th>CIE flux code</th><td class="number">{{.FluxCode}}</td></tr>
+{{range .Beams}}<tr><th>Beam / field angle {{.Plane}}</th><td class="number">{{fixed 1 .Beam}}° / {{fixed 1 .Field}}°</td></tr>
+{{end}}</table>
+
+<h2>Zonal lumens</h2>
+<div class="overview">
+<table>
+<tr><th>Zone</th><th>Lumens</th><th>% Luminaire</th></tr>
+{{range .Summary}}<tr><td>{{.From}}° - {{.To}}°</td><td class="number">{{fixed 1 .Flux}}</td><td class="number">{{fixed 1 .Share}}</td></tr>
+{{end}}</table>
+<table>
+<tr><th>Zone</th><th>Lumens</th><th>% Luminaire</th></tr>
+{{range .Zones}}<tr><td>{{.From}}° - {{.To}}°</td><td class="number">{{fixed 1 .Flux}}</td><td class="number">{{fixed 1 .Share}}</td></tr>
+{{end}}</table>
+</div>
+
+<h2>UGR table</h2>
+{{if .UGRError}}<p>UGR table not available: {{.UGRError}}</p>
+{{else}}{{.UGR}}{{end}}
+</body>
+</html>
+`))
@@ -0,0 +1,54 @@
+package report
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteFile(t *testing.T) {
+	var out bytes.Buffer
+	assert.NoError(t, WriteFile(&out, "../test/sample2.ldt", Options{Title: "Sample <2>"}))
+	html := out.String()
+	assert.True(t, strings.HasPrefix(html, "<!DOCTYPE html>"))
+	assert.Contains(t, html, "<title>Sample &lt;2&gt;</title>")
+	assert.Contains(t, html, `<svg xmlns="http://www.w3.org/2000/svg"`)
+	assert.Contains(t, html, "CIE flux code")
+	assert.Contains(t, html, "Viewed along C90")
+	assert.NotContains(t, html, "UGR table not available")
+
+	out.Reset()
+	assert.NoError(t, WriteFile(&out, "../test/sample.ies", Options{}))
+	html = out.String()
+	assert.Contains(t, html, "IES LM-63")
+	assert.Contains(t, html, "<th>MANUFAC</th>")
+
+	assert.Error(t, WriteFile(&out, "../test/sample.txt", Options{}))
+	assert.Error(t, WriteFile(&out, "../test/missing.ldt", Options{}))
+}
+
+func TestZones(t *testing.T) {
+	// isotropic source of 1 cd: 4π lm in total, 2π lm in each hemisphere
+	bands := fluxBands(func(c, gamma float64) float64 { return 1 })
+	assert.InDelta(t, 4*math.Pi, cumulativeFlux(bands, 180), 1e-9)
+
+	result := zones(bands, [][2]float64{{0, 90}, {90, 180}, {0, 60}})
+	assert.InDelta(t, 2*math.Pi, result[0].Flux, 1e-9)
+	assert.InDelta(t, 50, result[1].Share, 1e-9)
+	assert.InDelta(t, math.Pi, result[2].Flux, 1e-9)
+
+	assert.Equal(t, "25 50 75 50 80", fluxCode(bands, 80))
+}
+
+func TestBeamAngles(t *testing.T) {
+	// cosine distribution: 50 % at 60°, 10 % at 84.26°
+	angles := beamAngles(func(c, gamma float64) float64 {
+		return math.Max(0, math.Cos(gamma*math.Pi/180))
+	}, 90)
+	assert.Equal(t, "C90 - C270", angles.Plane)
+	assert.InDelta(t, 120, angles.Beam, 0.01)
+	assert.InDelta(t, 2*84.26, angles.Field, 0.01)
+}
@@ -0,0 +1,191 @@
+package report
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/h44z/eulumies"
+)
+
+// UGRRow holds the unified glare ratings of one room size for all reflectance combinations.
+type UGRRow struct {
+	Width, Length float64 // room dimensions in multiples of H
+	AlongC0       []float64
+	AlongC90      []float64
+}
+
+// Reflectances holds the ceiling, wall and floor reflectances of a UGR table column.
+type Reflectances struct {
+	Ceiling, Walls, Floor float64
+}
+
+// UGRVariation holds the change of the unified glare rating if the luminaires are installed with a larger spacing
+// than the table spacing of 0.25H.
+type UGRVariation struct {
+	Spacing  float64 // spacing to height ratio
+	AlongC0  float64
+	AlongC90 float64
+}
+
+// UGRTable is the CIE 117 tabular evaluation of the unified glare rating. The rows are the standard room dimensions
+// in multiples of the height H of the luminaires above the eye, the columns the standard reflectance combinations.
+// Every value is given for the observer looking along the C0 plane (crosswise) and along the C90 plane (endwise).
+type UGRTable struct {
+	Reflectances []Reflectances
+	Rows         []UGRRow
+	Variations   []UGRVariation // corrections for larger spacings, evaluated in a 4H x 8H room
+	Flux         float64        // luminaire flux (lm) of the table values
+}
+
+// ugrReflectances are the reflectance combinations of the CIE 117 tabular method.
+var ugrReflectances = []Reflectances{
+	{0.7, 0.5, 0.2},
+	{0.7, 0.3, 0.2},
+	{0.5, 0.5, 0.2},
+	{0.5, 0.3, 0.2},
+	{0.3, 0.3, 0.2},
+}
+
+// ugrRooms are the room dimensions (width across and length along the line of sight) of the CIE 117 tabular method.
+var ugrRooms = [][2]float64{
+	{2, 2}, {2, 3}, {2, 4}, {2, 6}, {2, 8}, {2, 12},
+	{4, 2}, {4, 3}, {4, 4}, {4, 6}, {4, 8}, {4, 12},
+	{8, 4}, {8, 6}, {8, 8}, {8, 12},
+	{12, 4}, {12, 6}, {12, 8},
+}
+
+// ugrVariationSpacings are the luminaire spacings of the spacing corrections.
+var ugrVariationSpacings = []float64{1, 1.5, 2}
+
+// NewUGRTable calculates the CIE 117 UGR table of the given photometry.
+func NewUGRTable(photometry Photometry) (UGRTable, error) {
+	table := UGRTable{
+		Reflectances: ugrReflectances,
+		Rows:         make([]UGRRow, len(ugrRooms)),
+		Flux:         photometry.ComputeTotalFlux(),
+	}
+	for i, room := range ugrRooms {
+		table.Rows[i] = UGRRow{Width: room[0], Length: room[1]}
+		for _, reflectances := range ugrReflectances {
+			alongC0, alongC90, err := ugrBothDirections(photometry, room, reflectances, 0)
+			if err != nil {
+				return UGRTable{}, err
+			}
+			table.Rows[i].AlongC0 = append(table.Rows[i].AlongC0, alongC0)
+			table.Rows[i].AlongC90 = append(table.Rows[i].AlongC90, alongC90)
+		}
+	}
+
+	room := [2]float64{4, 8}
+	referenceC0, referenceC90, err := ugrBothDirections(photometry, room, ugrReflectances[0], 0)
+	if err != nil {
+		return UGRTable{}, err
+	}
+	for _, spacing := range ugrVariationSpacings {
+		alongC0, alongC90, err := ugrBothDirections(photometry, room, ugrReflectances[0], spacing)
+		if err != nil {
+			return UGRTable{}, err
+		}
+		table.Variations = append(table.Variations, UGRVariation{
+			Spacing:  spacing,
+			AlongC0:  alongC0 - referenceC0,
+			AlongC90: alongC90 - referenceC90,
+		})
+	}
+
+	return table, nil
+}
+
+// ugrBothDirections returns the unified glare rating for the observer looking along the C0 and the C90 plane.
+func ugrBothDirections(photometry Photometry, room [2]float64, reflectances Reflectances,
+	spacing float64) (alongC0, alongC90 float64, err error) {
+	opts := eulumies.UGROptions{
+		Width:              room[0],
+		Length:             room[1],
+		Spacing:            spacing,
+		CeilingReflectance: reflectances.Ceiling,
+		WallReflectance:    reflectances.Walls,
+		FloorReflectance:   reflectances.Floor,
+	}
+	if alongC0, err = photometry.ComputeUGR(opts); err != nil {
+		return 0, 0, err
+	}
+	opts.Rotation = 90
+	if alongC90, err = photometry.ComputeUGR(opts); err != nil {
+		return 0, 0, err
+	}
+
+	return alongC0, alongC90, nil
+}
+
+// FluxCorrection returns the summand correcting the table values for a luminaire flux (lm) differing from the flux
+// of the table, for example for another lamp set with the same distribution.
+func (t UGRTable) FluxCorrection(flux float64) float64 {
+	if flux <= 0 || t.Flux <= 0 {
+		return 0
+	}
+
+	return 8 * math.Log10(flux/t.Flux)
+}
+
+// WriteText writes the table as plain text with aligned columns, the reflectances are given in tenths.
+func (t UGRTable) WriteText(out io.Writer) error {
+	var b strings.Builder
+	width := 6 * len(t.Reflectances)
+	fmt.Fprintf(&b, "%-13s |%-*s |%s\n", "Room", width, " viewed along C0", " viewed along C90")
+	codes := make([]string, len(t.Reflectances))
+	for i, r := range t.Reflectances {
+		codes[i] = fmt.Sprintf(" %5s", fmt.Sprintf("%.0f%.0f%.0f", r.Ceiling*10, r.Walls*10, r.Floor*10))
+	}
+	fmt.Fprintf(&b, "%-6s %-6s |%s |%s\n", "X", "Y", strings.Join(codes, ""), strings.Join(codes, ""))
+	for _, row := range t.Rows {
+		fmt.Fprintf(&b, "%-6s %-6s |%s |%s\n", number(row.Width)+"H", number(row.Length)+"H",
+			textValues(row.AlongC0), textValues(row.AlongC90))
+	}
+	for _, variation := range t.Variations {
+		fmt.Fprintf(&b, "%-13s |%*s |%6s\n", "S = "+number(variation.Spacing)+"H", width,
+			fmt.Sprintf("%+.1f", variation.AlongC0), fmt.Sprintf("%+.1f", variation.AlongC90))
+	}
+	fmt.Fprintf(&b, "Luminaire spacing 0.25H, %.0f lm. The S rows give the change for larger spacings in a 4H x 8H room.\n",
+		t.Flux)
+
+	_, err := io.WriteString(out, b.String())
+	return err
+}
+
+func textValues(values []float64) string {
+	var b strings.Builder
+	for _, value := range values {
+		fmt.Fprintf(&b, " %5.1f", value)
+	}
+
+	return b.String()
+}
+
+// HTML returns the table as HTML fragment, which can be embedded into a datasheet.
+func (t UGRTable) HTML() (template.HTML, error) {
+	var b bytes.Buffer
+	if err := ugrTemplate.Execute(&b, t); err != nil {
+		return "", err
+	}
+
+	return template.HTML(b.String()), nil
+}
+
+var ugrTemplate = template.Must(template.New("ugr").Funcs(template.FuncMap{
+	"fixed": fixed,
+	"signed": func(value float64) string {
+		return fmt.Sprintf("%+.1f", value)
+	},
+}).Parse(`<table class="ugr">
+<tr><th rowspan="2">Room X</th><th rowspan="2">Room Y</th><th colspan="{{len .Reflectances}}">Viewed along C0</th><th colspan="{{len .Reflectances}}">Viewed along C90</th></tr>
+<tr>{{range .Reflectances}}<th>{{.Ceiling}}/{{.Walls}}/{{.Floor}}</th>{{end}}{{range .Reflectances}}<th>{{.Ceiling}}/{{.Walls}}/{{.Floor}}</th>{{end}}</tr>
+{{range .Rows}}<tr><td>{{.Width}}H</td><td>{{.Length}}H</td>{{range .AlongC0}}<td class="number">{{fixed 1 .}}</td>{{end}}{{range .AlongC90}}<td class="number">{{fixed 1 .}}</td>{{end}}</tr>
+{{end}}{{$columns := len .Reflectances}}{{range .Variations}}<tr><th colspan="2">S = {{.Spacing}}H</th><td class="number" colspan="{{$columns}}">{{signed .AlongC0}}</td><td class="number" colspan="{{$columns}}">{{signed .AlongC90}}</td></tr>
+{{end}}</table>
+<p>Reflectances of ceiling / walls / floor, luminaire spacing 0.25H, {{fixed 0 .Flux}} lm. The spacing rows give the change for larger luminaire spacings in a 4H x 8H room.</p>
+`))
@@ -0,0 +1,44 @@
+package report
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/h44z/eulumies"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewUGRTable(t *testing.T) {
+	file, err := os.Open("../test/sample2.ldt")
+	assert.NoError(t, err)
+	defer file.Close()
+	eulumdat, err := eulumies.NewEulumdat(file, false)
+	assert.NoError(t, err)
+
+	table, err := NewUGRTable(eulumdat)
+	assert.NoError(t, err)
+	assert.Len(t, table.Rows, len(ugrRooms))
+	assert.Len(t, table.Rows[0].AlongC0, len(ugrReflectances))
+	assert.Len(t, table.Variations, 3)
+	assert.InDelta(t, eulumdat.ComputeTotalFlux(), table.Flux, 1e-9)
+	assert.InDelta(t, 8*0.30103, table.FluxCorrection(2*table.Flux), 1e-4)
+	assert.Equal(t, 0.0, table.FluxCorrection(0))
+
+	var text strings.Builder
+	assert.NoError(t, table.WriteText(&text))
+	lines := strings.Split(strings.TrimSpace(text.String()), "\n")
+	assert.Len(t, lines, 2+len(ugrRooms)+3+1)
+	assert.Contains(t, lines[1], "  752")
+	assert.True(t, strings.HasPrefix(lines[2], "2H     2H"))
+
+	html, err := table.HTML()
+	assert.NoError(t, err)
+	assert.Contains(t, string(html), `<table class="ugr">`)
+	assert.Contains(t, string(html), "S = 1.5H")
+
+	eulumdat.LengthDiameterLuminousArea = 0
+	eulumdat.WidthLuminousArea = 0
+	_, err = NewUGRTable(eulumdat)
+	assert.Error(t, err)
+}
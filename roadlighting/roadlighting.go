@@ -0,0 +1,231 @@
+// Package roadlighting contains a simplified EN 13201 assessment of road lighting installations
+// for motorized traffic (M lighting classes).
+package roadlighting
+
+import (
+	"errors"
+	"math"
+
+	"github.com/h44z/eulumies"
+)
+
+// Photometry is implemented by eulumies.Eulumdat and *eulumies.IES.
+type Photometry interface {
+	IntensityFunc() eulumies.IntensityFunc
+	ThresholdIncrement(opts eulumies.ThresholdIncrementOptions) (float64, error)
+}
+
+// Surface describes the reflection properties of the road surface.
+type Surface interface {
+	// ReducedLuminanceCoefficient returns the reduced luminance coefficient r (1/sr) for the angle beta (in degrees)
+	// between the plane of observation and the plane of incidence and the tangent of the angle of incidence.
+	ReducedLuminanceCoefficient(beta, tanGamma float64) float64
+}
+
+// DiffuseSurface is a perfectly diffuse road surface with the given average luminance coefficient Q0.
+// Use a measured r-table implementation of Surface for accurate results on real road surfaces.
+type DiffuseSurface struct {
+	Q0 float64
+}
+
+// ReducedLuminanceCoefficient returns q0 * cos³(gamma).
+func (s DiffuseSurface) ReducedLuminanceCoefficient(beta, tanGamma float64) float64 {
+	return s.Q0 * math.Pow(1+tanGamma*tanGamma, -1.5)
+}
+
+// Road describes a straight road with a single-sided luminaire arrangement. All lengths are given in meters.
+// The luminaires are mounted at the road edge y = 0 (plus overhang), C0 runs along the road and C90 faces the road.
+type Road struct {
+	Lanes             int
+	LaneWidth         float64
+	PoleSpacing       float64
+	MountingHeight    float64
+	Overhang          float64 // distance of the luminaire from the road edge, positive values towards the road
+	Tilt              float64 // luminaire tilt in degrees, positive values raise the road side
+	MaintenanceFactor float64 // defaults to 1
+	Surface           Surface // defaults to DiffuseSurface{Q0: 0.07} (similar to R3)
+}
+
+// Class holds the minimum requirements of a lighting class.
+type Class struct {
+	Name                   string
+	AverageLuminance       float64 // minimum maintained average luminance (cd/m²)
+	OverallUniformity      float64 // minimum Uo
+	LongitudinalUniformity float64 // minimum Ul
+	ThresholdIncrement     float64 // maximum TI (%)
+}
+
+// MClasses contains the M lighting classes of EN 13201-2, ordered from the highest to the lowest class.
+var MClasses = []Class{
+	{"M1", 2.0, 0.4, 0.7, 10},
+	{"M2", 1.5, 0.4, 0.7, 10},
+	{"M3", 1.0, 0.4, 0.6, 15},
+	{"M4", 0.75, 0.4, 0.6, 15},
+	{"M5", 0.5, 0.35, 0.4, 15},
+	{"M6", 0.3, 0.35, 0.4, 20},
+}
+
+// Result contains the calculated quality figures, the worst value over all observer positions is reported.
+type Result struct {
+	AverageLuminance       float64 // cd/m²
+	OverallUniformity      float64 // Uo = Lmin / Lav
+	LongitudinalUniformity float64 // Ul = Lmin / Lmax along the lane centre lines
+	ThresholdIncrement     float64 // TI (%)
+	Class                  string  // highest M class met, empty if no class is met
+}
+
+const (
+	observerDistance = 60.0 // distance of the observer in front of the calculation field
+	eyeHeight        = 1.5
+)
+
+// Assess calculates the road luminance quality figures for the given road and photometry and determines the
+// highest M class met by the installation.
+func Assess(road Road, photometry Photometry) (Result, error) {
+	if road.Lanes <= 0 || road.LaneWidth <= 0 || road.PoleSpacing <= 0 || road.MountingHeight <= 0 {
+		return Result{}, errors.New("invalid road geometry")
+	}
+	if road.MaintenanceFactor <= 0 {
+		road.MaintenanceFactor = 1
+	}
+	if road.Surface == nil {
+		road.Surface = DiffuseSurface{Q0: 0.07}
+	}
+
+	intensity := photometry.IntensityFunc()
+	pointsX := calculationPointsAlongRoad(road.PoleSpacing)
+
+	result := Result{
+		AverageLuminance:       math.MaxFloat64,
+		OverallUniformity:      math.MaxFloat64,
+		LongitudinalUniformity: math.MaxFloat64,
+	}
+	for lane := 0; lane < road.Lanes; lane++ {
+		observerY := (float64(lane) + 0.5) * road.LaneWidth
+
+		sum, min := 0.0, math.MaxFloat64
+		count := 0
+		for row := 0; row < 3*road.Lanes; row++ {
+			y := (float64(row) + 0.5) * road.LaneWidth / 3
+			centerLine := row == 3*lane+1
+			lineMin, lineMax := math.MaxFloat64, 0.0
+			for column := 0; column < pointsX; column++ {
+				x := (float64(column) + 0.5) * road.PoleSpacing / float64(pointsX)
+				value := luminanceAt(road, intensity, -observerDistance, observerY, x, y)
+				sum += value
+				count++
+				min = math.Min(min, value)
+				if centerLine {
+					lineMin = math.Min(lineMin, value)
+					lineMax = math.Max(lineMax, value)
+				}
+			}
+			if centerLine && lineMax > 0 {
+				result.LongitudinalUniformity = math.Min(result.LongitudinalUniformity, lineMin/lineMax)
+			}
+		}
+
+		average := sum / float64(count)
+		result.AverageLuminance = math.Min(result.AverageLuminance, average)
+		if average > 0 {
+			result.OverallUniformity = math.Min(result.OverallUniformity, min/average)
+		}
+
+		ti, err := photometry.ThresholdIncrement(eulumies.ThresholdIncrementOptions{
+			MountingHeight:   road.MountingHeight,
+			Spacing:          road.PoleSpacing,
+			LateralOffset:    road.Overhang - observerY,
+			Tilt:             road.Tilt,
+			EyeHeight:        eyeHeight,
+			AverageLuminance: average,
+		})
+		if err != nil {
+			return Result{}, err
+		}
+		result.ThresholdIncrement = math.Max(result.ThresholdIncrement, ti)
+	}
+
+	if result.OverallUniformity == math.MaxFloat64 {
+		result.OverallUniformity = 0
+	}
+	if result.LongitudinalUniformity == math.MaxFloat64 {
+		result.LongitudinalUniformity = 0
+	}
+
+	for _, class := range MClasses {
+		if result.Meets(class) {
+			result.Class = class.Name
+			break
+		}
+	}
+
+	return result, nil
+}
+
+// Meets reports whether the result fulfills the requirements of the given class.
+func (r Result) Meets(class Class) bool {
+	return r.AverageLuminance >= class.AverageLuminance &&
+		r.OverallUniformity >= class.OverallUniformity &&
+		r.LongitudinalUniformity >= class.LongitudinalUniformity &&
+		r.ThresholdIncrement <= class.ThresholdIncrement
+}
+
+// calculationPointsAlongRoad returns the number of calculation points between two luminaires (EN 13201-3).
+func calculationPointsAlongRoad(spacing float64) int {
+	if spacing <= 30 {
+		return 10
+	}
+
+	return int(math.Ceil(spacing / 3))
+}
+
+// luminanceAt returns the road surface luminance (cd/m²) at the point (x, y) seen by the observer.
+// All luminaires within five mounting heights of the point are taken into account.
+func luminanceAt(road Road, intensity eulumies.IntensityFunc, observerX, observerY, x, y float64) float64 {
+	reach := 5 * road.MountingHeight
+	first := math.Floor((x - reach) / road.PoleSpacing)
+	last := math.Ceil((x + reach) / road.PoleSpacing)
+
+	observation := math.Atan2(observerY-y, observerX-x)
+	luminance := 0.0
+	for k := first; k <= last; k++ {
+		luminaireX := k * road.PoleSpacing
+		dx := x - luminaireX
+		dy := y - road.Overhang
+		if math.Abs(dx) > reach {
+			continue
+		}
+
+		c, gamma := installedDirection(road, dx, dy)
+		tanGamma := math.Hypot(dx, dy) / road.MountingHeight
+		incidence := math.Atan2(-dy, -dx)
+		beta := math.Abs(math.Mod(observation-incidence, 2*math.Pi)) * 180 / math.Pi
+		if beta > 180 {
+			beta = 360 - beta
+		}
+
+		r := road.Surface.ReducedLuminanceCoefficient(beta, tanGamma)
+		luminance += intensity(c, gamma) * r / (road.MountingHeight * road.MountingHeight)
+	}
+
+	return luminance * road.MaintenanceFactor
+}
+
+// installedDirection returns the C-plane and gamma angle in which a luminaire of the road sees the point at the
+// given horizontal offset. The luminaire C90 plane faces the road (+y), positive tilt raises the road side.
+func installedDirection(road Road, dx, dy float64) (float64, float64) {
+	dz := -road.MountingHeight
+	sinT, cosT := math.Sincos(road.Tilt * math.Pi / 180)
+	lx := dx
+	ly := dy*cosT + dz*sinT
+	lz := -dy*sinT + dz*cosT
+
+	distance := math.Sqrt(lx*lx + ly*ly + lz*lz)
+	gamma := math.Acos(math.Max(-1, math.Min(1, -lz/distance))) * 180 / math.Pi
+	c := math.Atan2(ly, lx) * 180 / math.Pi
+	if c < 0 {
+		c += 360
+	}
+
+	return c, gamma
+}
@@ -0,0 +1,62 @@
+package roadlighting
+
+import (
+	"testing"
+
+	"github.com/h44z/eulumies"
+	"github.com/stretchr/testify/assert"
+)
+
+type testPhotometry struct {
+	intensity eulumies.IntensityFunc
+	ti        float64
+}
+
+func (p testPhotometry) IntensityFunc() eulumies.IntensityFunc {
+	return p.intensity
+}
+
+func (p testPhotometry) ThresholdIncrement(opts eulumies.ThresholdIncrementOptions) (float64, error) {
+	return p.ti, nil
+}
+
+func TestAssess(t *testing.T) {
+	road := Road{Lanes: 2, LaneWidth: 3.5, PoleSpacing: 30, MountingHeight: 8}
+	photometry := testPhotometry{intensity: func(c, gamma float64) float64 {
+		if gamma > 80 {
+			return 0
+		}
+		return 3000
+	}, ti: 8}
+
+	result, err := Assess(road, photometry)
+	assert.NoError(t, err)
+	assert.Greater(t, result.AverageLuminance, 0.0)
+	assert.Greater(t, result.OverallUniformity, 0.0)
+	assert.LessOrEqual(t, result.OverallUniformity, 1.0)
+	assert.LessOrEqual(t, result.LongitudinalUniformity, 1.0)
+	assert.Equal(t, 8.0, result.ThresholdIncrement)
+
+	// doubling the intensity doubles the luminance but keeps the uniformities
+	brighter := testPhotometry{intensity: func(c, gamma float64) float64 {
+		return 2 * photometry.intensity(c, gamma)
+	}, ti: 8}
+	brighterResult, err := Assess(road, brighter)
+	assert.NoError(t, err)
+	assert.InDelta(t, 2*result.AverageLuminance, brighterResult.AverageLuminance, 1e-9)
+	assert.InDelta(t, result.OverallUniformity, brighterResult.OverallUniformity, 1e-9)
+
+	_, err = Assess(Road{}, photometry)
+	assert.Error(t, err)
+}
+
+func TestResult_Meets(t *testing.T) {
+	result := Result{AverageLuminance: 1.2, OverallUniformity: 0.45, LongitudinalUniformity: 0.65, ThresholdIncrement: 12}
+	assert.False(t, result.Meets(MClasses[1]))
+	assert.True(t, result.Meets(MClasses[2]))
+}
+
+func Test_calculationPointsAlongRoad(t *testing.T) {
+	assert.Equal(t, 10, calculationPointsAlongRoad(30))
+	assert.Equal(t, 12, calculationPointsAlongRoad(35))
+}
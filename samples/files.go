@@ -0,0 +1,3055 @@
+// Code generated by go run ./internal/generate; DO NOT EDIT.
+
+package samples
+
+var files = []Sample{
+	{
+		Name:        "sym0_wallwasher.ldt",
+		Description: "EULUMDAT asymmetric wall washer, symmetry indicator 0",
+		content: `eulumies sample corpus
+3
+0
+24
+15.0
+37
+5.0
+SAMPLE-SYM0_WALLWASHER
+Asymmetric wall washer
+EX-SYM0_WALLWASHER
+SYM0.LDT
+synthetic photometry
+300.0
+120.0
+90.0
+260.0
+80.0
+0.0
+0.0
+0.0
+0.0
+98.8
+78.0
+1.0
+0.0
+1
+1
+LED
+2400.0
+3000K
+90
+24.0
+0.3
+0.4
+0.5
+0.5
+0.6
+0.7
+0.7
+0.8
+0.8
+0.9
+0.0
+15.0
+30.0
+45.0
+60.0
+75.0
+90.0
+105.0
+120.0
+135.0
+150.0
+165.0
+180.0
+195.0
+210.0
+225.0
+240.0
+255.0
+270.0
+285.0
+300.0
+315.0
+330.0
+345.0
+0.0
+5.0
+10.0
+15.0
+20.0
+25.0
+30.0
+35.0
+40.0
+45.0
+50.0
+55.0
+60.0
+65.0
+70.0
+75.0
+80.0
+85.0
+90.0
+95.0
+100.0
+105.0
+110.0
+115.0
+120.0
+125.0
+130.0
+135.0
+140.0
+145.0
+150.0
+155.0
+160.0
+165.0
+170.0
+175.0
+180.0
+279.4
+310.4
+333.9
+348.1
+351.9
+345.1
+328.2
+302.3
+269.5
+232.0
+192.4
+153.1
+116.3
+83.7
+56.6
+35.5
+20.2
+10.1
+4.2
+1.3
+0.2
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+279.4
+326.0
+367.3
+400.3
+422.6
+432.6
+429.6
+413.7
+386.2
+349.0
+304.9
+256.8
+207.9
+161.0
+118.7
+82.5
+53.4
+31.7
+16.8
+7.6
+2.7
+0.6
+0.1
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+279.4
+338.3
+394.5
+444.1
+483.7
+510.3
+522.1
+518.1
+498.6
+465.1
+420.0
+366.6
+308.6
+249.6
+193.1
+142.1
+98.6
+63.8
+37.8
+19.9
+9.0
+3.2
+0.8
+0.1
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+279.4
+346.3
+412.4
+473.5
+525.3
+564.3
+587.4
+593.1
+580.8
+551.5
+507.4
+451.7
+388.2
+321.3
+255.1
+193.4
+138.9
+93.7
+58.5
+33.1
+16.4
+6.8
+2.1
+0.4
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+279.4
+349.0
+418.6
+483.8
+540.1
+583.6
+611.1
+620.5
+611.1
+583.6
+540.1
+483.8
+418.6
+349.0
+279.4
+213.7
+155.1
+105.9
+67.2
+38.8
+19.8
+8.5
+2.8
+0.6
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+279.4
+346.3
+412.4
+473.5
+525.3
+564.3
+587.4
+593.1
+580.8
+551.5
+507.4
+451.7
+388.2
+321.3
+255.1
+193.4
+138.9
+93.7
+58.5
+33.1
+16.4
+6.8
+2.1
+0.4
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+279.4
+338.3
+394.5
+444.1
+483.7
+510.3
+522.1
+518.1
+498.6
+465.1
+420.0
+366.6
+308.6
+249.6
+193.1
+142.1
+98.6
+63.8
+37.8
+19.9
+9.0
+3.2
+0.8
+0.1
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+279.4
+326.0
+367.3
+400.3
+422.6
+432.6
+429.6
+413.7
+386.2
+349.0
+304.9
+256.8
+207.9
+161.0
+118.7
+82.5
+53.4
+31.7
+16.8
+7.6
+2.7
+0.6
+0.1
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+279.4
+310.4
+333.9
+348.1
+351.9
+345.1
+328.2
+302.3
+269.5
+232.0
+192.4
+153.1
+116.3
+83.7
+56.6
+35.5
+20.2
+10.1
+4.2
+1.3
+0.2
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+279.4
+293.0
+298.0
+294.0
+281.2
+260.7
+234.0
+202.8
+169.5
+136.0
+104.3
+75.9
+52.0
+33.1
+19.2
+9.9
+4.3
+1.4
+0.3
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+279.4
+275.1
+262.8
+243.2
+217.8
+188.5
+157.1
+125.8
+96.2
+69.8
+47.7
+30.2
+17.5
+8.9
+3.8
+1.3
+0.3
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+279.4
+258.1
+230.8
+199.3
+165.8
+132.4
+101.0
+73.1
+49.7
+31.4
+18.0
+9.1
+3.9
+1.2
+0.2
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+279.4
+242.9
+203.6
+164.0
+126.3
+92.4
+63.7
+40.8
+23.9
+12.5
+5.5
+1.9
+0.4
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+279.4
+230.5
+182.3
+137.6
+98.4
+66.0
+40.9
+22.9
+11.2
+4.5
+1.3
+0.2
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+279.4
+221.2
+167.2
+119.7
+80.3
+49.9
+28.0
+13.8
+5.6
+1.7
+0.3
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+279.4
+215.6
+158.1
+109.3
+70.3
+41.4
+21.7
+9.7
+3.4
+0.8
+0.1
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+279.4
+213.7
+155.1
+105.9
+67.2
+38.8
+19.8
+8.5
+2.8
+0.6
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+279.4
+215.6
+158.1
+109.3
+70.3
+41.4
+21.7
+9.7
+3.4
+0.8
+0.1
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+279.4
+221.2
+167.2
+119.7
+80.3
+49.9
+28.0
+13.8
+5.6
+1.7
+0.3
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+279.4
+230.5
+182.3
+137.6
+98.4
+66.0
+40.9
+22.9
+11.2
+4.5
+1.3
+0.2
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+279.4
+242.9
+203.6
+164.0
+126.3
+92.4
+63.7
+40.8
+23.9
+12.5
+5.5
+1.9
+0.4
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+279.4
+258.1
+230.8
+199.3
+165.8
+132.4
+101.0
+73.1
+49.7
+31.4
+18.0
+9.1
+3.9
+1.2
+0.2
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+279.4
+275.1
+262.8
+243.2
+217.8
+188.5
+157.1
+125.8
+96.2
+69.8
+47.7
+30.2
+17.5
+8.9
+3.8
+1.3
+0.3
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+279.4
+293.0
+298.0
+294.0
+281.2
+260.7
+234.0
+202.8
+169.5
+136.0
+104.3
+75.9
+52.0
+33.1
+19.2
+9.9
+4.3
+1.4
+0.3
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+`,
+	},
+	{
+		Name:        "sym1_downlight.ldt",
+		Description: "EULUMDAT round downlight, symmetry indicator 1",
+		content: `eulumies sample corpus
+1
+1
+36
+10.0
+37
+5.0
+SAMPLE-SYM1_DOWNLIGHT
+Round downlight
+EX-SYM1_DOWNLIGHT
+SYM1.LDT
+synthetic photometry
+200.0
+0.0
+110.0
+180.0
+0.0
+0.0
+0.0
+0.0
+0.0
+100.0
+82.0
+1.0
+0.0
+1
+1
+LED
+1800.0
+4000K
+80
+17.5
+0.4
+0.5
+0.6
+0.7
+0.7
+0.8
+0.8
+0.9
+0.9
+0.9
+0.0
+10.0
+20.0
+30.0
+40.0
+50.0
+60.0
+70.0
+80.0
+90.0
+100.0
+110.0
+120.0
+130.0
+140.0
+150.0
+160.0
+170.0
+180.0
+190.0
+200.0
+210.0
+220.0
+230.0
+240.0
+250.0
+260.0
+270.0
+280.0
+290.0
+300.0
+310.0
+320.0
+330.0
+340.0
+350.0
+0.0
+5.0
+10.0
+15.0
+20.0
+25.0
+30.0
+35.0
+40.0
+45.0
+50.0
+55.0
+60.0
+65.0
+70.0
+75.0
+80.0
+85.0
+90.0
+95.0
+100.0
+105.0
+110.0
+115.0
+120.0
+125.0
+130.0
+135.0
+140.0
+145.0
+150.0
+155.0
+160.0
+165.0
+170.0
+175.0
+180.0
+521.5
+515.6
+498.1
+470.0
+432.8
+388.2
+338.7
+286.7
+234.4
+184.4
+138.5
+98.4
+65.2
+39.4
+20.9
+9.0
+2.7
+0.3
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+`,
+	},
+	{
+		Name:        "sym2_streetlight.ldt",
+		Description: "EULUMDAT street light, symmetric to C0-C180, symmetry indicator 2",
+		content: `eulumies sample corpus
+3
+2
+36
+10.0
+37
+5.0
+SAMPLE-SYM2_STREETLIGHT
+Street light, symmetric to C0-C180
+EX-SYM2_STREETLIGHT
+SYM2.LDT
+synthetic photometry
+560.0
+250.0
+110.0
+460.0
+160.0
+0.0
+0.0
+0.0
+0.0
+91.4
+80.0
+1.0
+0.0
+1
+1
+LED
+8000.0
+4000K
+70
+62.0
+0.1
+0.2
+0.2
+0.3
+0.4
+0.5
+0.5
+0.6
+0.7
+0.7
+0.0
+10.0
+20.0
+30.0
+40.0
+50.0
+60.0
+70.0
+80.0
+90.0
+100.0
+110.0
+120.0
+130.0
+140.0
+150.0
+160.0
+170.0
+180.0
+190.0
+200.0
+210.0
+220.0
+230.0
+240.0
+250.0
+260.0
+270.0
+280.0
+290.0
+300.0
+310.0
+320.0
+330.0
+340.0
+350.0
+0.0
+5.0
+10.0
+15.0
+20.0
+25.0
+30.0
+35.0
+40.0
+45.0
+50.0
+55.0
+60.0
+65.0
+70.0
+75.0
+80.0
+85.0
+90.0
+95.0
+100.0
+105.0
+110.0
+115.0
+120.0
+125.0
+130.0
+135.0
+140.0
+145.0
+150.0
+155.0
+160.0
+165.0
+170.0
+175.0
+180.0
+13.9
+31.7
+62.9
+111.4
+180.2
+269.4
+376.1
+494.1
+613.8
+724.1
+813.3
+871.4
+891.5
+871.4
+813.3
+724.1
+613.8
+494.1
+376.1
+269.4
+180.2
+111.4
+62.9
+31.7
+13.9
+5.1
+1.4
+0.3
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+13.9
+31.4
+61.6
+108.3
+173.9
+258.6
+359.3
+469.9
+581.4
+683.2
+764.6
+816.3
+832.3
+810.6
+753.9
+668.8
+564.8
+452.7
+343.1
+244.5
+162.6
+99.9
+56.0
+28.0
+12.1
+4.4
+1.2
+0.2
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+13.9
+30.3
+57.7
+99.3
+156.4
+228.6
+313.0
+403.8
+493.5
+573.1
+634.1
+669.6
+675.3
+650.6
+598.5
+524.8
+437.9
+346.5
+259.0
+181.7
+118.7
+71.5
+39.0
+18.9
+7.9
+2.7
+0.7
+0.1
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+13.9
+28.5
+51.9
+85.9
+130.9
+186.0
+248.1
+312.6
+373.7
+425.1
+461.1
+477.4
+472.3
+446.2
+402.3
+345.5
+282.0
+217.9
+158.7
+108.1
+68.4
+39.6
+20.6
+9.4
+3.6
+1.1
+0.2
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+13.9
+26.3
+44.9
+70.2
+102.0
+138.9
+178.3
+216.9
+250.8
+276.3
+290.6
+291.9
+280.1
+256.6
+224.1
+186.1
+146.6
+109.0
+76.0
+49.3
+29.5
+15.9
+7.6
+3.1
+1.0
+0.3
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+13.9
+23.8
+37.2
+54.2
+73.8
+94.9
+115.5
+133.7
+147.4
+155.1
+155.9
+149.7
+137.3
+119.9
+99.7
+78.6
+58.5
+40.8
+26.5
+15.9
+8.6
+4.1
+1.7
+0.6
+0.1
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+13.9
+21.1
+29.8
+39.6
+49.6
+59.1
+66.9
+72.3
+74.6
+73.5
+69.2
+62.1
+53.1
+43.2
+33.2
+24.1
+16.3
+10.2
+5.9
+3.0
+1.4
+0.5
+0.2
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+13.9
+18.4
+23.1
+27.4
+30.9
+33.4
+34.3
+33.8
+31.7
+28.4
+24.2
+19.6
+15.1
+10.9
+7.3
+4.6
+2.6
+1.3
+0.6
+0.2
+0.1
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+13.9
+15.9
+17.3
+18.0
+17.9
+17.0
+15.4
+13.2
+10.9
+8.4
+6.2
+4.2
+2.7
+1.6
+0.8
+0.4
+0.1
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+13.9
+13.6
+12.7
+11.3
+9.6
+7.7
+5.9
+4.2
+2.8
+1.7
+1.0
+0.5
+0.2
+0.1
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+13.9
+11.6
+9.2
+6.8
+4.8
+3.1
+1.9
+1.0
+0.5
+0.2
+0.1
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+13.9
+9.9
+6.6
+4.0
+2.2
+1.1
+0.5
+0.2
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+13.9
+8.5
+4.7
+2.3
+1.0
+0.3
+0.1
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+13.9
+7.4
+3.4
+1.4
+0.4
+0.1
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+13.9
+6.5
+2.6
+0.8
+0.2
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+13.9
+5.9
+2.0
+0.5
+0.1
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+13.9
+5.4
+1.7
+0.4
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+13.9
+5.2
+1.5
+0.3
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+13.9
+5.1
+1.4
+0.3
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+`,
+	},
+	{
+		Name:        "sym3_floodlight.ldt",
+		Description: "EULUMDAT floodlight, symmetric to C90-C270, symmetry indicator 3",
+		content: `eulumies sample corpus
+3
+3
+24
+15.0
+37
+5.0
+SAMPLE-SYM3_FLOODLIGHT
+Floodlight, symmetric to C90-C270
+EX-SYM3_FLOODLIGHT
+SYM3.LDT
+synthetic photometry
+400.0
+350.0
+120.0
+350.0
+300.0
+0.0
+0.0
+0.0
+0.0
+99.9
+85.0
+1.0
+0.0
+1
+1
+LED
+12000.0
+5000K
+70
+98.0
+0.2
+0.4
+0.4
+0.5
+0.6
+0.7
+0.7
+0.8
+0.8
+0.9
+0.0
+15.0
+30.0
+45.0
+60.0
+75.0
+90.0
+105.0
+120.0
+135.0
+150.0
+165.0
+180.0
+195.0
+210.0
+225.0
+240.0
+255.0
+270.0
+285.0
+300.0
+315.0
+330.0
+345.0
+0.0
+5.0
+10.0
+15.0
+20.0
+25.0
+30.0
+35.0
+40.0
+45.0
+50.0
+55.0
+60.0
+65.0
+70.0
+75.0
+80.0
+85.0
+90.0
+95.0
+100.0
+105.0
+110.0
+115.0
+120.0
+125.0
+130.0
+135.0
+140.0
+145.0
+150.0
+155.0
+160.0
+165.0
+170.0
+175.0
+180.0
+103.6
+46.5
+17.9
+5.7
+1.5
+0.3
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+103.6
+47.8
+19.0
+6.3
+1.7
+0.3
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+103.6
+51.7
+22.6
+8.4
+2.6
+0.6
+0.1
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+103.6
+58.5
+29.4
+13.0
+4.9
+1.5
+0.4
+0.1
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+103.6
+68.6
+41.2
+22.2
+10.6
+4.4
+1.5
+0.4
+0.1
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+103.6
+82.3
+60.1
+40.2
+24.4
+13.3
+6.4
+2.7
+1.0
+0.3
+0.1
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+103.6
+99.7
+88.9
+73.2
+55.6
+38.7
+24.6
+14.1
+7.2
+3.2
+1.2
+0.4
+0.1
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+103.6
+120.3
+129.4
+128.9
+119.0
+101.6
+80.1
+58.0
+38.4
+23.1
+12.5
+5.9
+2.5
+0.9
+0.2
+0.1
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+103.6
+143.0
+181.4
+212.5
+230.3
+231.2
+215.0
+185.1
+147.1
+107.5
+71.9
+43.7
+23.8
+11.5
+4.8
+1.7
+0.5
+0.1
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+103.6
+165.4
+240.4
+320.2
+392.9
+445.4
+467.6
+454.8
+409.7
+341.3
+262.1
+184.8
+118.8
+69.1
+35.9
+16.4
+6.5
+2.1
+0.6
+0.1
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+103.6
+184.6
+296.7
+433.8
+581.1
+716.6
+816.6
+861.5
+842.1
+762.4
+638.3
+492.9
+349.5
+226.1
+132.4
+69.4
+32.0
+12.8
+4.3
+1.1
+0.2
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+103.6
+197.8
+338.0
+522.6
+737.5
+955.6
+1141.9
+1261.8
+1291.5
+1224.8
+1075.3
+872.3
+651.6
+445.9
+277.7
+155.8
+77.8
+34.0
+12.7
+3.9
+0.9
+0.2
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+103.6
+202.4
+353.2
+556.4
+799.0
+1052.2
+1277.0
+1432.5
+1488.2
+1432.5
+1277.0
+1052.2
+799.0
+556.4
+353.2
+202.4
+103.6
+46.5
+17.9
+5.7
+1.5
+0.3
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+0.0
+`,
+	},
+	{
+		Name:        "sym4_linear.ldt",
+		Description: "EULUMDAT linear suspended luminaire, symmetry indicator 4",
+		content: `eulumies sample corpus
+2
+4
+24
+15.0
+37
+5.0
+SAMPLE-SYM4_LINEAR
+Linear suspended luminaire
+EX-SYM4_LINEAR
+SYM4.LDT
+synthetic photometry
+1170.0
+70.0
+60.0
+1150.0
+60.0
+0.0
+0.0
+0.0
+0.0
+57.9
+90.0
+1.0
+0.0
+1
+2
+T5 28W
+5200.0
+830
+1B
+62.0
+0.3
+0.4
+0.5
+0.6
+0.7
+0.7
+0.8
+0.8
+0.9
+0.9
+0.0
+15.0
+30.0
+45.0
+60.0
+75.0
+90.0
+105.0
+120.0
+135.0
+150.0
+165.0
+180.0
+195.0
+210.0
+225.0
+240.0
+255.0
+270.0
+285.0
+300.0
+315.0
+330.0
+345.0
+0.0
+5.0
+10.0
+15.0
+20.0
+25.0
+30.0
+35.0
+40.0
+45.0
+50.0
+55.0
+60.0
+65.0
+70.0
+75.0
+80.0
+85.0
+90.0
+95.0
+100.0
+105.0
+110.0
+115.0
+120.0
+125.0
+130.0
+135.0
+140.0
+145.0
+150.0
+155.0
+160.0
+165.0
+170.0
+175.0
+180.0
+259.0
+258.0
+255.0
+250.2
+243.4
+234.7
+224.3
+212.1
+198.4
+183.1
+166.5
+148.5
+129.5
+109.5
+88.6
+67.0
+45.0
+22.6
+0.0
+15.7
+31.0
+45.3
+58.3
+69.4
+78.5
+85.2
+89.3
+90.6
+89.3
+85.2
+78.5
+69.4
+58.3
+45.3
+31.0
+15.7
+0.0
+259.0
+257.5
+253.0
+245.7
+235.7
+223.1
+208.2
+191.3
+172.8
+153.1
+132.4
+111.4
+90.5
+70.1
+50.8
+33.3
+18.2
+6.4
+0.0
+15.7
+31.0
+45.3
+58.3
+69.4
+78.5
+85.2
+89.3
+90.6
+89.3
+85.2
+78.5
+69.4
+58.3
+45.3
+31.0
+15.7
+0.0
+259.0
+257.0
+251.2
+241.6
+228.7
+212.7
+194.2
+173.8
+152.0
+129.5
+107.0
+85.2
+64.7
+46.3
+30.3
+17.3
+7.8
+2.0
+0.0
+15.7
+31.0
+45.3
+58.3
+69.4
+78.5
+85.2
+89.3
+90.6
+89.3
+85.2
+78.5
+69.4
+58.3
+45.3
+31.0
+15.7
+0.0
+259.0
+256.6
+249.6
+238.2
+222.9
+204.2
+183.0
+160.0
+136.1
+112.2
+89.1
+67.7
+48.6
+32.4
+19.4
+9.9
+3.8
+0.7
+0.0
+15.7
+31.0
+45.3
+58.3
+69.4
+78.5
+85.2
+89.3
+90.6
+89.3
+85.2
+78.5
+69.4
+58.3
+45.3
+31.0
+15.7
+0.0
+259.0
+256.3
+248.4
+235.6
+218.5
+197.9
+174.8
+150.2
+125.0
+100.5
+77.4
+56.7
+39.0
+24.6
+13.8
+6.4
+2.2
+0.3
+0.0
+15.7
+31.0
+45.3
+58.3
+69.4
+78.5
+85.2
+89.3
+90.6
+89.3
+85.2
+78.5
+69.4
+58.3
+45.3
+31.0
+15.7
+0.0
+259.0
+256.1
+247.6
+234.0
+215.8
+194.1
+169.9
+144.3
+118.6
+93.8
+70.9
+50.8
+33.9
+20.7
+11.1
+4.9
+1.5
+0.2
+0.0
+15.7
+31.0
+45.3
+58.3
+69.4
+78.5
+85.2
+89.3
+90.6
+89.3
+85.2
+78.5
+69.4
+58.3
+45.3
+31.0
+15.7
+0.0
+259.0
+256.0
+247.4
+233.4
+214.9
+192.8
+168.2
+142.4
+116.4
+91.6
+68.8
+48.9
+32.4
+19.5
+10.4
+4.5
+1.4
+0.2
+0.0
+15.7
+31.0
+45.3
+58.3
+69.4
+78.5
+85.2
+89.3
+90.6
+89.3
+85.2
+78.5
+69.4
+58.3
+45.3
+31.0
+15.7
+0.0
+`,
+	},
+	{
+		Name:        "lm63_1986_downlight.ies",
+		Description: "LM-63-1986 downlight",
+		content: `
+TILT=NONE
+1 1800.0 1.0 37 1 1 2 0.0 0.2 0.1
+1.0 1.0 17.5
+0.0 5.0 10.0 15.0 20.0 25.0 30.0 35.0 40.0 45.0 50.0 55.0 60.0 65.0 70.0 75.0 80.0 85.0 90.0 95.0 100.0 105.0 110.0 115.0 120.0
+125.0 130.0 135.0 140.0 145.0 150.0 155.0 160.0 165.0 170.0 175.0 180.0
+0.0
+938.7 928.1 896.6 846.0 779.0 698.8 609.7 516.1 421.9 331.9 249.3 177.1 117.4 70.9 37.6 16.2 4.9 0.5 0.0 0.0 0.0 0.0 0.0 0.0 0.0
+0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0
+`,
+	},
+	{
+		Name:        "lm63_1991_downlight.ies",
+		Description: "LM-63-1991 downlight",
+		content: `IESNA91
+[TEST] SAMPLE-SYM1_DOWNLIGHT
+[MANUFAC] eulumies sample corpus
+[DATE] synthetic photometry
+[LAMP] LED
+[LUMCAT] EX-SYM1_DOWNLIGHT
+[LUMINAIRE] Round downlight
+TILT=NONE
+1 1800.0 1.0 37 1 1 2 0.0 0.2 0.1
+1.0 1.0 17.5
+0.0 5.0 10.0 15.0 20.0 25.0 30.0 35.0 40.0 45.0 50.0 55.0 60.0 65.0 70.0 75.0 80.0 85.0 90.0 95.0 100.0 105.0 110.0 115.0 120.0
+125.0 130.0 135.0 140.0 145.0 150.0 155.0 160.0 165.0 170.0 175.0 180.0
+0.0
+938.7 928.1 896.6 846.0 779.0 698.8 609.7 516.1 421.9 331.9 249.3 177.1 117.4 70.9 37.6 16.2 4.9 0.5 0.0 0.0 0.0 0.0 0.0 0.0 0.0
+0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0
+`,
+	},
+	{
+		Name:        "lm63_1995_streetlight.ies",
+		Description: "LM-63-1995 street light",
+		content: `IESNA:LM-63-1995
+[TEST] SAMPLE-SYM2_STREETLIGHT
+[MANUFAC] eulumies sample corpus
+[DATE] synthetic photometry
+[LAMP] LED
+[LUMCAT] EX-SYM2_STREETLIGHT
+[LUMINAIRE] Street light, symmetric to C0-C180
+[OTHER] converted using eulumies: SYM2.LDT
+TILT=NONE
+1 8000.0 1.0 37 19 1 2 0.2 0.6 0.1
+1.0 1.0 62.0
+0.0 5.0 10.0 15.0 20.0 25.0 30.0 35.0 40.0 45.0 50.0 55.0 60.0 65.0 70.0 75.0 80.0 85.0 90.0 95.0 100.0 105.0 110.0 115.0 120.0
+125.0 130.0 135.0 140.0 145.0 150.0 155.0 160.0 165.0 170.0 175.0 180.0
+0.0 10.0 20.0 30.0 40.0 50.0 60.0 70.0 80.0 90.0 100.0 110.0 120.0 130.0 140.0 150.0 160.0 170.0 180.0
+111.2 253.6 503.2 891.2 1441.6 2155.2 3008.8 3952.8 4910.4 5792.8 6506.4 6971.2 7132.0 6971.2 6506.4 5792.8 4910.4 3952.8 3008.8
+2155.2 1441.6 891.2 503.2 253.6 111.2 40.8 11.2 2.4 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0
+111.2 251.2 492.8 866.4 1391.2 2068.8 2874.4 3759.2 4651.2 5465.6 6116.8 6530.4 6658.4 6484.8 6031.2 5350.4 4518.4 3621.6 2744.8
+1956.0 1300.8 799.2 448.0 224.0 96.8 35.2 9.6 1.6 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0
+111.2 242.4 461.6 794.4 1251.2 1828.8 2504.0 3230.4 3948.0 4584.8 5072.8 5356.8 5402.4 5204.8 4788.0 4198.4 3503.2 2772.0 2072.0
+1453.6 949.6 572.0 312.0 151.2 63.2 21.6 5.6 0.8 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0
+111.2 228.0 415.2 687.2 1047.2 1488.0 1984.8 2500.8 2989.6 3400.8 3688.8 3819.2 3778.4 3569.6 3218.4 2764.0 2256.0 1743.2 1269.6
+864.8 547.2 316.8 164.8 75.2 28.8 8.8 1.6 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0
+111.2 210.4 359.2 561.6 816.0 1111.2 1426.4 1735.2 2006.4 2210.4 2324.8 2335.2 2240.8 2052.8 1792.8 1488.8 1172.8 872.0 608.0
+394.4 236.0 127.2 60.8 24.8 8.0 2.4 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0
+111.2 190.4 297.6 433.6 590.4 759.2 924.0 1069.6 1179.2 1240.8 1247.2 1197.6 1098.4 959.2 797.6 628.8 468.0 326.4 212.0 127.2 68.8
+32.8 13.6 4.8 0.8 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0
+111.2 168.8 238.4 316.8 396.8 472.8 535.2 578.4 596.8 588.0 553.6 496.8 424.8 345.6 265.6 192.8 130.4 81.6 47.2 24.0 11.2 4.0 1.6
+0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0
+111.2 147.2 184.8 219.2 247.2 267.2 274.4 270.4 253.6 227.2 193.6 156.8 120.8 87.2 58.4 36.8 20.8 10.4 4.8 1.6 0.8 0.0 0.0 0.0 0.0
+0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0
+111.2 127.2 138.4 144.0 143.2 136.0 123.2 105.6 87.2 67.2 49.6 33.6 21.6 12.8 6.4 3.2 0.8 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0
+0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0
+111.2 108.8 101.6 90.4 76.8 61.6 47.2 33.6 22.4 13.6 8.0 4.0 1.6 0.8 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0
+0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0
+111.2 92.8 73.6 54.4 38.4 24.8 15.2 8.0 4.0 1.6 0.8 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0
+0.0 0.0 0.0 0.0 0.0 0.0 0.0
+111.2 79.2 52.8 32.0 17.6 8.8 4.0 1.6 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0
+0.0 0.0 0.0 0.0 0.0 0.0
+111.2 68.0 37.6 18.4 8.0 2.4 0.8 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0
+0.0 0.0 0.0 0.0 0.0 0.0
+111.2 59.2 27.2 11.2 3.2 0.8 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0
+0.0 0.0 0.0 0.0 0.0 0.0
+111.2 52.0 20.8 6.4 1.6 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0
+0.0 0.0 0.0 0.0 0.0 0.0
+111.2 47.2 16.0 4.0 0.8 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0
+0.0 0.0 0.0 0.0 0.0 0.0
+111.2 43.2 13.6 3.2 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0
+0.0 0.0 0.0 0.0 0.0 0.0
+111.2 41.6 12.0 2.4 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0
+0.0 0.0 0.0 0.0 0.0 0.0
+111.2 40.8 11.2 2.4 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0 0.0
+0.0 0.0 0.0 0.0 0.0 0.0
+`,
+	},
+	{
+		Name:        "lm63_2002_linear.ies",
+		Description: "LM-63-2002 linear luminaire",
+		content: `IESNA:LM-63-2002
+[TEST] SAMPLE-SYM4_LINEAR
+[TESTLAB] eulumies sample corpus
+[ISSUEDATE] synthetic photometry
+[MANUFAC] eulumies sample corpus
+[LAMP] T5 28W
+[LUMCAT] EX-SYM4_LINEAR
+[LUMINAIRE] Linear suspended luminaire
+[OTHER] converted using eulumies: SYM4.LDT
+TILT=NONE
+2 2600.0 1.0 37 7 1 2 0.1 1.2 0.1
+1.0 1.0 62.0
+0.0 5.0 10.0 15.0 20.0 25.0 30.0 35.0 40.0 45.0 50.0 55.0 60.0 65.0 70.0 75.0 80.0 85.0 90.0 95.0 100.0 105.0 110.0 115.0 120.0 125.0 130.0 135.0 140.0 145.0 150.0 155.0 160.0 165.0 170.0 175.0 180.0
+0.0 15.0 30.0 45.0 60.0 75.0 90.0
+1346.8 1341.6 1326.0 1301.0 1265.7 1220.4 1166.4 1102.9 1031.7 952.1 865.8 772.2 673.4 569.4 460.7 348.4 234.0 117.5 0.0 81.6 161.2 235.6 303.2 360.9 408.2 443.0 464.4 471.1 464.4 443.0 408.2 360.9 303.2 235.6 161.2 81.6 0.0
+1346.8 1339.0 1315.6 1277.6 1225.6 1160.1 1082.6 994.8 898.6 796.1 688.5 579.3 470.6 364.5 264.2 173.2 94.6 33.3 0.0 81.6 161.2 235.6 303.2 360.9 408.2 443.0 464.4 471.1 464.4 443.0 408.2 360.9 303.2 235.6 161.2 81.6 0.0
+1346.8 1336.4 1306.2 1256.3 1189.2 1106.0 1009.8 903.8 790.4 673.4 556.4 443.0 336.4 240.8 157.6 90.0 40.6 10.4 0.0 81.6 161.2 235.6 303.2 360.9 408.2 443.0 464.4 471.1 464.4 443.0 408.2 360.9 303.2 235.6 161.2 81.6 0.0
+1346.8 1334.3 1297.9 1238.6 1159.1 1061.8 951.6 832.0 707.7 583.4 463.3 352.0 252.7 168.5 100.9 51.5 19.8 3.6 0.0 81.6 161.2 235.6 303.2 360.9 408.2 443.0 464.4 471.1 464.4 443.0 408.2 360.9 303.2 235.6 161.2 81.6 0.0
+1346.8 1332.8 1291.7 1225.1 1136.2 1029.1 909.0 781.0 650.0 522.6 402.5 294.8 202.8 127.9 71.8 33.3 11.4 1.6 0.0 81.6 161.2 235.6 303.2 360.9 408.2 443.0 464.4 471.1 464.4 443.0 408.2 360.9 303.2 235.6 161.2 81.6 0.0
+1346.8 1331.7 1287.5 1216.8 1122.2 1009.3 883.5 750.4 616.7 487.8 368.7 264.2 176.3 107.6 57.7 25.5 7.8 1.0 0.0 81.6 161.2 235.6 303.2 360.9 408.2 443.0 464.4 471.1 464.4 443.0 408.2 360.9 303.2 235.6 161.2 81.6 0.0
+1346.8 1331.2 1286.5 1213.7 1117.5 1002.6 874.6 740.5 605.3 476.3 357.8 254.3 168.5 101.4 54.1 23.4 7.3 1.0 0.0 81.6 161.2 235.6 303.2 360.9 408.2 443.0 464.4 471.1 464.4 443.0 408.2 360.9 303.2 235.6 161.2 81.6 0.0
+`,
+	},
+}
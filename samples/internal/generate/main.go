@@ -0,0 +1,211 @@
+// Command generate calculates the synthetic photometry of the sample corpus and writes it to files.go.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"math"
+	"strings"
+
+	"github.com/h44z/eulumies"
+)
+
+// lobe is a rotationally symmetric cosine power lobe, tilted by tilt degrees from nadir towards the C-plane tiltC.
+// The optional uplight follows sin(2 (gamma - 90)) in the upper hemisphere.
+type lobe struct {
+	tiltC, tilt, exponent, up float64
+}
+
+// value returns the relative intensity in the direction (c, g).
+func (l lobe) value(c, g float64) float64 {
+	rad := math.Pi / 180
+	ax := math.Sin(l.tilt*rad) * math.Cos(l.tiltC*rad)
+	ay := math.Sin(l.tilt*rad) * math.Sin(l.tiltC*rad)
+	az := -math.Cos(l.tilt * rad)
+	dx := math.Sin(g*rad) * math.Cos(c*rad)
+	dy := math.Sin(g*rad) * math.Sin(c*rad)
+	dz := -math.Cos(g * rad)
+	dot := ax*dx + ay*dy + az*dz
+	v := 0.0
+	if dot > 0 {
+		v = math.Pow(dot, l.exponent)
+	}
+	if g > 90 {
+		v += l.up * math.Sin((g-90)*2*rad)
+	}
+	return v
+}
+
+// sample describes one synthetic EULUMDAT file. The distribution is scaled to the given light output ratio.
+type sample struct {
+	name, title           string
+	typ, sym, mc, ng      int
+	f                     func(c, g float64) float64
+	lor                   float64
+	lamps                 int
+	lampType              string
+	flux, watts           float64
+	cct, cri              string
+	length, width, height float64
+	aLength, aWidth       float64
+}
+
+// conversion describes an IES file converted from one of the samples.
+type conversion struct {
+	name, title string
+	source      int
+	format      eulumies.IESFormat
+}
+
+// file is one entry of the generated corpus.
+type file struct {
+	name, description, content string
+}
+
+// firstStored returns the index of the first stored C-plane and the number of stored planes of the symmetry.
+func firstStored(sym, mc int) (int, int) {
+	switch sym {
+	case 1:
+		return 0, 1
+	case 2:
+		return 0, mc/2 + 1
+	case 3:
+		return 3 * mc / 4, mc/2 + 1
+	case 4:
+		return 0, mc/4 + 1
+	}
+	return 0, mc
+}
+
+// build calculates the EULUMDAT data of the sample.
+func build(s sample) eulumies.Eulumdat {
+	dc := 360 / float64(s.mc)
+	dg := 180 / float64(s.ng-1)
+	e := eulumies.Eulumdat{
+		CompanyIdentification: "eulumies sample corpus",
+		TypeIndicator:         s.typ, SymmetryIndicator: s.sym,
+		NumberMcCPlanes: s.mc, DistanceDcCPlanes: dc,
+		NumberNgIntensitiesCPlane: s.ng, DistanceDgCPlane: dg,
+		MeasurementReportNumber: "SAMPLE-" + strings.ToUpper(s.name),
+		LuminaireName:           s.title,
+		LuminaireNumber:         "EX-" + strings.ToUpper(s.name),
+		FileName:                strings.ToUpper(strings.Split(s.name, "_")[0]) + ".LDT",
+		DateUser:                "synthetic photometry",
+		LengthDiameter:          s.length, WidthLuminaire: s.width, HeightLuminaire: s.height,
+		LengthDiameterLuminousArea: s.aLength, WidthLuminousArea: s.aWidth,
+		IntensityConversionFactor: 1,
+		NumberStandardSetLamps:    1,
+		NumberLamps:               []int{s.lamps},
+		TypeLamps:                 []string{s.lampType},
+		TotalLuminousFluxLamps:    []float64{s.flux},
+		ColorTemperature:          []string{s.cct},
+		ColorRenderingIndexCRI:    []string{s.cri},
+		BallastWatts:              []float64{s.watts},
+	}
+	for i := 0; i < s.mc; i++ {
+		e.AnglesC = append(e.AnglesC, float64(i)*dc)
+	}
+	for i := 0; i < s.ng; i++ {
+		e.AnglesG = append(e.AnglesG, float64(i)*dg)
+	}
+	first, count := firstStored(s.sym, s.mc)
+	for i := 0; i < count; i++ {
+		c := e.AnglesC[(first+i)%s.mc]
+		plane := make([]float64, s.ng)
+		for g := range plane {
+			plane[g] = s.f(c, e.AnglesG[g])
+		}
+		e.LuminousIntensityDistribution = append(e.LuminousIntensityDistribution, plane)
+	}
+	e.CalcLuminousIntensityDistributionRawFromPlanes()
+	scale := s.lor * 10 / e.ComputeRelativeFlux()
+	for _, plane := range e.LuminousIntensityDistribution {
+		for g := range plane {
+			plane[g] = math.Round(plane[g]*scale*10) / 10
+		}
+	}
+	e.CalcLuminousIntensityDistributionRawFromPlanes()
+	e.LightOutputRatioLuminaire = math.Round(e.ComputeLightOutputRatio()*10) / 10
+	e.DownwardFluxFractionPhiu = math.Round((1-e.ComputeUpwardLightRatio())*1000) / 10
+	e.FillDirectRatios()
+	return e
+}
+
+func main() {
+	samples := []sample{
+		{"sym0_wallwasher", "Asymmetric wall washer", 3, 0, 24, 37,
+			lobe{60, 35, 4, 0}.value, 78, 1, "LED", 2400, 24, "3000K", "90", 300, 120, 90, 260, 80},
+		{"sym1_downlight", "Round downlight", 1, 1, 36, 37,
+			lobe{0, 0, 3, 0}.value, 82, 1, "LED", 1800, 17.5, "4000K", "80", 200, 0, 110, 180, 0},
+		{"sym2_streetlight", "Street light, symmetric to C0-C180", 3, 2, 36, 37,
+			lobe{0, 60, 6, 0}.value, 80, 1, "LED", 8000, 62, "4000K", "70", 560, 250, 110, 460, 160},
+		{"sym3_floodlight", "Floodlight, symmetric to C90-C270", 3, 3, 24, 37,
+			lobe{90, 40, 10, 0}.value, 85, 1, "LED", 12000, 98, "5000K", "70", 400, 350, 120, 350, 300},
+		{"sym4_linear", "Linear suspended luminaire", 2, 4, 24, 37,
+			func(c, g float64) float64 {
+				rad := math.Pi / 180
+				across := math.Abs(math.Sin(c * rad))
+				return lobe{0, 0, 1 + 2*across, 0.35}.value(c, g)
+			}, 90, 2, "T5 28W", 5200, 62, "830", "1B", 1170, 70, 60, 1150, 60},
+	}
+	conversions := []conversion{
+		{"lm63_1986_downlight", "LM-63-1986 downlight", 1, eulumies.IESFormatLM_63_1986},
+		{"lm63_1991_downlight", "LM-63-1991 downlight", 1, eulumies.IESFormatLM_63_1991},
+		{"lm63_1995_streetlight", "LM-63-1995 street light", 2, eulumies.IESFormatLM_63_1995},
+		{"lm63_2002_linear", "LM-63-2002 linear luminaire", 4, eulumies.IESFormatLM_63_2002},
+	}
+
+	var files []file
+	for _, s := range samples {
+		e := build(s)
+		if err := e.Validate(true).Err(); err != nil {
+			log.Fatalf("%s: %v", s.name, err)
+		}
+		var b strings.Builder
+		if err := e.ExportWithOptions(&b, eulumies.ExportOptions{Precision: 1}); err != nil {
+			log.Fatalf("%s: %v", s.name, err)
+		}
+		files = append(files, file{s.name + ".ldt", fmt.Sprintf("EULUMDAT %s, symmetry indicator %d",
+			strings.ToLower(s.title[:1])+s.title[1:], s.sym), b.String()})
+	}
+	for _, c := range conversions {
+		e := build(samples[c.source])
+		ies, err := eulumies.ConvertEulumdatToIES(&e, eulumies.ConversionOptions{Format: c.format, PreserveSymmetry: true})
+		if err != nil {
+			log.Fatalf("%s: %v", c.name, err)
+		}
+		var b strings.Builder
+		if err := ies.ExportTo(&b, eulumies.ExportOptions{Precision: 1}); err != nil {
+			log.Fatalf("%s: %v", c.name, err)
+		}
+		files = append(files, file{c.name + ".ies", c.title, b.String()})
+	}
+
+	if err := write("files.go", files); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// write writes the corpus as Go source, the file contents become raw string literals.
+func write(path string, files []file) error {
+	var b bytes.Buffer
+	b.WriteString("// Code generated by go run ./internal/generate; DO NOT EDIT.\n\npackage samples\n\n")
+	b.WriteString("var files = []Sample{\n")
+	for _, f := range files {
+		if strings.Contains(f.content, "`") {
+			return fmt.Errorf("%s contains a backtick", f.name)
+		}
+		fmt.Fprintf(&b, "{\nName: %q,\nDescription: %q,\ncontent: `%s`,\n},\n", f.name, f.description, f.content)
+	}
+	b.WriteString("}\n")
+
+	source, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, source, 0644)
+}
@@ -0,0 +1,78 @@
+// Package samples provides a corpus of EULUMDAT and IES files for tests and examples. It covers every EULUMDAT
+// symmetry indicator and every IES format version. The distributions are synthetic (cosine power lobes), so the
+// files can be redistributed under the license of this module.
+//
+// The files are compiled into the package, no file system access is required.
+package samples
+
+//go:generate go run ./internal/generate
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+)
+
+// Sample is one file of the corpus.
+type Sample struct {
+	Name        string // file name, the extension (.ldt or .ies) gives the format
+	Description string
+	content     string
+}
+
+// Open returns a reader for the content of the sample.
+func (s Sample) Open() io.Reader {
+	return strings.NewReader(s.content)
+}
+
+// Bytes returns a copy of the content of the sample.
+func (s Sample) Bytes() []byte {
+	return []byte(s.content)
+}
+
+// All returns all samples of the corpus.
+func All() []Sample {
+	all := make([]Sample, len(files))
+	copy(all, files)
+
+	return all
+}
+
+// Glob returns the samples whose name matches the given shell pattern, for example "*.ldt" or "sym4_*".
+func Glob(pattern string) ([]Sample, error) {
+	var matches []Sample
+	for _, sample := range files {
+		ok, err := path.Match(pattern, sample.Name)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			matches = append(matches, sample)
+		}
+	}
+
+	return matches, nil
+}
+
+// Get returns the sample with the given name.
+func Get(name string) (Sample, error) {
+	for _, sample := range files {
+		if sample.Name == name {
+			return sample, nil
+		}
+	}
+
+	return Sample{}, fmt.Errorf("unknown sample %s", name)
+}
+
+// Open returns a reader for the sample with the given name.
+func Open(name string) (io.Reader, error) {
+	sample, err := Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.NewReader(sample.Bytes()), nil
+}
@@ -0,0 +1,39 @@
+package samples
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGlob(t *testing.T) {
+	ldt, err := Glob("*.ldt")
+	assert.NoError(t, err)
+	ies, err := Glob("*.ies")
+	assert.NoError(t, err)
+	assert.Len(t, All(), len(ldt)+len(ies))
+	assert.Len(t, ldt, 5)
+
+	linear, err := Glob("sym4_*")
+	assert.NoError(t, err)
+	assert.Len(t, linear, 1)
+
+	_, err = Glob("[")
+	assert.Error(t, err)
+}
+
+func TestOpen(t *testing.T) {
+	in, err := Open("sym1_downlight.ldt")
+	assert.NoError(t, err)
+	content, err := ioutil.ReadAll(in)
+	assert.NoError(t, err)
+
+	sample, err := Get("sym1_downlight.ldt")
+	assert.NoError(t, err)
+	assert.Equal(t, sample.Bytes(), content)
+	assert.NotEmpty(t, sample.Description)
+
+	_, err = Open("missing.ldt")
+	assert.EqualError(t, err, "unknown sample missing.ldt")
+}
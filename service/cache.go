@@ -0,0 +1,148 @@
+package service
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"sync"
+)
+
+// Cache keeps the most recently used parsed photometries for services that repeatedly serve plots or conversions of
+// the same files. It is safe for concurrent use: concurrent requests of a key that is not cached wait for a single
+// load. The cached photometries are shared between all callers and must not be modified, convert a copy instead.
+type Cache struct {
+	size int
+
+	mu      sync.Mutex
+	order   *list.List // elements of type *cacheEntry, most recently used first
+	entries map[string]*list.Element
+	loads   map[string]*cacheLoad
+}
+
+// cacheEntry is a cached photometry.
+type cacheEntry struct {
+	key        string
+	photometry Photometry
+}
+
+// cacheLoad is a load in progress, done is closed when the result is set. A removed load is not cached.
+type cacheLoad struct {
+	done       chan struct{}
+	removed    bool // guarded by Cache.mu
+	photometry Photometry
+	err        error
+}
+
+// NewCache returns a cache holding at most size photometries, the least recently used photometry is evicted first.
+// A size below 1 is treated as 1.
+func NewCache(size int) *Cache {
+	if size < 1 {
+		size = 1
+	}
+
+	return &Cache{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+		loads:   make(map[string]*cacheLoad),
+	}
+}
+
+// Get returns the cached photometry of the key, e.g. a file path, or calls load and caches its result. If the key
+// is loaded by another goroutine, Get waits for that load instead of loading again. Errors are returned to all
+// waiting callers but not cached, so the next call loads again. A panic of load is returned as error.
+func (c *Cache) Get(key string, load func() (Photometry, error)) (Photometry, error) {
+	c.mu.Lock()
+	if element, ok := c.entries[key]; ok {
+		c.order.MoveToFront(element)
+		c.mu.Unlock()
+		return element.Value.(*cacheEntry).photometry, nil
+	}
+	if pending, ok := c.loads[key]; ok {
+		c.mu.Unlock()
+		<-pending.done
+		return pending.photometry, pending.err
+	}
+	pending := &cacheLoad{done: make(chan struct{})}
+	c.loads[key] = pending
+	c.mu.Unlock()
+
+	c.load(key, pending, load)
+
+	return pending.photometry, pending.err
+}
+
+// load sets the result of the pending load and caches it unless the key was removed meanwhile. The pending load is
+// finished even if load panics, so waiting callers do not block forever.
+func (c *Cache) load(key string, pending *cacheLoad, load func() (Photometry, error)) {
+	defer func() {
+		if r := recover(); r != nil {
+			pending.photometry, pending.err = Photometry{}, fmt.Errorf("loading %s failed: %v", key, r)
+		}
+
+		c.mu.Lock()
+		if c.loads[key] == pending {
+			delete(c.loads, key)
+		}
+		if pending.err == nil && !pending.removed {
+			c.add(key, pending.photometry)
+		}
+		c.mu.Unlock()
+		close(pending.done)
+	}()
+
+	pending.photometry, pending.err = load()
+}
+
+// Parse returns the photometry of the data like Parse, the data is parsed only if no photometry of the same content,
+// format and strictness is cached.
+func (c *Cache) Parse(data []byte, format Format, strict bool) (Photometry, error) {
+	hash := sha256.Sum256(data)
+	key := hex.EncodeToString(hash[:]) + "/" + strconv.Itoa(int(format)) + "/" + strconv.FormatBool(strict)
+
+	return c.Get(key, func() (Photometry, error) {
+		return Parse(data, format, strict)
+	})
+}
+
+// Remove removes the photometry of the key from the cache, e.g. after the file changed. The result of a load of the
+// key in progress is not cached, the next Get loads again.
+func (c *Cache) Remove(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if pending, ok := c.loads[key]; ok {
+		pending.removed = true
+		delete(c.loads, key)
+	}
+	if element, ok := c.entries[key]; ok {
+		c.order.Remove(element)
+		delete(c.entries, key)
+	}
+}
+
+// Len returns the number of cached photometries.
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+// add caches the photometry and evicts the least recently used photometries beyond the size, c.mu must be held.
+func (c *Cache) add(key string, photometry Photometry) {
+	if element, ok := c.entries[key]; ok {
+		element.Value.(*cacheEntry).photometry = photometry
+		c.order.MoveToFront(element)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&cacheEntry{key: key, photometry: photometry})
+
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+	}
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCache_Get(t *testing.T) {
+	cache := NewCache(2)
+	loads := 0
+	load := func(format Format) func() (Photometry, error) {
+		return func() (Photometry, error) {
+			loads++
+			return Photometry{Format: format}, nil
+		}
+	}
+
+	photometry, err := cache.Get("a", load(FormatLDT))
+	assert.NoError(t, err)
+	assert.Equal(t, FormatLDT, photometry.Format)
+	photometry, _ = cache.Get("a", load(FormatIES))
+	assert.Equal(t, FormatLDT, photometry.Format)
+	assert.Equal(t, 1, loads)
+
+	// b is evicted as least recently used
+	_, _ = cache.Get("b", load(FormatIES))
+	_, _ = cache.Get("a", load(FormatIES))
+	_, _ = cache.Get("c", load(FormatIES))
+	assert.Equal(t, 2, cache.Len())
+	assert.Equal(t, 3, loads)
+	_, _ = cache.Get("b", load(FormatIES))
+	assert.Equal(t, 4, loads)
+
+	cache.Remove("b")
+	assert.Equal(t, 1, cache.Len())
+
+	_, err = cache.Get("d", func() (Photometry, error) { return Photometry{}, errors.New("broken") })
+	assert.EqualError(t, err, "broken")
+	assert.Equal(t, 1, cache.Len())
+}
+
+func TestCache_GetConcurrent(t *testing.T) {
+	cache := NewCache(10)
+	release := make(chan struct{})
+	var loads int32
+	load := func() (Photometry, error) {
+		atomic.AddInt32(&loads, 1)
+		<-release
+		return Photometry{Format: FormatIES}, nil
+	}
+
+	var started, done sync.WaitGroup
+	for n := 0; n < 8; n++ {
+		started.Add(1)
+		done.Add(1)
+		go func() {
+			defer done.Done()
+			started.Done()
+			photometry, err := cache.Get("sample", load)
+			assert.NoError(t, err)
+			assert.Equal(t, FormatIES, photometry.Format)
+		}()
+	}
+	started.Wait()
+	close(release)
+	done.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&loads))
+}
+
+func TestCache_Parse(t *testing.T) {
+	cache := NewCache(4)
+	first, err := cache.Parse(readSample(t, "sample2.ldt"), FormatUnspecified, false)
+	assert.NoError(t, err)
+	second, err := cache.Parse(readSample(t, "sample2.ldt"), FormatUnspecified, false)
+	assert.NoError(t, err)
+	assert.Same(t, first.Eulumdat, second.Eulumdat)
+
+	_, err = cache.Parse(readSample(t, "sample2.ldt"), FormatIES, false)
+	assert.Error(t, err)
+	assert.Equal(t, 1, cache.Len())
+}
+
+func TestCache_GetPanic(t *testing.T) {
+	cache := NewCache(2)
+	_, err := cache.Get("a", func() (Photometry, error) { panic("broken") })
+	assert.EqualError(t, err, "loading a failed: broken")
+	assert.Equal(t, 0, cache.Len())
+
+	photometry, err := cache.Get("a", func() (Photometry, error) { return Photometry{Format: FormatLDT}, nil })
+	assert.NoError(t, err)
+	assert.Equal(t, FormatLDT, photometry.Format)
+}
+
+func TestCache_RemoveDuringLoad(t *testing.T) {
+	cache := NewCache(2)
+	loading := make(chan struct{})
+	release := make(chan struct{})
+	result := make(chan Photometry)
+	go func() {
+		photometry, _ := cache.Get("a", func() (Photometry, error) {
+			close(loading)
+			<-release
+			return Photometry{Format: FormatLDT}, nil
+		})
+		result <- photometry
+	}()
+
+	<-loading
+	cache.Remove("a")
+	close(release)
+	assert.Equal(t, FormatLDT, (<-result).Format)
+	assert.Equal(t, 0, cache.Len())
+
+	photometry, err := cache.Get("a", func() (Photometry, error) { return Photometry{Format: FormatIES}, nil })
+	assert.NoError(t, err)
+	assert.Equal(t, FormatIES, photometry.Format)
+}
@@ -0,0 +1,23 @@
+module github.com/h44z/eulumies/service/grpcserver
+
+go 1.21
+
+require (
+	github.com/h44z/eulumies v0.0.0
+	github.com/stretchr/testify v1.7.0
+	google.golang.org/grpc v1.65.0
+	google.golang.org/protobuf v1.35.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.0 // indirect
+	github.com/pkg/errors v0.9.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/net v0.25.0 // indirect
+	golang.org/x/sys v0.20.0 // indirect
+	golang.org/x/text v0.15.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240528184218-531527333157 // indirect
+	gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c // indirect
+)
+
+replace github.com/h44z/eulumies => ../..
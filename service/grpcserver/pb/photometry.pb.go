@@ -0,0 +1,1948 @@
+// PhotometryService exposes parsing, conversion, validation and analysis of EULUMDAT and IES files.
+//
+// The operations are implemented transport independent in package github.com/h44z/eulumies/service, the server of
+// package github.com/h44z/eulumies/service/grpcserver delegates each call to the function of the same name.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.0-devel
+// 	protoc        (unknown)
+// source: photometry.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Format int32
+
+const (
+	Format_FORMAT_UNSPECIFIED Format = 0 // detected from the file content
+	Format_FORMAT_LDT         Format = 1
+	Format_FORMAT_IES         Format = 2
+)
+
+// Enum value maps for Format.
+var (
+	Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "FORMAT_LDT",
+		2: "FORMAT_IES",
+	}
+	Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"FORMAT_LDT":         1,
+		"FORMAT_IES":         2,
+	}
+)
+
+func (x Format) Enum() *Format {
+	p := new(Format)
+	*p = x
+	return p
+}
+
+func (x Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_photometry_proto_enumTypes[0].Descriptor()
+}
+
+func (Format) Type() protoreflect.EnumType {
+	return &file_photometry_proto_enumTypes[0]
+}
+
+func (x Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Format.Descriptor instead.
+func (Format) EnumDescriptor() ([]byte, []int) {
+	return file_photometry_proto_rawDescGZIP(), []int{0}
+}
+
+type ValidationIssue_Severity int32
+
+const (
+	ValidationIssue_INFO    ValidationIssue_Severity = 0
+	ValidationIssue_WARNING ValidationIssue_Severity = 1
+	ValidationIssue_ERROR   ValidationIssue_Severity = 2
+)
+
+// Enum value maps for ValidationIssue_Severity.
+var (
+	ValidationIssue_Severity_name = map[int32]string{
+		0: "INFO",
+		1: "WARNING",
+		2: "ERROR",
+	}
+	ValidationIssue_Severity_value = map[string]int32{
+		"INFO":    0,
+		"WARNING": 1,
+		"ERROR":   2,
+	}
+)
+
+func (x ValidationIssue_Severity) Enum() *ValidationIssue_Severity {
+	p := new(ValidationIssue_Severity)
+	*p = x
+	return p
+}
+
+func (x ValidationIssue_Severity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ValidationIssue_Severity) Descriptor() protoreflect.EnumDescriptor {
+	return file_photometry_proto_enumTypes[1].Descriptor()
+}
+
+func (ValidationIssue_Severity) Type() protoreflect.EnumType {
+	return &file_photometry_proto_enumTypes[1]
+}
+
+func (x ValidationIssue_Severity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ValidationIssue_Severity.Descriptor instead.
+func (ValidationIssue_Severity) EnumDescriptor() ([]byte, []int) {
+	return file_photometry_proto_rawDescGZIP(), []int{11, 0}
+}
+
+type ParseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data   []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"` // file content, optionally gzip compressed
+	Format Format `protobuf:"varint,2,opt,name=format,proto3,enum=eulumies.v1.Format" json:"format,omitempty"`
+	Strict bool   `protobuf:"varint,3,opt,name=strict,proto3" json:"strict,omitempty"`
+}
+
+func (x *ParseRequest) Reset() {
+	*x = ParseRequest{}
+	mi := &file_photometry_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseRequest) ProtoMessage() {}
+
+func (x *ParseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_photometry_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseRequest.ProtoReflect.Descriptor instead.
+func (*ParseRequest) Descriptor() ([]byte, []int) {
+	return file_photometry_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ParseRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ParseRequest) GetFormat() Format {
+	if x != nil {
+		return x.Format
+	}
+	return Format_FORMAT_UNSPECIFIED
+}
+
+func (x *ParseRequest) GetStrict() bool {
+	if x != nil {
+		return x.Strict
+	}
+	return false
+}
+
+type ParseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Photometry *Photometry `protobuf:"bytes,1,opt,name=photometry,proto3" json:"photometry,omitempty"`
+}
+
+func (x *ParseResponse) Reset() {
+	*x = ParseResponse{}
+	mi := &file_photometry_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseResponse) ProtoMessage() {}
+
+func (x *ParseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_photometry_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseResponse.ProtoReflect.Descriptor instead.
+func (*ParseResponse) Descriptor() ([]byte, []int) {
+	return file_photometry_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ParseResponse) GetPhotometry() *Photometry {
+	if x != nil {
+		return x.Photometry
+	}
+	return nil
+}
+
+type Photometry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format Format `protobuf:"varint,1,opt,name=format,proto3,enum=eulumies.v1.Format" json:"format,omitempty"`
+	// Types that are assignable to Data:
+	//	*Photometry_Eulumdat
+	//	*Photometry_Ies
+	Data isPhotometry_Data `protobuf_oneof:"data"`
+}
+
+func (x *Photometry) Reset() {
+	*x = Photometry{}
+	mi := &file_photometry_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Photometry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Photometry) ProtoMessage() {}
+
+func (x *Photometry) ProtoReflect() protoreflect.Message {
+	mi := &file_photometry_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Photometry.ProtoReflect.Descriptor instead.
+func (*Photometry) Descriptor() ([]byte, []int) {
+	return file_photometry_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Photometry) GetFormat() Format {
+	if x != nil {
+		return x.Format
+	}
+	return Format_FORMAT_UNSPECIFIED
+}
+
+func (m *Photometry) GetData() isPhotometry_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *Photometry) GetEulumdat() *Eulumdat {
+	if x, ok := x.GetData().(*Photometry_Eulumdat); ok {
+		return x.Eulumdat
+	}
+	return nil
+}
+
+func (x *Photometry) GetIes() *IES {
+	if x, ok := x.GetData().(*Photometry_Ies); ok {
+		return x.Ies
+	}
+	return nil
+}
+
+type isPhotometry_Data interface {
+	isPhotometry_Data()
+}
+
+type Photometry_Eulumdat struct {
+	Eulumdat *Eulumdat `protobuf:"bytes,2,opt,name=eulumdat,proto3,oneof"`
+}
+
+type Photometry_Ies struct {
+	Ies *IES `protobuf:"bytes,3,opt,name=ies,proto3,oneof"`
+}
+
+func (*Photometry_Eulumdat) isPhotometry_Data() {}
+
+func (*Photometry_Ies) isPhotometry_Data() {}
+
+// Eulumdat mirrors eulumies.Eulumdat, the field comments refer to the EULUMDAT field numbers.
+type Eulumdat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompanyIdentification         string            `protobuf:"bytes,1,opt,name=company_identification,json=companyIdentification,proto3" json:"company_identification,omitempty"`                                       // 01
+	TypeIndicator                 int32             `protobuf:"varint,2,opt,name=type_indicator,json=typeIndicator,proto3" json:"type_indicator,omitempty"`                                                              // 02
+	SymmetryIndicator             int32             `protobuf:"varint,3,opt,name=symmetry_indicator,json=symmetryIndicator,proto3" json:"symmetry_indicator,omitempty"`                                                  // 03
+	NumberMcCPlanes               int32             `protobuf:"varint,4,opt,name=number_mc_c_planes,json=numberMcCPlanes,proto3" json:"number_mc_c_planes,omitempty"`                                                    // 04
+	DistanceDcCPlanes             float64           `protobuf:"fixed64,5,opt,name=distance_dc_c_planes,json=distanceDcCPlanes,proto3" json:"distance_dc_c_planes,omitempty"`                                             // 05
+	NumberNgIntensitiesCPlane     int32             `protobuf:"varint,6,opt,name=number_ng_intensities_c_plane,json=numberNgIntensitiesCPlane,proto3" json:"number_ng_intensities_c_plane,omitempty"`                    // 06
+	DistanceDgCPlane              float64           `protobuf:"fixed64,7,opt,name=distance_dg_c_plane,json=distanceDgCPlane,proto3" json:"distance_dg_c_plane,omitempty"`                                                // 07
+	MeasurementReportNumber       string            `protobuf:"bytes,8,opt,name=measurement_report_number,json=measurementReportNumber,proto3" json:"measurement_report_number,omitempty"`                               // 08
+	LuminaireName                 string            `protobuf:"bytes,9,opt,name=luminaire_name,json=luminaireName,proto3" json:"luminaire_name,omitempty"`                                                               // 09
+	LuminaireNumber               string            `protobuf:"bytes,10,opt,name=luminaire_number,json=luminaireNumber,proto3" json:"luminaire_number,omitempty"`                                                        // 10
+	FileName                      string            `protobuf:"bytes,11,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`                                                                             // 11
+	DateUser                      string            `protobuf:"bytes,12,opt,name=date_user,json=dateUser,proto3" json:"date_user,omitempty"`                                                                             // 12
+	LengthDiameter                float64           `protobuf:"fixed64,13,opt,name=length_diameter,json=lengthDiameter,proto3" json:"length_diameter,omitempty"`                                                         // 13
+	WidthLuminaire                float64           `protobuf:"fixed64,14,opt,name=width_luminaire,json=widthLuminaire,proto3" json:"width_luminaire,omitempty"`                                                         // 14
+	HeightLuminaire               float64           `protobuf:"fixed64,15,opt,name=height_luminaire,json=heightLuminaire,proto3" json:"height_luminaire,omitempty"`                                                      // 15
+	LengthDiameterLuminousArea    float64           `protobuf:"fixed64,16,opt,name=length_diameter_luminous_area,json=lengthDiameterLuminousArea,proto3" json:"length_diameter_luminous_area,omitempty"`                 // 16
+	WidthLuminousArea             float64           `protobuf:"fixed64,17,opt,name=width_luminous_area,json=widthLuminousArea,proto3" json:"width_luminous_area,omitempty"`                                              // 17
+	HeightLuminousAreaC0          float64           `protobuf:"fixed64,18,opt,name=height_luminous_area_c0,json=heightLuminousAreaC0,proto3" json:"height_luminous_area_c0,omitempty"`                                   // 18
+	HeightLuminousAreaC90         float64           `protobuf:"fixed64,19,opt,name=height_luminous_area_c90,json=heightLuminousAreaC90,proto3" json:"height_luminous_area_c90,omitempty"`                                // 19
+	HeightLuminousAreaC180        float64           `protobuf:"fixed64,20,opt,name=height_luminous_area_c180,json=heightLuminousAreaC180,proto3" json:"height_luminous_area_c180,omitempty"`                             // 20
+	HeightLuminousAreaC270        float64           `protobuf:"fixed64,21,opt,name=height_luminous_area_c270,json=heightLuminousAreaC270,proto3" json:"height_luminous_area_c270,omitempty"`                             // 21
+	DownwardFluxFractionPhiu      float64           `protobuf:"fixed64,22,opt,name=downward_flux_fraction_phiu,json=downwardFluxFractionPhiu,proto3" json:"downward_flux_fraction_phiu,omitempty"`                       // 22
+	LightOutputRatioLuminaire     float64           `protobuf:"fixed64,23,opt,name=light_output_ratio_luminaire,json=lightOutputRatioLuminaire,proto3" json:"light_output_ratio_luminaire,omitempty"`                    // 23
+	IntensityConversionFactor     float64           `protobuf:"fixed64,24,opt,name=intensity_conversion_factor,json=intensityConversionFactor,proto3" json:"intensity_conversion_factor,omitempty"`                      // 24
+	MeasurementTiltLuminaire      float64           `protobuf:"fixed64,25,opt,name=measurement_tilt_luminaire,json=measurementTiltLuminaire,proto3" json:"measurement_tilt_luminaire,omitempty"`                         // 25
+	LampSets                      []*LampSet        `protobuf:"bytes,26,rep,name=lamp_sets,json=lampSets,proto3" json:"lamp_sets,omitempty"`                                                                             // 26, 26a - 26f
+	DirectRatios                  []float64         `protobuf:"fixed64,27,rep,packed,name=direct_ratios,json=directRatios,proto3" json:"direct_ratios,omitempty"`                                                        // 27
+	AnglesC                       []float64         `protobuf:"fixed64,28,rep,packed,name=angles_c,json=anglesC,proto3" json:"angles_c,omitempty"`                                                                       // 28
+	AnglesG                       []float64         `protobuf:"fixed64,29,rep,packed,name=angles_g,json=anglesG,proto3" json:"angles_g,omitempty"`                                                                       // 29
+	LuminousIntensityDistribution []*Plane          `protobuf:"bytes,30,rep,name=luminous_intensity_distribution,json=luminousIntensityDistribution,proto3" json:"luminous_intensity_distribution,omitempty"`            // 30, cd/klm per stored C-plane
+	Extensions                    map[string]string `protobuf:"bytes,31,rep,name=extensions,proto3" json:"extensions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // extension block after field 30
+}
+
+func (x *Eulumdat) Reset() {
+	*x = Eulumdat{}
+	mi := &file_photometry_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Eulumdat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Eulumdat) ProtoMessage() {}
+
+func (x *Eulumdat) ProtoReflect() protoreflect.Message {
+	mi := &file_photometry_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Eulumdat.ProtoReflect.Descriptor instead.
+func (*Eulumdat) Descriptor() ([]byte, []int) {
+	return file_photometry_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Eulumdat) GetCompanyIdentification() string {
+	if x != nil {
+		return x.CompanyIdentification
+	}
+	return ""
+}
+
+func (x *Eulumdat) GetTypeIndicator() int32 {
+	if x != nil {
+		return x.TypeIndicator
+	}
+	return 0
+}
+
+func (x *Eulumdat) GetSymmetryIndicator() int32 {
+	if x != nil {
+		return x.SymmetryIndicator
+	}
+	return 0
+}
+
+func (x *Eulumdat) GetNumberMcCPlanes() int32 {
+	if x != nil {
+		return x.NumberMcCPlanes
+	}
+	return 0
+}
+
+func (x *Eulumdat) GetDistanceDcCPlanes() float64 {
+	if x != nil {
+		return x.DistanceDcCPlanes
+	}
+	return 0
+}
+
+func (x *Eulumdat) GetNumberNgIntensitiesCPlane() int32 {
+	if x != nil {
+		return x.NumberNgIntensitiesCPlane
+	}
+	return 0
+}
+
+func (x *Eulumdat) GetDistanceDgCPlane() float64 {
+	if x != nil {
+		return x.DistanceDgCPlane
+	}
+	return 0
+}
+
+func (x *Eulumdat) GetMeasurementReportNumber() string {
+	if x != nil {
+		return x.MeasurementReportNumber
+	}
+	return ""
+}
+
+func (x *Eulumdat) GetLuminaireName() string {
+	if x != nil {
+		return x.LuminaireName
+	}
+	return ""
+}
+
+func (x *Eulumdat) GetLuminaireNumber() string {
+	if x != nil {
+		return x.LuminaireNumber
+	}
+	return ""
+}
+
+func (x *Eulumdat) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *Eulumdat) GetDateUser() string {
+	if x != nil {
+		return x.DateUser
+	}
+	return ""
+}
+
+func (x *Eulumdat) GetLengthDiameter() float64 {
+	if x != nil {
+		return x.LengthDiameter
+	}
+	return 0
+}
+
+func (x *Eulumdat) GetWidthLuminaire() float64 {
+	if x != nil {
+		return x.WidthLuminaire
+	}
+	return 0
+}
+
+func (x *Eulumdat) GetHeightLuminaire() float64 {
+	if x != nil {
+		return x.HeightLuminaire
+	}
+	return 0
+}
+
+func (x *Eulumdat) GetLengthDiameterLuminousArea() float64 {
+	if x != nil {
+		return x.LengthDiameterLuminousArea
+	}
+	return 0
+}
+
+func (x *Eulumdat) GetWidthLuminousArea() float64 {
+	if x != nil {
+		return x.WidthLuminousArea
+	}
+	return 0
+}
+
+func (x *Eulumdat) GetHeightLuminousAreaC0() float64 {
+	if x != nil {
+		return x.HeightLuminousAreaC0
+	}
+	return 0
+}
+
+func (x *Eulumdat) GetHeightLuminousAreaC90() float64 {
+	if x != nil {
+		return x.HeightLuminousAreaC90
+	}
+	return 0
+}
+
+func (x *Eulumdat) GetHeightLuminousAreaC180() float64 {
+	if x != nil {
+		return x.HeightLuminousAreaC180
+	}
+	return 0
+}
+
+func (x *Eulumdat) GetHeightLuminousAreaC270() float64 {
+	if x != nil {
+		return x.HeightLuminousAreaC270
+	}
+	return 0
+}
+
+func (x *Eulumdat) GetDownwardFluxFractionPhiu() float64 {
+	if x != nil {
+		return x.DownwardFluxFractionPhiu
+	}
+	return 0
+}
+
+func (x *Eulumdat) GetLightOutputRatioLuminaire() float64 {
+	if x != nil {
+		return x.LightOutputRatioLuminaire
+	}
+	return 0
+}
+
+func (x *Eulumdat) GetIntensityConversionFactor() float64 {
+	if x != nil {
+		return x.IntensityConversionFactor
+	}
+	return 0
+}
+
+func (x *Eulumdat) GetMeasurementTiltLuminaire() float64 {
+	if x != nil {
+		return x.MeasurementTiltLuminaire
+	}
+	return 0
+}
+
+func (x *Eulumdat) GetLampSets() []*LampSet {
+	if x != nil {
+		return x.LampSets
+	}
+	return nil
+}
+
+func (x *Eulumdat) GetDirectRatios() []float64 {
+	if x != nil {
+		return x.DirectRatios
+	}
+	return nil
+}
+
+func (x *Eulumdat) GetAnglesC() []float64 {
+	if x != nil {
+		return x.AnglesC
+	}
+	return nil
+}
+
+func (x *Eulumdat) GetAnglesG() []float64 {
+	if x != nil {
+		return x.AnglesG
+	}
+	return nil
+}
+
+func (x *Eulumdat) GetLuminousIntensityDistribution() []*Plane {
+	if x != nil {
+		return x.LuminousIntensityDistribution
+	}
+	return nil
+}
+
+func (x *Eulumdat) GetExtensions() map[string]string {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+type LampSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NumberLamps            int32   `protobuf:"varint,1,opt,name=number_lamps,json=numberLamps,proto3" json:"number_lamps,omitempty"`
+	TypeLamps              string  `protobuf:"bytes,2,opt,name=type_lamps,json=typeLamps,proto3" json:"type_lamps,omitempty"`
+	TotalLuminousFluxLamps float64 `protobuf:"fixed64,3,opt,name=total_luminous_flux_lamps,json=totalLuminousFluxLamps,proto3" json:"total_luminous_flux_lamps,omitempty"`
+	ColorTemperature       string  `protobuf:"bytes,4,opt,name=color_temperature,json=colorTemperature,proto3" json:"color_temperature,omitempty"`
+	ColorRenderingIndexCri string  `protobuf:"bytes,5,opt,name=color_rendering_index_cri,json=colorRenderingIndexCri,proto3" json:"color_rendering_index_cri,omitempty"`
+	BallastWatts           float64 `protobuf:"fixed64,6,opt,name=ballast_watts,json=ballastWatts,proto3" json:"ballast_watts,omitempty"`
+}
+
+func (x *LampSet) Reset() {
+	*x = LampSet{}
+	mi := &file_photometry_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LampSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LampSet) ProtoMessage() {}
+
+func (x *LampSet) ProtoReflect() protoreflect.Message {
+	mi := &file_photometry_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LampSet.ProtoReflect.Descriptor instead.
+func (*LampSet) Descriptor() ([]byte, []int) {
+	return file_photometry_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LampSet) GetNumberLamps() int32 {
+	if x != nil {
+		return x.NumberLamps
+	}
+	return 0
+}
+
+func (x *LampSet) GetTypeLamps() string {
+	if x != nil {
+		return x.TypeLamps
+	}
+	return ""
+}
+
+func (x *LampSet) GetTotalLuminousFluxLamps() float64 {
+	if x != nil {
+		return x.TotalLuminousFluxLamps
+	}
+	return 0
+}
+
+func (x *LampSet) GetColorTemperature() string {
+	if x != nil {
+		return x.ColorTemperature
+	}
+	return ""
+}
+
+func (x *LampSet) GetColorRenderingIndexCri() string {
+	if x != nil {
+		return x.ColorRenderingIndexCri
+	}
+	return ""
+}
+
+func (x *LampSet) GetBallastWatts() float64 {
+	if x != nil {
+		return x.BallastWatts
+	}
+	return 0
+}
+
+type Plane struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []float64 `protobuf:"fixed64,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *Plane) Reset() {
+	*x = Plane{}
+	mi := &file_photometry_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Plane) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Plane) ProtoMessage() {}
+
+func (x *Plane) ProtoReflect() protoreflect.Message {
+	mi := &file_photometry_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Plane.ProtoReflect.Descriptor instead.
+func (*Plane) Descriptor() ([]byte, []int) {
+	return file_photometry_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Plane) GetValues() []float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// IES mirrors eulumies.IES.
+type IES struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format                      string            `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"` // LM-63-1986, LM-63-1991, LM-63-1995 or LM-63-2002
+	Keywords                    map[string]string `protobuf:"bytes,2,rep,name=keywords,proto3" json:"keywords,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Tilt                        string            `protobuf:"bytes,3,opt,name=tilt,proto3" json:"tilt,omitempty"` // NONE, INCLUDE or FILE
+	TiltLampToLuminaireGeometry int32             `protobuf:"varint,4,opt,name=tilt_lamp_to_luminaire_geometry,json=tiltLampToLuminaireGeometry,proto3" json:"tilt_lamp_to_luminaire_geometry,omitempty"`
+	TiltAngles                  []float64         `protobuf:"fixed64,5,rep,packed,name=tilt_angles,json=tiltAngles,proto3" json:"tilt_angles,omitempty"`
+	TiltMultiplierFactors       []float64         `protobuf:"fixed64,6,rep,packed,name=tilt_multiplier_factors,json=tiltMultiplierFactors,proto3" json:"tilt_multiplier_factors,omitempty"`
+	NumberLamps                 int32             `protobuf:"varint,7,opt,name=number_lamps,json=numberLamps,proto3" json:"number_lamps,omitempty"`
+	LumensPerLamp               float64           `protobuf:"fixed64,8,opt,name=lumens_per_lamp,json=lumensPerLamp,proto3" json:"lumens_per_lamp,omitempty"`
+	CandelaMultiplier           float64           `protobuf:"fixed64,9,opt,name=candela_multiplier,json=candelaMultiplier,proto3" json:"candela_multiplier,omitempty"`
+	PhotometricType             int32             `protobuf:"varint,10,opt,name=photometric_type,json=photometricType,proto3" json:"photometric_type,omitempty"`
+	UnitsType                   int32             `protobuf:"varint,11,opt,name=units_type,json=unitsType,proto3" json:"units_type,omitempty"`
+	LuminaireWidth              float64           `protobuf:"fixed64,12,opt,name=luminaire_width,json=luminaireWidth,proto3" json:"luminaire_width,omitempty"`
+	LuminaireLength             float64           `protobuf:"fixed64,13,opt,name=luminaire_length,json=luminaireLength,proto3" json:"luminaire_length,omitempty"`
+	LuminaireHeight             float64           `protobuf:"fixed64,14,opt,name=luminaire_height,json=luminaireHeight,proto3" json:"luminaire_height,omitempty"`
+	BallastFactor               float64           `protobuf:"fixed64,15,opt,name=ballast_factor,json=ballastFactor,proto3" json:"ballast_factor,omitempty"`
+	FutureUse                   float64           `protobuf:"fixed64,16,opt,name=future_use,json=futureUse,proto3" json:"future_use,omitempty"`
+	InputWatts                  float64           `protobuf:"fixed64,17,opt,name=input_watts,json=inputWatts,proto3" json:"input_watts,omitempty"`
+	VerticalAngles              []float64         `protobuf:"fixed64,18,rep,packed,name=vertical_angles,json=verticalAngles,proto3" json:"vertical_angles,omitempty"`
+	HorizontalAngles            []float64         `protobuf:"fixed64,19,rep,packed,name=horizontal_angles,json=horizontalAngles,proto3" json:"horizontal_angles,omitempty"`
+	CandelaValues               []*Plane          `protobuf:"bytes,20,rep,name=candela_values,json=candelaValues,proto3" json:"candela_values,omitempty"` // one plane per horizontal angle
+}
+
+func (x *IES) Reset() {
+	*x = IES{}
+	mi := &file_photometry_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IES) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IES) ProtoMessage() {}
+
+func (x *IES) ProtoReflect() protoreflect.Message {
+	mi := &file_photometry_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IES.ProtoReflect.Descriptor instead.
+func (*IES) Descriptor() ([]byte, []int) {
+	return file_photometry_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *IES) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *IES) GetKeywords() map[string]string {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
+func (x *IES) GetTilt() string {
+	if x != nil {
+		return x.Tilt
+	}
+	return ""
+}
+
+func (x *IES) GetTiltLampToLuminaireGeometry() int32 {
+	if x != nil {
+		return x.TiltLampToLuminaireGeometry
+	}
+	return 0
+}
+
+func (x *IES) GetTiltAngles() []float64 {
+	if x != nil {
+		return x.TiltAngles
+	}
+	return nil
+}
+
+func (x *IES) GetTiltMultiplierFactors() []float64 {
+	if x != nil {
+		return x.TiltMultiplierFactors
+	}
+	return nil
+}
+
+func (x *IES) GetNumberLamps() int32 {
+	if x != nil {
+		return x.NumberLamps
+	}
+	return 0
+}
+
+func (x *IES) GetLumensPerLamp() float64 {
+	if x != nil {
+		return x.LumensPerLamp
+	}
+	return 0
+}
+
+func (x *IES) GetCandelaMultiplier() float64 {
+	if x != nil {
+		return x.CandelaMultiplier
+	}
+	return 0
+}
+
+func (x *IES) GetPhotometricType() int32 {
+	if x != nil {
+		return x.PhotometricType
+	}
+	return 0
+}
+
+func (x *IES) GetUnitsType() int32 {
+	if x != nil {
+		return x.UnitsType
+	}
+	return 0
+}
+
+func (x *IES) GetLuminaireWidth() float64 {
+	if x != nil {
+		return x.LuminaireWidth
+	}
+	return 0
+}
+
+func (x *IES) GetLuminaireLength() float64 {
+	if x != nil {
+		return x.LuminaireLength
+	}
+	return 0
+}
+
+func (x *IES) GetLuminaireHeight() float64 {
+	if x != nil {
+		return x.LuminaireHeight
+	}
+	return 0
+}
+
+func (x *IES) GetBallastFactor() float64 {
+	if x != nil {
+		return x.BallastFactor
+	}
+	return 0
+}
+
+func (x *IES) GetFutureUse() float64 {
+	if x != nil {
+		return x.FutureUse
+	}
+	return 0
+}
+
+func (x *IES) GetInputWatts() float64 {
+	if x != nil {
+		return x.InputWatts
+	}
+	return 0
+}
+
+func (x *IES) GetVerticalAngles() []float64 {
+	if x != nil {
+		return x.VerticalAngles
+	}
+	return nil
+}
+
+func (x *IES) GetHorizontalAngles() []float64 {
+	if x != nil {
+		return x.HorizontalAngles
+	}
+	return nil
+}
+
+func (x *IES) GetCandelaValues() []*Plane {
+	if x != nil {
+		return x.CandelaValues
+	}
+	return nil
+}
+
+type ConversionOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PreserveSymmetry bool              `protobuf:"varint,1,opt,name=preserve_symmetry,json=preserveSymmetry,proto3" json:"preserve_symmetry,omitempty"`
+	LampSet          int32             `protobuf:"varint,2,opt,name=lamp_set,json=lampSet,proto3" json:"lamp_set,omitempty"`
+	Keywords         map[string]string `protobuf:"bytes,3,rep,name=keywords,proto3" json:"keywords,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ConversionOptions) Reset() {
+	*x = ConversionOptions{}
+	mi := &file_photometry_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConversionOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversionOptions) ProtoMessage() {}
+
+func (x *ConversionOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_photometry_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversionOptions.ProtoReflect.Descriptor instead.
+func (*ConversionOptions) Descriptor() ([]byte, []int) {
+	return file_photometry_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ConversionOptions) GetPreserveSymmetry() bool {
+	if x != nil {
+		return x.PreserveSymmetry
+	}
+	return false
+}
+
+func (x *ConversionOptions) GetLampSet() int32 {
+	if x != nil {
+		return x.LampSet
+	}
+	return 0
+}
+
+func (x *ConversionOptions) GetKeywords() map[string]string {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
+type ConvertRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data    []byte             `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	From    Format             `protobuf:"varint,2,opt,name=from,proto3,enum=eulumies.v1.Format" json:"from,omitempty"`
+	To      Format             `protobuf:"varint,3,opt,name=to,proto3,enum=eulumies.v1.Format" json:"to,omitempty"` // defaults to the other format
+	Options *ConversionOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+	Name    string             `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"` // optional file name, returned unchanged to correlate batch responses
+}
+
+func (x *ConvertRequest) Reset() {
+	*x = ConvertRequest{}
+	mi := &file_photometry_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertRequest) ProtoMessage() {}
+
+func (x *ConvertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_photometry_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertRequest.ProtoReflect.Descriptor instead.
+func (*ConvertRequest) Descriptor() ([]byte, []int) {
+	return file_photometry_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ConvertRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ConvertRequest) GetFrom() Format {
+	if x != nil {
+		return x.From
+	}
+	return Format_FORMAT_UNSPECIFIED
+}
+
+func (x *ConvertRequest) GetTo() Format {
+	if x != nil {
+		return x.To
+	}
+	return Format_FORMAT_UNSPECIFIED
+}
+
+func (x *ConvertRequest) GetOptions() *ConversionOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *ConvertRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ConvertResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data   []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Format Format `protobuf:"varint,2,opt,name=format,proto3,enum=eulumies.v1.Format" json:"format,omitempty"`
+	Name   string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Error  string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"` // set if the conversion failed
+}
+
+func (x *ConvertResponse) Reset() {
+	*x = ConvertResponse{}
+	mi := &file_photometry_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertResponse) ProtoMessage() {}
+
+func (x *ConvertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_photometry_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertResponse.ProtoReflect.Descriptor instead.
+func (*ConvertResponse) Descriptor() ([]byte, []int) {
+	return file_photometry_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ConvertResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ConvertResponse) GetFormat() Format {
+	if x != nil {
+		return x.Format
+	}
+	return Format_FORMAT_UNSPECIFIED
+}
+
+func (x *ConvertResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConvertResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ValidateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data   []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Format Format `protobuf:"varint,2,opt,name=format,proto3,enum=eulumies.v1.Format" json:"format,omitempty"`
+	Strict bool   `protobuf:"varint,3,opt,name=strict,proto3" json:"strict,omitempty"`
+}
+
+func (x *ValidateRequest) Reset() {
+	*x = ValidateRequest{}
+	mi := &file_photometry_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateRequest) ProtoMessage() {}
+
+func (x *ValidateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_photometry_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateRequest.ProtoReflect.Descriptor instead.
+func (*ValidateRequest) Descriptor() ([]byte, []int) {
+	return file_photometry_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ValidateRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ValidateRequest) GetFormat() Format {
+	if x != nil {
+		return x.Format
+	}
+	return Format_FORMAT_UNSPECIFIED
+}
+
+func (x *ValidateRequest) GetStrict() bool {
+	if x != nil {
+		return x.Strict
+	}
+	return false
+}
+
+type ValidationIssue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code     string                   `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Severity ValidationIssue_Severity `protobuf:"varint,2,opt,name=severity,proto3,enum=eulumies.v1.ValidationIssue_Severity" json:"severity,omitempty"`
+	Field    string                   `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	Message  string                   `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ValidationIssue) Reset() {
+	*x = ValidationIssue{}
+	mi := &file_photometry_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidationIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidationIssue) ProtoMessage() {}
+
+func (x *ValidationIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_photometry_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidationIssue.ProtoReflect.Descriptor instead.
+func (*ValidationIssue) Descriptor() ([]byte, []int) {
+	return file_photometry_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ValidationIssue) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ValidationIssue) GetSeverity() ValidationIssue_Severity {
+	if x != nil {
+		return x.Severity
+	}
+	return ValidationIssue_INFO
+}
+
+func (x *ValidationIssue) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ValidationIssue) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ValidateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Valid  bool               `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Issues []*ValidationIssue `protobuf:"bytes,2,rep,name=issues,proto3" json:"issues,omitempty"`
+}
+
+func (x *ValidateResponse) Reset() {
+	*x = ValidateResponse{}
+	mi := &file_photometry_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateResponse) ProtoMessage() {}
+
+func (x *ValidateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_photometry_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateResponse.ProtoReflect.Descriptor instead.
+func (*ValidateResponse) Descriptor() ([]byte, []int) {
+	return file_photometry_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ValidateResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateResponse) GetIssues() []*ValidationIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+type AnalyzeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data   []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Format Format `protobuf:"varint,2,opt,name=format,proto3,enum=eulumies.v1.Format" json:"format,omitempty"`
+}
+
+func (x *AnalyzeRequest) Reset() {
+	*x = AnalyzeRequest{}
+	mi := &file_photometry_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyzeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeRequest) ProtoMessage() {}
+
+func (x *AnalyzeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_photometry_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeRequest.ProtoReflect.Descriptor instead.
+func (*AnalyzeRequest) Descriptor() ([]byte, []int) {
+	return file_photometry_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AnalyzeRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *AnalyzeRequest) GetFormat() Format {
+	if x != nil {
+		return x.Format
+	}
+	return Format_FORMAT_UNSPECIFIED
+}
+
+type AnalyzeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalFlux                float64 `protobuf:"fixed64,1,opt,name=total_flux,json=totalFlux,proto3" json:"total_flux,omitempty"`             // lm
+	PeakIntensity            float64 `protobuf:"fixed64,2,opt,name=peak_intensity,json=peakIntensity,proto3" json:"peak_intensity,omitempty"` // cd
+	PeakC                    float64 `protobuf:"fixed64,3,opt,name=peak_c,json=peakC,proto3" json:"peak_c,omitempty"`
+	PeakGamma                float64 `protobuf:"fixed64,4,opt,name=peak_gamma,json=peakGamma,proto3" json:"peak_gamma,omitempty"`
+	UpwardLightRatio         float64 `protobuf:"fixed64,5,opt,name=upward_light_ratio,json=upwardLightRatio,proto3" json:"upward_light_ratio,omitempty"`
+	UpwardLightOutputRatio   float64 `protobuf:"fixed64,6,opt,name=upward_light_output_ratio,json=upwardLightOutputRatio,proto3" json:"upward_light_output_ratio,omitempty"`
+	DarkSkyCompliant         bool    `protobuf:"varint,7,opt,name=dark_sky_compliant,json=darkSkyCompliant,proto3" json:"dark_sky_compliant,omitempty"`
+	IntensityClass           string  `protobuf:"bytes,8,opt,name=intensity_class,json=intensityClass,proto3" json:"intensity_class,omitempty"`
+	SpacingCriterionC0C180   float64 `protobuf:"fixed64,9,opt,name=spacing_criterion_c0_c180,json=spacingCriterionC0C180,proto3" json:"spacing_criterion_c0_c180,omitempty"`
+	SpacingCriterionC90C270  float64 `protobuf:"fixed64,10,opt,name=spacing_criterion_c90_c270,json=spacingCriterionC90C270,proto3" json:"spacing_criterion_c90_c270,omitempty"`
+	SpacingCriterionDiagonal float64 `protobuf:"fixed64,11,opt,name=spacing_criterion_diagonal,json=spacingCriterionDiagonal,proto3" json:"spacing_criterion_diagonal,omitempty"`
+	Fingerprint              string  `protobuf:"bytes,12,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+}
+
+func (x *AnalyzeResponse) Reset() {
+	*x = AnalyzeResponse{}
+	mi := &file_photometry_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyzeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeResponse) ProtoMessage() {}
+
+func (x *AnalyzeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_photometry_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeResponse.ProtoReflect.Descriptor instead.
+func (*AnalyzeResponse) Descriptor() ([]byte, []int) {
+	return file_photometry_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AnalyzeResponse) GetTotalFlux() float64 {
+	if x != nil {
+		return x.TotalFlux
+	}
+	return 0
+}
+
+func (x *AnalyzeResponse) GetPeakIntensity() float64 {
+	if x != nil {
+		return x.PeakIntensity
+	}
+	return 0
+}
+
+func (x *AnalyzeResponse) GetPeakC() float64 {
+	if x != nil {
+		return x.PeakC
+	}
+	return 0
+}
+
+func (x *AnalyzeResponse) GetPeakGamma() float64 {
+	if x != nil {
+		return x.PeakGamma
+	}
+	return 0
+}
+
+func (x *AnalyzeResponse) GetUpwardLightRatio() float64 {
+	if x != nil {
+		return x.UpwardLightRatio
+	}
+	return 0
+}
+
+func (x *AnalyzeResponse) GetUpwardLightOutputRatio() float64 {
+	if x != nil {
+		return x.UpwardLightOutputRatio
+	}
+	return 0
+}
+
+func (x *AnalyzeResponse) GetDarkSkyCompliant() bool {
+	if x != nil {
+		return x.DarkSkyCompliant
+	}
+	return false
+}
+
+func (x *AnalyzeResponse) GetIntensityClass() string {
+	if x != nil {
+		return x.IntensityClass
+	}
+	return ""
+}
+
+func (x *AnalyzeResponse) GetSpacingCriterionC0C180() float64 {
+	if x != nil {
+		return x.SpacingCriterionC0C180
+	}
+	return 0
+}
+
+func (x *AnalyzeResponse) GetSpacingCriterionC90C270() float64 {
+	if x != nil {
+		return x.SpacingCriterionC90C270
+	}
+	return 0
+}
+
+func (x *AnalyzeResponse) GetSpacingCriterionDiagonal() float64 {
+	if x != nil {
+		return x.SpacingCriterionDiagonal
+	}
+	return 0
+}
+
+func (x *AnalyzeResponse) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+var File_photometry_proto protoreflect.FileDescriptor
+
+var file_photometry_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x0b, 0x65, 0x75, 0x6c, 0x75, 0x6d, 0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x22,
+	0x67, 0x0a, 0x0c, 0x50, 0x61, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x65, 0x75, 0x6c, 0x75, 0x6d, 0x69, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x22, 0x48, 0x0a, 0x0d, 0x50, 0x61, 0x72, 0x73,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x70, 0x68, 0x6f,
+	0x74, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x65, 0x75, 0x6c, 0x75, 0x6d, 0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x68, 0x6f, 0x74,
+	0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x6d, 0x65, 0x74,
+	0x72, 0x79, 0x22, 0x9c, 0x01, 0x0a, 0x0a, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x6d, 0x65, 0x74, 0x72,
+	0x79, 0x12, 0x2b, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x65, 0x75, 0x6c, 0x75, 0x6d, 0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x33,
+	0x0a, 0x08, 0x65, 0x75, 0x6c, 0x75, 0x6d, 0x64, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x65, 0x75, 0x6c, 0x75, 0x6d, 0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x75, 0x6c, 0x75, 0x6d, 0x64, 0x61, 0x74, 0x48, 0x00, 0x52, 0x08, 0x65, 0x75, 0x6c, 0x75, 0x6d,
+	0x64, 0x61, 0x74, 0x12, 0x24, 0x0a, 0x03, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x65, 0x75, 0x6c, 0x75, 0x6d, 0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x45, 0x53, 0x48, 0x00, 0x52, 0x03, 0x69, 0x65, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0xf2, 0x0c, 0x0a, 0x08, 0x45, 0x75, 0x6c, 0x75, 0x6d, 0x64, 0x61, 0x74, 0x12, 0x35,
+	0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x6e,
+	0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x74,
+	0x79, 0x70, 0x65, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2d, 0x0a, 0x12,
+	0x73, 0x79, 0x6d, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x73, 0x79, 0x6d, 0x6d, 0x65, 0x74,
+	0x72, 0x79, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2b, 0x0a, 0x12, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6d, 0x63, 0x5f, 0x63, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4d,
+	0x63, 0x43, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x64, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x63, 0x5f, 0x63, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x44, 0x63, 0x43, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x1d, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x5f, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x5f, 0x63, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x19, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x43, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x12, 0x2d, 0x0a, 0x13, 0x64,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x67, 0x5f, 0x63, 0x5f, 0x70, 0x6c, 0x61,
+	0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x44, 0x67, 0x43, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x12, 0x3a, 0x0a, 0x19, 0x6d, 0x65,
+	0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x6d,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x75, 0x6d, 0x69, 0x6e, 0x61,
+	0x69, 0x72, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6c, 0x75, 0x6d, 0x69, 0x6e, 0x61, 0x69, 0x72, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a,
+	0x10, 0x6c, 0x75, 0x6d, 0x69, 0x6e, 0x61, 0x69, 0x72, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6c, 0x75, 0x6d, 0x69, 0x6e, 0x61, 0x69,
+	0x72, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x75, 0x73,
+	0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x5f, 0x64, 0x69, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x44, 0x69, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x77,
+	0x69, 0x64, 0x74, 0x68, 0x5f, 0x6c, 0x75, 0x6d, 0x69, 0x6e, 0x61, 0x69, 0x72, 0x65, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x77, 0x69, 0x64, 0x74, 0x68, 0x4c, 0x75, 0x6d, 0x69, 0x6e,
+	0x61, 0x69, 0x72, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x6c,
+	0x75, 0x6d, 0x69, 0x6e, 0x61, 0x69, 0x72, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x4c, 0x75, 0x6d, 0x69, 0x6e, 0x61, 0x69, 0x72, 0x65, 0x12,
+	0x41, 0x0a, 0x1d, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x5f, 0x64, 0x69, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x5f, 0x6c, 0x75, 0x6d, 0x69, 0x6e, 0x6f, 0x75, 0x73, 0x5f, 0x61, 0x72, 0x65, 0x61,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x01, 0x52, 0x1a, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x44, 0x69,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x4c, 0x75, 0x6d, 0x69, 0x6e, 0x6f, 0x75, 0x73, 0x41, 0x72,
+	0x65, 0x61, 0x12, 0x2e, 0x0a, 0x13, 0x77, 0x69, 0x64, 0x74, 0x68, 0x5f, 0x6c, 0x75, 0x6d, 0x69,
+	0x6e, 0x6f, 0x75, 0x73, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x18, 0x11, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x11, 0x77, 0x69, 0x64, 0x74, 0x68, 0x4c, 0x75, 0x6d, 0x69, 0x6e, 0x6f, 0x75, 0x73, 0x41, 0x72,
+	0x65, 0x61, 0x12, 0x35, 0x0a, 0x17, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x6c, 0x75, 0x6d,
+	0x69, 0x6e, 0x6f, 0x75, 0x73, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x5f, 0x63, 0x30, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x14, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x4c, 0x75, 0x6d, 0x69, 0x6e,
+	0x6f, 0x75, 0x73, 0x41, 0x72, 0x65, 0x61, 0x43, 0x30, 0x12, 0x37, 0x0a, 0x18, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x5f, 0x6c, 0x75, 0x6d, 0x69, 0x6e, 0x6f, 0x75, 0x73, 0x5f, 0x61, 0x72, 0x65,
+	0x61, 0x5f, 0x63, 0x39, 0x30, 0x18, 0x13, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x4c, 0x75, 0x6d, 0x69, 0x6e, 0x6f, 0x75, 0x73, 0x41, 0x72, 0x65, 0x61, 0x43,
+	0x39, 0x30, 0x12, 0x39, 0x0a, 0x19, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x6c, 0x75, 0x6d,
+	0x69, 0x6e, 0x6f, 0x75, 0x73, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x5f, 0x63, 0x31, 0x38, 0x30, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x01, 0x52, 0x16, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x4c, 0x75, 0x6d,
+	0x69, 0x6e, 0x6f, 0x75, 0x73, 0x41, 0x72, 0x65, 0x61, 0x43, 0x31, 0x38, 0x30, 0x12, 0x39, 0x0a,
+	0x19, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x6c, 0x75, 0x6d, 0x69, 0x6e, 0x6f, 0x75, 0x73,
+	0x5f, 0x61, 0x72, 0x65, 0x61, 0x5f, 0x63, 0x32, 0x37, 0x30, 0x18, 0x15, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x16, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x4c, 0x75, 0x6d, 0x69, 0x6e, 0x6f, 0x75, 0x73,
+	0x41, 0x72, 0x65, 0x61, 0x43, 0x32, 0x37, 0x30, 0x12, 0x3d, 0x0a, 0x1b, 0x64, 0x6f, 0x77, 0x6e,
+	0x77, 0x61, 0x72, 0x64, 0x5f, 0x66, 0x6c, 0x75, 0x78, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x70, 0x68, 0x69, 0x75, 0x18, 0x16, 0x20, 0x01, 0x28, 0x01, 0x52, 0x18, 0x64,
+	0x6f, 0x77, 0x6e, 0x77, 0x61, 0x72, 0x64, 0x46, 0x6c, 0x75, 0x78, 0x46, 0x72, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x68, 0x69, 0x75, 0x12, 0x3f, 0x0a, 0x1c, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x5f, 0x6c, 0x75,
+	0x6d, 0x69, 0x6e, 0x61, 0x69, 0x72, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x01, 0x52, 0x19, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x4c,
+	0x75, 0x6d, 0x69, 0x6e, 0x61, 0x69, 0x72, 0x65, 0x12, 0x3e, 0x0a, 0x1b, 0x69, 0x6e, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x18, 0x20, 0x01, 0x28, 0x01, 0x52, 0x19, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x3c, 0x0a, 0x1a, 0x6d, 0x65, 0x61, 0x73,
+	0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6c, 0x74, 0x5f, 0x6c, 0x75, 0x6d,
+	0x69, 0x6e, 0x61, 0x69, 0x72, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x01, 0x52, 0x18, 0x6d, 0x65,
+	0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6c, 0x74, 0x4c, 0x75, 0x6d,
+	0x69, 0x6e, 0x61, 0x69, 0x72, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x6c, 0x61, 0x6d, 0x70, 0x5f, 0x73,
+	0x65, 0x74, 0x73, 0x18, 0x1a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x75, 0x6c, 0x75,
+	0x6d, 0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x6d, 0x70, 0x53, 0x65, 0x74, 0x52,
+	0x08, 0x6c, 0x61, 0x6d, 0x70, 0x53, 0x65, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x73, 0x18, 0x1b, 0x20, 0x03, 0x28, 0x01,
+	0x52, 0x0c, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x73, 0x12, 0x19,
+	0x0a, 0x08, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x73, 0x5f, 0x63, 0x18, 0x1c, 0x20, 0x03, 0x28, 0x01,
+	0x52, 0x07, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x73, 0x43, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6e, 0x67,
+	0x6c, 0x65, 0x73, 0x5f, 0x67, 0x18, 0x1d, 0x20, 0x03, 0x28, 0x01, 0x52, 0x07, 0x61, 0x6e, 0x67,
+	0x6c, 0x65, 0x73, 0x47, 0x12, 0x5a, 0x0a, 0x1f, 0x6c, 0x75, 0x6d, 0x69, 0x6e, 0x6f, 0x75, 0x73,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x1e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x65, 0x75, 0x6c, 0x75, 0x6d, 0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e,
+	0x65, 0x52, 0x1d, 0x6c, 0x75, 0x6d, 0x69, 0x6e, 0x6f, 0x75, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x74, 0x79, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x45, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x1f,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x75, 0x6c, 0x75, 0x6d, 0x69, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x75, 0x6c, 0x75, 0x6d, 0x64, 0x61, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x93, 0x02, 0x0a, 0x07, 0x4c, 0x61, 0x6d, 0x70, 0x53,
+	0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6c, 0x61, 0x6d,
+	0x70, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x4c, 0x61, 0x6d, 0x70, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6c, 0x61,
+	0x6d, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x79, 0x70, 0x65, 0x4c,
+	0x61, 0x6d, 0x70, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6c, 0x75,
+	0x6d, 0x69, 0x6e, 0x6f, 0x75, 0x73, 0x5f, 0x66, 0x6c, 0x75, 0x78, 0x5f, 0x6c, 0x61, 0x6d, 0x70,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x16, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x75,
+	0x6d, 0x69, 0x6e, 0x6f, 0x75, 0x73, 0x46, 0x6c, 0x75, 0x78, 0x4c, 0x61, 0x6d, 0x70, 0x73, 0x12,
+	0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6c, 0x6f,
+	0x72, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x39, 0x0a, 0x19,
+	0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x63, 0x72, 0x69, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x16, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x43, 0x72, 0x69, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61, 0x6c, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x77, 0x61, 0x74, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c,
+	0x62, 0x61, 0x6c, 0x6c, 0x61, 0x73, 0x74, 0x57, 0x61, 0x74, 0x74, 0x73, 0x22, 0x1f, 0x0a, 0x05,
+	0x50, 0x6c, 0x61, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x84, 0x07,
+	0x0a, 0x03, 0x49, 0x45, 0x53, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x3a, 0x0a,
+	0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x65, 0x75, 0x6c, 0x75, 0x6d, 0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x45,
+	0x53, 0x2e, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6c,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6c, 0x74, 0x12, 0x44, 0x0a,
+	0x1f, 0x74, 0x69, 0x6c, 0x74, 0x5f, 0x6c, 0x61, 0x6d, 0x70, 0x5f, 0x74, 0x6f, 0x5f, 0x6c, 0x75,
+	0x6d, 0x69, 0x6e, 0x61, 0x69, 0x72, 0x65, 0x5f, 0x67, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x1b, 0x74, 0x69, 0x6c, 0x74, 0x4c, 0x61, 0x6d, 0x70,
+	0x54, 0x6f, 0x4c, 0x75, 0x6d, 0x69, 0x6e, 0x61, 0x69, 0x72, 0x65, 0x47, 0x65, 0x6f, 0x6d, 0x65,
+	0x74, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x69, 0x6c, 0x74, 0x5f, 0x61, 0x6e, 0x67, 0x6c,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x01, 0x52, 0x0a, 0x74, 0x69, 0x6c, 0x74, 0x41, 0x6e,
+	0x67, 0x6c, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x74, 0x69, 0x6c, 0x74, 0x5f, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x01, 0x52, 0x15, 0x74, 0x69, 0x6c, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x70, 0x6c, 0x69, 0x65, 0x72, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6c, 0x61, 0x6d, 0x70, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4c, 0x61, 0x6d, 0x70, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x6c, 0x75, 0x6d, 0x65, 0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6c, 0x61,
+	0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6c, 0x75, 0x6d, 0x65, 0x6e, 0x73,
+	0x50, 0x65, 0x72, 0x4c, 0x61, 0x6d, 0x70, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x61, 0x6e, 0x64, 0x65,
+	0x6c, 0x61, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x11, 0x63, 0x61, 0x6e, 0x64, 0x65, 0x6c, 0x61, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x75, 0x6d, 0x69, 0x6e, 0x61, 0x69, 0x72, 0x65, 0x5f, 0x77, 0x69,
+	0x64, 0x74, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x6c, 0x75, 0x6d, 0x69, 0x6e,
+	0x61, 0x69, 0x72, 0x65, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x29, 0x0a, 0x10, 0x6c, 0x75, 0x6d,
+	0x69, 0x6e, 0x61, 0x69, 0x72, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0f, 0x6c, 0x75, 0x6d, 0x69, 0x6e, 0x61, 0x69, 0x72, 0x65, 0x4c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x12, 0x29, 0x0a, 0x10, 0x6c, 0x75, 0x6d, 0x69, 0x6e, 0x61, 0x69, 0x72,
+	0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f,
+	0x6c, 0x75, 0x6d, 0x69, 0x6e, 0x61, 0x69, 0x72, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x62, 0x61, 0x6c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x62, 0x61, 0x6c, 0x6c, 0x61, 0x73, 0x74,
+	0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65,
+	0x5f, 0x75, 0x73, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x66, 0x75, 0x74, 0x75,
+	0x72, 0x65, 0x55, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x77,
+	0x61, 0x74, 0x74, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x57, 0x61, 0x74, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x63,
+	0x61, 0x6c, 0x5f, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x01, 0x52,
+	0x0e, 0x76, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x41, 0x6e, 0x67, 0x6c, 0x65, 0x73, 0x12,
+	0x2b, 0x0a, 0x11, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6e,
+	0x67, 0x6c, 0x65, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x01, 0x52, 0x10, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x6f, 0x6e, 0x74, 0x61, 0x6c, 0x41, 0x6e, 0x67, 0x6c, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0e,
+	0x63, 0x61, 0x6e, 0x64, 0x65, 0x6c, 0x61, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x14,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x75, 0x6c, 0x75, 0x6d, 0x69, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x52, 0x0d, 0x63, 0x61, 0x6e, 0x64, 0x65, 0x6c,
+	0x61, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x3b, 0x0a, 0x0d, 0x4b, 0x65, 0x79, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0xe2, 0x01, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x73, 0x79, 0x6d, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x53,
+	0x79, 0x6d, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x6d, 0x70, 0x5f,
+	0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6c, 0x61, 0x6d, 0x70, 0x53,
+	0x65, 0x74, 0x12, 0x48, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x65, 0x75, 0x6c, 0x75, 0x6d, 0x69, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x1a, 0x3b, 0x0a, 0x0d,
+	0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc0, 0x01, 0x0a, 0x0e, 0x43, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x27, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13,
+	0x2e, 0x65, 0x75, 0x6c, 0x75, 0x6d, 0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x23, 0x0a, 0x02, 0x74, 0x6f, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x65, 0x75, 0x6c, 0x75, 0x6d, 0x69, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x38,
+	0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x65, 0x75, 0x6c, 0x75, 0x6d, 0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x7c, 0x0a, 0x0f,
+	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x65, 0x75, 0x6c, 0x75, 0x6d, 0x69, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x6a, 0x0a, 0x0f, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x2b, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x65, 0x75, 0x6c, 0x75, 0x6d, 0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x22, 0xc6, 0x01, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x41,
+	0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x25, 0x2e, 0x65, 0x75, 0x6c, 0x75, 0x6d, 0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x2e, 0x53,
+	0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x2c, 0x0a, 0x08, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x08, 0x0a,
+	0x04, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x41, 0x52, 0x4e, 0x49,
+	0x4e, 0x47, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02, 0x22,
+	0x5e, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x75, 0x6c, 0x75,
+	0x6d, 0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x22,
+	0x51, 0x0a, 0x0e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x65, 0x75, 0x6c, 0x75, 0x6d, 0x69, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x22, 0xa5, 0x04, 0x0a, 0x0f, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x66, 0x6c, 0x75, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x46, 0x6c, 0x75, 0x78, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x65, 0x61, 0x6b, 0x5f, 0x69, 0x6e,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x70,
+	0x65, 0x61, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x12, 0x15, 0x0a, 0x06,
+	0x70, 0x65, 0x61, 0x6b, 0x5f, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x65,
+	0x61, 0x6b, 0x43, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x65, 0x61, 0x6b, 0x5f, 0x67, 0x61, 0x6d, 0x6d,
+	0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x70, 0x65, 0x61, 0x6b, 0x47, 0x61, 0x6d,
+	0x6d, 0x61, 0x12, 0x2c, 0x0a, 0x12, 0x75, 0x70, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10,
+	0x75, 0x70, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6f,
+	0x12, 0x39, 0x0a, 0x19, 0x75, 0x70, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x16, 0x75, 0x70, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x67, 0x68, 0x74,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x2c, 0x0a, 0x12, 0x64,
+	0x61, 0x72, 0x6b, 0x5f, 0x73, 0x6b, 0x79, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x64, 0x61, 0x72, 0x6b, 0x53, 0x6b, 0x79,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x73, 0x70, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x30, 0x5f, 0x63, 0x31, 0x38, 0x30, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x16, 0x73, 0x70, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x43, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x43, 0x30, 0x43, 0x31, 0x38, 0x30, 0x12, 0x3b, 0x0a,
+	0x1a, 0x73, 0x70, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69,
+	0x6f, 0x6e, 0x5f, 0x63, 0x39, 0x30, 0x5f, 0x63, 0x32, 0x37, 0x30, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x17, 0x73, 0x70, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72,
+	0x69, 0x6f, 0x6e, 0x43, 0x39, 0x30, 0x43, 0x32, 0x37, 0x30, 0x12, 0x3c, 0x0a, 0x1a, 0x73, 0x70,
+	0x61, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x5f,
+	0x64, 0x69, 0x61, 0x67, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x18,
+	0x73, 0x70, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e,
+	0x44, 0x69, 0x61, 0x67, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x67,
+	0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66,
+	0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2a, 0x40, 0x0a, 0x06, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a,
+	0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4c, 0x44, 0x54, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a,
+	0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x49, 0x45, 0x53, 0x10, 0x02, 0x32, 0xf7, 0x02, 0x0a,
+	0x11, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x3e, 0x0a, 0x05, 0x50, 0x61, 0x72, 0x73, 0x65, 0x12, 0x19, 0x2e, 0x65, 0x75,
+	0x6c, 0x75, 0x6d, 0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x65, 0x75, 0x6c, 0x75, 0x6d, 0x69, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x44, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x12, 0x1b, 0x2e,
+	0x65, 0x75, 0x6c, 0x75, 0x6d, 0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x65, 0x75, 0x6c,
+	0x75, 0x6d, 0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1b, 0x2e, 0x65, 0x75, 0x6c, 0x75, 0x6d,
+	0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x65, 0x75, 0x6c, 0x75, 0x6d, 0x69, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x47, 0x0a, 0x08, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x65, 0x75, 0x6c, 0x75, 0x6d, 0x69, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x75, 0x6c, 0x75, 0x6d, 0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x44, 0x0a, 0x07, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x12, 0x1b, 0x2e, 0x65, 0x75,
+	0x6c, 0x75, 0x6d, 0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x65, 0x75, 0x6c, 0x75, 0x6d,
+	0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x34, 0x34, 0x7a, 0x2f, 0x65, 0x75, 0x6c, 0x75, 0x6d, 0x69,
+	0x65, 0x73, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x62, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_photometry_proto_rawDescOnce sync.Once
+	file_photometry_proto_rawDescData = file_photometry_proto_rawDesc
+)
+
+func file_photometry_proto_rawDescGZIP() []byte {
+	file_photometry_proto_rawDescOnce.Do(func() {
+		file_photometry_proto_rawDescData = protoimpl.X.CompressGZIP(file_photometry_proto_rawDescData)
+	})
+	return file_photometry_proto_rawDescData
+}
+
+var file_photometry_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_photometry_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_photometry_proto_goTypes = []any{
+	(Format)(0),                   // 0: eulumies.v1.Format
+	(ValidationIssue_Severity)(0), // 1: eulumies.v1.ValidationIssue.Severity
+	(*ParseRequest)(nil),          // 2: eulumies.v1.ParseRequest
+	(*ParseResponse)(nil),         // 3: eulumies.v1.ParseResponse
+	(*Photometry)(nil),            // 4: eulumies.v1.Photometry
+	(*Eulumdat)(nil),              // 5: eulumies.v1.Eulumdat
+	(*LampSet)(nil),               // 6: eulumies.v1.LampSet
+	(*Plane)(nil),                 // 7: eulumies.v1.Plane
+	(*IES)(nil),                   // 8: eulumies.v1.IES
+	(*ConversionOptions)(nil),     // 9: eulumies.v1.ConversionOptions
+	(*ConvertRequest)(nil),        // 10: eulumies.v1.ConvertRequest
+	(*ConvertResponse)(nil),       // 11: eulumies.v1.ConvertResponse
+	(*ValidateRequest)(nil),       // 12: eulumies.v1.ValidateRequest
+	(*ValidationIssue)(nil),       // 13: eulumies.v1.ValidationIssue
+	(*ValidateResponse)(nil),      // 14: eulumies.v1.ValidateResponse
+	(*AnalyzeRequest)(nil),        // 15: eulumies.v1.AnalyzeRequest
+	(*AnalyzeResponse)(nil),       // 16: eulumies.v1.AnalyzeResponse
+	nil,                           // 17: eulumies.v1.Eulumdat.ExtensionsEntry
+	nil,                           // 18: eulumies.v1.IES.KeywordsEntry
+	nil,                           // 19: eulumies.v1.ConversionOptions.KeywordsEntry
+}
+var file_photometry_proto_depIdxs = []int32{
+	0,  // 0: eulumies.v1.ParseRequest.format:type_name -> eulumies.v1.Format
+	4,  // 1: eulumies.v1.ParseResponse.photometry:type_name -> eulumies.v1.Photometry
+	0,  // 2: eulumies.v1.Photometry.format:type_name -> eulumies.v1.Format
+	5,  // 3: eulumies.v1.Photometry.eulumdat:type_name -> eulumies.v1.Eulumdat
+	8,  // 4: eulumies.v1.Photometry.ies:type_name -> eulumies.v1.IES
+	6,  // 5: eulumies.v1.Eulumdat.lamp_sets:type_name -> eulumies.v1.LampSet
+	7,  // 6: eulumies.v1.Eulumdat.luminous_intensity_distribution:type_name -> eulumies.v1.Plane
+	17, // 7: eulumies.v1.Eulumdat.extensions:type_name -> eulumies.v1.Eulumdat.ExtensionsEntry
+	18, // 8: eulumies.v1.IES.keywords:type_name -> eulumies.v1.IES.KeywordsEntry
+	7,  // 9: eulumies.v1.IES.candela_values:type_name -> eulumies.v1.Plane
+	19, // 10: eulumies.v1.ConversionOptions.keywords:type_name -> eulumies.v1.ConversionOptions.KeywordsEntry
+	0,  // 11: eulumies.v1.ConvertRequest.from:type_name -> eulumies.v1.Format
+	0,  // 12: eulumies.v1.ConvertRequest.to:type_name -> eulumies.v1.Format
+	9,  // 13: eulumies.v1.ConvertRequest.options:type_name -> eulumies.v1.ConversionOptions
+	0,  // 14: eulumies.v1.ConvertResponse.format:type_name -> eulumies.v1.Format
+	0,  // 15: eulumies.v1.ValidateRequest.format:type_name -> eulumies.v1.Format
+	1,  // 16: eulumies.v1.ValidationIssue.severity:type_name -> eulumies.v1.ValidationIssue.Severity
+	13, // 17: eulumies.v1.ValidateResponse.issues:type_name -> eulumies.v1.ValidationIssue
+	0,  // 18: eulumies.v1.AnalyzeRequest.format:type_name -> eulumies.v1.Format
+	2,  // 19: eulumies.v1.PhotometryService.Parse:input_type -> eulumies.v1.ParseRequest
+	10, // 20: eulumies.v1.PhotometryService.Convert:input_type -> eulumies.v1.ConvertRequest
+	10, // 21: eulumies.v1.PhotometryService.ConvertBatch:input_type -> eulumies.v1.ConvertRequest
+	12, // 22: eulumies.v1.PhotometryService.Validate:input_type -> eulumies.v1.ValidateRequest
+	15, // 23: eulumies.v1.PhotometryService.Analyze:input_type -> eulumies.v1.AnalyzeRequest
+	3,  // 24: eulumies.v1.PhotometryService.Parse:output_type -> eulumies.v1.ParseResponse
+	11, // 25: eulumies.v1.PhotometryService.Convert:output_type -> eulumies.v1.ConvertResponse
+	11, // 26: eulumies.v1.PhotometryService.ConvertBatch:output_type -> eulumies.v1.ConvertResponse
+	14, // 27: eulumies.v1.PhotometryService.Validate:output_type -> eulumies.v1.ValidateResponse
+	16, // 28: eulumies.v1.PhotometryService.Analyze:output_type -> eulumies.v1.AnalyzeResponse
+	24, // [24:29] is the sub-list for method output_type
+	19, // [19:24] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_photometry_proto_init() }
+func file_photometry_proto_init() {
+	if File_photometry_proto != nil {
+		return
+	}
+	file_photometry_proto_msgTypes[2].OneofWrappers = []any{
+		(*Photometry_Eulumdat)(nil),
+		(*Photometry_Ies)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_photometry_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_photometry_proto_goTypes,
+		DependencyIndexes: file_photometry_proto_depIdxs,
+		EnumInfos:         file_photometry_proto_enumTypes,
+		MessageInfos:      file_photometry_proto_msgTypes,
+	}.Build()
+	File_photometry_proto = out.File
+	file_photometry_proto_rawDesc = nil
+	file_photometry_proto_goTypes = nil
+	file_photometry_proto_depIdxs = nil
+}
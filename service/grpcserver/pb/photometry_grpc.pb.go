@@ -0,0 +1,285 @@
+// PhotometryService exposes parsing, conversion, validation and analysis of EULUMDAT and IES files.
+//
+// The operations are implemented transport independent in package github.com/h44z/eulumies/service, the server of
+// package github.com/h44z/eulumies/service/grpcserver delegates each call to the function of the same name.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: photometry.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PhotometryService_Parse_FullMethodName        = "/eulumies.v1.PhotometryService/Parse"
+	PhotometryService_Convert_FullMethodName      = "/eulumies.v1.PhotometryService/Convert"
+	PhotometryService_ConvertBatch_FullMethodName = "/eulumies.v1.PhotometryService/ConvertBatch"
+	PhotometryService_Validate_FullMethodName     = "/eulumies.v1.PhotometryService/Validate"
+	PhotometryService_Analyze_FullMethodName      = "/eulumies.v1.PhotometryService/Analyze"
+)
+
+// PhotometryServiceClient is the client API for PhotometryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PhotometryServiceClient interface {
+	// Parse returns the parsed structure of a photometric file.
+	Parse(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*ParseResponse, error)
+	// Convert converts a photometric file between EULUMDAT and IES.
+	Convert(ctx context.Context, in *ConvertRequest, opts ...grpc.CallOption) (*ConvertResponse, error)
+	// ConvertBatch converts a stream of files, a failed conversion is reported in its response and does not end
+	// the stream.
+	ConvertBatch(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ConvertRequest, ConvertResponse], error)
+	// Validate returns all validation issues of a photometric file.
+	Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error)
+	// Analyze returns the key photometric metrics of a photometric file.
+	Analyze(ctx context.Context, in *AnalyzeRequest, opts ...grpc.CallOption) (*AnalyzeResponse, error)
+}
+
+type photometryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPhotometryServiceClient(cc grpc.ClientConnInterface) PhotometryServiceClient {
+	return &photometryServiceClient{cc}
+}
+
+func (c *photometryServiceClient) Parse(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*ParseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ParseResponse)
+	err := c.cc.Invoke(ctx, PhotometryService_Parse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *photometryServiceClient) Convert(ctx context.Context, in *ConvertRequest, opts ...grpc.CallOption) (*ConvertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConvertResponse)
+	err := c.cc.Invoke(ctx, PhotometryService_Convert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *photometryServiceClient) ConvertBatch(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ConvertRequest, ConvertResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PhotometryService_ServiceDesc.Streams[0], PhotometryService_ConvertBatch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ConvertRequest, ConvertResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PhotometryService_ConvertBatchClient = grpc.BidiStreamingClient[ConvertRequest, ConvertResponse]
+
+func (c *photometryServiceClient) Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateResponse)
+	err := c.cc.Invoke(ctx, PhotometryService_Validate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *photometryServiceClient) Analyze(ctx context.Context, in *AnalyzeRequest, opts ...grpc.CallOption) (*AnalyzeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnalyzeResponse)
+	err := c.cc.Invoke(ctx, PhotometryService_Analyze_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PhotometryServiceServer is the server API for PhotometryService service.
+// All implementations must embed UnimplementedPhotometryServiceServer
+// for forward compatibility.
+type PhotometryServiceServer interface {
+	// Parse returns the parsed structure of a photometric file.
+	Parse(context.Context, *ParseRequest) (*ParseResponse, error)
+	// Convert converts a photometric file between EULUMDAT and IES.
+	Convert(context.Context, *ConvertRequest) (*ConvertResponse, error)
+	// ConvertBatch converts a stream of files, a failed conversion is reported in its response and does not end
+	// the stream.
+	ConvertBatch(grpc.BidiStreamingServer[ConvertRequest, ConvertResponse]) error
+	// Validate returns all validation issues of a photometric file.
+	Validate(context.Context, *ValidateRequest) (*ValidateResponse, error)
+	// Analyze returns the key photometric metrics of a photometric file.
+	Analyze(context.Context, *AnalyzeRequest) (*AnalyzeResponse, error)
+	mustEmbedUnimplementedPhotometryServiceServer()
+}
+
+// UnimplementedPhotometryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPhotometryServiceServer struct{}
+
+func (UnimplementedPhotometryServiceServer) Parse(context.Context, *ParseRequest) (*ParseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Parse not implemented")
+}
+func (UnimplementedPhotometryServiceServer) Convert(context.Context, *ConvertRequest) (*ConvertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Convert not implemented")
+}
+func (UnimplementedPhotometryServiceServer) ConvertBatch(grpc.BidiStreamingServer[ConvertRequest, ConvertResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ConvertBatch not implemented")
+}
+func (UnimplementedPhotometryServiceServer) Validate(context.Context, *ValidateRequest) (*ValidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validate not implemented")
+}
+func (UnimplementedPhotometryServiceServer) Analyze(context.Context, *AnalyzeRequest) (*AnalyzeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Analyze not implemented")
+}
+func (UnimplementedPhotometryServiceServer) mustEmbedUnimplementedPhotometryServiceServer() {}
+func (UnimplementedPhotometryServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafePhotometryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PhotometryServiceServer will
+// result in compilation errors.
+type UnsafePhotometryServiceServer interface {
+	mustEmbedUnimplementedPhotometryServiceServer()
+}
+
+func RegisterPhotometryServiceServer(s grpc.ServiceRegistrar, srv PhotometryServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPhotometryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PhotometryService_ServiceDesc, srv)
+}
+
+func _PhotometryService_Parse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhotometryServiceServer).Parse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhotometryService_Parse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhotometryServiceServer).Parse(ctx, req.(*ParseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PhotometryService_Convert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhotometryServiceServer).Convert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhotometryService_Convert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhotometryServiceServer).Convert(ctx, req.(*ConvertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PhotometryService_ConvertBatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PhotometryServiceServer).ConvertBatch(&grpc.GenericServerStream[ConvertRequest, ConvertResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PhotometryService_ConvertBatchServer = grpc.BidiStreamingServer[ConvertRequest, ConvertResponse]
+
+func _PhotometryService_Validate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhotometryServiceServer).Validate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhotometryService_Validate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhotometryServiceServer).Validate(ctx, req.(*ValidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PhotometryService_Analyze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhotometryServiceServer).Analyze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhotometryService_Analyze_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhotometryServiceServer).Analyze(ctx, req.(*AnalyzeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PhotometryService_ServiceDesc is the grpc.ServiceDesc for PhotometryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PhotometryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "eulumies.v1.PhotometryService",
+	HandlerType: (*PhotometryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Parse",
+			Handler:    _PhotometryService_Parse_Handler,
+		},
+		{
+			MethodName: "Convert",
+			Handler:    _PhotometryService_Convert_Handler,
+		},
+		{
+			MethodName: "Validate",
+			Handler:    _PhotometryService_Validate_Handler,
+		},
+		{
+			MethodName: "Analyze",
+			Handler:    _PhotometryService_Analyze_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ConvertBatch",
+			Handler:       _PhotometryService_ConvertBatch_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "photometry.proto",
+}
@@ -0,0 +1,227 @@
+// Package grpcserver serves the operations of package github.com/h44z/eulumies/service as the PhotometryService gRPC
+// API (pb/photometry.proto). It is a separate module, so the eulumies module itself has no gRPC dependency.
+package grpcserver
+
+//go:generate protoc -I pb --go_out=pb --go_opt=paths=source_relative --go-grpc_out=pb --go-grpc_opt=paths=source_relative pb/photometry.proto
+
+import (
+	"context"
+
+	"github.com/h44z/eulumies"
+	"github.com/h44z/eulumies/service"
+	"github.com/h44z/eulumies/service/grpcserver/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server implements pb.PhotometryServiceServer, each call is delegated to the function of the same name in package
+// service. Files that can not be parsed are rejected with codes.InvalidArgument.
+type Server struct {
+	pb.UnimplementedPhotometryServiceServer
+}
+
+// Register registers a Server with the given gRPC server.
+func Register(registrar grpc.ServiceRegistrar) {
+	pb.RegisterPhotometryServiceServer(registrar, Server{})
+}
+
+// Parse returns the parsed structure of a photometric file.
+func (Server) Parse(_ context.Context, request *pb.ParseRequest) (*pb.ParseResponse, error) {
+	photometry, err := service.Parse(request.GetData(), service.Format(request.GetFormat()), request.GetStrict())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &pb.ParseResponse{Photometry: toPhotometry(photometry)}, nil
+}
+
+// Convert converts a photometric file between EULUMDAT and IES, a failed conversion is reported in the response.
+func (Server) Convert(_ context.Context, request *pb.ConvertRequest) (*pb.ConvertResponse, error) {
+	return toConvertResponse(service.Convert(fromConvertRequest(request))), nil
+}
+
+// ConvertBatch converts all files of the stream until the client closes its side.
+func (Server) ConvertBatch(stream grpc.BidiStreamingServer[pb.ConvertRequest, pb.ConvertResponse]) error {
+	return service.ConvertBatch(func() (service.ConvertRequest, error) {
+		request, err := stream.Recv()
+		if err != nil {
+			return service.ConvertRequest{}, err
+		}
+
+		return fromConvertRequest(request), nil
+	}, func(response service.ConvertResponse) error {
+		return stream.Send(toConvertResponse(response))
+	})
+}
+
+// Validate returns all validation issues of a photometric file.
+func (Server) Validate(_ context.Context, request *pb.ValidateRequest) (*pb.ValidateResponse, error) {
+	issues, err := service.Validate(request.GetData(), service.Format(request.GetFormat()), request.GetStrict())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	response := &pb.ValidateResponse{Valid: issues.Valid()}
+	for _, issue := range issues {
+		response.Issues = append(response.Issues, &pb.ValidationIssue{
+			Code:     string(issue.Code),
+			Severity: pb.ValidationIssue_Severity(issue.Severity),
+			Field:    issue.Field,
+			Message:  issue.Message,
+		})
+	}
+
+	return response, nil
+}
+
+// Analyze returns the key photometric metrics of a photometric file.
+func (Server) Analyze(_ context.Context, request *pb.AnalyzeRequest) (*pb.AnalyzeResponse, error) {
+	analysis, err := service.Analyze(request.GetData(), service.Format(request.GetFormat()))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &pb.AnalyzeResponse{
+		TotalFlux:                analysis.TotalFlux,
+		PeakIntensity:            analysis.PeakIntensity,
+		PeakC:                    analysis.PeakC,
+		PeakGamma:                analysis.PeakGamma,
+		UpwardLightRatio:         analysis.UpwardLightRatio,
+		UpwardLightOutputRatio:   analysis.UpwardLightOutputRatio,
+		DarkSkyCompliant:         analysis.DarkSkyCompliant,
+		IntensityClass:           analysis.IntensityClass,
+		SpacingCriterionC0C180:   analysis.SpacingCriterion.C0C180,
+		SpacingCriterionC90C270:  analysis.SpacingCriterion.C90C270,
+		SpacingCriterionDiagonal: analysis.SpacingCriterion.Diagonal,
+		Fingerprint:              analysis.Fingerprint,
+	}, nil
+}
+
+// fromConvertRequest maps a protobuf conversion request, unset options select the defaults of
+// eulumies.ConversionOptions.
+func fromConvertRequest(request *pb.ConvertRequest) service.ConvertRequest {
+	return service.ConvertRequest{
+		Data: request.GetData(),
+		From: service.Format(request.GetFrom()),
+		To:   service.Format(request.GetTo()),
+		Options: eulumies.ConversionOptions{
+			LampSet:          int(request.GetOptions().GetLampSet()),
+			PreserveSymmetry: request.GetOptions().GetPreserveSymmetry(),
+			Keywords:         request.GetOptions().GetKeywords(),
+		},
+		Name: request.GetName(),
+	}
+}
+
+func toConvertResponse(response service.ConvertResponse) *pb.ConvertResponse {
+	return &pb.ConvertResponse{
+		Data:   response.Data,
+		Format: pb.Format(response.Format),
+		Name:   response.Name,
+		Error:  response.Error,
+	}
+}
+
+func toPhotometry(photometry service.Photometry) *pb.Photometry {
+	result := &pb.Photometry{Format: pb.Format(photometry.Format)}
+	if photometry.Eulumdat != nil {
+		result.Data = &pb.Photometry_Eulumdat{Eulumdat: toEulumdat(photometry.Eulumdat)}
+	} else {
+		result.Data = &pb.Photometry_Ies{Ies: toIES(photometry.IES)}
+	}
+
+	return result
+}
+
+func toEulumdat(e *eulumies.Eulumdat) *pb.Eulumdat {
+	result := &pb.Eulumdat{
+		CompanyIdentification:         e.CompanyIdentification,
+		TypeIndicator:                 int32(e.TypeIndicator),
+		SymmetryIndicator:             int32(e.SymmetryIndicator),
+		NumberMcCPlanes:               int32(e.NumberMcCPlanes),
+		DistanceDcCPlanes:             e.DistanceDcCPlanes,
+		NumberNgIntensitiesCPlane:     int32(e.NumberNgIntensitiesCPlane),
+		DistanceDgCPlane:              e.DistanceDgCPlane,
+		MeasurementReportNumber:       e.MeasurementReportNumber,
+		LuminaireName:                 e.LuminaireName,
+		LuminaireNumber:               e.LuminaireNumber,
+		FileName:                      e.FileName,
+		DateUser:                      e.DateUser,
+		LengthDiameter:                e.LengthDiameter,
+		WidthLuminaire:                e.WidthLuminaire,
+		HeightLuminaire:               e.HeightLuminaire,
+		LengthDiameterLuminousArea:    e.LengthDiameterLuminousArea,
+		WidthLuminousArea:             e.WidthLuminousArea,
+		HeightLuminousAreaC0:          e.HeightLuminousAreaC0,
+		HeightLuminousAreaC90:         e.HeightLuminousAreaC90,
+		HeightLuminousAreaC180:        e.HeightLuminousAreaC180,
+		HeightLuminousAreaC270:        e.HeightLuminousAreaC270,
+		DownwardFluxFractionPhiu:      e.DownwardFluxFractionPhiu,
+		LightOutputRatioLuminaire:     e.LightOutputRatioLuminaire,
+		IntensityConversionFactor:     e.IntensityConversionFactor,
+		MeasurementTiltLuminaire:      e.MeasurementTiltLuminaire,
+		DirectRatios:                  append([]float64(nil), e.DirectRatios[:]...),
+		AnglesC:                       e.AnglesC,
+		AnglesG:                       e.AnglesG,
+		LuminousIntensityDistribution: toPlanes(e.LuminousIntensityDistribution),
+		Extensions:                    e.Extensions,
+	}
+	for i := 0; i < e.NumberStandardSetLamps; i++ {
+		result.LampSets = append(result.LampSets, &pb.LampSet{
+			NumberLamps:            int32(valueAt(e.NumberLamps, i)),
+			TypeLamps:              valueAt(e.TypeLamps, i),
+			TotalLuminousFluxLamps: valueAt(e.TotalLuminousFluxLamps, i),
+			ColorTemperature:       valueAt(e.ColorTemperature, i),
+			ColorRenderingIndexCri: valueAt(e.ColorRenderingIndexCRI, i),
+			BallastWatts:           valueAt(e.BallastWatts, i),
+		})
+	}
+
+	return result
+}
+
+func toIES(ies *eulumies.IES) *pb.IES {
+	return &pb.IES{
+		Format:                      string(ies.Format),
+		Keywords:                    ies.Keywords,
+		Tilt:                        string(ies.Tilt),
+		TiltLampToLuminaireGeometry: int32(ies.TiltLampToLuminaireGeometry),
+		TiltAngles:                  ies.TiltAngles,
+		TiltMultiplierFactors:       ies.TiltMultiplierFactors,
+		NumberLamps:                 int32(ies.NumberLamps),
+		LumensPerLamp:               ies.LumensPerLamp,
+		CandelaMultiplier:           ies.CandelaMultiplier,
+		PhotometricType:             int32(ies.PhotometricType),
+		UnitsType:                   int32(ies.UnitsType),
+		LuminaireWidth:              ies.LuminaireWidth,
+		LuminaireLength:             ies.LuminaireLength,
+		LuminaireHeight:             ies.LuminaireHeight,
+		BallastFactor:               ies.BallastFactor,
+		FutureUse:                   ies.FutureUse,
+		InputWatts:                  ies.InputWatts,
+		VerticalAngles:              ies.VerticalAngles,
+		HorizontalAngles:            ies.HorizontalAngles,
+		CandelaValues:               toPlanes(ies.CandelaValues),
+	}
+}
+
+func toPlanes(planes [][]float64) []*pb.Plane {
+	result := make([]*pb.Plane, len(planes))
+	for i, values := range planes {
+		result[i] = &pb.Plane{Values: values}
+	}
+
+	return result
+}
+
+// valueAt returns the value at the index or the zero value if the slice is too short, the lamp set fields of
+// leniently parsed files may be incomplete.
+func valueAt[T any](values []T, index int) T {
+	var value T
+	if index < len(values) {
+		value = values[index]
+	}
+
+	return value
+}
@@ -0,0 +1,122 @@
+package grpcserver
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net"
+	"testing"
+
+	"github.com/h44z/eulumies/service/grpcserver/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func readSample(t *testing.T, name string) []byte {
+	data, err := ioutil.ReadFile("../../test/" + name)
+	require.NoError(t, err)
+
+	return data
+}
+
+// newClient starts a gRPC server with the registered PhotometryService on an in-memory listener.
+func newClient(t *testing.T) pb.PhotometryServiceClient {
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	Register(server)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return pb.NewPhotometryServiceClient(conn)
+}
+
+func TestServer_Parse(t *testing.T) {
+	client := newClient(t)
+
+	response, err := client.Parse(context.Background(), &pb.ParseRequest{Data: readSample(t, "sample2.ldt")})
+	require.NoError(t, err)
+	assert.Equal(t, pb.Format_FORMAT_LDT, response.GetPhotometry().GetFormat())
+	assert.Equal(t, "A SUPER LAMP 2", response.GetPhotometry().GetEulumdat().GetLuminaireName())
+	assert.NotEmpty(t, response.GetPhotometry().GetEulumdat().GetLampSets())
+
+	response, err = client.Parse(context.Background(), &pb.ParseRequest{Data: readSample(t, "sample.ies")})
+	require.NoError(t, err)
+	assert.Equal(t, pb.Format_FORMAT_IES, response.GetPhotometry().GetFormat())
+	assert.NotEmpty(t, response.GetPhotometry().GetIes().GetCandelaValues())
+
+	_, err = client.Parse(context.Background(), &pb.ParseRequest{Data: readSample(t, "sample2.ldt"), Format: pb.Format_FORMAT_IES})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_Convert(t *testing.T) {
+	client := newClient(t)
+
+	response, err := client.Convert(context.Background(), &pb.ConvertRequest{Data: readSample(t, "sample2.ldt"), Name: "sample2"})
+	require.NoError(t, err)
+	assert.Empty(t, response.GetError())
+	assert.Equal(t, pb.Format_FORMAT_IES, response.GetFormat())
+	assert.Equal(t, "sample2", response.GetName())
+	assert.NotEmpty(t, response.GetData())
+
+	response, err = client.Convert(context.Background(), &pb.ConvertRequest{Data: []byte("broken")})
+	require.NoError(t, err)
+	assert.NotEmpty(t, response.GetError())
+}
+
+func TestServer_ConvertBatch(t *testing.T) {
+	client := newClient(t)
+
+	stream, err := client.ConvertBatch(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&pb.ConvertRequest{Data: readSample(t, "sample2.ldt"), Name: "a"}))
+	require.NoError(t, stream.Send(&pb.ConvertRequest{Data: []byte("broken"), Name: "b"}))
+	require.NoError(t, stream.Send(&pb.ConvertRequest{Data: readSample(t, "sample.ies"), Name: "c"}))
+	require.NoError(t, stream.CloseSend())
+
+	var responses []*pb.ConvertResponse
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		responses = append(responses, response)
+	}
+	require.Len(t, responses, 3)
+	assert.Equal(t, "a", responses[0].GetName())
+	assert.Empty(t, responses[0].GetError())
+	assert.NotEmpty(t, responses[1].GetError())
+	assert.Equal(t, pb.Format_FORMAT_LDT, responses[2].GetFormat())
+}
+
+func TestServer_Validate(t *testing.T) {
+	client := newClient(t)
+
+	response, err := client.Validate(context.Background(), &pb.ValidateRequest{Data: readSample(t, "sample2.ldt")})
+	require.NoError(t, err)
+	assert.True(t, response.GetValid())
+	for _, issue := range response.GetIssues() {
+		assert.NotEqual(t, pb.ValidationIssue_ERROR, issue.GetSeverity())
+	}
+}
+
+func TestServer_Analyze(t *testing.T) {
+	client := newClient(t)
+
+	response, err := client.Analyze(context.Background(), &pb.AnalyzeRequest{Data: readSample(t, "sample2.ldt")})
+	require.NoError(t, err)
+	assert.Greater(t, response.GetTotalFlux(), 0.0)
+	assert.Greater(t, response.GetPeakIntensity(), 0.0)
+	assert.NotEmpty(t, response.GetFingerprint())
+}
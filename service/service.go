@@ -0,0 +1,250 @@
+// Package service implements the operations of the PhotometryService gRPC API independent of the transport. The
+// gRPC server is provided by the separate module github.com/h44z/eulumies/service/grpcserver, so this module has no
+// gRPC dependency.
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/h44z/eulumies"
+)
+
+// Format of the photometric data, the values match the Format enum of the protobuf definition.
+type Format int
+
+const (
+	FormatUnspecified Format = iota // detect the format from the file content
+	FormatLDT
+	FormatIES
+)
+
+// Photometry is the parsed structure of a photometric file, exactly one of Eulumdat and IES is set.
+type Photometry struct {
+	Format   Format
+	Eulumdat *eulumies.Eulumdat
+	IES      *eulumies.IES
+}
+
+// ConvertRequest is a single conversion of a file to another format.
+type ConvertRequest struct {
+	Data    []byte
+	From    Format
+	To      Format // defaults to the other format
+	Options eulumies.ConversionOptions
+	Name    string // returned unchanged to correlate batch responses
+}
+
+// ConvertResponse is the result of a conversion, Error is set if the conversion failed.
+type ConvertResponse struct {
+	Data   []byte
+	Format Format
+	Name   string
+	Error  string
+}
+
+// Analysis holds the key photometric metrics of a file.
+type Analysis struct {
+	TotalFlux              float64 // lm
+	PeakIntensity          float64 // cd
+	PeakC                  float64
+	PeakGamma              float64
+	UpwardLightRatio       float64
+	UpwardLightOutputRatio float64
+	DarkSkyCompliant       bool
+	IntensityClass         string
+	SpacingCriterion       eulumies.SpacingCriterion
+	Fingerprint            string
+}
+
+// DetectFormat returns FormatIES if the (optionally gzip compressed) data starts with an IES format line or
+// keyword, FormatLDT otherwise.
+func DetectFormat(data []byte) Format {
+	var in io.Reader = bytes.NewReader(data)
+	if compressed, err := gzip.NewReader(in); err == nil {
+		in = compressed
+	} else {
+		in = bytes.NewReader(data)
+	}
+
+	scanner := bufio.NewScanner(in)
+	if scanner.Scan() {
+		line := strings.ToUpper(strings.TrimSpace(scanner.Text()))
+		if strings.HasPrefix(line, "IESNA") || strings.HasPrefix(line, "IES:") || strings.HasPrefix(line, "[") {
+			return FormatIES
+		}
+	}
+
+	return FormatLDT
+}
+
+// Parse parses the photometric data in the given format.
+func Parse(data []byte, format Format, strict bool) (Photometry, error) {
+	if format == FormatUnspecified {
+		format = DetectFormat(data)
+	}
+
+	switch format {
+	case FormatLDT:
+		eulumdat, err := eulumies.NewEulumdat(bytes.NewReader(data), strict)
+		if err != nil {
+			return Photometry{}, err
+		}
+
+		return Photometry{Format: FormatLDT, Eulumdat: &eulumdat}, nil
+	case FormatIES:
+		ies, err := eulumies.NewIESFromReader(bytes.NewReader(data), strict)
+		if err != nil {
+			return Photometry{}, err
+		}
+
+		return Photometry{Format: FormatIES, IES: ies}, nil
+	default:
+		return Photometry{}, errors.New("unsupported format")
+	}
+}
+
+// Convert converts the photometric data of the request and returns the exported file. Data already in the target
+// format is parsed and exported again.
+func Convert(request ConvertRequest) ConvertResponse {
+	response := ConvertResponse{Name: request.Name}
+	photometry, err := Parse(request.Data, request.From, false)
+	if err != nil {
+		response.Error = err.Error()
+		return response
+	}
+
+	target := request.To
+	if target == FormatUnspecified {
+		target = FormatIES
+		if photometry.Format == FormatIES {
+			target = FormatLDT
+		}
+	}
+	if target != photometry.Format {
+		if photometry, err = convert(photometry, request.Options); err != nil {
+			response.Error = err.Error()
+			return response
+		}
+	}
+
+	var out bytes.Buffer
+	if photometry.Eulumdat != nil {
+		err = photometry.Eulumdat.Export(&out)
+	} else {
+		err = photometry.IES.ExportTo(&out, eulumies.ExportOptions{})
+	}
+	if err != nil {
+		response.Error = err.Error()
+		return response
+	}
+	response.Data = out.Bytes()
+	response.Format = target
+
+	return response
+}
+
+// ConvertBatch converts all requests received until receive returns io.EOF, which matches the Recv and Send
+// methods of a bidirectional gRPC stream. Failed conversions are reported in their response and do not end the
+// batch.
+func ConvertBatch(receive func() (ConvertRequest, error), send func(ConvertResponse) error) error {
+	for {
+		request, err := receive()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := send(Convert(request)); err != nil {
+			return err
+		}
+	}
+}
+
+// ConvertAll converts all requests of a batch of known size. Failed conversions are reported in their response. The
+// optional progress function is called after each conversion with the name of the request.
+func ConvertAll(requests []ConvertRequest, progress eulumies.ProgressFunc) []ConvertResponse {
+	responses := make([]ConvertResponse, len(requests))
+	for i, request := range requests {
+		responses[i] = Convert(request)
+		if progress != nil {
+			progress(eulumies.Progress{Done: i + 1, Total: len(requests), File: request.Name})
+		}
+	}
+
+	return responses
+}
+
+// Validate parses the photometric data leniently and returns all validation issues.
+func Validate(data []byte, format Format, strict bool) (eulumies.ValidationIssues, error) {
+	photometry, err := Parse(data, format, false)
+	if err != nil {
+		return nil, err
+	}
+	if photometry.Eulumdat != nil {
+		return photometry.Eulumdat.Validate(strict), nil
+	}
+
+	return photometry.IES.Validate(strict), nil
+}
+
+// analyzable is implemented by eulumies.Eulumdat and *eulumies.IES.
+type analyzable interface {
+	ComputeTotalFlux() float64
+	GetPeakIntensity() (intensity, c, gamma float64)
+	ComputeUpwardLightRatio() float64
+	ComputeUpwardLightOutputRatio() float64
+	IsDarkSkyCompliant() bool
+	GetIntensityClass() string
+	GetSpacingCriterion() eulumies.SpacingCriterion
+	Fingerprint() string
+}
+
+// Analyze returns the key photometric metrics of the photometric data.
+func Analyze(data []byte, format Format) (Analysis, error) {
+	photometry, err := Parse(data, format, false)
+	if err != nil {
+		return Analysis{}, err
+	}
+
+	var source analyzable = photometry.IES
+	if photometry.Eulumdat != nil {
+		source = *photometry.Eulumdat
+	}
+	analysis := Analysis{
+		TotalFlux:              source.ComputeTotalFlux(),
+		UpwardLightRatio:       source.ComputeUpwardLightRatio(),
+		UpwardLightOutputRatio: source.ComputeUpwardLightOutputRatio(),
+		DarkSkyCompliant:       source.IsDarkSkyCompliant(),
+		IntensityClass:         source.GetIntensityClass(),
+		SpacingCriterion:       source.GetSpacingCriterion(),
+		Fingerprint:            source.Fingerprint(),
+	}
+	analysis.PeakIntensity, analysis.PeakC, analysis.PeakGamma = source.GetPeakIntensity()
+
+	return analysis, nil
+}
+
+// convert converts the photometry to the other format.
+func convert(photometry Photometry, opts eulumies.ConversionOptions) (Photometry, error) {
+	if photometry.Eulumdat != nil {
+		ies, err := eulumies.ConvertEulumdatToIES(photometry.Eulumdat, opts)
+		if err != nil {
+			return Photometry{}, err
+		}
+
+		return Photometry{Format: FormatIES, IES: ies}, nil
+	}
+
+	eulumdat, err := eulumies.ConvertIESToEulumdat(photometry.IES, opts)
+	if err != nil {
+		return Photometry{}, err
+	}
+
+	return Photometry{Format: FormatLDT, Eulumdat: eulumdat}, nil
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/h44z/eulumies"
+	"github.com/stretchr/testify/assert"
+)
+
+func readSample(t *testing.T, name string) []byte {
+	data, err := ioutil.ReadFile("../test/" + name)
+	assert.NoError(t, err)
+
+	return data
+}
+
+func TestDetectFormat(t *testing.T) {
+	assert.Equal(t, FormatLDT, DetectFormat(readSample(t, "sample2.ldt")))
+	assert.Equal(t, FormatIES, DetectFormat(readSample(t, "sample.ies")))
+
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	_, _ = writer.Write(readSample(t, "sample.ies"))
+	assert.NoError(t, writer.Close())
+	assert.Equal(t, FormatIES, DetectFormat(compressed.Bytes()))
+}
+
+func TestParse(t *testing.T) {
+	photometry, err := Parse(readSample(t, "sample2.ldt"), FormatUnspecified, false)
+	assert.NoError(t, err)
+	assert.Equal(t, FormatLDT, photometry.Format)
+	assert.Equal(t, "A SUPER LAMP 2", photometry.Eulumdat.LuminaireName)
+
+	_, err = Parse(readSample(t, "sample2.ldt"), FormatIES, false)
+	assert.Error(t, err)
+}
+
+func TestConvert(t *testing.T) {
+	response := Convert(ConvertRequest{Data: readSample(t, "sample2.ldt"), Name: "sample2"})
+	assert.Empty(t, response.Error)
+	assert.Equal(t, FormatIES, response.Format)
+	assert.Equal(t, "sample2", response.Name)
+
+	back := Convert(ConvertRequest{Data: response.Data, From: FormatIES, To: FormatLDT})
+	assert.Empty(t, back.Error)
+	photometry, err := Parse(back.Data, FormatLDT, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "A SUPER LAMP 2", photometry.Eulumdat.LuminaireName)
+
+	assert.NotEmpty(t, Convert(ConvertRequest{Data: []byte("broken")}).Error)
+}
+
+func TestConvertBatch(t *testing.T) {
+	requests := []ConvertRequest{
+		{Data: readSample(t, "sample2.ldt"), Name: "a"},
+		{Data: []byte("broken"), Name: "b"},
+		{Data: readSample(t, "sample.ies"), Name: "c"},
+	}
+	var responses []ConvertResponse
+	err := ConvertBatch(func() (ConvertRequest, error) {
+		if len(requests) == 0 {
+			return ConvertRequest{}, io.EOF
+		}
+		request := requests[0]
+		requests = requests[1:]
+		return request, nil
+	}, func(response ConvertResponse) error {
+		responses = append(responses, response)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Len(t, responses, 3)
+	assert.Empty(t, responses[0].Error)
+	assert.NotEmpty(t, responses[1].Error)
+	assert.Equal(t, FormatLDT, responses[2].Format)
+
+	failure := errors.New("stream closed")
+	err = ConvertBatch(func() (ConvertRequest, error) { return ConvertRequest{}, failure }, nil)
+	assert.Equal(t, failure, err)
+}
+
+func TestConvertAll(t *testing.T) {
+	requests := []ConvertRequest{
+		{Data: readSample(t, "sample2.ldt"), Name: "a"},
+		{Data: []byte("broken"), Name: "b"},
+	}
+	var progress []eulumies.Progress
+	responses := ConvertAll(requests, func(p eulumies.Progress) {
+		progress = append(progress, p)
+	})
+	assert.Len(t, responses, 2)
+	assert.Equal(t, FormatIES, responses[0].Format)
+	assert.NotEmpty(t, responses[1].Error)
+	assert.Equal(t, []eulumies.Progress{{Done: 1, Total: 2, File: "a"}, {Done: 2, Total: 2, File: "b"}}, progress)
+}
+
+func TestValidateAndAnalyze(t *testing.T) {
+	issues, err := Validate(readSample(t, "sample2.ldt"), FormatLDT, true)
+	assert.NoError(t, err)
+	assert.True(t, issues.Valid())
+
+	analysis, err := Analyze(readSample(t, "sample2.ldt"), FormatUnspecified)
+	assert.NoError(t, err)
+	assert.InDelta(t, 284.8, analysis.TotalFlux, 0.1)
+	assert.NotEmpty(t, analysis.Fingerprint)
+
+	photometry, err := Parse(readSample(t, "sample2.ldt"), FormatLDT, false)
+	assert.NoError(t, err)
+	relativePeak := photometry.Eulumdat.GetOverallMaximumLuminousIntensity()
+	assert.InDelta(t, relativePeak*photometry.Eulumdat.TotalLuminousFluxLamps[0]/1000, analysis.PeakIntensity, 1e-9)
+}
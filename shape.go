@@ -0,0 +1,104 @@
+package eulumies
+
+import (
+	"errors"
+	"math"
+)
+
+// DistributionShape is a coarse classification of a luminous intensity distribution, meant for catalog filtering.
+type DistributionShape string
+
+const (
+	ShapeNarrow     DistributionShape = "narrow"     // half-peak width below 40 degrees
+	ShapeMedium     DistributionShape = "medium"     // half-peak width between 40 and 90 degrees
+	ShapeWide       DistributionShape = "wide"       // half-peak width of 90 degrees or more
+	ShapeBatwing    DistributionShape = "batwing"    // peak intensity off-axis, well above the nadir intensity
+	ShapeAsymmetric DistributionShape = "asymmetric" // beam axis tilted away from nadir
+)
+
+// ShapeUniformityGamma is the gamma angle (degrees) at which ShapeMetrics.PlaneUniformity is evaluated.
+const ShapeUniformityGamma = 30.0
+
+const (
+	shapeBatwingFactor   = 1.2  // minimum ratio of peak to nadir intensity of a batwing distribution
+	shapeAsymmetricTilt  = 10.0 // minimum tilt (degrees) of the beam axis of an asymmetric distribution
+	shapeNarrowBeamWidth = 40.0
+	shapeMediumBeamWidth = 90.0
+	shapeUniformityCStep = 15.0 // C-plane step (degrees) of the uniformity evaluation
+)
+
+// ShapeMetrics characterizes the shape of a luminous intensity distribution. All metrics are ratios, so they do not
+// depend on the scaling of the intensities.
+type ShapeMetrics struct {
+	BatwingFactor   float64 // peak intensity divided by the nadir intensity, 0 if there is no intensity at nadir
+	CenterBeamRatio float64 // nadir intensity divided by the mean intensity of the lower hemisphere (2 = Lambertian)
+	PlaneUniformity float64 // minimum divided by maximum intensity of all C-planes at ShapeUniformityGamma
+	BeamWidth       float64 // mean half-peak width (degrees) of the C0-C180 and C90-C270 planes
+	Shape           DistributionShape
+}
+
+// computeShapeMetrics returns the shape metrics of the given distribution.
+func computeShapeMetrics(cAngles, gAngles []float64, planes [][]float64) (ShapeMetrics, error) {
+	if len(cAngles) == 0 || len(gAngles) == 0 {
+		return ShapeMetrics{}, errors.New("no luminous intensity distribution")
+	}
+
+	nadir := interpolateIntensity(cAngles, gAngles, planes, 0, 0)
+	peak := 0.0
+	for _, plane := range planes {
+		for g, value := range plane {
+			if g < len(gAngles) && gAngles[g] <= 90 {
+				peak = math.Max(peak, value)
+			}
+		}
+	}
+	if peak <= 0 {
+		return ShapeMetrics{}, errors.New("no downward luminous intensity")
+	}
+
+	var metrics ShapeMetrics
+	if nadir > 0 {
+		metrics.BatwingFactor = peak / nadir
+	}
+	if downward := zonalFluxBetween(cAngles, gAngles, planes, 0, 90); downward > 0 {
+		metrics.CenterBeamRatio = nadir / (downward / (2 * math.Pi))
+	}
+	metrics.PlaneUniformity = planeUniformity(cAngles, gAngles, planes, ShapeUniformityGamma)
+
+	for _, c := range []float64{0, 90} {
+		angles, values := planeProfile(cAngles, gAngles, planes, c)
+		metrics.BeamWidth += beamWidth(angles, values, 0.5) / 2
+	}
+
+	_, tilt := beamCentroid(cAngles, gAngles, planes)
+	switch {
+	case tilt > shapeAsymmetricTilt && tilt < 180-shapeAsymmetricTilt:
+		metrics.Shape = ShapeAsymmetric
+	case nadir <= 0 || metrics.BatwingFactor >= shapeBatwingFactor:
+		metrics.Shape = ShapeBatwing
+	case metrics.BeamWidth < shapeNarrowBeamWidth:
+		metrics.Shape = ShapeNarrow
+	case metrics.BeamWidth < shapeMediumBeamWidth:
+		metrics.Shape = ShapeMedium
+	default:
+		metrics.Shape = ShapeWide
+	}
+
+	return metrics, nil
+}
+
+// planeUniformity returns the minimum divided by the maximum intensity of all C-planes at the given gamma angle.
+// Returns 0 if there is no intensity at that angle.
+func planeUniformity(cAngles, gAngles []float64, planes [][]float64, gamma float64) float64 {
+	min, max := math.Inf(1), 0.0
+	for c := 0.0; c < 360; c += shapeUniformityCStep {
+		value := interpolateIntensity(cAngles, gAngles, planes, c, gamma)
+		min = math.Min(min, value)
+		max = math.Max(max, value)
+	}
+	if max <= 0 {
+		return 0
+	}
+
+	return min / max
+}
@@ -0,0 +1,77 @@
+package eulumies
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// rotationalPlanes returns a rotationally symmetric distribution with the given intensity function of gamma.
+func rotationalPlanes(intensity func(gamma float64) float64) ([]float64, []float64, [][]float64) {
+	gAngles := make([]float64, 37)
+	plane := make([]float64, 37)
+	for g := range gAngles {
+		gAngles[g] = float64(g) * 5
+		plane[g] = math.Max(0, intensity(gAngles[g]))
+	}
+
+	return []float64{0}, gAngles, [][]float64{plane}
+}
+
+func Test_computeShapeMetrics(t *testing.T) {
+	lambertian := func(gamma float64) float64 { return 100 * math.Cos(degToRad(gamma)) }
+	metrics, err := computeShapeMetrics(rotationalPlanes(lambertian))
+	assert.NoError(t, err)
+	assert.InDelta(t, 1, metrics.BatwingFactor, 1e-9)
+	assert.InDelta(t, 2, metrics.CenterBeamRatio, 0.01)
+	assert.InDelta(t, 1, metrics.PlaneUniformity, 1e-9)
+	assert.InDelta(t, 120, metrics.BeamWidth, 0.5)
+	assert.Equal(t, ShapeWide, metrics.Shape)
+
+	spot := func(gamma float64) float64 { return 100 * math.Pow(math.Cos(degToRad(gamma)), 40) }
+	metrics, err = computeShapeMetrics(rotationalPlanes(spot))
+	assert.NoError(t, err)
+	assert.Greater(t, metrics.CenterBeamRatio, 20.0)
+	assert.Equal(t, ShapeNarrow, metrics.Shape)
+
+	batwing := func(gamma float64) float64 { return 100 / math.Pow(math.Cos(degToRad(math.Min(gamma, 60))), 2) }
+	metrics, err = computeShapeMetrics(rotationalPlanes(func(gamma float64) float64 {
+		if gamma > 60 {
+			return 0
+		}
+		return batwing(gamma)
+	}))
+	assert.NoError(t, err)
+	assert.InDelta(t, 4, metrics.BatwingFactor, 1e-9)
+	assert.Equal(t, ShapeBatwing, metrics.Shape)
+
+	// lambertian tilted towards C0
+	cAngles := []float64{0, 90, 180, 270}
+	_, gAngles, _ := rotationalPlanes(lambertian)
+	planes := make([][]float64, len(cAngles))
+	for i, c := range cAngles {
+		planes[i] = make([]float64, len(gAngles))
+		for g, gamma := range gAngles {
+			x := math.Sin(degToRad(gamma)) * math.Cos(degToRad(c))
+			z := math.Cos(degToRad(gamma))
+			planes[i][g] = math.Max(0, 100*(z*math.Cos(degToRad(30))+x*math.Sin(degToRad(30))))
+		}
+	}
+	metrics, err = computeShapeMetrics(cAngles, gAngles, planes)
+	assert.NoError(t, err)
+	assert.InDelta(t, 0.5, metrics.PlaneUniformity, 1e-9) // cos(60°) / cos(0°)
+	assert.Equal(t, ShapeAsymmetric, metrics.Shape)
+
+	_, err = computeShapeMetrics(nil, nil, nil)
+	assert.Error(t, err)
+}
+
+func TestEulumdat_ComputeShapeMetrics(t *testing.T) {
+	eulumdat := loadSample2(t)
+
+	metrics, err := eulumdat.ComputeShapeMetrics()
+	assert.NoError(t, err)
+	assert.Greater(t, metrics.CenterBeamRatio, 0.0)
+	assert.NotEmpty(t, metrics.Shape)
+}
@@ -0,0 +1,218 @@
+package eulumies
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// signatureKeyword is the user defined IES keyword that holds the integrity signature.
+const signatureKeyword = "_SIGNATURE"
+
+// signatureExtension is the EULUMDAT extension that holds the integrity signature.
+const signatureExtension = "SIGNATURE"
+
+// Prefixes of the signature values, they select the algorithm used by the verification.
+const (
+	signatureDigest = "sha256:"
+	signatureHMAC   = "hmac-sha256:"
+)
+
+// Sign embeds an integrity signature of the photometric content in the _SIGNATURE keyword, so hand edits after the
+// release of the data can be detected with Verify. Without key the signature is a SHA-256 digest, which detects
+// accidental changes only. With key it is an HMAC-SHA256, which can only be created and verified with the same key.
+//
+// The content is normalized: numbers are compared with two decimal places (the default IES export precision) and
+// white space in texts is ignored, so the signature survives exporting and parsing the data again. Exporting with
+// a lower precision invalidates it.
+func (i *IES) Sign(key []byte) error {
+	if i.Format == IESFormatLM_63_1986 {
+		return errors.New("format LM-63-1986 does not support keywords")
+	}
+
+	delete(i.Keywords, signatureKeyword)
+	signature := signContent(i.writeSignatureContent, key)
+
+	// wrapped to the keyword line length of older formats, Verify ignores the line breaks
+	return i.SetKeyword(signatureKeyword, signature, KeywordOverflowSplit).Err()
+}
+
+// Verify checks the integrity signature embedded by Sign, the key must match the one used for signing. Returns an
+// error if the data contains no signature or the content was changed.
+func (i *IES) Verify(key []byte) error {
+	return verifySignature(i.Keywords[signatureKeyword], i.writeSignatureContent, key)
+}
+
+// writeSignatureContent writes the normalized content of the IES data without the signature.
+func (i *IES) writeSignatureContent(content *signatureContent) {
+	content.text("FORMAT", string(i.Format))
+	keywords := make([]string, 0, len(i.Keywords))
+	for keyword := range i.Keywords {
+		if keyword != signatureKeyword {
+			keywords = append(keywords, keyword)
+		}
+	}
+	sort.Strings(keywords)
+	for _, keyword := range keywords {
+		content.text("["+keyword+"]", i.Keywords[keyword])
+	}
+
+	content.text("TILT", string(i.Tilt))
+	content.integers("TILTDATA", i.TiltLampToLuminaireGeometry, i.TiltAnglesAndFactors)
+	content.numbers("TILTANGLES", i.TiltAngles...)
+	content.numbers("TILTFACTORS", i.TiltMultiplierFactors...)
+	content.integers("LAMPS", i.NumberLamps)
+	content.numbers("LUMENS", i.LumensPerLamp, i.CandelaMultiplier)
+	content.integers("ANGLES", i.NumberVerticalAngles, i.NumberHorizontalAngles, i.PhotometricType, i.UnitsType)
+	content.numbers("DIMENSIONS", i.LuminaireWidth, i.LuminaireLength, i.LuminaireHeight)
+	content.numbers("FACTORS", i.BallastFactor, i.FutureUse, i.InputWatts)
+	content.numbers("V", i.VerticalAngles...)
+	content.numbers("H", i.HorizontalAngles...)
+	for _, values := range i.CandelaValues {
+		content.numbers("CD", values...)
+	}
+}
+
+// Sign embeds an integrity signature of the photometric content in the SIGNATURE extension, so hand edits after the
+// release of the data can be detected with Verify. Without key the signature is a SHA-256 digest, which detects
+// accidental changes only. With key it is an HMAC-SHA256, which can only be created and verified with the same key.
+//
+// The content is normalized: numbers are compared with two decimal places and white space in texts is ignored, so
+// the signature survives exporting and parsing the data again.
+func (e *Eulumdat) Sign(key []byte) {
+	delete(e.Extensions, signatureExtension)
+	signature := signContent(e.writeSignatureContent, key)
+	if e.Extensions == nil {
+		e.Extensions = make(map[string]string)
+	}
+	e.Extensions[signatureExtension] = signature
+}
+
+// Verify checks the integrity signature embedded by Sign, the key must match the one used for signing. Returns an
+// error if the data contains no signature or the content was changed.
+func (e Eulumdat) Verify(key []byte) error {
+	return verifySignature(e.Extensions[signatureExtension], e.writeSignatureContent, key)
+}
+
+// writeSignatureContent writes the normalized content of the EULUMDAT data without the signature.
+func (e Eulumdat) writeSignatureContent(content *signatureContent) {
+	content.text("COMPANY", e.CompanyIdentification)
+	content.integers("TYPE", e.TypeIndicator, e.SymmetryIndicator, e.NumberMcCPlanes, e.NumberNgIntensitiesCPlane)
+	content.numbers("DISTANCES", e.DistanceDcCPlanes, e.DistanceDgCPlane)
+	content.text("REPORT", e.MeasurementReportNumber)
+	content.text("NAME", e.LuminaireName)
+	content.text("NUMBER", e.LuminaireNumber)
+	content.text("FILE", e.FileName)
+	content.text("DATE", e.DateUser)
+	content.numbers("DIMENSIONS", e.LengthDiameter, e.WidthLuminaire, e.HeightLuminaire, e.LengthDiameterLuminousArea,
+		e.WidthLuminousArea, e.HeightLuminousAreaC0, e.HeightLuminousAreaC90, e.HeightLuminousAreaC180,
+		e.HeightLuminousAreaC270)
+	content.numbers("RATIOS", e.DownwardFluxFractionPhiu, e.LightOutputRatioLuminaire, e.IntensityConversionFactor,
+		e.MeasurementTiltLuminaire)
+	content.integers("SETS", e.NumberStandardSetLamps)
+	content.integers("LAMPS", e.NumberLamps...)
+	for set := range e.TypeLamps {
+		content.text("LAMPTYPE", e.TypeLamps[set])
+	}
+	content.numbers("FLUX", e.TotalLuminousFluxLamps...)
+	for set := range e.ColorTemperature {
+		content.text("CCT", e.ColorTemperature[set])
+	}
+	for set := range e.ColorRenderingIndexCRI {
+		content.text("CRI", e.ColorRenderingIndexCRI[set])
+	}
+	content.numbers("WATTS", e.BallastWatts...)
+	content.numbers("DR", e.DirectRatios[:]...)
+	content.numbers("C", e.AnglesC...)
+	content.numbers("G", e.AnglesG...)
+	content.numbers("I", e.LuminousIntensityDistributionRaw...)
+
+	keys := make([]string, 0, len(e.Extensions))
+	for key := range e.Extensions {
+		if key != signatureExtension {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		content.text("["+key+"]", e.Extensions[key])
+	}
+}
+
+// signatureContent writes the normalized content of photometric data to the hash of a signature. Every entry is
+// written as a named line, so moving values between fields changes the signature.
+type signatureContent struct {
+	hash hash.Hash
+}
+
+// text writes the text without white space.
+func (c *signatureContent) text(name, value string) {
+	value = strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, value)
+	c.hash.Write([]byte(name + " " + value + "\n"))
+}
+
+// numbers writes the values with two decimal places.
+func (c *signatureContent) numbers(name string, values ...float64) {
+	buffer := []byte(name)
+	for _, value := range values {
+		buffer = append(buffer, ' ')
+		buffer = strconv.AppendFloat(buffer, value, 'f', 2, 64)
+	}
+	c.hash.Write(append(buffer, '\n'))
+}
+
+// integers writes the values.
+func (c *signatureContent) integers(name string, values ...int) {
+	buffer := []byte(name)
+	for _, value := range values {
+		buffer = append(buffer, ' ')
+		buffer = strconv.AppendInt(buffer, int64(value), 10)
+	}
+	c.hash.Write(append(buffer, '\n'))
+}
+
+// signContent returns the signature of the content written by the given function, an HMAC-SHA256 if a key is given
+// and a SHA-256 digest otherwise.
+func signContent(write func(content *signatureContent), key []byte) string {
+	prefix, content := signatureDigest, &signatureContent{hash: sha256.New()}
+	if key != nil {
+		prefix, content = signatureHMAC, &signatureContent{hash: hmac.New(sha256.New, key)}
+	}
+	write(content)
+
+	return prefix + hex.EncodeToString(content.hash.Sum(nil))
+}
+
+// verifySignature checks the signature against the content written by the given function. A keyed signature can
+// only be verified with a key and a key requires a keyed signature, since anyone can compute a digest.
+func verifySignature(signature string, write func(content *signatureContent), key []byte) error {
+	signature = strings.Join(strings.Fields(signature), "")
+	switch {
+	case signature == "":
+		return errors.New("no integrity signature")
+	case strings.HasPrefix(signature, signatureHMAC) && key == nil:
+		return errors.New("integrity signature requires a key")
+	case strings.HasPrefix(signature, signatureDigest) && key != nil:
+		return errors.New("integrity signature is not keyed")
+	case !strings.HasPrefix(signature, signatureHMAC) && !strings.HasPrefix(signature, signatureDigest):
+		return fmt.Errorf("unknown integrity signature %q", signature)
+	}
+
+	if !hmac.Equal([]byte(signContent(write, key)), []byte(signature)) {
+		return errors.New("integrity signature does not match the content")
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package eulumies
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIES_Sign(t *testing.T) {
+	ies, err := NewIES("test/sample.ies", false)
+	assert.NoError(t, err)
+	assert.EqualError(t, ies.Verify(nil), "no integrity signature")
+
+	key := []byte("lab secret")
+	assert.NoError(t, ies.Sign(key))
+	assert.NoError(t, ies.Verify(key))
+	assert.EqualError(t, ies.Verify(nil), "integrity signature requires a key")
+	assert.EqualError(t, ies.Verify([]byte("other")), "integrity signature does not match the content")
+
+	// the signature is wrapped to the LM-63-1995 keyword lines and survives the export
+	var buffer bytes.Buffer
+	assert.NoError(t, ies.ExportTo(&buffer, ExportOptions{}))
+	exported, err := NewIESFromReader(&buffer, false)
+	assert.NoError(t, err)
+	assert.NoError(t, exported.Verify(key))
+
+	exported.CandelaValues[0][3] += 0.1
+	assert.Error(t, exported.Verify(key))
+	exported.CandelaValues[0][3] -= 0.1
+	exported.Keywords["LUMCAT"] += "X"
+	assert.Error(t, exported.Verify(key))
+	exported.Keywords["LUMCAT"] = ies.Keywords["LUMCAT"]
+	assert.NoError(t, exported.Verify(key))
+
+	assert.NoError(t, exported.Sign(nil))
+	assert.NoError(t, exported.Verify(nil))
+	assert.EqualError(t, exported.Verify(key), "integrity signature is not keyed")
+
+	exported.Format = IESFormatLM_63_1986
+	assert.Error(t, exported.Sign(nil))
+}
+
+func TestEulumdat_Sign(t *testing.T) {
+	eulumdat := loadSample2(t)
+	assert.Error(t, eulumdat.Verify(nil))
+
+	eulumdat.Sign(nil)
+	assert.NoError(t, eulumdat.Verify(nil))
+
+	var buffer bytes.Buffer
+	assert.NoError(t, eulumdat.Export(&buffer))
+	exported, err := NewEulumdat(&buffer, false)
+	assert.NoError(t, err)
+	assert.NoError(t, exported.Verify(nil))
+
+	exported.LuminousIntensityDistributionRaw[10] *= 1.01
+	assert.EqualError(t, exported.Verify(nil), "integrity signature does not match the content")
+}
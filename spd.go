@@ -0,0 +1,353 @@
+package eulumies
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// spdKeyword is the user defined IES keyword holding the spectral power distribution. Conversions carry it to the
+// EULUMDAT extension spdExtension and back.
+const spdKeyword = "_SPD"
+
+// spdExtension is the name of the extension holding the spectral power distribution in EULUMDAT data.
+const spdExtension = "SPD"
+
+// spdPairsPerLine is the number of wavelength/value pairs per IES keyword line, which keeps the lines below the
+// length limit of the older formats.
+const spdPairsPerLine = 4
+
+// SpectralPowerDistribution is the relative spectral power of the light source, sampled at ascending wavelengths.
+type SpectralPowerDistribution struct {
+	Wavelengths []float64 // nm
+	Values      []float64 // relative spectral power
+}
+
+// NewSpectralPowerDistribution reads a spectral power distribution from a text file with one wavelength (nm) and
+// value per line, separated by whitespace, a comma or a semicolon. Lines not starting with a number, like a CSV
+// header or comments, are ignored.
+func NewSpectralPowerDistribution(in io.Reader) (SpectralPowerDistribution, error) {
+	in, err := decompressReader(in)
+	if err != nil {
+		return SpectralPowerDistribution{}, err
+	}
+
+	var spd SpectralPowerDistribution
+	scanner := bufio.NewScanner(in)
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.FieldsFunc(scanner.Text(), func(r rune) bool {
+			return r == ' ' || r == '\t' || r == ',' || r == ';'
+		})
+		if len(fields) == 0 {
+			continue
+		}
+		wavelength, err := strconv.ParseFloat(fields[0], 64)
+		if err != nil {
+			continue // header or comment
+		}
+		if len(fields) != 2 {
+			return SpectralPowerDistribution{}, fmt.Errorf("line %d: expected wavelength and value", line)
+		}
+		value, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil {
+			return SpectralPowerDistribution{}, fmt.Errorf("line %d: %w", line, err)
+		}
+		spd.Wavelengths = append(spd.Wavelengths, wavelength)
+		spd.Values = append(spd.Values, value)
+	}
+	if err = scanner.Err(); err != nil {
+		return SpectralPowerDistribution{}, err
+	}
+	if err = spd.Validate(); err != nil {
+		return SpectralPowerDistribution{}, err
+	}
+
+	return spd, nil
+}
+
+// ReadSidecarSpectralPowerDistribution reads the spectral power distribution stored next to the given photometric
+// file. The sidecar file has the same name with the extension .spd, e.g. luminaire.spd for luminaire.ldt.gz.
+func ReadSidecarSpectralPowerDistribution(path string) (SpectralPowerDistribution, error) {
+	base := strings.TrimSuffix(path, filepath.Ext(path))
+	if strings.EqualFold(filepath.Ext(path), ".gz") {
+		base = strings.TrimSuffix(base, filepath.Ext(base))
+	}
+
+	file, err := os.Open(base + ".spd")
+	if err != nil {
+		return SpectralPowerDistribution{}, err
+	}
+	defer file.Close()
+
+	return NewSpectralPowerDistribution(file)
+}
+
+// parseSpectralPowerDistribution parses the keyword notation "wavelength:value", pairs are separated by whitespace
+// or line breaks.
+func parseSpectralPowerDistribution(value string) (SpectralPowerDistribution, error) {
+	var spd SpectralPowerDistribution
+	for _, pair := range strings.Fields(value) {
+		parts := strings.Split(pair, ":")
+		if len(parts) != 2 {
+			return SpectralPowerDistribution{}, fmt.Errorf("invalid spectral value %q, expected wavelength:value", pair)
+		}
+		wavelength, err := strconv.ParseFloat(parts[0], 64)
+		if err != nil {
+			return SpectralPowerDistribution{}, fmt.Errorf("invalid wavelength %q", parts[0])
+		}
+		power, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil {
+			return SpectralPowerDistribution{}, fmt.Errorf("invalid spectral value %q", parts[1])
+		}
+		spd.Wavelengths = append(spd.Wavelengths, wavelength)
+		spd.Values = append(spd.Values, power)
+	}
+	if err := spd.Validate(); err != nil {
+		return SpectralPowerDistribution{}, err
+	}
+
+	return spd, nil
+}
+
+// format returns the keyword notation of the spectral power distribution with the given number of pairs per line.
+// A count of 0 writes all pairs on one line.
+func (s SpectralPowerDistribution) format(pairsPerLine int) string {
+	var builder strings.Builder
+	for i := range s.Wavelengths {
+		if i > 0 {
+			if pairsPerLine > 0 && i%pairsPerLine == 0 {
+				builder.WriteString("\n")
+			} else {
+				builder.WriteString(" ")
+			}
+		}
+		builder.WriteString(strconv.FormatFloat(s.Wavelengths[i], 'f', -1, 64))
+		builder.WriteString(":")
+		builder.WriteString(strconv.FormatFloat(s.Values[i], 'g', 6, 64))
+	}
+
+	return builder.String()
+}
+
+// Validate checks that the wavelengths ascend and all values are non-negative.
+func (s SpectralPowerDistribution) Validate() error {
+	if len(s.Wavelengths) < 2 {
+		return errors.New("spectral power distribution requires at least two wavelengths")
+	}
+	if len(s.Wavelengths) != len(s.Values) {
+		return fmt.Errorf("%d wavelengths but %d spectral values", len(s.Wavelengths), len(s.Values))
+	}
+	for i := range s.Wavelengths {
+		if i > 0 && s.Wavelengths[i] <= s.Wavelengths[i-1] {
+			return fmt.Errorf("wavelength %g is not ascending", s.Wavelengths[i])
+		}
+		if s.Values[i] < 0 || math.IsNaN(s.Values[i]) || math.IsInf(s.Values[i], 0) {
+			return fmt.Errorf("invalid spectral value %g at %g nm", s.Values[i], s.Wavelengths[i])
+		}
+	}
+
+	return nil
+}
+
+// Chromaticity returns the CIE 1931 xy chromaticity coordinates. The color matching functions are evaluated with the
+// multi-lobe approximation of Wyman, Sloan and Shirley (2013).
+func (s SpectralPowerDistribution) Chromaticity() (x, y float64, err error) {
+	if err = s.Validate(); err != nil {
+		return 0, 0, err
+	}
+
+	var tristimulus [3]float64
+	for i := 1; i < len(s.Wavelengths); i++ {
+		width := s.Wavelengths[i] - s.Wavelengths[i-1]
+		previous := colorMatchingFunctions(s.Wavelengths[i-1])
+		current := colorMatchingFunctions(s.Wavelengths[i])
+		for n := range tristimulus {
+			tristimulus[n] += width * (previous[n]*s.Values[i-1] + current[n]*s.Values[i]) / 2
+		}
+	}
+
+	sum := tristimulus[0] + tristimulus[1] + tristimulus[2]
+	if sum <= 0 {
+		return 0, 0, errors.New("spectral power distribution contains no visible radiation")
+	}
+
+	return tristimulus[0] / sum, tristimulus[1] / sum, nil
+}
+
+// EstimateColorTemperature returns the correlated color temperature (K) and the distance Duv to the Planckian locus
+// in the CIE 1960 uv diagram, positive above the locus. Only temperatures between 1000 K and 15000 K are supported,
+// chromaticities with a distance of more than 0.05 have no meaningful color temperature.
+func (s SpectralPowerDistribution) EstimateColorTemperature() (cct, duv float64, err error) {
+	x, y, err := s.Chromaticity()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	denominator := -2*x + 12*y + 3
+	u, v := 4*x/denominator, 6*y/denominator
+	distance := func(temperature float64) float64 {
+		pu, pv := planckianLocus(temperature)
+		return math.Hypot(u-pu, v-pv)
+	}
+
+	// coarse scan followed by a golden section search around the closest temperature
+	cct = minColorTemperature
+	for temperature := minColorTemperature; temperature <= maxColorTemperature; temperature += 100 {
+		if distance(temperature) < distance(cct) {
+			cct = temperature
+		}
+	}
+	low, high := math.Max(minColorTemperature, cct-100), math.Min(maxColorTemperature, cct+100)
+	ratio := (math.Sqrt(5) - 1) / 2
+	for high-low > 0.01 {
+		a, b := high-ratio*(high-low), low+ratio*(high-low)
+		if distance(a) < distance(b) {
+			high = b
+		} else {
+			low = a
+		}
+	}
+	cct = (low + high) / 2
+
+	duv = distance(cct)
+	if _, pv := planckianLocus(cct); v < pv {
+		duv = -duv
+	}
+	if math.Abs(duv) > 0.05 {
+		return 0, 0, fmt.Errorf("chromaticity %.4f/%.4f is too far from the planckian locus (Duv %.4f)", x, y, duv)
+	}
+	if cct <= minColorTemperature+1 || cct >= maxColorTemperature-1 {
+		return 0, 0, fmt.Errorf("color temperature out of range (%g - %g K)", minColorTemperature,
+			maxColorTemperature)
+	}
+
+	return cct, duv, nil
+}
+
+// Color temperature range of the planckian locus approximation.
+const (
+	minColorTemperature = 1000.0
+	maxColorTemperature = 15000.0
+)
+
+// planckianLocus returns the CIE 1960 uv coordinates of a black body with the given temperature (K), using the
+// rational approximation of Krystek (1985).
+func planckianLocus(temperature float64) (u, v float64) {
+	t := temperature
+	u = (0.860117757 + 1.54118254e-4*t + 1.28641212e-7*t*t) / (1 + 8.42420235e-4*t + 7.08145163e-7*t*t)
+	v = (0.317398726 + 4.22806245e-5*t + 4.20481691e-8*t*t) / (1 - 2.89741816e-5*t + 1.61456053e-7*t*t)
+
+	return u, v
+}
+
+// colorMatchingFunctions returns the CIE 1931 2° color matching functions x̄, ȳ and z̄ at the wavelength (nm).
+func colorMatchingFunctions(wavelength float64) [3]float64 {
+	lobe := func(mean, below, above float64) float64 {
+		deviation := below
+		if wavelength >= mean {
+			deviation = above
+		}
+		t := (wavelength - mean) / deviation
+		return math.Exp(-t * t / 2)
+	}
+
+	return [3]float64{
+		1.056*lobe(599.8, 37.9, 31.0) + 0.362*lobe(442.0, 16.0, 26.7) - 0.065*lobe(501.1, 20.4, 26.2),
+		0.821*lobe(568.8, 46.9, 40.5) + 0.286*lobe(530.9, 16.3, 31.1),
+		1.217*lobe(437.0, 11.8, 36.0) + 0.681*lobe(459.0, 26.0, 13.8),
+	}
+}
+
+// SpectralPowerDistribution parses the _SPD keyword.
+func (i *IES) SpectralPowerDistribution() (SpectralPowerDistribution, error) {
+	value, ok := i.Keywords[spdKeyword]
+	if !ok {
+		return SpectralPowerDistribution{}, fmt.Errorf("keyword %s not set", spdKeyword)
+	}
+
+	return parseSpectralPowerDistribution(value)
+}
+
+// SetSpectralPowerDistribution sets the _SPD keyword, which is written as several keyword lines.
+func (i *IES) SetSpectralPowerDistribution(spd SpectralPowerDistribution) error {
+	if err := spd.Validate(); err != nil {
+		return err
+	}
+	i.setKeyword(spdKeyword, spd.format(spdPairsPerLine))
+
+	return nil
+}
+
+// EstimateColorTemperature returns the correlated color temperature (K) of the spectral power distribution in the
+// _SPD keyword. IES defines no keyword for the color temperature, so it can only be estimated.
+func (i *IES) EstimateColorTemperature() (float64, error) {
+	spd, err := i.SpectralPowerDistribution()
+	if err != nil {
+		return 0, err
+	}
+	cct, _, err := spd.EstimateColorTemperature()
+
+	return cct, err
+}
+
+// SpectralPowerDistribution parses the SPD extension.
+func (e Eulumdat) SpectralPowerDistribution() (SpectralPowerDistribution, error) {
+	value, ok := e.Extensions[spdExtension]
+	if !ok {
+		return SpectralPowerDistribution{}, fmt.Errorf("extension %s not set", spdExtension)
+	}
+
+	return parseSpectralPowerDistribution(value)
+}
+
+// SetSpectralPowerDistribution sets the SPD extension.
+func (e *Eulumdat) SetSpectralPowerDistribution(spd SpectralPowerDistribution) error {
+	if err := spd.Validate(); err != nil {
+		return err
+	}
+	if e.Extensions == nil {
+		e.Extensions = make(map[string]string)
+	}
+	e.Extensions[spdExtension] = spd.format(0)
+
+	return nil
+}
+
+// EstimateColorTemperature returns the color temperature of the given standard set of lamps (field 26d). If the field is
+// empty, the color temperature is estimated from the SPD extension and formatted like "4000K".
+func (e Eulumdat) EstimateColorTemperature(set int) (string, error) {
+	if set < 0 || set >= len(e.ColorTemperature) {
+		return "", fmt.Errorf("lamp set %d does not exist", set)
+	}
+	if value := strings.TrimSpace(e.ColorTemperature[set]); value != "" {
+		return value, nil
+	}
+
+	spd, err := e.SpectralPowerDistribution()
+	if err != nil {
+		return "", err
+	}
+	cct, _, err := spd.EstimateColorTemperature()
+	if err != nil {
+		return "", err
+	}
+
+	return strconv.FormatFloat(math.Round(cct), 'f', -1, 64) + "K", nil
+}
+
+// convertSpectralPowerDistribution copies the spectral power distribution between the IES keyword and the EULUMDAT
+// extension notation, invalid data is dropped.
+func convertSpectralPowerDistribution(value string, pairsPerLine int) (string, bool) {
+	spd, err := parseSpectralPowerDistribution(value)
+	if err != nil {
+		return "", false
+	}
+
+	return spd.format(pairsPerLine), true
+}
@@ -0,0 +1,116 @@
+package eulumies
+
+import (
+	"bytes"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// blackBody returns the spectral power distribution of a black body with the given temperature (K).
+func blackBody(temperature float64) SpectralPowerDistribution {
+	const c2 = 1.4388e-2 // second radiation constant (m K)
+	var spd SpectralPowerDistribution
+	for wavelength := 380.0; wavelength <= 780; wavelength += 5 {
+		meters := wavelength * 1e-9
+		spd.Wavelengths = append(spd.Wavelengths, wavelength)
+		spd.Values = append(spd.Values, 1e-30/(math.Pow(meters, 5)*(math.Exp(c2/(meters*temperature))-1)))
+	}
+
+	return spd
+}
+
+func TestSpectralPowerDistribution_EstimateColorTemperature(t *testing.T) {
+	for _, temperature := range []float64{2700, 4000, 6500} {
+		cct, duv, err := blackBody(temperature).EstimateColorTemperature()
+		assert.NoError(t, err)
+		assert.InDelta(t, temperature, cct, temperature*0.01)
+		assert.InDelta(t, 0, duv, 0.002)
+	}
+
+	// monochromatic green light is far off the planckian locus
+	spd := SpectralPowerDistribution{Wavelengths: []float64{520, 525, 530}, Values: []float64{0, 1, 0}}
+	_, _, err := spd.EstimateColorTemperature()
+	assert.Error(t, err)
+
+	// infrared only
+	spd = SpectralPowerDistribution{Wavelengths: []float64{1500, 1600}, Values: []float64{1, 1}}
+	_, _, err = spd.EstimateColorTemperature()
+	assert.Error(t, err)
+}
+
+func TestNewSpectralPowerDistribution(t *testing.T) {
+	spd, err := NewSpectralPowerDistribution(strings.NewReader("wavelength,value\n# comment\n380,0.1\n385;0.2\n\n390 0.3\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{380, 385, 390}, spd.Wavelengths)
+	assert.Equal(t, []float64{0.1, 0.2, 0.3}, spd.Values)
+
+	_, err = NewSpectralPowerDistribution(strings.NewReader("380,0.1\n375,0.2\n"))
+	assert.Error(t, err)
+	_, err = NewSpectralPowerDistribution(strings.NewReader("380,0.1,2\n385,0.2\n"))
+	assert.Error(t, err)
+	_, err = NewSpectralPowerDistribution(strings.NewReader("380,-1\n385,0.2\n"))
+	assert.Error(t, err)
+}
+
+func TestReadSidecarSpectralPowerDistribution(t *testing.T) {
+	dir, err := ioutil.TempDir("", "spd")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "luminaire.spd"), []byte("400 1\n500 2\n"), 0644))
+
+	for _, name := range []string{"luminaire.ldt", "luminaire.ies.gz"} {
+		spd, err := ReadSidecarSpectralPowerDistribution(filepath.Join(dir, name))
+		assert.NoError(t, err, name)
+		assert.Equal(t, []float64{400, 500}, spd.Wavelengths, name)
+	}
+
+	_, err = ReadSidecarSpectralPowerDistribution(filepath.Join(dir, "other.ldt"))
+	assert.Error(t, err)
+}
+
+func TestSpectralPowerDistribution_Conversion(t *testing.T) {
+	eulumdat := loadSample2(t)
+
+	spd := blackBody(3000)
+	assert.NoError(t, eulumdat.SetSpectralPowerDistribution(spd))
+	assert.NoError(t, eulumdat.Validate(false).Err())
+
+	// EULUMDAT -> IES, the keyword survives export and import
+	ies, err := ConvertEulumdatToIES(&eulumdat, ConversionOptions{})
+	assert.NoError(t, err)
+	var buf bytes.Buffer
+	assert.NoError(t, ies.ExportTo(&buf, ExportOptions{}))
+	ies, err = NewIESFromReader(&buf, false)
+	assert.NoError(t, err)
+	fromIES, err := ies.SpectralPowerDistribution()
+	assert.NoError(t, err)
+	assert.Equal(t, spd.Wavelengths, fromIES.Wavelengths)
+	assert.InDeltaSlice(t, spd.Values, fromIES.Values, 1e-5*spd.Values[len(spd.Values)-1])
+	cct, err := ies.EstimateColorTemperature()
+	assert.NoError(t, err)
+	assert.InDelta(t, 3000, cct, 30)
+
+	// IES -> EULUMDAT
+	converted, err := ConvertIESToEulumdat(ies, ConversionOptions{})
+	assert.NoError(t, err)
+	fromEulumdat, err := converted.SpectralPowerDistribution()
+	assert.NoError(t, err)
+	assert.Equal(t, fromIES, fromEulumdat)
+
+	// the header value takes precedence over the estimation
+	temperature, err := eulumdat.EstimateColorTemperature(0)
+	assert.NoError(t, err)
+	assert.Equal(t, "4000K", temperature)
+	temperature, err = converted.EstimateColorTemperature(0)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasSuffix(temperature, "K"))
+	assert.Equal(t, "30", temperature[:2])
+	_, err = converted.EstimateColorTemperature(1)
+	assert.Error(t, err)
+}
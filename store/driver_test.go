@@ -0,0 +1,238 @@
+package store
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// fakeDriver is an in-memory database/sql driver understanding the statements of the store. Like SQLite it rejects
+// OFFSET without LIMIT and treats a negative LIMIT as unlimited.
+type fakeDriver struct {
+	mu        sync.Mutex
+	databases map[string]*fakeDatabase
+}
+
+// fakeDatabase holds the rows of the photometries table in the column order of the schema.
+type fakeDatabase struct {
+	mu     sync.Mutex
+	nextID int64
+	rows   [][]driver.Value
+}
+
+var testDriver = &fakeDriver{databases: make(map[string]*fakeDatabase)}
+
+func init() {
+	sql.Register("storetest", testDriver)
+}
+
+// fakeColumns are the columns of the photometries table.
+var fakeColumns = append(strings.Split(columns, ", "), "data")
+
+var (
+	fakeCondition = regexp.MustCompile(`^(LOWER\()?(\w+)\)? (=|>=|<=) (\?|\$\d+)$`)
+	fakePaging    = regexp.MustCompile(` ORDER BY id(?: LIMIT (-?\d+))?(?: OFFSET (\d+))?$`)
+)
+
+// Open returns a connection to the database of the name, databases are created on first use.
+func (d *fakeDriver) Open(name string) (driver.Conn, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	database, ok := d.databases[name]
+	if !ok {
+		database = &fakeDatabase{nextID: 1}
+		d.databases[name] = database
+	}
+
+	return &fakeConn{database: database}, nil
+}
+
+type fakeConn struct {
+	database *fakeDatabase
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{database: c.database, query: query}, nil
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+type fakeStmt struct {
+	database *fakeDatabase
+	query    string
+}
+
+func (s *fakeStmt) Close() error {
+	return nil
+}
+
+func (s *fakeStmt) NumInput() int {
+	return -1
+}
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.database.mu.Lock()
+	defer s.database.mu.Unlock()
+
+	switch {
+	case strings.HasPrefix(s.query, "CREATE "):
+		return driver.RowsAffected(0), nil
+	case strings.HasPrefix(s.query, "DELETE "):
+		var kept [][]driver.Value
+		for _, row := range s.database.rows {
+			if row[0] != args[0] {
+				kept = append(kept, row)
+			}
+		}
+		affected := len(s.database.rows) - len(kept)
+		s.database.rows = kept
+		return driver.RowsAffected(affected), nil
+	}
+
+	return nil, fmt.Errorf("unsupported statement %q", s.query)
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.database.mu.Lock()
+	defer s.database.mu.Unlock()
+
+	switch {
+	case strings.HasPrefix(s.query, "INSERT "):
+		if len(args) != len(fakeColumns)-1 {
+			return nil, fmt.Errorf("expected %d arguments, got %d", len(fakeColumns)-1, len(args))
+		}
+		id := s.database.nextID
+		s.database.nextID++
+		s.database.rows = append(s.database.rows, append([]driver.Value{id}, args...))
+		return &fakeRows{columns: []string{"id"}, rows: [][]driver.Value{{id}}}, nil
+	case strings.HasPrefix(s.query, "SELECT "):
+		return s.selectRows(args)
+	}
+
+	return nil, fmt.Errorf("unsupported statement %q", s.query)
+}
+
+// selectRows evaluates the WHERE conditions and the paging of a SELECT statement, database.mu must be held.
+func (s *fakeStmt) selectRows(args []driver.Value) (driver.Rows, error) {
+	selected := strings.Split(strings.TrimPrefix(s.query[:strings.Index(s.query, " FROM ")], "SELECT "), ", ")
+	clause := s.query[strings.Index(s.query, " FROM photometries")+len(" FROM photometries"):]
+
+	limit, offset := -1, 0
+	if paging := fakePaging.FindStringSubmatch(clause); paging != nil {
+		if paging[2] != "" && paging[1] == "" {
+			return nil, errors.New(`near "OFFSET": syntax error`)
+		}
+		if paging[1] != "" {
+			limit, _ = strconv.Atoi(paging[1])
+		}
+		if paging[2] != "" {
+			offset, _ = strconv.Atoi(paging[2])
+		}
+		clause = clause[:len(clause)-len(paging[0])]
+	}
+
+	var conditions []string
+	if clause != "" {
+		conditions = strings.Split(strings.TrimPrefix(clause, " WHERE "), " AND ")
+	}
+	result := &fakeRows{columns: selected}
+	for _, row := range s.database.rows {
+		matches, err := fakeMatches(row, conditions, args)
+		if err != nil {
+			return nil, err
+		}
+		if !matches {
+			continue
+		}
+		if offset > 0 {
+			offset--
+			continue
+		}
+		if limit >= 0 && len(result.rows) == limit {
+			break
+		}
+		values := make([]driver.Value, len(selected))
+		for n, column := range selected {
+			values[n] = row[fakeColumnIndex(column)]
+		}
+		result.rows = append(result.rows, values)
+	}
+
+	return result, nil
+}
+
+// fakeMatches reports whether the row fulfills all conditions, the arguments are bound in order.
+func fakeMatches(row []driver.Value, conditions []string, args []driver.Value) (bool, error) {
+	for n, condition := range conditions {
+		parts := fakeCondition.FindStringSubmatch(condition)
+		if parts == nil || n >= len(args) || fakeColumnIndex(parts[2]) < 0 {
+			return false, fmt.Errorf("unsupported condition %q", condition)
+		}
+		value := row[fakeColumnIndex(parts[2])]
+		if parts[1] != "" {
+			value = strings.ToLower(value.(string))
+		}
+
+		var matches bool
+		switch parts[3] {
+		case "=":
+			matches = value == args[n]
+		case ">=":
+			matches = value.(float64) >= args[n].(float64)
+		case "<=":
+			matches = value.(float64) <= args[n].(float64)
+		}
+		if !matches {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// fakeColumnIndex returns the index of the column in the table or -1.
+func fakeColumnIndex(column string) int {
+	for n, name := range fakeColumns {
+		if name == column {
+			return n
+		}
+	}
+
+	return -1
+}
+
+type fakeRows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string {
+	return r.columns
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+
+	return nil
+}
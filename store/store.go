@@ -0,0 +1,293 @@
+// Package store persists photometries in SQL databases (PostgreSQL or SQLite) using database/sql. The metadata used
+// for catalog queries is stored in columns, the complete file is stored as blob so it can be loaded losslessly.
+// The database driver is registered by the application, for example github.com/lib/pq or modernc.org/sqlite.
+package store
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/h44z/eulumies"
+)
+
+// Dialect selects the SQL dialect of the database.
+type Dialect int
+
+const (
+	DialectSQLite Dialect = iota
+	DialectPostgres
+)
+
+// Record is a stored photometry.
+type Record struct {
+	ID            int64
+	Format        string // ldt or ies
+	Name          string
+	Manufacturer  string
+	CatalogNumber string
+	Flux          float64 // luminaire flux (lm)
+	Watts         float64
+	BeamAngle     float64 // mean beam angle of both principal planes (degrees)
+	Fingerprint   string
+	Data          []byte // exported file content, not loaded by Find
+}
+
+// Query filters the stored photometries, zero values are ignored.
+type Query struct {
+	Manufacturer string // case-insensitive exact match
+	MinFlux      float64
+	MaxFlux      float64
+	MinBeamAngle float64
+	MaxBeamAngle float64
+	Limit        int
+	Offset       int
+}
+
+// Store reads and writes photometries of a database.
+type Store struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+// New returns a store using the given database connection.
+func New(db *sql.DB, dialect Dialect) *Store {
+	return &Store{db: db, dialect: dialect}
+}
+
+// Schema returns the statements creating the table and indexes of the given dialect.
+func Schema(dialect Dialect) []string {
+	id, real, blob := "INTEGER PRIMARY KEY AUTOINCREMENT", "REAL", "BLOB"
+	if dialect == DialectPostgres {
+		id, real, blob = "BIGSERIAL PRIMARY KEY", "DOUBLE PRECISION", "BYTEA"
+	}
+
+	return []string{
+		`CREATE TABLE IF NOT EXISTS photometries (
+	id ` + id + `,
+	format TEXT NOT NULL,
+	name TEXT NOT NULL,
+	manufacturer TEXT NOT NULL,
+	catalog_number TEXT NOT NULL,
+	flux ` + real + ` NOT NULL,
+	watts ` + real + ` NOT NULL,
+	beam_angle ` + real + ` NOT NULL,
+	fingerprint TEXT NOT NULL,
+	data ` + blob + ` NOT NULL
+)`,
+		"CREATE INDEX IF NOT EXISTS photometries_manufacturer ON photometries (manufacturer)",
+		"CREATE INDEX IF NOT EXISTS photometries_flux ON photometries (flux)",
+		"CREATE INDEX IF NOT EXISTS photometries_beam_angle ON photometries (beam_angle)",
+		"CREATE INDEX IF NOT EXISTS photometries_fingerprint ON photometries (fingerprint)",
+	}
+}
+
+// CreateSchema creates the table and indexes if they do not exist yet.
+func (s *Store) CreateSchema(ctx context.Context) error {
+	for _, statement := range Schema(s.dialect) {
+		if _, err := s.db.ExecContext(ctx, statement); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SaveEulumdat stores the EULUMDAT file and returns the ID of the new record.
+func (s *Store) SaveEulumdat(ctx context.Context, eulumdat eulumies.Eulumdat) (int64, error) {
+	record, err := eulumdatRecord(eulumdat)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.insert(ctx, record)
+}
+
+// SaveIES stores the IES file and returns the ID of the new record.
+func (s *Store) SaveIES(ctx context.Context, ies *eulumies.IES) (int64, error) {
+	record, err := iesRecord(ies)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.insert(ctx, record)
+}
+
+// Load returns the record with the given ID including the file content.
+func (s *Store) Load(ctx context.Context, id int64) (Record, error) {
+	var record Record
+	row := s.db.QueryRowContext(ctx, "SELECT "+columns+", data FROM photometries WHERE id = "+s.placeholder(1), id)
+	err := row.Scan(&record.ID, &record.Format, &record.Name, &record.Manufacturer, &record.CatalogNumber,
+		&record.Flux, &record.Watts, &record.BeamAngle, &record.Fingerprint, &record.Data)
+	if err == sql.ErrNoRows {
+		return Record{}, fmt.Errorf("photometry %d not found", id)
+	}
+
+	return record, err
+}
+
+// Delete removes the record with the given ID.
+func (s *Store) Delete(ctx context.Context, id int64) error {
+	_, err := s.db.ExecContext(ctx, "DELETE FROM photometries WHERE id = "+s.placeholder(1), id)
+	return err
+}
+
+// Find returns the metadata of all records matching the query ordered by ID, the file content is not loaded.
+func (s *Store) Find(ctx context.Context, query Query) ([]Record, error) {
+	statement, args := s.findStatement(query)
+	rows, err := s.db.QueryContext(ctx, statement, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var records []Record
+	for rows.Next() {
+		var record Record
+		err := rows.Scan(&record.ID, &record.Format, &record.Name, &record.Manufacturer, &record.CatalogNumber,
+			&record.Flux, &record.Watts, &record.BeamAngle, &record.Fingerprint)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	return records, rows.Err()
+}
+
+// Eulumdat parses the stored EULUMDAT file.
+func (r Record) Eulumdat() (eulumies.Eulumdat, error) {
+	if r.Format != "ldt" {
+		return eulumies.Eulumdat{}, errors.New("record does not contain an EULUMDAT file")
+	}
+
+	return eulumies.NewEulumdat(bytes.NewReader(r.Data), false)
+}
+
+// IES parses the stored IES file.
+func (r Record) IES() (*eulumies.IES, error) {
+	if r.Format != "ies" {
+		return nil, errors.New("record does not contain an IES file")
+	}
+
+	return eulumies.NewIESFromReader(bytes.NewReader(r.Data), false)
+}
+
+const columns = "id, format, name, manufacturer, catalog_number, flux, watts, beam_angle, fingerprint"
+
+func (s *Store) insert(ctx context.Context, record Record) (int64, error) {
+	placeholders := make([]string, 9)
+	for i := range placeholders {
+		placeholders[i] = s.placeholder(i + 1)
+	}
+	statement := "INSERT INTO photometries (format, name, manufacturer, catalog_number, flux, watts, beam_angle, " +
+		"fingerprint, data) VALUES (" + strings.Join(placeholders, ", ") + ") RETURNING id"
+
+	var id int64
+	err := s.db.QueryRowContext(ctx, statement, record.Format, record.Name, record.Manufacturer,
+		record.CatalogNumber, record.Flux, record.Watts, record.BeamAngle, record.Fingerprint, record.Data).Scan(&id)
+
+	return id, err
+}
+
+// findStatement builds the SELECT statement and its arguments for the query.
+func (s *Store) findStatement(query Query) (string, []interface{}) {
+	var conditions []string
+	var args []interface{}
+	add := func(condition string, value interface{}) {
+		args = append(args, value)
+		conditions = append(conditions, condition+" "+s.placeholder(len(args)))
+	}
+	if query.Manufacturer != "" {
+		add("LOWER(manufacturer) =", strings.ToLower(query.Manufacturer))
+	}
+	if query.MinFlux > 0 {
+		add("flux >=", query.MinFlux)
+	}
+	if query.MaxFlux > 0 {
+		add("flux <=", query.MaxFlux)
+	}
+	if query.MinBeamAngle > 0 {
+		add("beam_angle >=", query.MinBeamAngle)
+	}
+	if query.MaxBeamAngle > 0 {
+		add("beam_angle <=", query.MaxBeamAngle)
+	}
+
+	statement := "SELECT " + columns + " FROM photometries"
+	if len(conditions) > 0 {
+		statement += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	statement += " ORDER BY id"
+	if query.Limit > 0 {
+		statement += " LIMIT " + strconv.Itoa(query.Limit)
+	} else if query.Offset > 0 && s.dialect == DialectSQLite {
+		// SQLite accepts OFFSET only after LIMIT, a negative limit is unlimited
+		statement += " LIMIT -1"
+	}
+	if query.Offset > 0 {
+		statement += " OFFSET " + strconv.Itoa(query.Offset)
+	}
+
+	return statement, args
+}
+
+// placeholder returns the n-th (starting at 1) bind parameter of the dialect.
+func (s *Store) placeholder(n int) string {
+	if s.dialect == DialectPostgres {
+		return "$" + strconv.Itoa(n)
+	}
+
+	return "?"
+}
+
+func eulumdatRecord(eulumdat eulumies.Eulumdat) (Record, error) {
+	var data bytes.Buffer
+	if err := eulumdat.Export(&data); err != nil {
+		return Record{}, err
+	}
+
+	record := Record{
+		Format:        "ldt",
+		Name:          eulumdat.LuminaireName,
+		Manufacturer:  eulumdat.CompanyIdentification,
+		CatalogNumber: eulumdat.LuminaireNumber,
+		Flux:          eulumdat.ComputeTotalFlux(),
+		BeamAngle:     meanBeamAngle(eulumdat),
+		Fingerprint:   eulumdat.Fingerprint(),
+		Data:          data.Bytes(),
+	}
+	if len(eulumdat.BallastWatts) > 0 {
+		record.Watts = eulumdat.BallastWatts[0]
+	}
+
+	return record, nil
+}
+
+func iesRecord(ies *eulumies.IES) (Record, error) {
+	var data bytes.Buffer
+	if err := ies.ExportTo(&data, eulumies.ExportOptions{}); err != nil {
+		return Record{}, err
+	}
+
+	return Record{
+		Format:        "ies",
+		Name:          ies.Keywords["LUMINAIRE"],
+		Manufacturer:  ies.Keywords["MANUFAC"],
+		CatalogNumber: ies.Keywords["LUMCAT"],
+		Flux:          ies.ComputeTotalFlux(),
+		Watts:         ies.InputWatts,
+		BeamAngle:     meanBeamAngle(ies),
+		Fingerprint:   ies.Fingerprint(),
+		Data:          data.Bytes(),
+	}, nil
+}
+
+// meanBeamAngle returns the mean beam angle of the C0-C180 and the C90-C270 plane.
+func meanBeamAngle(data eulumies.PhotometricData) float64 {
+	return (eulumies.BeamAngle(data, 0) + eulumies.BeamAngle(data, 90)) / 2
+}
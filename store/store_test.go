@@ -0,0 +1,139 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/h44z/eulumies"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// loadSample2 returns the parsed test/sample2.ldt.
+func loadSample2(t *testing.T) eulumies.Eulumdat {
+	file, err := os.Open("../test/sample2.ldt")
+	require.NoError(t, err)
+	defer file.Close()
+	eulumdat, err := eulumies.NewEulumdat(file, false)
+	require.NoError(t, err)
+
+	return eulumdat
+}
+
+func TestSchema(t *testing.T) {
+	sqlite := Schema(DialectSQLite)
+	assert.Contains(t, sqlite[0], "id INTEGER PRIMARY KEY AUTOINCREMENT")
+	assert.Contains(t, sqlite[0], "data BLOB NOT NULL")
+
+	postgres := Schema(DialectPostgres)
+	assert.Contains(t, postgres[0], "id BIGSERIAL PRIMARY KEY")
+	assert.Contains(t, postgres[0], "flux DOUBLE PRECISION NOT NULL")
+	assert.Contains(t, postgres[0], "data BYTEA NOT NULL")
+	assert.Len(t, postgres, len(sqlite))
+}
+
+func TestStore_findStatement(t *testing.T) {
+	query := Query{Manufacturer: "ACME", MinFlux: 1000, MaxBeamAngle: 60, Limit: 10, Offset: 20}
+
+	statement, args := New(nil, DialectSQLite).findStatement(query)
+	assert.True(t, strings.HasSuffix(statement,
+		"WHERE LOWER(manufacturer) = ? AND flux >= ? AND beam_angle <= ? ORDER BY id LIMIT 10 OFFSET 20"))
+	assert.Equal(t, []interface{}{"acme", 1000.0, 60.0}, args)
+
+	statement, _ = New(nil, DialectPostgres).findStatement(query)
+	assert.Contains(t, statement, "WHERE LOWER(manufacturer) = $1 AND flux >= $2 AND beam_angle <= $3")
+
+	statement, args = New(nil, DialectPostgres).findStatement(Query{})
+	assert.True(t, strings.HasSuffix(statement, "FROM photometries ORDER BY id"))
+	assert.Empty(t, args)
+
+	statement, _ = New(nil, DialectSQLite).findStatement(Query{Offset: 5})
+	assert.True(t, strings.HasSuffix(statement, "ORDER BY id LIMIT -1 OFFSET 5"))
+	statement, _ = New(nil, DialectPostgres).findStatement(Query{Offset: 5})
+	assert.True(t, strings.HasSuffix(statement, "ORDER BY id OFFSET 5"))
+}
+
+func TestStore_SaveLoadFind(t *testing.T) {
+	db, err := sql.Open("storetest", t.Name())
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+	store := New(db, DialectSQLite)
+	require.NoError(t, store.CreateSchema(ctx))
+
+	eulumdat := loadSample2(t)
+	ies, err := eulumies.NewIES("../test/sample.ies", false)
+	require.NoError(t, err)
+
+	ldtID, err := store.SaveEulumdat(ctx, eulumdat)
+	require.NoError(t, err)
+	iesID, err := store.SaveIES(ctx, ies)
+	require.NoError(t, err)
+	assert.NotEqual(t, ldtID, iesID)
+
+	record, err := store.Load(ctx, ldtID)
+	require.NoError(t, err)
+	assert.Equal(t, ldtID, record.ID)
+	assert.Equal(t, "ldt", record.Format)
+	loaded, err := record.Eulumdat()
+	require.NoError(t, err)
+	assert.Equal(t, eulumdat.Fingerprint(), loaded.Fingerprint())
+
+	records, err := store.Find(ctx, Query{})
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, []int64{ldtID, iesID}, []int64{records[0].ID, records[1].ID})
+	assert.Nil(t, records[0].Data)
+
+	records, err = store.Find(ctx, Query{Manufacturer: strings.ToUpper(eulumdat.CompanyIdentification)})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, ldtID, records[0].ID)
+
+	records, err = store.Find(ctx, Query{Offset: 1})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, iesID, records[0].ID)
+
+	require.NoError(t, store.Delete(ctx, ldtID))
+	_, err = store.Load(ctx, ldtID)
+	assert.EqualError(t, err, fmt.Sprintf("photometry %d not found", ldtID))
+}
+
+func Test_eulumdatRecord(t *testing.T) {
+	eulumdat := loadSample2(t)
+
+	record, err := eulumdatRecord(eulumdat)
+	require.NoError(t, err)
+	assert.Equal(t, "ldt", record.Format)
+	assert.Equal(t, eulumdat.LuminaireName, record.Name)
+	assert.InDelta(t, eulumdat.ComputeTotalFlux(), record.Flux, 1e-9)
+	assert.True(t, record.BeamAngle > 0)
+	assert.Equal(t, eulumdat.Fingerprint(), record.Fingerprint)
+
+	loaded, err := record.Eulumdat()
+	require.NoError(t, err)
+	assert.Equal(t, eulumdat.Fingerprint(), loaded.Fingerprint())
+
+	_, err = record.IES()
+	assert.Error(t, err)
+}
+
+func Test_iesRecord(t *testing.T) {
+	ies, err := eulumies.NewIES("../test/sample.ies", false)
+	require.NoError(t, err)
+
+	record, err := iesRecord(ies)
+	require.NoError(t, err)
+	assert.Equal(t, "ies", record.Format)
+	assert.Equal(t, ies.Keywords["MANUFAC"], record.Manufacturer)
+	assert.Equal(t, ies.InputWatts, record.Watts)
+
+	loaded, err := record.IES()
+	require.NoError(t, err)
+	assert.Equal(t, ies.Fingerprint(), loaded.Fingerprint())
+}
@@ -0,0 +1,126 @@
+package eulumies
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+)
+
+// TiltData describes the lamp output depending on the luminaire tilt, as stored after TILT=INCLUDE or in the file
+// referenced by TILT=<filename>.
+type TiltData struct {
+	LampToLuminaireGeometry int       // 1 - vertical lamp, 2 - horizontal lamp tilting in its plane, 3 - horizontal lamp tilting about its axis
+	Angles                  []float64 // tilt angles (degrees), strictly increasing
+	Factors                 []float64 // candela multiplying factor per angle
+}
+
+// NewTiltData returns validated tilt data.
+func NewTiltData(geometry int, angles, factors []float64) (TiltData, error) {
+	tilt := TiltData{LampToLuminaireGeometry: geometry, Angles: angles, Factors: factors}
+	if err := tilt.Validate(); err != nil {
+		return TiltData{}, err
+	}
+
+	return tilt, nil
+}
+
+// Validate checks the geometry code, the number of angles and factors and the order of the angles.
+func (t TiltData) Validate() error {
+	if t.LampToLuminaireGeometry < 1 || t.LampToLuminaireGeometry > 3 {
+		return fmt.Errorf("lamp to luminaire geometry %d out of range (1 - 3)", t.LampToLuminaireGeometry)
+	}
+	if len(t.Angles) == 0 || len(t.Angles) != len(t.Factors) {
+		return fmt.Errorf("%d tilt angles and %d factors do not match", len(t.Angles), len(t.Factors))
+	}
+	for n := range t.Angles {
+		if n > 0 && t.Angles[n] <= t.Angles[n-1] {
+			return errors.New("tilt angles must be strictly increasing")
+		}
+		if t.Factors[n] < 0 {
+			return fmt.Errorf("negative tilt factor at angle %g", t.Angles[n])
+		}
+	}
+
+	return nil
+}
+
+// Export writes the tilt data as standalone tilt file, which is referenced by TILT=<filename>. Lines are limited to
+// the data line length of the options, or to 130 characters which is accepted by all LM-63 versions.
+func (t TiltData) Export(out io.StringWriter, opts ExportOptions) error {
+	if err := t.Validate(); err != nil {
+		return err
+	}
+
+	lineLength := 132 - 2 // \r\n
+	if opts.DataLineLength > 0 {
+		lineLength = opts.DataLineLength
+	}
+	lines := []string{strconv.Itoa(t.LampToLuminaireGeometry), strconv.Itoa(len(t.Angles))}
+	lines = append(lines, convertFloatSliceToStringSlice(lineLength, opts, t.Angles)...)
+	lines = append(lines, convertFloatSliceToStringSlice(lineLength, opts, t.Factors)...)
+	for _, line := range lines {
+		if _, err := out.WriteString(line + "\r\n"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetTilt validates the tilt data and stores it as TILT=INCLUDE data.
+func (i *IES) SetTilt(tilt TiltData) error {
+	if err := tilt.Validate(); err != nil {
+		return err
+	}
+
+	i.Tilt = IESTiltInclude
+	i.TiltLampToLuminaireGeometry = tilt.LampToLuminaireGeometry
+	i.TiltAnglesAndFactors = len(tilt.Angles)
+	i.TiltAngles = append([]float64(nil), tilt.Angles...)
+	i.TiltMultiplierFactors = append([]float64(nil), tilt.Factors...)
+
+	return nil
+}
+
+// TiltData returns a copy of the TILT=INCLUDE data.
+func (i *IES) TiltData() (TiltData, error) {
+	if i.Tilt != IESTiltInclude {
+		return TiltData{}, errors.New("TILT=INCLUDE data required")
+	}
+
+	return TiltData{
+		LampToLuminaireGeometry: i.TiltLampToLuminaireGeometry,
+		Angles:                  append([]float64(nil), i.TiltAngles...),
+		Factors:                 append([]float64(nil), i.TiltMultiplierFactors...),
+	}, nil
+}
+
+// ApplyTilt multiplies the candela values with the lamp output factor of the TILT=INCLUDE data at the given operating
+// tilt angle (degrees) and removes the tilt data. The result is a TILT=NONE file describing the luminaire in its
+// installed orientation. The factor is interpolated linearly, angles outside of the tilt data use the nearest factor.
+func (i *IES) ApplyTilt(operatingAngle float64) error {
+	tilt, err := i.TiltData()
+	if err != nil {
+		return err
+	}
+	if err := tilt.Validate(); err != nil {
+		return err
+	}
+	angle := math.Max(tilt.Angles[0], math.Min(tilt.Angles[len(tilt.Angles)-1], operatingAngle))
+	factor := interpolateLinear(tilt.Factors, tilt.Angles, angle)
+
+	for h := range i.CandelaValues {
+		for v := range i.CandelaValues[h] {
+			i.CandelaValues[h][v] *= factor
+		}
+	}
+	i.Tilt = IESTiltNone
+	i.TiltLampToLuminaireGeometry = 0
+	i.TiltAnglesAndFactors = 0
+	i.TiltAngles = nil
+	i.TiltMultiplierFactors = nil
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package eulumies
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIES_ApplyTilt(t *testing.T) {
+	ies := IES{
+		Tilt:                        IESTiltInclude,
+		TiltLampToLuminaireGeometry: 1,
+		TiltAnglesAndFactors:        3,
+		TiltAngles:                  []float64{0, 30, 60},
+		TiltMultiplierFactors:       []float64{1, 0.9, 0.7},
+		CandelaValues:               [][]float64{{100, 50}, {80, 40}},
+	}
+
+	assert.NoError(t, ies.ApplyTilt(45))
+	assert.Equal(t, IESTiltNone, ies.Tilt)
+	assert.Empty(t, ies.TiltAngles)
+	assert.InDelta(t, 80, ies.CandelaValues[0][0], 1e-9)
+	assert.InDelta(t, 32, ies.CandelaValues[1][1], 1e-9)
+
+	assert.Error(t, ies.ApplyTilt(0))
+
+	ies.Tilt = IESTiltInclude
+	ies.TiltLampToLuminaireGeometry = 1
+	ies.TiltAngles = []float64{0, 30}
+	ies.TiltMultiplierFactors = []float64{1, 0.5}
+	assert.NoError(t, ies.ApplyTilt(90))
+	assert.InDelta(t, 40, ies.CandelaValues[0][0], 1e-9)
+
+	ies.Tilt = IESTiltInclude
+	ies.TiltLampToLuminaireGeometry = 1
+	ies.TiltAngles = []float64{30, 0}
+	ies.TiltMultiplierFactors = []float64{1, 0.5}
+	assert.Error(t, ies.ApplyTilt(10))
+}
+
+func TestTiltData(t *testing.T) {
+	_, err := NewTiltData(4, []float64{0, 90}, []float64{1, 0.8})
+	assert.Error(t, err)
+	_, err = NewTiltData(1, []float64{0, 90}, []float64{1})
+	assert.Error(t, err)
+	_, err = NewTiltData(1, []float64{0, 0}, []float64{1, 1})
+	assert.Error(t, err)
+
+	tilt, err := NewTiltData(2, []float64{0, 45, 90}, []float64{1, 0.95, 0.8})
+	assert.NoError(t, err)
+
+	var buffer bytes.Buffer
+	assert.NoError(t, tilt.Export(&buffer, ExportOptions{}))
+	assert.Equal(t, "2\r\n3\r\n0.00 45.00 90.00\r\n1.00 0.95 0.80\r\n", buffer.String())
+
+	var ies IES
+	assert.NoError(t, ies.SetTilt(tilt))
+	assert.Equal(t, IESTiltInclude, ies.Tilt)
+	assert.Equal(t, 3, ies.TiltAnglesAndFactors)
+	for _, issue := range ies.Validate(true) {
+		assert.NotEqual(t, "TiltAngles", issue.Field)
+	}
+	stored, err := ies.TiltData()
+	assert.NoError(t, err)
+	assert.Equal(t, tilt, stored)
+
+	ies.TiltAngles[2] = 10
+	assert.False(t, ies.Validate(true).Valid())
+}
@@ -0,0 +1,131 @@
+package eulumies
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// TypeCOptions controls the resampling of type A and B photometry onto a type C grid, see IES.ToTypeC.
+type TypeCOptions struct {
+	CStep     float64 // distance of the C-planes (degrees) from 0 to 360, defaults to 5
+	GammaStep float64 // distance of the gamma angles (degrees) from 0 to 180, defaults to 1
+}
+
+// withDefaults returns the options with defaults applied to unset values.
+func (o TypeCOptions) withDefaults() TypeCOptions {
+	if o.CStep <= 0 {
+		o.CStep = 5
+	}
+	if o.GammaStep <= 0 {
+		o.GammaStep = 1
+	}
+
+	return o
+}
+
+// ToTypeC returns a copy of type A or B photometry (photometric type 3 or 2), as measured on type A or B goniometers
+// defined in IES LM-75, resampled onto an equidistant type C grid without symmetry. The intensity of each C-gamma
+// direction is interpolated bilinearly between the measured horizontal (A or B) and vertical (alpha or beta) angles,
+// directions outside of the measured range are dark. Laterally symmetric data (horizontal angles starting at 0) is
+// mirrored first. Type C photometry is returned as unchanged copy.
+func (i *IES) ToTypeC(opts TypeCOptions) (*IES, error) {
+	opts = opts.withDefaults()
+	if i.PhotometricType == 1 {
+		return i.Clone(), nil
+	}
+	if i.PhotometricType != 2 && i.PhotometricType != 3 {
+		return nil, fmt.Errorf("unsupported photometric type %d", i.PhotometricType)
+	}
+	hAngles, planes := i.typeABDistribution()
+	if len(hAngles) == 0 || len(i.VerticalAngles) == 0 {
+		return nil, errors.New("ies contains no candela values")
+	}
+	if len(planes) != len(hAngles) {
+		return nil, errors.New("number of candela planes does not match the horizontal angles")
+	}
+	for _, plane := range planes {
+		if len(plane) != len(i.VerticalAngles) {
+			return nil, errors.New("number of candela values does not match the vertical angles")
+		}
+	}
+	if opts.CStep > 360 || opts.GammaStep > 180 {
+		return nil, errors.New("angle steps exceed the angle ranges")
+	}
+
+	ies := i.Clone()
+	ies.PhotometricType = 1
+	ies.HorizontalAngles = equidistantAngles(0, 360-opts.CStep, opts.CStep)
+	ies.VerticalAngles = equidistantAngles(0, 180, opts.GammaStep)
+	ies.CandelaValues = make([][]float64, len(ies.HorizontalAngles))
+	for h, c := range ies.HorizontalAngles {
+		ies.CandelaValues[h] = make([]float64, len(ies.VerticalAngles))
+		for v, gamma := range ies.VerticalAngles {
+			direction := CGammaToDirection(c, gamma)
+			horizontal, vertical := direction.BBeta()
+			if i.PhotometricType == 3 {
+				horizontal, vertical = direction.AAlpha()
+			}
+			ies.CandelaValues[h][v] = interpolateTypeAB(hAngles, i.VerticalAngles, planes, horizontal, vertical)
+		}
+	}
+	ies.NumberHorizontalAngles = len(ies.HorizontalAngles)
+	ies.NumberVerticalAngles = len(ies.VerticalAngles)
+
+	return ies, nil
+}
+
+// typeABDistribution returns the horizontal angles and candela values of type A or B photometry. Laterally symmetric
+// data (horizontal angles starting at 0) is mirrored to the negative horizontal angles.
+func (i *IES) typeABDistribution() ([]float64, [][]float64) {
+	hAngles, planes := i.HorizontalAngles, i.CandelaValues
+	if len(hAngles) < 2 || hAngles[0] != 0 || len(planes) != len(hAngles) {
+		return hAngles, planes
+	}
+
+	mirrored := make([]float64, 0, 2*len(hAngles))
+	for n := len(hAngles) - 1; n > 0; n-- {
+		mirrored = append(mirrored, -hAngles[n])
+	}
+	mirroredPlanes := make([][]float64, 0, 2*len(planes))
+	for n := len(planes) - 1; n > 0; n-- {
+		mirroredPlanes = append(mirroredPlanes, planes[n])
+	}
+
+	return append(mirrored, hAngles...), append(mirroredPlanes, planes...)
+}
+
+// interpolateTypeAB returns the candela value at the given horizontal and vertical angle of type A or B photometry,
+// interpolated bilinearly. Angles outside of the measured range yield zero.
+func interpolateTypeAB(hAngles, vAngles []float64, planes [][]float64, horizontal, vertical float64) float64 {
+	const epsilon = 1e-9
+	if len(hAngles) == 0 || len(vAngles) == 0 ||
+		horizontal < hAngles[0]-epsilon || horizontal > hAngles[len(hAngles)-1]+epsilon ||
+		vertical < vAngles[0]-epsilon || vertical > vAngles[len(vAngles)-1]+epsilon {
+		return 0
+	}
+	horizontal = math.Max(hAngles[0], math.Min(hAngles[len(hAngles)-1], horizontal))
+	vertical = math.Max(vAngles[0], math.Min(vAngles[len(vAngles)-1], vertical))
+
+	upper := sort.SearchFloat64s(hAngles, horizontal)
+	if upper == 0 || hAngles[upper] == horizontal {
+		return interpolateLinear(planes[upper], vAngles, vertical)
+	}
+	lower := upper - 1
+	weight := (horizontal - hAngles[lower]) / (hAngles[upper] - hAngles[lower])
+
+	return interpolateLinear(planes[lower], vAngles, vertical)*(1-weight) +
+		interpolateLinear(planes[upper], vAngles, vertical)*weight
+}
+
+// equidistantAngles returns the angles from first to last (inclusive) with the given step.
+func equidistantAngles(first, last, step float64) []float64 {
+	count := int(math.Floor((last-first)/step+1e-9)) + 1
+	angles := make([]float64, count)
+	for n := range angles {
+		angles[n] = first + float64(n)*step
+	}
+
+	return angles
+}
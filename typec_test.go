@@ -0,0 +1,123 @@
+package eulumies
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// lambertianTypeAB returns type A or B photometry of a lambertian emitter facing nadir, measured in 5 degree steps.
+func lambertianTypeAB(photometricType int, hFirst float64) *IES {
+	ies := &IES{
+		Format:            IESFormatLM_63_2002,
+		Keywords:          map[string]string{"TEST": "LAB-1"},
+		NumberLamps:       1,
+		LumensPerLamp:     -1,
+		CandelaMultiplier: 1,
+		PhotometricType:   photometricType,
+		UnitsType:         IESUnitsMeters,
+		BallastFactor:     1,
+		FutureUse:         1,
+		HorizontalAngles:  equidistantAngles(hFirst, 90, 5),
+		VerticalAngles:    equidistantAngles(-90, 90, 5),
+	}
+	for _, horizontal := range ies.HorizontalAngles {
+		plane := make([]float64, len(ies.VerticalAngles))
+		for v, vertical := range ies.VerticalAngles {
+			plane[v] = 1000 * math.Cos(degToRad(horizontal)) * math.Cos(degToRad(vertical))
+		}
+		ies.CandelaValues = append(ies.CandelaValues, plane)
+	}
+	ies.NumberHorizontalAngles, ies.NumberVerticalAngles = len(ies.HorizontalAngles), len(ies.VerticalAngles)
+
+	return ies
+}
+
+func TestIES_ToTypeC(t *testing.T) {
+	for _, photometricType := range []int{2, 3} {
+		for _, hFirst := range []float64{-90, 0} {
+			original := lambertianTypeAB(photometricType, hFirst)
+			ies, err := original.ToTypeC(TypeCOptions{CStep: 15, GammaStep: 5})
+			require.NoError(t, err)
+
+			assert.Equal(t, 1, ies.PhotometricType)
+			assert.Equal(t, photometricType, original.PhotometricType)
+			assert.Len(t, ies.HorizontalAngles, 24)
+			assert.Len(t, ies.VerticalAngles, 37)
+			assert.Equal(t, 24, ies.NumberHorizontalAngles)
+			assert.Equal(t, "LAB-1", ies.Keywords["TEST"])
+			for h := range ies.HorizontalAngles {
+				for v, gamma := range ies.VerticalAngles {
+					expected := math.Max(0, 1000*math.Cos(degToRad(gamma)))
+					assert.InDelta(t, expected, ies.CandelaValues[h][v], 5, "C%g gamma %g",
+						ies.HorizontalAngles[h], gamma)
+				}
+			}
+			assert.InDelta(t, 1000*math.Pi, ies.ComputeTotalFlux(), 0.01*1000*math.Pi)
+		}
+	}
+
+	typeC, err := lambertianTypeAB(2, -90).ToTypeC(TypeCOptions{})
+	require.NoError(t, err)
+	assert.Len(t, typeC.HorizontalAngles, 72)
+	assert.Len(t, typeC.VerticalAngles, 181)
+	clone, err := typeC.ToTypeC(TypeCOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, typeC.CandelaValues, clone.CandelaValues)
+
+	_, err = (&IES{PhotometricType: 2}).ToTypeC(TypeCOptions{})
+	assert.Error(t, err)
+	_, err = (&IES{PhotometricType: 4}).ToTypeC(TypeCOptions{})
+	assert.Error(t, err)
+}
+
+func TestConvertIESToEulumdat_TypeB(t *testing.T) {
+	eulumdat, err := ConvertIESToEulumdat(lambertianTypeAB(2, 0), ConversionOptions{})
+	require.NoError(t, err)
+	assert.InDelta(t, 1000*math.Pi, eulumdat.ComputeTotalFlux(), 0.01*1000*math.Pi)
+}
+
+func TestIES_TypeCDistribution(t *testing.T) {
+	for _, photometricType := range []int{2, 3} {
+		ies := lambertianTypeAB(photometricType, 0)
+		typeC, err := ies.ToTypeC(TypeCOptions{})
+		require.NoError(t, err)
+
+		intensity := ies.IntensityFunc()
+		assert.InDelta(t, 1000, intensity(0, 0), 5)
+		assert.InDelta(t, 500, intensity(90, 60), 5)
+		assert.InDelta(t, 0, intensity(180, 120), 1e-9)
+		assert.Equal(t, typeC.Fingerprint(), ies.Fingerprint())
+		assert.True(t, ComparePhotometries(ies, typeC, CompareOptions{}).DistributionsMatching)
+	}
+}
+
+func TestIES_UpwardLightTypeAB(t *testing.T) {
+	isotropic := lambertianTypeAB(2, 0)
+	isotropic.HorizontalAngles = equidistantAngles(-180, 180, 5)
+	isotropic.CandelaValues = nil
+	for range isotropic.HorizontalAngles {
+		plane := make([]float64, len(isotropic.VerticalAngles))
+		for v := range plane {
+			plane[v] = 100
+		}
+		isotropic.CandelaValues = append(isotropic.CandelaValues, plane)
+	}
+	assert.InDelta(t, 0.5, isotropic.ComputeUpwardLightRatio(), 0.01)
+	assert.InDelta(t, 0.5, isotropic.ComputeUpwardLightOutputRatio(), 0.01)
+	assert.False(t, isotropic.IsDarkSkyCompliant())
+
+	// no light at the edges of the measured range, which border the horizontal plane
+	downward := lambertianTypeAB(3, 0)
+	for h := range downward.CandelaValues {
+		for v := range downward.CandelaValues[h] {
+			if math.Abs(downward.HorizontalAngles[h]) == 90 || math.Abs(downward.VerticalAngles[v]) == 90 {
+				downward.CandelaValues[h][v] = 0
+			}
+		}
+	}
+	assert.InDelta(t, 0, downward.ComputeUpwardLightRatio(), 1e-9)
+	assert.True(t, downward.IsDarkSkyCompliant())
+}
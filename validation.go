@@ -0,0 +1,185 @@
+package eulumies
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// ValidationSeverity describes how serious a validation issue is.
+type ValidationSeverity int
+
+const (
+	SeverityInfo    ValidationSeverity = iota // The data is valid, but noteworthy.
+	SeverityWarning                           // The data can be processed, but is probably wrong.
+	SeverityError                             // The data is invalid and cannot be exported.
+)
+
+func (s ValidationSeverity) String() string {
+	switch s {
+	case SeverityInfo:
+		return "info"
+	case SeverityWarning:
+		return "warning"
+	case SeverityError:
+		return "error"
+	}
+
+	return "unknown"
+}
+
+// ValidationCode identifies the kind of a validation issue.
+type ValidationCode string
+
+const (
+	ValidationLengthMismatch     ValidationCode = "LENGTH_MISMATCH"     // A count does not match the number of values.
+	ValidationFieldTooLong       ValidationCode = "FIELD_TOO_LONG"      // A string exceeds the maximum length.
+	ValidationOutOfRange         ValidationCode = "OUT_OF_RANGE"        // A numeric value is outside the allowed range.
+	ValidationSpacingMismatch    ValidationCode = "SPACING_MISMATCH"    // The angle count does not match the angular distance.
+	ValidationMissingKeyword     ValidationCode = "MISSING_KEYWORD"     // A keyword required by the format is missing.
+	ValidationKeywordNotAllowed  ValidationCode = "KEYWORD_NOT_ALLOWED" // A keyword is not allowed by the format.
+	ValidationUnknownFormat      ValidationCode = "UNKNOWN_FORMAT"      // The format version is unknown.
+	ValidationInvalidTilt        ValidationCode = "INVALID_TILT"        // The TILT data is invalid.
+	ValidationMissingPhotometry  ValidationCode = "MISSING_PHOTOMETRY"  // The luminous intensity distribution is missing.
+	ValidationInconsistentValues ValidationCode = "INCONSISTENT_VALUES" // Values contradict each other.
+	ValidationAngleRange         ValidationCode = "ANGLE_RANGE"         // The angles do not cover a range allowed by the format.
+	ValidationInvalidKeyword     ValidationCode = "INVALID_KEYWORD"     // A keyword value does not match its syntax.
+	ValidationInvalidJSON        ValidationCode = "INVALID_JSON"        // The JSON document cannot be decoded.
+	ValidationSchemaViolation    ValidationCode = "SCHEMA_VIOLATION"    // The JSON document does not match JSONSchema.
+	ValidationNonASCII           ValidationCode = "NON_ASCII"           // Text of an ASCII only format contains other characters.
+	ValidationFieldTooWide       ValidationCode = "FIELD_TOO_WIDE"      // A numeric value exceeds its field width.
+	ValidationAngleOrder         ValidationCode = "ANGLE_ORDER"         // The angles are not strictly ascending.
+	ValidationFluxMismatch       ValidationCode = "FLUX_MISMATCH"       // The declared flux differs from the integrated flux.
+)
+
+// StrictChecks selects the checks of strict parsing and validation. The checks can be combined, e.g. to validate the
+// keywords strictly while tolerating overlong header lines.
+type StrictChecks uint
+
+const (
+	CheckLineLength        StrictChecks = 1 << iota // Lines and text fields do not exceed the maximum length.
+	CheckKeywordLegality                            // Keywords and extensions are allowed and have a valid syntax.
+	CheckRequiredKeywords                           // The keywords required by the IES format version are present.
+	CheckFieldWidths                                // Numeric EULUMDAT header values fit into their field width.
+	CheckAngleMonotonicity                          // Angles are strictly ascending.
+	CheckValueRanges                                // Numeric values are within their ranges and consistent.
+	CheckFluxConsistency                            // The declared flux matches the integrated distribution.
+
+	// StrictDefault are the checks of strict parsing and validation. The field widths, the angle order and the flux
+	// consistency are not checked by them, many files in use violate the field widths, Repair sorts unordered angles
+	// and the flux check integrates the whole distribution.
+	StrictDefault = CheckLineLength | CheckKeywordLegality | CheckRequiredKeywords | CheckValueRanges
+	// StrictAll enables all checks.
+	StrictAll = StrictDefault | CheckFieldWidths | CheckAngleMonotonicity | CheckFluxConsistency
+)
+
+// has reports whether the given check is enabled.
+func (c StrictChecks) has(check StrictChecks) bool {
+	return c&check != 0
+}
+
+// strictChecks returns the checks of the strict flag. Non-strict validation has always checked the required keywords.
+func strictChecks(strict bool) StrictChecks {
+	if strict {
+		return StrictDefault
+	}
+
+	return CheckRequiredKeywords
+}
+
+// ValidationIssue describes a single problem found by Validate.
+type ValidationIssue struct {
+	Code     ValidationCode
+	Severity ValidationSeverity
+	Field    string // name of the affected struct field, empty if the issue is not related to a single field
+	Message  string
+}
+
+func (v ValidationIssue) String() string {
+	if v.Field == "" {
+		return fmt.Sprintf("%s: %s", v.Severity, v.Message)
+	}
+
+	return fmt.Sprintf("%s: %s: %s", v.Severity, v.Field, v.Message)
+}
+
+// ValidationIssues is the list of problems found by Validate.
+type ValidationIssues []ValidationIssue
+
+// Valid reports whether no issue with error severity was found.
+func (v ValidationIssues) Valid() bool {
+	return len(v.Errors()) == 0
+}
+
+// Errors returns all issues with error severity.
+func (v ValidationIssues) Errors() ValidationIssues {
+	return v.filter(SeverityError)
+}
+
+// Warnings returns all issues with warning severity.
+func (v ValidationIssues) Warnings() ValidationIssues {
+	return v.filter(SeverityWarning)
+}
+
+// Err returns an error describing all issues with error severity, or nil if the data is valid.
+func (v ValidationIssues) Err() error {
+	errs := v.Errors()
+	if len(errs) == 0 {
+		return nil
+	}
+
+	messages := make([]string, len(errs))
+	for i := range errs {
+		messages[i] = errs[i].String()
+	}
+
+	return errors.New(strings.Join(messages, "; "))
+}
+
+func (v ValidationIssues) filter(severity ValidationSeverity) ValidationIssues {
+	var filtered ValidationIssues
+	for _, issue := range v {
+		if issue.Severity == severity {
+			filtered = append(filtered, issue)
+		}
+	}
+
+	return filtered
+}
+
+// add appends a new issue to the list.
+func (v *ValidationIssues) add(code ValidationCode, severity ValidationSeverity, field, format string, args ...interface{}) {
+	*v = append(*v, ValidationIssue{
+		Code:     code,
+		Severity: severity,
+		Field:    field,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// angleGridTolerance is the allowed deviation (in degrees) of an angle from the equidistant grid.
+const angleGridTolerance = 0.01
+
+// angleSpacingMismatch returns the index of the first angle deviating from the equidistant grid with the given
+// distance that starts at the first angle, or -1 if all angles are on the grid.
+func angleSpacingMismatch(angles []float64, distance float64) int {
+	for i := range angles {
+		if math.Abs(angles[i]-angles[0]-float64(i)*distance) > angleGridTolerance {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// validateAngleOrder adds an issue if the angles are not strictly ascending.
+func validateAngleOrder(issues *ValidationIssues, field string, angles []float64) {
+	for i := 1; i < len(angles); i++ {
+		if angles[i] <= angles[i-1] {
+			issues.add(ValidationAngleOrder, SeverityError, field,
+				"angle %g at index %d is not greater than %g", angles[i], i, angles[i-1])
+			return
+		}
+	}
+}
@@ -0,0 +1,324 @@
+package eulumies
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+)
+
+// XMLPhotometricTypeC is the only photometry type supported in XML photometric data.
+const XMLPhotometricTypeC = "CIE-C"
+
+// XMLPhotometry is photometric data in the XML structure used for EN 13032-4 data exchange (root element
+// LuminaireOpticalData, as defined by UNI 11733 and IES TM-33). Only a single emitter with a type C luminous intensity
+// distribution is supported, unknown elements are ignored when reading.
+type XMLPhotometry struct {
+	XMLName   xml.Name     `xml:"LuminaireOpticalData"`
+	Version   string       `xml:"Version,omitempty"`
+	Header    XMLHeader    `xml:"Header"`
+	Luminaire XMLLuminaire `xml:"Luminaire"`
+	Emitter   XMLEmitter   `xml:"Emitter"`
+}
+
+// XMLHeader contains the identification of the luminaire and the measurement.
+type XMLHeader struct {
+	Manufacturer  string `xml:"Manufacturer,omitempty"`
+	CatalogNumber string `xml:"CatalogNumber,omitempty"`
+	Description   string `xml:"Description,omitempty"`
+	Laboratory    string `xml:"Laboratory,omitempty"`
+	ReportNumber  string `xml:"ReportNumber,omitempty"`
+	ReportDate    string `xml:"ReportDate,omitempty"`
+}
+
+// XMLLuminaire contains the dimensions (mm) of the luminaire.
+type XMLLuminaire struct {
+	Length float64 `xml:"Dimensions>Length"`
+	Width  float64 `xml:"Dimensions>Width"` // 0 for circular luminaires
+	Height float64 `xml:"Dimensions>Height"`
+}
+
+// XMLEmitter describes the light source and its luminous intensity distribution.
+type XMLEmitter struct {
+	Quantity         int                      `xml:"Quantity"`
+	Description      string                   `xml:"Description,omitempty"`
+	RatedLumens      float64                  `xml:"RatedLumens,omitempty"` // flux of all lamps (lm), 0 for absolute photometry
+	InputWatts       float64                  `xml:"InputWatts,omitempty"`
+	ColorTemperature string                   `xml:"CCT,omitempty"`
+	ColorRendering   string                   `xml:"ColorRendering>Ra,omitempty"`
+	Distribution     XMLIntensityDistribution `xml:"IntensityDistribution"`
+}
+
+// XMLIntensityDistribution holds the luminous intensities (cd) as one element per direction. The horizontal angles
+// follow the IES conventions, so a range of 0 to 90 degrees describes a distribution symmetric to both principal
+// planes.
+type XMLIntensityDistribution struct {
+	PhotometryType string         `xml:"PhotometryType"`
+	Multiplier     float64        `xml:"Multiplier"`
+	Intensities    []XMLIntensity `xml:"IntData"`
+}
+
+// XMLIntensity is the luminous intensity in the direction given by the horizontal (C) and vertical (gamma) angle.
+type XMLIntensity struct {
+	Horizontal float64 `xml:"horz,attr"`
+	Vertical   float64 `xml:"vert,attr"`
+	Value      float64 `xml:",chardata"`
+}
+
+// NewXMLPhotometry reads and validates XML photometric data.
+func NewXMLPhotometry(in io.Reader) (*XMLPhotometry, error) {
+	in, err := decompressReader(in)
+	if err != nil {
+		return nil, err
+	}
+
+	var photometry XMLPhotometry
+	if err = xml.NewDecoder(in).Decode(&photometry); err != nil {
+		return nil, err
+	}
+	if err = photometry.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &photometry, nil
+}
+
+// Validate checks that the distribution is of type C and contains an intensity for every combination of angles.
+func (x *XMLPhotometry) Validate() error {
+	_, _, _, err := x.Emitter.Distribution.grid()
+	return err
+}
+
+// Export writes the XML photometric data, including the XML declaration.
+func (x *XMLPhotometry) Export(out io.Writer) error {
+	if err := x.Validate(); err != nil {
+		return err
+	}
+
+	data, err := xml.MarshalIndent(x, "", "  ")
+	if err != nil {
+		return err
+	}
+	if _, err = io.WriteString(out, xml.Header); err != nil {
+		return err
+	}
+	if _, err = out.Write(append(data, '\n')); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// grid returns the sorted horizontal and vertical angles and the intensities per horizontal angle.
+func (d XMLIntensityDistribution) grid() ([]float64, []float64, [][]float64, error) {
+	if d.PhotometryType != "" && d.PhotometryType != XMLPhotometricTypeC {
+		return nil, nil, nil, fmt.Errorf("unsupported photometry type %s, only %s is supported", d.PhotometryType,
+			XMLPhotometricTypeC)
+	}
+	if len(d.Intensities) == 0 {
+		return nil, nil, nil, errors.New("no luminous intensities")
+	}
+
+	horizontalIndex := make(map[float64]int)
+	verticalIndex := make(map[float64]int)
+	for _, intensity := range d.Intensities {
+		if math.IsNaN(intensity.Horizontal) || math.IsNaN(intensity.Vertical) {
+			return nil, nil, nil, errors.New("invalid angle")
+		}
+		horizontalIndex[intensity.Horizontal] = 0
+		verticalIndex[intensity.Vertical] = 0
+	}
+	hAngles := sortedKeys(horizontalIndex)
+	vAngles := sortedKeys(verticalIndex)
+	if len(hAngles)*len(vAngles) != len(d.Intensities) {
+		return nil, nil, nil, fmt.Errorf("expected %d luminous intensities (%d horizontal x %d vertical angles), found %d",
+			len(hAngles)*len(vAngles), len(hAngles), len(vAngles), len(d.Intensities))
+	}
+
+	values := make([][]float64, len(hAngles))
+	found := make([][]bool, len(hAngles))
+	for h := range values {
+		values[h] = make([]float64, len(vAngles))
+		found[h] = make([]bool, len(vAngles))
+	}
+	for _, intensity := range d.Intensities {
+		h, v := horizontalIndex[intensity.Horizontal], verticalIndex[intensity.Vertical]
+		if found[h][v] {
+			return nil, nil, nil, fmt.Errorf("duplicate luminous intensity at %g/%g", intensity.Horizontal,
+				intensity.Vertical)
+		}
+		found[h][v] = true
+		values[h][v] = intensity.Value
+	}
+
+	return hAngles, vAngles, values, nil
+}
+
+// sortedKeys returns the sorted keys of the map and stores the index of each key as its value.
+func sortedKeys(indices map[float64]int) []float64 {
+	keys := make([]float64, 0, len(indices))
+	for key := range indices {
+		keys = append(keys, key)
+	}
+	sort.Float64s(keys)
+	for i, key := range keys {
+		indices[key] = i
+	}
+
+	return keys
+}
+
+// ConvertIESToXML converts type C IES data to XML photometric data. The candela values are stored unscaled together
+// with the candela multiplier, the ballast factor is applied to the multiplier.
+func ConvertIESToXML(ies *IES) (*XMLPhotometry, error) {
+	if ies.PhotometricType != 1 {
+		return nil, fmt.Errorf("unsupported photometric type %d, only type C can be converted", ies.PhotometricType)
+	}
+	if len(ies.HorizontalAngles) == 0 || len(ies.CandelaValues) != len(ies.HorizontalAngles) {
+		return nil, errors.New("ies contains no candela values")
+	}
+
+	date := ies.Keywords["ISSUEDATE"]
+	if date == "" {
+		date = ies.Keywords["DATE"]
+	}
+	photometry := &XMLPhotometry{
+		Version: "1.0",
+		Header: XMLHeader{
+			Manufacturer:  ies.Keywords["MANUFAC"],
+			CatalogNumber: ies.Keywords["LUMCAT"],
+			Description:   ies.Keywords["LUMINAIRE"],
+			Laboratory:    ies.Keywords["TESTLAB"],
+			ReportNumber:  ies.Keywords["TEST"],
+			ReportDate:    date,
+		},
+		Luminaire: XMLLuminaire{
+			Length: math.Abs(iesUnitsToMillimeters(ies.LuminaireLength, ies.UnitsType)),
+			Width:  math.Abs(iesUnitsToMillimeters(ies.LuminaireWidth, ies.UnitsType)),
+			Height: math.Abs(iesUnitsToMillimeters(ies.LuminaireHeight, ies.UnitsType)),
+		},
+		Emitter: XMLEmitter{
+			Quantity:    int(math.Max(1, math.Abs(float64(ies.NumberLamps)))),
+			Description: ies.Keywords["LAMP"],
+			RatedLumens: ies.lampLumens(),
+			InputWatts:  ies.InputWatts,
+			Distribution: XMLIntensityDistribution{
+				PhotometryType: XMLPhotometricTypeC,
+				Multiplier:     ies.candelaScale(),
+			},
+		},
+	}
+	if ies.LuminaireWidth < 0 && ies.LuminaireLength < 0 {
+		photometry.Luminaire.Width = 0 // circular luminous opening
+	}
+
+	for h, horizontal := range ies.HorizontalAngles {
+		for v, vertical := range ies.VerticalAngles {
+			if v >= len(ies.CandelaValues[h]) {
+				return nil, fmt.Errorf("horizontal plane %g contains too few candela values", horizontal)
+			}
+			photometry.Emitter.Distribution.Intensities = append(photometry.Emitter.Distribution.Intensities,
+				XMLIntensity{Horizontal: horizontal, Vertical: vertical, Value: ies.CandelaValues[h][v]})
+		}
+	}
+
+	return photometry, nil
+}
+
+// ConvertXMLToIES converts XML photometric data to LM-63-2002 IES data. The luminaire dimensions are given in meters.
+func ConvertXMLToIES(photometry *XMLPhotometry) (*IES, error) {
+	hAngles, vAngles, values, err := photometry.Emitter.Distribution.grid()
+	if err != nil {
+		return nil, err
+	}
+
+	ies := &IES{
+		Format: IESFormatLM_63_2002,
+		Keywords: map[string]string{
+			"TEST":      photometry.Header.ReportNumber,
+			"TESTLAB":   photometry.Header.Laboratory,
+			"ISSUEDATE": photometry.Header.ReportDate,
+			"MANUFAC":   photometry.Header.Manufacturer,
+			"LUMINAIRE": photometry.Header.Description,
+			"LUMCAT":    photometry.Header.CatalogNumber,
+			"LAMP":      photometry.Emitter.Description,
+		},
+		Tilt:                   IESTiltNone,
+		NumberLamps:            photometry.Emitter.Quantity,
+		LumensPerLamp:          -1, // absolute photometry without rated lumens
+		CandelaMultiplier:      photometry.Emitter.Distribution.Multiplier,
+		NumberVerticalAngles:   len(vAngles),
+		NumberHorizontalAngles: len(hAngles),
+		PhotometricType:        1,
+		UnitsType:              IESUnitsMeters,
+		LuminaireWidth:         millimetersToIESUnits(photometry.Luminaire.Width, IESUnitsMeters),
+		LuminaireLength:        millimetersToIESUnits(photometry.Luminaire.Length, IESUnitsMeters),
+		LuminaireHeight:        millimetersToIESUnits(photometry.Luminaire.Height, IESUnitsMeters),
+		BallastFactor:          1,
+		FutureUse:              1,
+		InputWatts:             photometry.Emitter.InputWatts,
+		VerticalAngles:         vAngles,
+		HorizontalAngles:       hAngles,
+		CandelaValues:          values,
+	}
+	if ies.NumberLamps < 1 {
+		ies.NumberLamps = 1
+	}
+	if ies.CandelaMultiplier == 0 {
+		ies.CandelaMultiplier = 1
+	}
+	if photometry.Emitter.RatedLumens > 0 {
+		ies.LumensPerLamp = photometry.Emitter.RatedLumens / float64(ies.NumberLamps)
+	}
+	for _, keyword := range []string{"LUMINAIRE", "LUMCAT", "LAMP"} {
+		if ies.Keywords[keyword] == "" {
+			delete(ies.Keywords, keyword) // TEST, TESTLAB, ISSUEDATE and MANUFAC are required by LM-63-2002
+		}
+	}
+
+	return ies, nil
+}
+
+// ConvertEulumdatToXML converts the EULUMDAT data to XML photometric data. The LampSet option selects the standard
+// set of lamps, PreserveSymmetry stores only the planes of the symmetric range.
+func ConvertEulumdatToXML(eulumdat *Eulumdat, opts ConversionOptions) (*XMLPhotometry, error) {
+	opts.Format = IESFormatLM_63_2002
+	ies, err := ConvertEulumdatToIES(eulumdat, opts)
+	if err != nil {
+		return nil, err
+	}
+	photometry, err := ConvertIESToXML(ies)
+	if err != nil {
+		return nil, err
+	}
+
+	opts = opts.withDefaults()
+	photometry.Header.Manufacturer = eulumdat.CompanyIdentification
+	photometry.Header.Laboratory = ""
+	photometry.Luminaire.Length = eulumdat.LengthDiameter
+	photometry.Luminaire.Width = eulumdat.WidthLuminaire
+	photometry.Luminaire.Height = eulumdat.HeightLuminaire
+	photometry.Emitter.ColorTemperature = eulumdat.ColorTemperature[opts.LampSet]
+	photometry.Emitter.ColorRendering = eulumdat.ColorRenderingIndexCRI[opts.LampSet]
+
+	return photometry, nil
+}
+
+// ConvertXMLToEulumdat converts XML photometric data to EULUMDAT data using the given options.
+func ConvertXMLToEulumdat(photometry *XMLPhotometry, opts ConversionOptions) (*Eulumdat, error) {
+	ies, err := ConvertXMLToIES(photometry)
+	if err != nil {
+		return nil, err
+	}
+	eulumdat, err := ConvertIESToEulumdat(ies, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	eulumdat.ColorTemperature[0] = photometry.Emitter.ColorTemperature
+	eulumdat.ColorRenderingIndexCRI[0] = photometry.Emitter.ColorRendering
+
+	return eulumdat, nil
+}
@@ -0,0 +1,78 @@
+package eulumies
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestXMLPhotometry_RoundTrip(t *testing.T) {
+	eulumdat := loadSample2(t)
+
+	photometry, err := ConvertEulumdatToXML(&eulumdat, ConversionOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, eulumdat.LengthDiameter, photometry.Luminaire.Length)
+	assert.Equal(t, eulumdat.ColorTemperature[0], photometry.Emitter.ColorTemperature)
+
+	var buf bytes.Buffer
+	assert.NoError(t, photometry.Export(&buf))
+	assert.True(t, strings.HasPrefix(buf.String(), "<?xml"))
+	assert.Contains(t, buf.String(), "<LuminaireOpticalData>")
+
+	parsed, err := NewXMLPhotometry(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, photometry.Header, parsed.Header)
+	assert.Equal(t, photometry.Luminaire, parsed.Luminaire)
+	assert.Equal(t, photometry.Emitter, parsed.Emitter)
+
+	converted, err := ConvertXMLToEulumdat(parsed, ConversionOptions{})
+	assert.NoError(t, err)
+	assert.InDelta(t, eulumdat.ComputeTotalFlux(), converted.ComputeTotalFlux(), 1e-6*eulumdat.ComputeTotalFlux())
+	assert.Equal(t, eulumdat.ColorTemperature[0], converted.ColorTemperature[0])
+	assert.Equal(t, eulumdat.ColorRenderingIndexCRI[0], converted.ColorRenderingIndexCRI[0])
+}
+
+func TestConvertXMLToIES(t *testing.T) {
+	file, err := os.Open("test/sample.ies")
+	assert.NoError(t, err)
+	defer file.Close()
+	ies, err := NewIESFromReader(file, false)
+	assert.NoError(t, err)
+
+	photometry, err := ConvertIESToXML(ies)
+	assert.NoError(t, err)
+	assert.Len(t, photometry.Emitter.Distribution.Intensities, ies.NumberHorizontalAngles*ies.NumberVerticalAngles)
+
+	converted, err := ConvertXMLToIES(photometry)
+	assert.NoError(t, err)
+	assert.NoError(t, converted.Validate(false).Err())
+	assert.Equal(t, ies.HorizontalAngles, converted.HorizontalAngles)
+	assert.Equal(t, ies.VerticalAngles, converted.VerticalAngles)
+	assert.InDelta(t, ies.ComputeTotalFlux(), converted.ComputeTotalFlux(), 1e-6*ies.ComputeTotalFlux())
+	assert.Equal(t, ies.Keywords["MANUFAC"], converted.Keywords["MANUFAC"])
+	assert.InDelta(t, ies.lampLumens(), converted.lampLumens(), 1e-9)
+}
+
+func TestNewXMLPhotometry_Invalid(t *testing.T) {
+	_, err := NewXMLPhotometry(strings.NewReader(`<LuminaireOpticalData><Emitter><IntensityDistribution>` +
+		`<PhotometryType>CIE-A</PhotometryType><IntData horz="0" vert="0">1</IntData>` +
+		`</IntensityDistribution></Emitter></LuminaireOpticalData>`))
+	assert.Error(t, err)
+
+	_, err = NewXMLPhotometry(strings.NewReader(`<LuminaireOpticalData><Emitter><IntensityDistribution>` +
+		`<IntData horz="0" vert="0">1</IntData><IntData horz="0" vert="90">1</IntData>` +
+		`<IntData horz="90" vert="0">1</IntData></IntensityDistribution></Emitter></LuminaireOpticalData>`))
+	assert.Error(t, err)
+
+	_, err = NewXMLPhotometry(strings.NewReader(`<LuminaireOpticalData></LuminaireOpticalData>`))
+	assert.Error(t, err)
+
+	photometry, err := NewXMLPhotometry(strings.NewReader(`<LuminaireOpticalData><Unknown/><Emitter>` +
+		`<IntensityDistribution><IntData horz="0" vert="0">10</IntData><IntData horz="0" vert="90">5</IntData>` +
+		`</IntensityDistribution></Emitter></LuminaireOpticalData>`))
+	assert.NoError(t, err)
+	assert.Len(t, photometry.Emitter.Distribution.Intensities, 2)
+}